@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 
+	"github.com/weaveworks/eksctl/pkg/ctl/associate"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/completion"
 	"github.com/weaveworks/eksctl/pkg/ctl/create"
@@ -15,18 +15,30 @@ import (
 	"github.com/weaveworks/eksctl/pkg/ctl/enable"
 	"github.com/weaveworks/eksctl/pkg/ctl/generate"
 	"github.com/weaveworks/eksctl/pkg/ctl/get"
+	"github.com/weaveworks/eksctl/pkg/ctl/refresh"
+	"github.com/weaveworks/eksctl/pkg/ctl/register"
 	"github.com/weaveworks/eksctl/pkg/ctl/scale"
+	"github.com/weaveworks/eksctl/pkg/ctl/set"
+	"github.com/weaveworks/eksctl/pkg/ctl/unset"
 	"github.com/weaveworks/eksctl/pkg/ctl/update"
+	"github.com/weaveworks/eksctl/pkg/ctl/upgrade"
 	"github.com/weaveworks/eksctl/pkg/ctl/utils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
 	rootCmd.AddCommand(create.Command(flagGrouping))
 	rootCmd.AddCommand(get.Command(flagGrouping))
 	rootCmd.AddCommand(update.Command(flagGrouping))
+	rootCmd.AddCommand(upgrade.Command(flagGrouping))
+	rootCmd.AddCommand(refresh.Command(flagGrouping))
+	rootCmd.AddCommand(register.Command(flagGrouping))
 	rootCmd.AddCommand(delete.Command(flagGrouping))
 	rootCmd.AddCommand(scale.Command(flagGrouping))
 	rootCmd.AddCommand(drain.Command(flagGrouping))
+	rootCmd.AddCommand(set.Command(flagGrouping))
+	rootCmd.AddCommand(unset.Command(flagGrouping))
+	rootCmd.AddCommand(associate.Command(flagGrouping))
 	if os.Getenv("EKSCTL_EXPERIMENTAL") == "true" {
 		rootCmd.AddCommand(generate.Command(flagGrouping))
 		rootCmd.AddCommand(enable.Command(flagGrouping))
@@ -56,20 +68,27 @@ func main() {
 	rootCmd.PersistentFlags().BoolP("help", "h", false, "help for this command")
 	rootCmd.PersistentFlags().IntVarP(&logger.Level, "verbose", "v", 3, "set log level, use 0 to silence, 4 for debugging and 5 for debugging with AWS debug logging")
 
-	colorValue := rootCmd.PersistentFlags().StringP("color", "C", "true", "toggle colorized logs (valid options: true, false, fabulous)")
+	loggerOptions := cmdutils.LoggerOptions{}
+
+	rootCmd.PersistentFlags().StringVarP(&loggerOptions.Color, "color", "C", "true", "toggle colorized logs (valid options: true, false, fabulous)")
+	rootCmd.PersistentFlags().StringVar(&loggerOptions.Format, "log-format", cmdutils.LogFormatText, fmt.Sprintf("format of logs, JSON logs are written to stderr (valid options: %s, %s)", cmdutils.LogFormatText, cmdutils.LogFormatJSON))
+	rootCmd.PersistentFlags().BoolVarP(&loggerOptions.Quiet, "quiet", "q", false, "only log errors and successes")
 
 	cobra.OnInitialize(func() {
-		// Control colored output
-		logger.Color = *colorValue == "true"
-		logger.Fabulous = *colorValue == "fabulous"
-		// Add timestamps for debugging
-		logger.Timestamps = logger.Level >= 4
+		if err := cmdutils.ConfigureLogger(loggerOptions); err != nil {
+			fmt.Println(err)
+			os.Exit(-1)
+		}
 	})
 
 	rootCmd.SetUsageFunc(flagGrouping.Usage)
 
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err)
+		if loggerOptions.Format == cmdutils.LogFormatJSON {
+			logger.Critical("%s", err.Error())
+		} else {
+			fmt.Println(err)
+		}
 		os.Exit(-1)
 	}
 }
@@ -19,7 +19,7 @@ func main() {
 	var document strings.Builder
 	document.WriteString(`---
 title: Config file schema
-weight: 200
+weight: 210
 url: usage/schema
 ---
 
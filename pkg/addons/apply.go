@@ -0,0 +1,29 @@
+package addons
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// applyManifest creates or updates all resources in the manifest of an addon
+func applyManifest(rawClient kubernetes.RawClientInterface, name string, manifest []byte, plan bool) error {
+	list, err := kubernetes.NewList(manifest)
+	if err != nil {
+		return errors.Wrapf(err, "loading %q manifest", name)
+	}
+
+	for _, item := range list.Items {
+		resource, err := rawClient.NewRawResource(item.Object)
+		if err != nil {
+			return err
+		}
+		status, err := resource.CreateOrReplace(plan)
+		if err != nil {
+			return errors.Wrapf(err, "installing %q", name)
+		}
+		logger.Info(status)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package addons
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	// ClusterAutoscaler is the name of the cluster-autoscaler addon
+	ClusterAutoscaler = "cluster-autoscaler"
+
+	// ClusterAutoscalerManifestFileName is the name of the file the manifest
+	// is written to when it gets committed to a gitops repository
+	ClusterAutoscalerManifestFileName = ClusterAutoscaler + ".yaml"
+)
+
+// clusterAutoscalerImages are the cluster-autoscaler releases that match each
+// Kubernetes version, see https://github.com/kubernetes/autoscaler/releases
+var clusterAutoscalerImages = map[string]string{
+	api.Version1_11: "k8s.gcr.io/cluster-autoscaler:v1.3.9",
+	api.Version1_12: "k8s.gcr.io/cluster-autoscaler:v1.12.8",
+	api.Version1_13: "k8s.gcr.io/cluster-autoscaler:v1.13.9",
+	api.Version1_14: "k8s.gcr.io/cluster-autoscaler:v1.14.7",
+}
+
+// ClusterAutoscalerImage returns the cluster-autoscaler image that matches the
+// given Kubernetes version, or the one of the latest version
+func ClusterAutoscalerImage(version string) string {
+	if image, ok := clusterAutoscalerImages[version]; ok {
+		return image
+	}
+	return clusterAutoscalerImages[api.LatestVersion]
+}
+
+// clusterAutoscalerManifest is based on the upstream manifest for auto-discovery from
+// https://github.com/kubernetes/autoscaler/tree/master/cluster-autoscaler/cloudprovider/aws;
+// the service account is not part of it, as it's created as an iamserviceaccount
+var clusterAutoscalerManifest = template.Must(template.New(ClusterAutoscaler).Parse(`---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: cluster-autoscaler
+  labels:
+    k8s-addon: cluster-autoscaler.addons.k8s.io
+    k8s-app: cluster-autoscaler
+rules:
+- apiGroups: [""]
+  resources: ["events", "endpoints"]
+  verbs: ["create", "patch"]
+- apiGroups: [""]
+  resources: ["pods/eviction"]
+  verbs: ["create"]
+- apiGroups: [""]
+  resources: ["pods/status"]
+  verbs: ["update"]
+- apiGroups: [""]
+  resources: ["endpoints"]
+  resourceNames: ["cluster-autoscaler"]
+  verbs: ["get", "update"]
+- apiGroups: [""]
+  resources: ["nodes"]
+  verbs: ["watch", "list", "get", "update"]
+- apiGroups: [""]
+  resources: ["pods", "services", "replicationcontrollers", "persistentvolumeclaims", "persistentvolumes"]
+  verbs: ["watch", "list", "get"]
+- apiGroups: ["extensions"]
+  resources: ["replicasets", "daemonsets"]
+  verbs: ["watch", "list", "get"]
+- apiGroups: ["policy"]
+  resources: ["poddisruptionbudgets"]
+  verbs: ["watch", "list"]
+- apiGroups: ["apps"]
+  resources: ["statefulsets", "replicasets", "daemonsets"]
+  verbs: ["watch", "list", "get"]
+- apiGroups: ["storage.k8s.io"]
+  resources: ["storageclasses", "csinodes"]
+  verbs: ["watch", "list", "get"]
+- apiGroups: ["batch", "extensions"]
+  resources: ["jobs"]
+  verbs: ["get", "list", "watch", "patch"]
+- apiGroups: ["coordination.k8s.io"]
+  resources: ["leases"]
+  verbs: ["create"]
+- apiGroups: ["coordination.k8s.io"]
+  resourceNames: ["cluster-autoscaler"]
+  resources: ["leases"]
+  verbs: ["get", "update"]
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: cluster-autoscaler
+  namespace: kube-system
+  labels:
+    k8s-addon: cluster-autoscaler.addons.k8s.io
+    k8s-app: cluster-autoscaler
+rules:
+- apiGroups: [""]
+  resources: ["configmaps"]
+  verbs: ["create", "list", "watch"]
+- apiGroups: [""]
+  resources: ["configmaps"]
+  resourceNames: ["cluster-autoscaler-status", "cluster-autoscaler-priority-expander"]
+  verbs: ["delete", "get", "update", "watch"]
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: cluster-autoscaler
+  labels:
+    k8s-addon: cluster-autoscaler.addons.k8s.io
+    k8s-app: cluster-autoscaler
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: cluster-autoscaler
+subjects:
+- kind: ServiceAccount
+  name: {{ .ServiceAccountName }}
+  namespace: kube-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: cluster-autoscaler
+  namespace: kube-system
+  labels:
+    k8s-addon: cluster-autoscaler.addons.k8s.io
+    k8s-app: cluster-autoscaler
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: cluster-autoscaler
+subjects:
+- kind: ServiceAccount
+  name: {{ .ServiceAccountName }}
+  namespace: kube-system
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: cluster-autoscaler
+  namespace: kube-system
+  labels:
+    app: cluster-autoscaler
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: cluster-autoscaler
+  template:
+    metadata:
+      labels:
+        app: cluster-autoscaler
+      annotations:
+        cluster-autoscaler.kubernetes.io/safe-to-evict: "false"
+    spec:
+      serviceAccountName: {{ .ServiceAccountName }}
+      priorityClassName: system-cluster-critical
+      securityContext:
+        # allows the process to read the web identity token of the iamserviceaccount
+        fsGroup: 65534
+      containers:
+      - image: {{ .Image }}
+        name: cluster-autoscaler
+        resources:
+          limits:
+            cpu: 100m
+            memory: 300Mi
+          requests:
+            cpu: 100m
+            memory: 300Mi
+        command:
+        - ./cluster-autoscaler
+        - --v=4
+        - --stderrthreshold=info
+        - --cloud-provider=aws
+        - --skip-nodes-with-local-storage=false
+        - --expander=least-waste
+        - --node-group-auto-discovery=asg:tag=k8s.io/cluster-autoscaler/enabled,k8s.io/cluster-autoscaler/{{ .ClusterName }}
+        - --balance-similar-node-groups
+        - --skip-nodes-with-system-pods=false
+        env:
+        - name: AWS_REGION
+          value: {{ .Region }}
+        volumeMounts:
+        - name: ssl-certs
+          mountPath: /etc/ssl/certs/ca-certificates.crt
+          readOnly: true
+      volumes:
+      - name: ssl-certs
+        hostPath:
+          path: /etc/ssl/certs/ca-bundle.crt
+`))
+
+// ClusterAutoscalerManifest returns the manifest of cluster-autoscaler for the cluster
+func ClusterAutoscalerManifest(cfg *api.ClusterConfig) ([]byte, error) {
+	image := cfg.ClusterAutoscaler.Image
+	if image == "" {
+		image = ClusterAutoscalerImage(cfg.Metadata.Version)
+	}
+
+	manifest := &bytes.Buffer{}
+	err := clusterAutoscalerManifest.Execute(manifest, struct {
+		ClusterName, Region, Image, ServiceAccountName string
+	}{
+		ClusterName:        cfg.Metadata.Name,
+		Region:             cfg.Metadata.Region,
+		Image:              image,
+		ServiceAccountName: api.ClusterAutoscalerServiceAccountName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "rendering %q manifest", ClusterAutoscaler)
+	}
+	return manifest.Bytes(), nil
+}
+
+// InstallClusterAutoscaler creates or updates cluster-autoscaler, which scales
+// the nodegroups of the cluster according to the pods that need to be scheduled
+func InstallClusterAutoscaler(rawClient kubernetes.RawClientInterface, cfg *api.ClusterConfig, plan bool) error {
+	manifest, err := ClusterAutoscalerManifest(cfg)
+	if err != nil {
+		return err
+	}
+	return applyManifest(rawClient, ClusterAutoscaler, manifest, plan)
+}
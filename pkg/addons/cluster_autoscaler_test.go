@@ -0,0 +1,61 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("cluster-autoscaler", func() {
+	var (
+		rawClient *testutils.FakeRawClient
+		cfg       *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.AssumeObjectsMissing = true
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "eu-west-1"
+		cfg.Metadata.Version = api.Version1_13
+		cfg.ClusterAutoscaler = &api.ClusterAutoscaler{Enabled: api.Enabled()}
+	})
+
+	It("creates cluster-autoscaler for the nodegroups of the cluster", func() {
+		Expect(InstallClusterAutoscaler(rawClient, cfg, false)).To(Succeed())
+
+		Expect(rawClient.Collection.CreatedItems()).To(HaveLen(5))
+
+		deployment, err := rawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get("cluster-autoscaler", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		podSpec := deployment.Spec.Template.Spec
+		Expect(podSpec.ServiceAccountName).To(Equal("cluster-autoscaler"))
+
+		container := podSpec.Containers[0]
+		Expect(container.Image).To(Equal("k8s.gcr.io/cluster-autoscaler:v1.13.9"))
+		Expect(container.Command).To(ContainElement("--node-group-auto-discovery=asg:tag=k8s.io/cluster-autoscaler/enabled,k8s.io/cluster-autoscaler/test-cluster"))
+		Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "AWS_REGION", Value: "eu-west-1"}))
+	})
+
+	It("uses the given image", func() {
+		cfg.ClusterAutoscaler.Image = "example.com/cluster-autoscaler:v1.13.0"
+		Expect(InstallClusterAutoscaler(rawClient, cfg, false)).To(Succeed())
+
+		deployment, err := rawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get("cluster-autoscaler", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("example.com/cluster-autoscaler:v1.13.0"))
+	})
+
+	It("uses the image of the latest version for unknown versions", func() {
+		Expect(ClusterAutoscalerImage("1.99")).To(Equal(ClusterAutoscalerImage(api.LatestVersion)))
+	})
+})
@@ -0,0 +1,120 @@
+package addons
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	awsNode = "aws-node"
+
+	// CustomNetworkConfigEnv is the aws-node environment variable that enables custom networking
+	CustomNetworkConfigEnv = "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG"
+	// ENIConfigLabelDefEnv is the aws-node environment variable that sets the node label used to pick an ENIConfig
+	ENIConfigLabelDefEnv = "ENI_CONFIG_LABEL_DEF"
+
+	// ENIConfigs are named after availability zones, so nodes pick them based on the zone label
+	eniConfigLabelDef = "failure-domain.beta.kubernetes.io/zone"
+)
+
+var eniConfigResource = schema.GroupVersionResource{
+	Group:    "crd.k8s.amazonaws.com",
+	Version:  "v1alpha1",
+	Resource: "eniconfigs",
+}
+
+// ConfigureCustomNetworking creates an ENIConfig for each of the pod subnets and
+// configures aws-node to use them, it must be done before any nodes join the cluster,
+// as the configuration only takes effect on nodes that start after it's applied
+func ConfigureCustomNetworking(clientSet kubernetes.Interface, dynamicClient dynamic.Interface, spec *api.ClusterConfig) error {
+	if !spec.HasCustomNetworking() {
+		return nil
+	}
+
+	eniConfigs := dynamicClient.Resource(eniConfigResource)
+	for az, subnet := range spec.VPC.CustomNetworking.PodSubnets {
+		eniConfig := newENIConfig(az, subnet.ID, spec.VPC.SharedNodeSecurityGroup)
+
+		existing, err := eniConfigs.Get(az, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			if _, err := eniConfigs.Create(eniConfig, metav1.CreateOptions{}); err != nil {
+				return errors.Wrapf(err, "creating ENIConfig %q", az)
+			}
+			logger.Info("created ENIConfig %q using subnet %q", az, subnet.ID)
+		case err != nil:
+			return errors.Wrapf(err, "getting ENIConfig %q", az)
+		default:
+			eniConfig.SetResourceVersion(existing.GetResourceVersion())
+			if _, err := eniConfigs.Update(eniConfig, metav1.UpdateOptions{}); err != nil {
+				return errors.Wrapf(err, "updating ENIConfig %q", az)
+			}
+			logger.Info("updated ENIConfig %q to use subnet %q", az, subnet.ID)
+		}
+	}
+
+	return enableCustomNetworkingForAWSNode(clientSet)
+}
+
+func newENIConfig(name, subnetID, securityGroupID string) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"subnet": subnetID,
+	}
+	if securityGroupID != "" {
+		spec["securityGroups"] = []interface{}{securityGroupID}
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": eniConfigResource.GroupVersion().String(),
+			"kind":       "ENIConfig",
+			"metadata": map[string]interface{}{
+				"name": name,
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func enableCustomNetworkingForAWSNode(clientSet kubernetes.Interface) error {
+	daemonSets := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem)
+
+	ds, err := daemonSets.Get(awsNode, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "getting %q", awsNode)
+	}
+
+	for i := range ds.Spec.Template.Spec.Containers {
+		container := &ds.Spec.Template.Spec.Containers[i]
+		if container.Name != awsNode {
+			continue
+		}
+		container.Env = setEnvVar(container.Env, CustomNetworkConfigEnv, "true")
+		container.Env = setEnvVar(container.Env, ENIConfigLabelDefEnv, eniConfigLabelDef)
+	}
+
+	if _, err := daemonSets.Update(ds); err != nil {
+		return errors.Wrapf(err, "updating %q", awsNode)
+	}
+	logger.Info("enabled custom networking in %q", awsNode)
+	return nil
+}
+
+func setEnvVar(env []corev1.EnvVar, name, value string) []corev1.EnvVar {
+	for i := range env {
+		if env[i].Name == name {
+			env[i].Value = value
+			env[i].ValueFrom = nil
+			return env
+		}
+	}
+	return append(env, corev1.EnvVar{Name: name, Value: value})
+}
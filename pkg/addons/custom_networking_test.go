@@ -0,0 +1,98 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("VPC CNI custom networking", func() {
+	var (
+		clientSet     *fake.Clientset
+		dynamicClient *dynamicfake.FakeDynamicClient
+		cfg           *api.ClusterConfig
+	)
+
+	eniConfigs := schema.GroupVersionResource{Group: "crd.k8s.amazonaws.com", Version: "v1alpha1", Resource: "eniconfigs"}
+
+	BeforeEach(func() {
+		clientSet = fake.NewSimpleClientset(&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "aws-node",
+				Namespace: metav1.NamespaceSystem,
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name: "aws-node",
+							Env: []corev1.EnvVar{
+								{Name: "AWS_VPC_K8S_CNI_LOGLEVEL", Value: "DEBUG"},
+								{Name: CustomNetworkConfigEnv, Value: "false"},
+							},
+						}},
+					},
+				},
+			},
+		})
+
+		existing := &unstructured.Unstructured{}
+		existing.SetAPIVersion("crd.k8s.amazonaws.com/v1alpha1")
+		existing.SetKind("ENIConfig")
+		existing.SetName("us-west-2b")
+		Expect(unstructured.SetNestedField(existing.Object, "subnet-old", "spec", "subnet")).To(Succeed())
+		dynamicClient = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), existing)
+
+		cfg = api.NewClusterConfig()
+		cfg.VPC.SharedNodeSecurityGroup = "sg-shared"
+		cfg.VPC.CustomNetworking = &api.ClusterCustomNetworking{
+			PodSubnets: map[string]api.Network{
+				"us-west-2a": {ID: "subnet-pod-a"},
+				"us-west-2b": {ID: "subnet-pod-b"},
+			},
+		}
+	})
+
+	It("should create or update an ENIConfig for each pod subnet", func() {
+		Expect(ConfigureCustomNetworking(clientSet, dynamicClient, cfg)).To(Succeed())
+
+		for az, subnetID := range map[string]string{"us-west-2a": "subnet-pod-a", "us-west-2b": "subnet-pod-b"} {
+			eniConfig, err := dynamicClient.Resource(eniConfigs).Get(az, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			subnet, _, _ := unstructured.NestedString(eniConfig.Object, "spec", "subnet")
+			Expect(subnet).To(Equal(subnetID))
+			securityGroups, _, _ := unstructured.NestedStringSlice(eniConfig.Object, "spec", "securityGroups")
+			Expect(securityGroups).To(Equal([]string{"sg-shared"}))
+		}
+	})
+
+	It("should enable custom networking in aws-node", func() {
+		Expect(ConfigureCustomNetworking(clientSet, dynamicClient, cfg)).To(Succeed())
+
+		awsNode, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get("aws-node", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(awsNode.Spec.Template.Spec.Containers[0].Env).To(Equal([]corev1.EnvVar{
+			{Name: "AWS_VPC_K8S_CNI_LOGLEVEL", Value: "DEBUG"},
+			{Name: CustomNetworkConfigEnv, Value: "true"},
+			{Name: ENIConfigLabelDefEnv, Value: "failure-domain.beta.kubernetes.io/zone"},
+		}))
+	})
+
+	It("should do nothing when custom networking is not configured", func() {
+		cfg.VPC.CustomNetworking = nil
+		Expect(ConfigureCustomNetworking(clientSet, dynamicClient, cfg)).To(Succeed())
+		Expect(clientSet.Actions()).To(BeEmpty())
+	})
+})
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	appsv1 "k8s.io/api/apps/v1"
@@ -12,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -22,9 +22,16 @@ const (
 	awsNodeImageSuffix    = ".amazonaws.com/amazon-k8s-cni"
 )
 
+// awsNodePreservedEnvVars are set on aws-node by eksctl when custom networking is configured,
+// they need to be carried over, as the manifest would otherwise silently disable it
+var awsNodePreservedEnvVars = []string{
+	"AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG",
+	"ENI_CONFIG_LABEL_DEF",
+}
+
 // UpdateAWSNode will update the `aws-node` add-on
 func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region string, plan bool) (bool, error) {
-	_, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get(AWSNode, metav1.GetOptions{})
+	existing, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get(AWSNode, metav1.GetOptions{})
 	if err != nil {
 		if apierrs.IsNotFound(err) {
 			logger.Warning("%q was not found", AWSNode)
@@ -39,13 +46,22 @@ func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region string, plan
 		return false, err
 	}
 
+	currentImage := ""
+	if len(existing.Spec.Template.Spec.Containers) > 0 {
+		currentImage = existing.Spec.Template.Spec.Containers[0].Image
+	}
+	updateRequired := false
+
 	for _, rawObj := range list.Items {
 		resource, err := rawClient.NewRawResource(rawObj.Object)
 		if err != nil {
 			return false, err
 		}
 		if resource.GVK.Kind == "DaemonSet" {
-			image := &resource.Info.Object.(*appsv1.DaemonSet).Spec.Template.Spec.Containers[0].Image
+			daemonSet := resource.Info.Object.(*appsv1.DaemonSet)
+			preserveEnvVars(existing, daemonSet)
+
+			image := &daemonSet.Spec.Template.Spec.Containers[0].Image
 			imageParts := strings.Split(*image, ":")
 
 			if len(imageParts) != 2 {
@@ -55,6 +71,7 @@ func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region string, plan
 			if strings.HasSuffix(imageParts[0], awsNodeImageSuffix) {
 				*image = awsNodeImagePrefix + region + awsNodeImageSuffix + ":" + imageParts[1]
 			}
+			logImageUpdate(AWSNode, currentImage, *image)
 		}
 
 		if resource.GVK.Kind == "CustomResourceDefinition" && plan {
@@ -64,6 +81,14 @@ func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region string, plan
 			continue
 		}
 
+		if plan {
+			changed, err := logResourceDiff(resource)
+			if err != nil {
+				return false, err
+			}
+			updateRequired = updateRequired || changed
+		}
+
 		status, err := resource.CreateOrReplace(plan)
 		if err != nil {
 			return false, err
@@ -72,6 +97,10 @@ func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region string, plan
 	}
 
 	if plan {
+		if !updateRequired {
+			logger.Info("%q is already up-to-date", AWSNode)
+			return false, nil
+		}
 		logger.Critical("(plan) %q is not up-to-date", AWSNode)
 		return true, nil
 	}
@@ -79,3 +108,27 @@ func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region string, plan
 	logger.Info("%q is now up-to-date", AWSNode)
 	return false, nil
 }
+
+func preserveEnvVars(existing, updated *appsv1.DaemonSet) {
+	if len(existing.Spec.Template.Spec.Containers) == 0 {
+		return
+	}
+	container := &updated.Spec.Template.Spec.Containers[0]
+	for _, envVar := range existing.Spec.Template.Spec.Containers[0].Env {
+		for _, name := range awsNodePreservedEnvVars {
+			if envVar.Name != name {
+				continue
+			}
+			replaced := false
+			for i := range container.Env {
+				if container.Env[i].Name == name {
+					container.Env[i] = envVar
+					replaced = true
+				}
+			}
+			if !replaced {
+				container.Env = append(container.Env, envVar)
+			}
+		}
+	}
+}
@@ -86,5 +86,37 @@ var _ = Describe("default addons - aws-node", func() {
 				Equal("602401143452.dkr.ecr.us-east-1.amazonaws.com/amazon-k8s-cni:v1.5.0"),
 			)
 		})
+
+	})
+
+	Describe("can plan an update of aws-node add-on", func() {
+		var rawClient *testutils.FakeRawClient
+
+		BeforeEach(func() {
+			rawClient = testutils.NewFakeRawClient()
+			rawClient.UseUnionTracker = true
+
+			for _, item := range testutils.LoadSamples("testdata/sample-1.12.json") {
+				rc, err := rawClient.NewRawResource(item)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = rc.CreateOrReplace(false)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("reports an update when the manifest differs from the live objects", func() {
+			updateRequired, err := UpdateAWSNode(rawClient, "eu-west-1", true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeTrue())
+		})
+
+		It("reports no update once the manifest has been applied", func() {
+			_, err := UpdateAWSNode(rawClient, "eu-west-1", false)
+			Expect(err).ToNot(HaveOccurred())
+
+			updateRequired, err := UpdateAWSNode(rawClient, "eu-west-1", true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
+		})
 	})
 })
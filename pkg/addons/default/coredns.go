@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -13,6 +12,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -36,7 +36,7 @@ func UpdateCoreDNS(rawClient kubernetes.RawClientInterface, region, controlPlane
 		return false, errors.Wrapf(err, "getting %q service", KubeDNS)
 	}
 
-	_, err = rawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get(CoreDNS, metav1.GetOptions{})
+	existing, err := rawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get(CoreDNS, metav1.GetOptions{})
 	if err != nil {
 		if apierrs.IsNotFound(err) {
 			logger.Warning("%q was not found", CoreDNS)
@@ -51,6 +51,12 @@ func UpdateCoreDNS(rawClient kubernetes.RawClientInterface, region, controlPlane
 		return false, err
 	}
 
+	currentImage := ""
+	if len(existing.Spec.Template.Spec.Containers) > 0 {
+		currentImage = existing.Spec.Template.Spec.Containers[0].Image
+	}
+	updateRequired := false
+
 	for _, rawObj := range list.Items {
 		resource, err := rawClient.NewRawResource(rawObj.Object)
 		if err != nil {
@@ -62,18 +68,27 @@ func UpdateCoreDNS(rawClient kubernetes.RawClientInterface, region, controlPlane
 			imageParts := strings.Split(*image, ":")
 
 			if len(imageParts) != 2 {
-				return false, fmt.Errorf("unexpected image format %q for %q", *image, KubeProxy)
+				return false, fmt.Errorf("unexpected image format %q for %q", *image, CoreDNS)
 			}
 
 			coreDNSImagePrefix := fmt.Sprintf(coreDNSImagePrefixPTN, api.EKSResourceAccountID(region))
 			if strings.HasSuffix(imageParts[0], coreDNSImageSuffix) {
 				*image = coreDNSImagePrefix + region + coreDNSImageSuffix + ":" + imageParts[1]
 			}
+			logImageUpdate(CoreDNS, currentImage, *image)
 		case "Service":
 			resource.Info.Object.(*corev1.Service).SetResourceVersion(kubeDNSSevice.GetResourceVersion())
 			resource.Info.Object.(*corev1.Service).Spec.ClusterIP = kubeDNSSevice.Spec.ClusterIP
 		}
 
+		if plan {
+			changed, err := logResourceDiff(resource)
+			if err != nil {
+				return false, err
+			}
+			updateRequired = updateRequired || changed
+		}
+
 		status, err := resource.CreateOrReplace(plan)
 		if err != nil {
 			return false, err
@@ -82,6 +97,10 @@ func UpdateCoreDNS(rawClient kubernetes.RawClientInterface, region, controlPlane
 	}
 
 	if plan {
+		if !updateRequired {
+			logger.Info("%q is already up-to-date", CoreDNS)
+			return false, nil
+		}
 		logger.Critical("(plan) %q is not up-to-date", CoreDNS)
 		return true, nil
 	}
@@ -1,8 +1,11 @@
 package defaultaddons
 
 import (
+	"strings"
+
 	"github.com/pkg/errors"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -19,3 +22,27 @@ func LoadAsset(name, ext string) (*metav1.List, error) {
 	}
 	return list, nil
 }
+
+// logImageUpdate shows how the image of an add-on is going to change,
+// and returns whether it differs from the image currently in use
+func logImageUpdate(addon, currentImage, desiredImage string) bool {
+	if currentImage == desiredImage {
+		logger.Info("%q is using the desired image %q", addon, desiredImage)
+		return false
+	}
+	logger.Info("%q image: %q -> %q", addon, currentImage, desiredImage)
+	return true
+}
+
+// logResourceDiff shows how a resource is going to change, and returns
+// whether it differs from the live object
+func logResourceDiff(resource *kubernetes.RawResource) (bool, error) {
+	diff, changed, err := resource.Diff()
+	if err != nil {
+		return false, errors.Wrapf(err, "comparing %q with the live object", resource)
+	}
+	if diff != "" {
+		logger.Info("(plan) %q differs from the live object (a: live, b: manifest):\n%s", resource, strings.TrimSpace(diff))
+	}
+	return changed, nil
+}
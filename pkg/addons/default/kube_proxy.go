@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
 
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
@@ -47,8 +47,9 @@ func UpdateKubeProxyImageTag(clientSet kubernetes.Interface, controlPlaneVersion
 	}
 
 	desiredTag := "v" + controlPlaneVersion
+	desiredImage := imageParts[0] + ":" + desiredTag
 
-	if imageParts[1] == desiredTag {
+	if !logImageUpdate(KubeProxy, *image, desiredImage) {
 		logger.Debug("imageParts = %v, desiredTag = %s", imageParts, desiredTag)
 		logger.Info("%q is already up-to-date", KubeProxy)
 		return false, nil
@@ -59,8 +60,7 @@ func UpdateKubeProxyImageTag(clientSet kubernetes.Interface, controlPlaneVersion
 		return true, nil
 	}
 
-	imageParts[1] = desiredTag
-	*image = strings.Join(imageParts, ":")
+	*image = desiredImage
 
 	if err := printer.LogObj(logger.Debug, KubeProxy+" [updated] = \\\n%s\n", d); err != nil {
 		return false, err
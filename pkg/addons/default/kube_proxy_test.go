@@ -44,8 +44,16 @@ var _ = Describe("default addons - kube-proxy", func() {
 		})
 
 		It("can dry-run update based on control plane version", func() {
-			_, err := UpdateKubeProxyImageTag(clientSet, "1.13.1", true)
+			updateRequired, err := UpdateKubeProxyImageTag(clientSet, "1.13.1", true)
 			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeTrue())
+			check("v1.12.6")
+		})
+
+		It("does not require an update when the image matches the control plane version", func() {
+			updateRequired, err := UpdateKubeProxyImageTag(clientSet, "1.12.6", true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
 			check("v1.12.6")
 		})
 	})
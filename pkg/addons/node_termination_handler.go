@@ -0,0 +1,181 @@
+package addons
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	// NodeTerminationHandler is the name of the AWS Node Termination Handler addon
+	NodeTerminationHandler = "aws-node-termination-handler"
+
+	// NodeTerminationHandlerManifestFileName is the name of the file the manifest
+	// is written to when it gets committed to a gitops repository
+	NodeTerminationHandlerManifestFileName = NodeTerminationHandler + ".yaml"
+
+	// NodeTerminationHandlerImage is the image of the AWS Node Termination Handler,
+	// see https://github.com/aws/aws-node-termination-handler/releases
+	NodeTerminationHandlerImage = "public.ecr.aws/aws-ec2/aws-node-termination-handler:v1.20.0"
+)
+
+// nodeTerminationHandlerManifest is based on the upstream manifest of the handler in
+// IMDS mode, where it runs on every node and watches the instance metadata for spot
+// interruption notices, scheduled events and the termination by the auto scaling group
+var nodeTerminationHandlerManifest = template.Must(template.New(NodeTerminationHandler).Parse(`---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: aws-node-termination-handler
+  namespace: kube-system
+  labels:
+    app.kubernetes.io/name: aws-node-termination-handler
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: aws-node-termination-handler
+  labels:
+    app.kubernetes.io/name: aws-node-termination-handler
+rules:
+- apiGroups: [""]
+  resources: ["nodes"]
+  verbs: ["get", "list", "patch", "update"]
+- apiGroups: [""]
+  resources: ["pods"]
+  verbs: ["get", "list"]
+- apiGroups: [""]
+  resources: ["pods/eviction"]
+  verbs: ["create"]
+- apiGroups: ["extensions", "apps"]
+  resources: ["daemonsets"]
+  verbs: ["get"]
+- apiGroups: [""]
+  resources: ["events"]
+  verbs: ["create"]
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: aws-node-termination-handler
+  labels:
+    app.kubernetes.io/name: aws-node-termination-handler
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: aws-node-termination-handler
+subjects:
+- kind: ServiceAccount
+  name: aws-node-termination-handler
+  namespace: kube-system
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: aws-node-termination-handler
+  namespace: kube-system
+  labels:
+    app.kubernetes.io/name: aws-node-termination-handler
+spec:
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: aws-node-termination-handler
+  updateStrategy:
+    type: RollingUpdate
+    rollingUpdate:
+      maxUnavailable: 25%
+  template:
+    metadata:
+      labels:
+        app.kubernetes.io/name: aws-node-termination-handler
+    spec:
+      serviceAccountName: aws-node-termination-handler
+      priorityClassName: system-node-critical
+      # pods in the host network can reach the instance metadata even
+      # when the hop limit of IMDSv2 responses is 1
+      hostNetwork: true
+      dnsPolicy: ClusterFirstWithHostNet
+      affinity:
+        nodeAffinity:
+          requiredDuringSchedulingIgnoredDuringExecution:
+            nodeSelectorTerms:
+            - matchExpressions:
+              - key: kubernetes.io/os
+                operator: In
+                values: ["linux"]
+            - matchExpressions:
+              - key: beta.kubernetes.io/os
+                operator: In
+                values: ["linux"]
+      tolerations:
+      - operator: Exists
+      containers:
+      - name: aws-node-termination-handler
+        image: {{ .Image }}
+        imagePullPolicy: IfNotPresent
+        securityContext:
+          readOnlyRootFilesystem: true
+          runAsNonRoot: true
+          runAsUser: 1000
+          allowPrivilegeEscalation: false
+        resources:
+          limits:
+            cpu: 100m
+            memory: 128Mi
+          requests:
+            cpu: 50m
+            memory: 64Mi
+        env:
+        - name: NODE_NAME
+          valueFrom:
+            fieldRef:
+              fieldPath: spec.nodeName
+        - name: POD_NAME
+          valueFrom:
+            fieldRef:
+              fieldPath: metadata.name
+        - name: NAMESPACE
+          valueFrom:
+            fieldRef:
+              fieldPath: metadata.namespace
+        - name: DELETE_LOCAL_DATA
+          value: "true"
+        - name: IGNORE_DAEMON_SETS
+          value: "true"
+        - name: POD_TERMINATION_GRACE_PERIOD
+          value: "-1"
+        - name: ENABLE_SPOT_INTERRUPTION_DRAINING
+          value: "true"
+        - name: ENABLE_SCHEDULED_EVENT_DRAINING
+          value: "true"
+        - name: ENABLE_ASG_LIFECYCLE_DRAINING
+          value: "true"
+`))
+
+// NodeTerminationHandlerManifest returns the manifest of the AWS Node Termination Handler for the cluster
+func NodeTerminationHandlerManifest(cfg *api.ClusterConfig) ([]byte, error) {
+	image := cfg.NodeTerminationHandler.Image
+	if image == "" {
+		image = NodeTerminationHandlerImage
+	}
+
+	manifest := &bytes.Buffer{}
+	if err := nodeTerminationHandlerManifest.Execute(manifest, struct{ Image string }{image}); err != nil {
+		return nil, errors.Wrapf(err, "rendering %q manifest", NodeTerminationHandler)
+	}
+	return manifest.Bytes(), nil
+}
+
+// InstallNodeTerminationHandler creates or updates the AWS Node Termination Handler,
+// which drains nodes before their instances are interrupted or terminated
+func InstallNodeTerminationHandler(rawClient kubernetes.RawClientInterface, cfg *api.ClusterConfig, plan bool) error {
+	manifest, err := NodeTerminationHandlerManifest(cfg)
+	if err != nil {
+		return err
+	}
+	return applyManifest(rawClient, NodeTerminationHandler, manifest, plan)
+}
@@ -0,0 +1,56 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("AWS Node Termination Handler", func() {
+	var (
+		rawClient *testutils.FakeRawClient
+		cfg       *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.AssumeObjectsMissing = true
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.NodeTerminationHandler = &api.NodeTerminationHandler{Enabled: api.Enabled()}
+	})
+
+	It("creates the handler on every Linux node", func() {
+		Expect(InstallNodeTerminationHandler(rawClient, cfg, false)).To(Succeed())
+
+		Expect(rawClient.Collection.CreatedItems()).To(HaveLen(4))
+
+		daemonSet, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get("aws-node-termination-handler", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		podSpec := daemonSet.Spec.Template.Spec
+		Expect(podSpec.ServiceAccountName).To(Equal("aws-node-termination-handler"))
+		Expect(podSpec.HostNetwork).To(BeTrue())
+
+		container := podSpec.Containers[0]
+		Expect(container.Image).To(Equal(NodeTerminationHandlerImage))
+		Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "ENABLE_SPOT_INTERRUPTION_DRAINING", Value: "true"}))
+		Expect(container.Env).To(ContainElement(corev1.EnvVar{Name: "ENABLE_ASG_LIFECYCLE_DRAINING", Value: "true"}))
+	})
+
+	It("uses the given image", func() {
+		cfg.NodeTerminationHandler.Image = "example.com/aws-node-termination-handler:v1.0.0"
+		Expect(InstallNodeTerminationHandler(rawClient, cfg, false)).To(Succeed())
+
+		daemonSet, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get("aws-node-termination-handler", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(daemonSet.Spec.Template.Spec.Containers[0].Image).To(Equal("example.com/aws-node-termination-handler:v1.0.0"))
+	})
+})
@@ -0,0 +1,70 @@
+package addons
+
+import (
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	// NvidiaDevicePlugin is the name of the NVIDIA Kubernetes device plugin addon
+	NvidiaDevicePlugin = "nvidia-device-plugin"
+
+	// NvidiaDevicePluginManifestFileName is the name of the file the manifest
+	// is written to when it gets committed to a gitops repository
+	NvidiaDevicePluginManifestFileName = NvidiaDevicePlugin + ".yaml"
+)
+
+// nvidiaDevicePluginManifest is based on the upstream manifest from
+// https://github.com/NVIDIA/k8s-device-plugin, the toleration allows it
+// to run on GPU nodes that are tainted to keep other workloads off them
+const nvidiaDevicePluginManifest = `---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: nvidia-device-plugin-daemonset
+  namespace: kube-system
+spec:
+  selector:
+    matchLabels:
+      name: nvidia-device-plugin-ds
+  updateStrategy:
+    type: RollingUpdate
+  template:
+    metadata:
+      annotations:
+        scheduler.alpha.kubernetes.io/critical-pod: ""
+      labels:
+        name: nvidia-device-plugin-ds
+    spec:
+      tolerations:
+      - key: CriticalAddonsOnly
+        operator: Exists
+      - key: nvidia.com/gpu
+        operator: Exists
+        effect: NoSchedule
+      priorityClassName: system-node-critical
+      containers:
+      - image: nvidia/k8s-device-plugin:1.0.0-beta4
+        name: nvidia-device-plugin-ctr
+        securityContext:
+          allowPrivilegeEscalation: false
+          capabilities:
+            drop: ["ALL"]
+        volumeMounts:
+        - name: device-plugin
+          mountPath: /var/lib/kubelet/device-plugins
+      volumes:
+      - name: device-plugin
+        hostPath:
+          path: /var/lib/kubelet/device-plugins
+`
+
+// NvidiaDevicePluginManifest returns the manifest of the NVIDIA Kubernetes device plugin
+func NvidiaDevicePluginManifest() []byte {
+	return []byte(nvidiaDevicePluginManifest)
+}
+
+// InstallNvidiaDevicePlugin creates or updates the NVIDIA Kubernetes device plugin,
+// which is needed for pods to be able to request GPUs
+func InstallNvidiaDevicePlugin(rawClient kubernetes.RawClientInterface, plan bool) error {
+	return applyManifest(rawClient, NvidiaDevicePlugin, NvidiaDevicePluginManifest(), plan)
+}
@@ -0,0 +1,49 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("NVIDIA Kubernetes device plugin", func() {
+	var rawClient *testutils.FakeRawClient
+
+	BeforeEach(func() {
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.AssumeObjectsMissing = true
+	})
+
+	It("creates the device plugin daemonset", func() {
+		err := InstallNvidiaDevicePlugin(rawClient, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(rawClient.Collection.CreatedItems()).To(HaveLen(1))
+		Expect(rawClient.Collection.UpdatedItems()).To(BeEmpty())
+
+		daemonSet, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get("nvidia-device-plugin-daemonset", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(daemonSet.Spec.Template.Spec.Tolerations).To(ContainElement(corev1.Toleration{
+			Key:      "nvidia.com/gpu",
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		}))
+		Expect(daemonSet.Spec.Template.Spec.Containers[0].Image).To(HavePrefix("nvidia/k8s-device-plugin:"))
+	})
+
+	It("replaces the daemonset when it already exists", func() {
+		rawClient.AssumeObjectsMissing = false
+		err := InstallNvidiaDevicePlugin(rawClient, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		items := rawClient.Collection.UpdatedItems()
+		Expect(items).To(HaveLen(1))
+		Expect(items[0]).To(BeAssignableToTypeOf(&appsv1.DaemonSet{}))
+	})
+})
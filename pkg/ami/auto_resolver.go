@@ -4,9 +4,9 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils"
 )
 
@@ -16,6 +16,7 @@ func MakeImageSearchPatterns(version string) map[string]map[int]string {
 		api.NodeImageFamilyAmazonLinux2: {
 			ImageClassGeneral: fmt.Sprintf("amazon-eks-node-%s-v*", version),
 			ImageClassGPU:     fmt.Sprintf("amazon-eks-gpu-node-%s-*", version),
+			ImageClassARM:     fmt.Sprintf("amazon-eks-arm64-node-%s-v*", version),
 		},
 		api.NodeImageFamilyUbuntu1804: {
 			ImageClassGeneral: fmt.Sprintf("ubuntu-eks/k8s_%s/images/*", version),
@@ -56,7 +57,14 @@ func (r *AutoResolver) Resolve(region, version, instanceType, imageFamily string
 
 	imageClasses := MakeImageSearchPatterns(version)[imageFamily]
 	namePattern := imageClasses[ImageClassGeneral]
-	if utils.IsGPUInstanceType(instanceType) {
+	if api.IsARMInstanceType(instanceType) {
+		var ok bool
+		namePattern, ok = imageClasses[ImageClassARM]
+		if !ok {
+			logger.Critical("image family %s doesn't support ARM image class", imageFamily)
+			return "", NewErrFailedResolution(region, version, instanceType, imageFamily)
+		}
+	} else if utils.IsGPUInstanceType(instanceType) {
 		var ok bool
 		namePattern, ok = imageClasses[ImageClassGPU]
 		if !ok {
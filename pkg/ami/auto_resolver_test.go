@@ -212,6 +212,31 @@ var _ = Describe("AMI Auto Resolution", func() {
 					})
 				})
 			})
+
+			Context("and ARM instance type", func() {
+				BeforeEach(func() {
+					instanceType = "a1.large"
+				})
+
+				Context("and ami is available", func() {
+					BeforeEach(func() {
+						imageState = "available"
+
+						_, p = createProviders()
+						addMockDescribeImages(p, "amazon-eks-arm64-node-1.12-v*", expectedAmi, imageState, "2018-08-20T23:25:53.000Z", api.NodeImageFamilyAmazonLinux2)
+						resolver := NewAutoResolver(p.MockEC2())
+						resolvedAmi, err = resolver.Resolve(region, version, instanceType, imageFamily)
+					})
+
+					It("should not error", func() {
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should have returned an ami id", func() {
+						Expect(resolvedAmi).To(BeEquivalentTo(expectedAmi))
+					})
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,48 @@
+package ami
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaveworks/eksctl/pkg/utils/cache"
+)
+
+// resolvedAMICacheTTL is how long resolved AMIs are cached for, new AMIs
+// are usually released no more than once a week
+const resolvedAMICacheTTL = time.Hour
+
+// CachingResolver is a Resolver that caches the AMIs resolved by its
+// delegate, so that they are not looked up again by later invocations
+type CachingResolver struct {
+	name     string
+	delegate Resolver
+	cache    *cache.Cache
+}
+
+// NewCachingResolver creates a new CachingResolver, name distinguishes the
+// AMIs resolved by different delegates in the cache
+func NewCachingResolver(name string, delegate Resolver, c *cache.Cache) Resolver {
+	return &CachingResolver{
+		name:     name,
+		delegate: delegate,
+		cache:    c,
+	}
+}
+
+// Resolve will return the cached AMI, if there is one, and otherwise
+// resolve it using the delegate
+func (r *CachingResolver) Resolve(region, version, instanceType, imageFamily string) (string, error) {
+	key := fmt.Sprintf("ami/%s/%s/%s/%s/%s", r.name, region, version, imageFamily, instanceType)
+
+	var id string
+	if r.cache.Get(key, &id) {
+		return id, nil
+	}
+
+	id, err := r.delegate.Resolve(region, version, instanceType, imageFamily)
+	if err != nil || id == "" {
+		return id, err
+	}
+	r.cache.Set(key, id, resolvedAMICacheTTL)
+	return id, nil
+}
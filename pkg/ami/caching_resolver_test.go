@@ -0,0 +1,63 @@
+package ami_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/ami"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/cache"
+)
+
+var _ = Describe("AMI caching resolver", func() {
+	const ssmParameter = "/aws/service/eks/optimized-ami/1.14/amazon-linux-2/recommended/image_id"
+
+	var (
+		p        *mockprovider.MockProvider
+		cacheDir string
+		resolver Resolver
+	)
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "eksctl-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, p = createProviders()
+		addMockGetParameter(p, ssmParameter, "ami-12345")
+		resolver = NewCachingResolver("ssm", NewSSMResolver(p.MockSSM()), cache.New(cacheDir))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	It("should only look up an AMI once", func() {
+		for i := 0; i < 2; i++ {
+			id, err := resolver.Resolve("eu-west-1", "1.14", "m5.large", "AmazonLinux2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(id).To(Equal("ami-12345"))
+		}
+		Expect(p.MockSSM().AssertNumberOfCalls(GinkgoT(), "GetParameter", 1)).To(BeTrue())
+	})
+
+	It("should look up AMIs of different regions separately", func() {
+		_, err := resolver.Resolve("eu-west-1", "1.14", "m5.large", "AmazonLinux2")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = resolver.Resolve("us-west-2", "1.14", "m5.large", "AmazonLinux2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(p.MockSSM().AssertNumberOfCalls(GinkgoT(), "GetParameter", 2)).To(BeTrue())
+	})
+
+	It("should look up AMIs every time without a cache", func() {
+		resolver = NewCachingResolver("ssm", NewSSMResolver(p.MockSSM()), nil)
+		for i := 0; i < 2; i++ {
+			_, err := resolver.Resolve("eu-west-1", "1.14", "m5.large", "AmazonLinux2")
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(p.MockSSM().AssertNumberOfCalls(GinkgoT(), "GetParameter", 2)).To(BeTrue())
+	})
+})
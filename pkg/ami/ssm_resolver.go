@@ -6,11 +6,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils"
 )
 
@@ -53,6 +53,10 @@ func makeParameterName(version, instanceType, imageFamily string) (string, error
 		}
 	}
 
+	if api.IsARMInstanceType(instanceType) && imageFamily != api.NodeImageFamilyAmazonLinux2 && imageFamily != api.NodeImageFamilyBottlerocket {
+		return "", fmt.Errorf("%s AMIs do not support ARM instance types", imageFamily)
+	}
+
 	switch imageFamily {
 	case api.NodeImageFamilyAmazonLinux2:
 		return fmt.Sprintf("/aws/service/eks/optimized-ami/%s/%s/recommended/image_id", version, imageType(imageFamily, instanceType)), nil
@@ -60,6 +64,11 @@ func makeParameterName(version, instanceType, imageFamily string) (string, error
 		return fmt.Sprintf("/aws/service/ami-windows-latest/Windows_Server-2019-English-Core-EKS_Optimized-%s/image_id", version), nil
 	case api.NodeImageFamilyWindowsServer2019FullContainer:
 		return fmt.Sprintf("/aws/service/ami-windows-latest/Windows_Server-2019-English-Full-EKS_Optimized-%s/image_id", version), nil
+	case api.NodeImageFamilyBottlerocket:
+		if utils.IsGPUInstanceType(instanceType) {
+			return "", fmt.Errorf("%s AMIs do not support GPU instance types yet", imageFamily)
+		}
+		return fmt.Sprintf("/aws/service/bottlerocket/aws-k8s-%s/%s/latest/image_id", version, bottlerocketArchitecture(instanceType)), nil
 	case api.NodeImageFamilyUbuntu1804:
 		return "", fmt.Errorf("SSM Parameter lookups for %s AMIs is not supported yet", imageFamily)
 	default:
@@ -69,8 +78,19 @@ func makeParameterName(version, instanceType, imageFamily string) (string, error
 
 func imageType(imageFamily, instanceType string) string {
 	family := utils.ToKebabCase(imageFamily)
-	if utils.IsGPUInstanceType(instanceType) {
+	switch {
+	case api.IsARMInstanceType(instanceType):
+		return family + "-arm64"
+	case utils.IsGPUInstanceType(instanceType):
 		return family + "-gpu"
+	default:
+		return family
+	}
+}
+
+func bottlerocketArchitecture(instanceType string) string {
+	if api.IsARMInstanceType(instanceType) {
+		return "arm64"
 	}
-	return family
+	return "x86_64"
 }
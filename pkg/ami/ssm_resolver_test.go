@@ -115,6 +115,41 @@ var _ = Describe("AMI Auto Resolution", func() {
 				})
 			})
 
+			Context("and ARM instance type", func() {
+				BeforeEach(func() {
+					instanceType = "m6g.large"
+					_, p = createProviders()
+				})
+
+				It("should return a valid AmazonLinux2 image", func() {
+					addMockGetParameter(p, "/aws/service/eks/optimized-ami/1.14/amazon-linux-2-arm64/recommended/image_id", expectedAmi)
+
+					resolver := NewSSMResolver(p.MockSSM())
+					resolvedAmi, err = resolver.Resolve(region, "1.14", instanceType, "AmazonLinux2")
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(resolvedAmi).To(BeEquivalentTo(expectedAmi))
+					Expect(p.MockSSM().AssertNumberOfCalls(GinkgoT(), "GetParameter", 1)).To(BeTrue())
+				})
+
+				It("should return a valid Bottlerocket image", func() {
+					addMockGetParameter(p, "/aws/service/bottlerocket/aws-k8s-1.15/arm64/latest/image_id", expectedAmi)
+
+					resolver := NewSSMResolver(p.MockSSM())
+					resolvedAmi, err = resolver.Resolve(region, "1.15", instanceType, "Bottlerocket")
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(resolvedAmi).To(BeEquivalentTo(expectedAmi))
+				})
+
+				It("should return an error for Windows images", func() {
+					resolver := NewSSMResolver(p.MockSSM())
+					resolvedAmi, err = resolver.Resolve(region, "1.14", instanceType, "WindowsServer2019CoreContainer")
+
+					Expect(err).To(MatchError("WindowsServer2019CoreContainer AMIs do not support ARM instance types"))
+				})
+			})
+
 			Context("and Windows Core family", func() {
 				BeforeEach(func() {
 					instanceType = "t3.xlarge"
@@ -159,8 +194,34 @@ var _ = Describe("AMI Auto Resolution", func() {
 				})
 			})
 
+			Context("and Bottlerocket family", func() {
+				BeforeEach(func() {
+					imageFamily = "Bottlerocket"
+					_, p = createProviders()
+				})
+
+				It("should return a valid image", func() {
+					addMockGetParameter(p, "/aws/service/bottlerocket/aws-k8s-1.15/x86_64/latest/image_id", expectedAmi)
+
+					resolver := NewSSMResolver(p.MockSSM())
+					resolvedAmi, err = resolver.Resolve(region, "1.15", "t3.large", imageFamily)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(resolvedAmi).To(BeEquivalentTo(expectedAmi))
+					Expect(p.MockSSM().AssertNumberOfCalls(GinkgoT(), "GetParameter", 1)).To(BeTrue())
+				})
+
+				It("should return an error for GPU instance types", func() {
+					resolver := NewSSMResolver(p.MockSSM())
+					resolvedAmi, err = resolver.Resolve(region, "1.15", "p2.xlarge", imageFamily)
+
+					Expect(err).To(HaveOccurred())
+				})
+			})
+
 			Context("and Ubuntu family", func() {
 				BeforeEach(func() {
+					imageFamily = "Ubuntu1804"
 					_, p = createProviders()
 				})
 
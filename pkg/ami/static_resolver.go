@@ -1,7 +1,8 @@
 package ami
 
 import (
-	"github.com/kris-nova/logger"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils"
 )
 
@@ -16,7 +17,12 @@ type StaticDefaultResolver struct {
 func (r *StaticDefaultResolver) Resolve(region, version, instanceType, imageFamily string) (string, error) {
 	logger.Debug("resolving AMI using StaticDefaultResolver for region %s, version %s, instanceType %s and imageFamily %s", region, version, instanceType, imageFamily)
 
-	regionalAMIs := StaticImages[version][imageFamily][ImageClassGeneral]
+	imageClass := ImageClassGeneral
+	if api.IsARMInstanceType(instanceType) {
+		imageClass = ImageClassARM
+	}
+
+	regionalAMIs := StaticImages[version][imageFamily][imageClass]
 	return regionalAMIs[region], nil
 }
 
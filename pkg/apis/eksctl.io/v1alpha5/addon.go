@@ -0,0 +1,97 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Commonly-used EKS managed add-ons
+const (
+	// VPCCNIAddon is the name of the Amazon VPC CNI add-on
+	VPCCNIAddon = "vpc-cni"
+	// CoreDNSAddon is the name of the CoreDNS add-on
+	CoreDNSAddon = "coredns"
+	// KubeProxyAddon is the name of the kube-proxy add-on
+	KubeProxyAddon = "kube-proxy"
+)
+
+// Values for `resolveConflicts`
+const (
+	// AddonResolveConflictsNone keeps changes made to the resources of an add-on,
+	// the operation fails if they conflict with the configuration of the add-on
+	AddonResolveConflictsNone = "none"
+	// AddonResolveConflictsOverwrite overwrites changes made to the resources of an add-on
+	AddonResolveConflictsOverwrite = "overwrite"
+)
+
+// Addon holds the configuration of an EKS managed add-on
+type Addon struct {
+	// Name of the add-on, e.g. vpc-cni, coredns or kube-proxy
+	Name string `json:"name"`
+	// Version of the add-on, the default version for the Kubernetes
+	// version of the cluster is used when it's not set
+	// +optional
+	Version string `json:"version,omitempty"`
+	// ARN of an existing IAM role for the service account of the add-on
+	// +optional
+	ServiceAccountRoleARN string `json:"serviceAccountRoleARN,omitempty"`
+	// ARNs of the IAM policies to attach to a role eksctl creates for the
+	// service account of the add-on, iam.withOIDC must be enabled
+	// +optional
+	AttachPolicyARNs []string `json:"attachPolicyARNs,omitempty"`
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// How to resolve conflicts with changes made to the Kubernetes resources
+	// of the add-on, valid options: none, overwrite (defaults to none)
+	// +optional
+	ResolveConflicts string `json:"resolveConflicts,omitempty"`
+}
+
+// SupportedAddonResolveConflicts returns the supported values of `resolveConflicts`
+func SupportedAddonResolveConflicts() []string {
+	return []string{
+		AddonResolveConflictsNone,
+		AddonResolveConflictsOverwrite,
+	}
+}
+
+// AddonServiceAccount returns the namespace and the name of the Kubernetes service account
+// used by an add-on, it's only known for add-ons that make calls to AWS APIs
+func AddonServiceAccount(addonName string) (namespace, name string, ok bool) {
+	switch addonName {
+	case VPCCNIAddon:
+		return "kube-system", "aws-node", true
+	default:
+		return "", "", false
+	}
+}
+
+// ValidateAddon validates the configuration of the add-on at the given path
+func ValidateAddon(path string, addon *Addon, withOIDC bool) error {
+	if addon.Name == "" {
+		return fmt.Errorf("%s.name must be set", path)
+	}
+	if addon.ServiceAccountRoleARN != "" && len(addon.AttachPolicyARNs) > 0 {
+		return fmt.Errorf("%s.serviceAccountRoleARN and %s.attachPolicyARNs cannot be used together", path, path)
+	}
+	if len(addon.AttachPolicyARNs) > 0 {
+		if !withOIDC {
+			return fmt.Errorf("iam.withOIDC must be enabled for %s.attachPolicyARNs to be used", path)
+		}
+		if _, _, ok := AddonServiceAccount(addon.Name); !ok {
+			return fmt.Errorf("%s.attachPolicyARNs cannot be used, the service account of add-on %q is not known", path, addon.Name)
+		}
+	}
+	if addon.ResolveConflicts != "" {
+		supported := false
+		for _, value := range SupportedAddonResolveConflicts() {
+			if addon.ResolveConflicts == value {
+				supported = true
+			}
+		}
+		if !supported {
+			return fmt.Errorf("%s.resolveConflicts %q is not valid, supported values: %s", path, addon.ResolveConflicts, strings.Join(SupportedAddonResolveConflicts(), ", "))
+		}
+	}
+	return validateTags(path+".tags", addon.Tags)
+}
@@ -12,7 +12,7 @@ type ClusterCloudWatchLogging struct {
 	EnableTypes []string `json:"enableTypes,omitempty"`
 }
 
-// SupportedCloudWatchClusterLogTypes retuls all supported logging facilities
+// SupportedCloudWatchClusterLogTypes returns all supported logging facilities
 func SupportedCloudWatchClusterLogTypes() []string {
 	return []string{"api", "audit", "authenticator", "controllerManager", "scheduler"}
 }
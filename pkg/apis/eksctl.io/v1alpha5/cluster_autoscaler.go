@@ -0,0 +1,64 @@
+package v1alpha5
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterAutoscalerServiceAccountName is the name of the service account
+// that cluster-autoscaler runs as, in the kube-system namespace
+const ClusterAutoscalerServiceAccountName = "cluster-autoscaler"
+
+// ClusterAutoscaler holds the configuration of the cluster-autoscaler that
+// eksctl installs once the cluster has been created
+type ClusterAutoscaler struct {
+	// Enabled installs cluster-autoscaler, it runs as the kube-system/cluster-autoscaler
+	// iamserviceaccount and scales all nodegroups of the cluster
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Image overrides the cluster-autoscaler image, which defaults to the
+	// release that matches the Kubernetes version of the cluster
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// HasClusterAutoscaler returns true if cluster-autoscaler is to be installed
+func (c *ClusterConfig) HasClusterAutoscaler() bool {
+	return c.ClusterAutoscaler != nil && IsEnabled(c.ClusterAutoscaler.Enabled)
+}
+
+// SetClusterAutoscalerDefaults enables IAM roles for service accounts and adds the
+// iamserviceaccount of cluster-autoscaler, unless it has been defined explicitly
+func SetClusterAutoscalerDefaults(cfg *ClusterConfig) {
+	if cfg.IAM.WithOIDC == nil {
+		cfg.IAM.WithOIDC = Enabled()
+	}
+
+	for _, sa := range cfg.IAM.ServiceAccounts {
+		if sa.Namespace == metav1.NamespaceSystem && sa.Name == ClusterAutoscalerServiceAccountName {
+			return
+		}
+	}
+	cfg.IAM.ServiceAccounts = append(cfg.IAM.ServiceAccounts, &ClusterIAMServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceSystem,
+			Name:      ClusterAutoscalerServiceAccountName,
+		},
+		WithAddonPolicies: &NodeGroupIAMAddonPolicies{
+			AutoScaler: Enabled(),
+		},
+	})
+}
+
+// ValidateClusterAutoscaler checks that cluster-autoscaler can get credentials
+// via its iamserviceaccount
+func ValidateClusterAutoscaler(cfg *ClusterConfig) error {
+	if !cfg.HasClusterAutoscaler() {
+		return nil
+	}
+	if !IsEnabled(cfg.IAM.WithOIDC) {
+		return fmt.Errorf("clusterAutoscaler.enabled requires iam.withOIDC to be enabled, as cluster-autoscaler uses an iamserviceaccount")
+	}
+	return nil
+}
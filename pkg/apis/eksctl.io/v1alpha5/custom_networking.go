@@ -0,0 +1,83 @@
+package v1alpha5
+
+import (
+	"fmt"
+
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
+)
+
+// ClusterCustomNetworking holds the configuration of VPC CNI custom networking,
+// where pods get their IP addresses from subnets other than those of the nodes
+type ClusterCustomNetworking struct {
+	// SecondaryCIDR is associated with a dedicated VPC and divided
+	// into pod subnets, one for each availability zone
+	// +optional
+	SecondaryCIDR *ipnet.IPNet `json:"secondaryCIDR,omitempty"`
+
+	// PodSubnets are keyed by availability zone, they are derived from
+	// secondaryCIDR, or need to be given when using an existing VPC
+	// +optional
+	PodSubnets map[string]Network `json:"podSubnets,omitempty"`
+}
+
+// HasCustomNetworking determines if VPC CNI custom networking is configured
+func (c *ClusterConfig) HasCustomNetworking() bool {
+	return c.VPC != nil && c.VPC.CustomNetworking != nil
+}
+
+// PodSubnetIDs returns the IDs of the pod subnets
+func (c *ClusterConfig) PodSubnetIDs() []string {
+	subnets := []string{}
+	if c.HasCustomNetworking() {
+		for _, s := range c.VPC.CustomNetworking.PodSubnets {
+			subnets = append(subnets, s.ID)
+		}
+	}
+	return subnets
+}
+
+// ImportPodSubnet loads a given pod subnet into cluster config
+func (c *ClusterConfig) ImportPodSubnet(az, subnetID, cidr string) error {
+	if c.VPC.CustomNetworking.PodSubnets == nil {
+		c.VPC.CustomNetworking.PodSubnets = make(map[string]Network)
+	}
+	return doImportSubnet(c.VPC.CustomNetworking.PodSubnets, az, subnetID, cidr)
+}
+
+// ValidateCustomNetworking checks the custom networking configuration, eksctl only
+// associates a secondary CIDR with a dedicated VPC, an existing VPC needs pod subnets
+func ValidateCustomNetworking(cfg *ClusterConfig) error {
+	if !cfg.HasCustomNetworking() {
+		return nil
+	}
+	cn := cfg.VPC.CustomNetworking
+
+	if cn.SecondaryCIDR == nil {
+		if len(cn.PodSubnets) == 0 {
+			return fmt.Errorf("either vpc.customNetworking.secondaryCIDR or vpc.customNetworking.podSubnets must be set")
+		}
+		for az, subnet := range cn.PodSubnets {
+			if subnet.ID == "" {
+				return fmt.Errorf("vpc.customNetworking.podSubnets[%q].id must be set", az)
+			}
+		}
+		return nil
+	}
+
+	existingVPC := cfg.VPC.ID != ""
+	for _, id := range append(cfg.PrivateSubnetIDs(), cfg.PublicSubnetIDs()...) {
+		existingVPC = existingVPC || id != ""
+	}
+	// pod subnets are already known when the VPC was created by eksctl earlier
+	if existingVPC && len(cn.PodSubnets) == 0 {
+		return fmt.Errorf("vpc.customNetworking.secondaryCIDR cannot be used with an existing VPC, use vpc.customNetworking.podSubnets instead")
+	}
+
+	if prefix, _ := cn.SecondaryCIDR.Mask.Size(); prefix < 16 || prefix > 24 {
+		return fmt.Errorf("vpc.customNetworking.secondaryCIDR prefix must be between /16 and /24")
+	}
+	if cfg.VPC.CIDR != nil && (cfg.VPC.CIDR.Contains(cn.SecondaryCIDR.IP) || cn.SecondaryCIDR.Contains(cfg.VPC.CIDR.IP)) {
+		return fmt.Errorf("vpc.customNetworking.secondaryCIDR (%s) overlaps with vpc.cidr (%s)", cn.SecondaryCIDR, cfg.VPC.CIDR)
+	}
+	return nil
+}
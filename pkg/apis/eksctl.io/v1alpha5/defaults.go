@@ -10,6 +10,10 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 		cfg.IAM = &ClusterIAM{}
 	}
 
+	if cfg.HasClusterAutoscaler() {
+		SetClusterAutoscalerDefaults(cfg)
+	}
+
 	if cfg.IAM.WithOIDC == nil {
 		cfg.IAM.WithOIDC = Disabled()
 	}
@@ -20,17 +24,25 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 		}
 	}
 
+	for _, idp := range cfg.IdentityProviders {
+		SetIdentityProviderDefaults(idp)
+	}
+
 	if cfg.HasClusterCloudWatchLogging() && len(cfg.CloudWatch.ClusterLogging.EnableTypes) == 1 {
 		switch cfg.CloudWatch.ClusterLogging.EnableTypes[0] {
 		case "all", "*":
 			cfg.CloudWatch.ClusterLogging.EnableTypes = SupportedCloudWatchClusterLogTypes()
 		}
 	}
+
+	if cfg.HasGitRepo() {
+		SetGitRepoDefaults(cfg.Git.Repo)
+	}
 }
 
 // SetNodeGroupDefaults will set defaults for a given nodegroup
 func SetNodeGroupDefaults(_ int, ng *NodeGroup) {
-	if ng.InstanceType == "" {
+	if ng.InstanceType == "" && ng.InstanceSelector == nil {
 		if HasMixedInstances(ng) {
 			ng.InstanceType = "mixed"
 		} else {
@@ -43,6 +55,45 @@ func SetNodeGroupDefaults(_ int, ng *NodeGroup) {
 	if ng.AMI == "" {
 		ng.AMI = "static"
 	}
+	if ng.AMIFamily == NodeImageFamilyBottlerocket && ng.AMI == NodeImageResolverStatic {
+		// there are no static AMIs for Bottlerocket, they are only
+		// published via SSM parameters
+		ng.AMI = NodeImageResolverAutoSSM
+	}
+	if ng.AMI == NodeImageResolverStatic && HasARMInstances(ng) {
+		// the static AMIs don't include ARM AMIs yet, they are
+		// resolved via SSM parameters instead
+		ng.AMI = NodeImageResolverAutoSSM
+	}
+
+	if ng.Placement != nil && ng.Placement.GroupName == "" && ng.Placement.Strategy == "" {
+		ng.Placement.Strategy = PlacementStrategyCluster
+	}
+
+	if ng.InstanceRefresh != nil && ng.InstanceRefresh.MinHealthyPercentage == nil {
+		minHealthyPercentage := DefaultInstanceRefreshMinHealthyPercentage
+		ng.InstanceRefresh.MinHealthyPercentage = &minHealthyPercentage
+	}
+
+	if ng.WarmPool != nil && ng.WarmPool.PoolState == "" {
+		ng.WarmPool.PoolState = WarmPoolStateStopped
+	}
+
+	for i := range ng.ASGMetricsCollection {
+		if ng.ASGMetricsCollection[i].Granularity == "" {
+			ng.ASGMetricsCollection[i].Granularity = MetricsCollectionGranularity1Minute
+		}
+	}
+
+	if options := ng.InstanceMetadataOptions; options != nil {
+		if options.HTTPTokens == "" {
+			options.HTTPTokens = HTTPTokensOptional
+		}
+		if options.HTTPTokens == HTTPTokensRequired && options.HTTPPutResponseHopLimit == nil {
+			hopLimit := DefaultHTTPPutResponseHopLimit
+			options.HTTPPutResponseHopLimit = &hopLimit
+		}
+	}
 
 	if ng.SecurityGroups == nil {
 		ng.SecurityGroups = &NodeGroupSGs{
@@ -77,6 +128,15 @@ func SetNodeGroupDefaults(_ int, ng *NodeGroup) {
 		}
 	}
 
+	if ng.AMIFamily == NodeImageFamilyBottlerocket {
+		if ng.Bottlerocket == nil {
+			ng.Bottlerocket = &NodeGroupBottlerocket{}
+		}
+		if ng.Bottlerocket.EnableAdminContainer == nil {
+			ng.Bottlerocket.EnableAdminContainer = ng.SSH.Allow
+		}
+	}
+
 	if !IsSetAndNonEmptyString(ng.VolumeType) {
 		ng.VolumeType = &DefaultNodeVolumeType
 	}
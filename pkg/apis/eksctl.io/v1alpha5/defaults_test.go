@@ -53,6 +53,46 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("clusterAutoscaler", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.ClusterAutoscaler = &ClusterAutoscaler{Enabled: Enabled()}
+		})
+
+		It("should add an iamserviceaccount with the autoscaler policy once", func() {
+			SetClusterConfigDefaults(cfg)
+			SetClusterConfigDefaults(cfg)
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			Expect(*cfg.IAM.WithOIDC).To(BeTrue())
+			Expect(cfg.IAM.ServiceAccounts).To(HaveLen(1))
+			sa := cfg.IAM.ServiceAccounts[0]
+			Expect(sa.NameString()).To(Equal("kube-system/cluster-autoscaler"))
+			Expect(*sa.WithAddonPolicies.AutoScaler).To(BeTrue())
+		})
+
+		It("should keep an iamserviceaccount that is defined explicitly", func() {
+			cfg.IAM.ServiceAccounts = []*ClusterIAMServiceAccount{{
+				AttachPolicyARNs: []string{"arn:aws:iam::123456789012:policy/autoscaler"},
+			}}
+			cfg.IAM.ServiceAccounts[0].Namespace = "kube-system"
+			cfg.IAM.ServiceAccounts[0].Name = "cluster-autoscaler"
+
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.IAM.ServiceAccounts).To(HaveLen(1))
+			Expect(cfg.IAM.ServiceAccounts[0].WithAddonPolicies).To(BeNil())
+		})
+
+		It("should require IAM roles for service accounts", func() {
+			cfg.IAM.WithOIDC = Disabled()
+
+			SetClusterConfigDefaults(cfg)
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("clusterAutoscaler.enabled requires iam.withOIDC")))
+		})
+	})
+
 	Context("SSH settings", func() {
 
 		It("Providing an SSH key enables SSH", func() {
@@ -85,6 +125,112 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Context("ARM nodegroups", func() {
+
+		It("resolves AMIs of ARM nodegroups via SSM", func() {
+			testNodeGroup := NodeGroup{
+				InstanceType: "m6g.large",
+			}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(testNodeGroup.AMI).To(Equal(NodeImageResolverAutoSSM))
+		})
+
+		It("keeps the static resolver for x86_64 nodegroups", func() {
+			testNodeGroup := NodeGroup{
+				InstanceType: "m5.large",
+			}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(testNodeGroup.AMI).To(Equal(NodeImageResolverStatic))
+		})
+	})
+
+	Context("Instance metadata options", func() {
+
+		It("uses a hop limit of 2 when IMDSv2 is required", func() {
+			testNodeGroup := NodeGroup{
+				InstanceMetadataOptions: &InstanceMetadataOptions{HTTPTokens: HTTPTokensRequired},
+			}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(*testNodeGroup.InstanceMetadataOptions.HTTPPutResponseHopLimit).To(Equal(2))
+		})
+
+		It("leaves the options unset when they are not set", func() {
+			testNodeGroup := NodeGroup{}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(testNodeGroup.InstanceMetadataOptions).To(BeNil())
+		})
+
+		It("keeps IMDSv1 enabled when only the hop limit is set", func() {
+			hopLimit := 1
+			testNodeGroup := NodeGroup{
+				InstanceMetadataOptions: &InstanceMetadataOptions{HTTPPutResponseHopLimit: &hopLimit},
+			}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(testNodeGroup.InstanceMetadataOptions.HTTPTokens).To(Equal(HTTPTokensOptional))
+			Expect(*testNodeGroup.InstanceMetadataOptions.HTTPPutResponseHopLimit).To(Equal(1))
+		})
+
+		It("keeps IMDSv1 enabled when it is allowed explicitly", func() {
+			testNodeGroup := NodeGroup{
+				InstanceMetadataOptions: &InstanceMetadataOptions{HTTPTokens: HTTPTokensOptional},
+			}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(testNodeGroup.InstanceMetadataOptions.HTTPTokens).To(Equal(HTTPTokensOptional))
+			Expect(testNodeGroup.InstanceMetadataOptions.HTTPPutResponseHopLimit).To(BeNil())
+		})
+	})
+
+	Context("Instance refresh and warm pool", func() {
+
+		It("keeps 90% of the nodes in service during instance refreshes", func() {
+			testNodeGroup := NodeGroup{InstanceRefresh: &InstanceRefresh{}}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(*testNodeGroup.InstanceRefresh.MinHealthyPercentage).To(Equal(90))
+			Expect(testNodeGroup.InstanceRefresh.InstanceWarmup).To(BeNil())
+		})
+
+		It("keeps the instances of warm pools stopped", func() {
+			testNodeGroup := NodeGroup{WarmPool: &WarmPool{}}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(testNodeGroup.WarmPool.PoolState).To(Equal(WarmPoolStateStopped))
+		})
+	})
+
+	Context("Placement", func() {
+
+		It("uses the cluster strategy for placement groups created by eksctl", func() {
+			testNodeGroup := NodeGroup{Placement: &Placement{}}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(testNodeGroup.Placement.Strategy).To(Equal(PlacementStrategyCluster))
+		})
+
+		It("doesn't set a strategy for existing placement groups", func() {
+			testNodeGroup := NodeGroup{Placement: &Placement{GroupName: "hpc-group"}}
+
+			SetNodeGroupDefaults(0, &testNodeGroup)
+
+			Expect(testNodeGroup.Placement.Strategy).To(BeEmpty())
+		})
+	})
+
 	Context("Cluster NAT settings", func() {
 
 		It("Cluster NAT defaults to single NAT gateway mode", func() {
@@ -0,0 +1,84 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// Types of zones, as returned by DescribeAvailabilityZones
+const (
+	ZoneTypeAvailabilityZone = "availability-zone"
+	ZoneTypeLocalZone        = "local-zone"
+	ZoneTypeWavelengthZone   = "wavelength-zone"
+)
+
+// IsEdgeZone determines if the zone is a Local Zone or a Wavelength Zone of the region,
+// these are named after the region and a location, e.g. us-west-2-lax-1a, unlike the
+// availability zones of the region, e.g. us-west-2a
+func IsEdgeZone(region, zone string) bool {
+	return region != "" && strings.HasPrefix(zone, region+"-")
+}
+
+// HasOutpost determines if the nodes of the nodegroup are launched on an Outpost
+func (n *NodeGroup) HasOutpost() bool {
+	return n.OutpostARN != ""
+}
+
+// validateControlPlaneZones checks that the cluster doesn't use Local Zones or
+// Wavelength Zones, which cannot host the control plane
+func validateControlPlaneZones(cfg *ClusterConfig) error {
+	zones := append([]string{}, cfg.AvailabilityZones...)
+	if cfg.VPC != nil && cfg.VPC.Subnets != nil {
+		for zone := range cfg.VPC.Subnets.Private {
+			zones = append(zones, zone)
+		}
+		for zone := range cfg.VPC.Subnets.Public {
+			zones = append(zones, zone)
+		}
+	}
+	for _, zone := range zones {
+		if IsEdgeZone(cfg.Metadata.Region, zone) {
+			return fmt.Errorf("%s is a Local Zone or Wavelength Zone, which cannot be used by the control plane, use nodeGroups[*].subnets to launch nodes in it", zone)
+		}
+	}
+	return nil
+}
+
+// validateNodeGroupSubnets checks the subnets of the nodegroup and the constraints
+// of Outposts, which don't support all the features of a region
+func validateNodeGroupSubnets(path string, ng *NodeGroup) error {
+	if len(ng.Subnets) > 0 && len(ng.AvailabilityZones) > 0 {
+		return fmt.Errorf("%s.subnets cannot be used with %s.availabilityZones", path, path)
+	}
+	for i, id := range ng.Subnets {
+		if id == "" {
+			return fmt.Errorf("%s.subnets[%d] must be non-empty", path, i)
+		}
+	}
+
+	if !ng.HasOutpost() {
+		return nil
+	}
+	if len(ng.Subnets) == 0 {
+		return fmt.Errorf("%s.subnets must be set to the subnets of the Outpost when %s.outpostARN is set", path, path)
+	}
+	outpostARN, err := arn.Parse(ng.OutpostARN)
+	if err != nil || outpostARN.Service != "outposts" || !strings.HasPrefix(outpostARN.Resource, "outpost/") {
+		return fmt.Errorf("%s.outpostARN (%q) must be the ARN of an Outpost", path, ng.OutpostARN)
+	}
+	if ng.InstancesDistribution != nil {
+		return fmt.Errorf("%s.instancesDistribution cannot be used on Outposts, they don't offer spot instances", path)
+	}
+	if ng.InstanceSelector != nil {
+		return fmt.Errorf("%s.instanceSelector cannot be used on Outposts, set %s.instanceType to an instance type of the Outpost", path, path)
+	}
+	if ng.WarmPool != nil {
+		return fmt.Errorf("%s.warmPool cannot be used on Outposts", path)
+	}
+	if IsSetAndNonEmptyString(ng.VolumeType) && *ng.VolumeType != NodeVolumeTypeGP2 {
+		return fmt.Errorf("%s.volumeType must be %q on Outposts", path, NodeVolumeTypeGP2)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package v1alpha5
+
+import (
+	"fmt"
+)
+
+const (
+	// MaxFargateProfileSelectors is the maximum number of selectors EKS allows per Fargate profile
+	MaxFargateProfileSelectors = 5
+
+	// DefaultFargateProfileName is the name of the Fargate profile created by 'eksctl create cluster --fargate'
+	DefaultFargateProfileName = "fp-default"
+)
+
+// FargateProfile defines the settings used to schedule workload onto Fargate
+type FargateProfile struct {
+	// Name of the Fargate profile
+	Name string `json:"name"`
+
+	// ARN of the IAM role used by Fargate to pull images and write logs,
+	// a role is created when it is not set
+	// +optional
+	PodExecutionRoleARN string `json:"podExecutionRoleARN,omitempty"`
+
+	// Selectors define the rules to select workload to schedule onto Fargate,
+	// a pod is scheduled onto Fargate when it matches any of the selectors
+	Selectors []FargateProfileSelector `json:"selectors"`
+
+	// Subnets to schedule pods into, only private subnets are supported by
+	// Fargate, the private subnets of the cluster are used when it is not set
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// FargateProfileSelector defines rules to select workload to schedule onto Fargate
+type FargateProfileSelector struct {
+	// Kubernetes namespace from which to select workload
+	Namespace string `json:"namespace"`
+
+	// Kubernetes label selector values of workloads to schedule onto Fargate,
+	// all labels need to match for a pod to be selected
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// NewDefaultFargateProfile returns a Fargate profile that selects all workload
+// in the "default" and "kube-system" namespaces
+func NewDefaultFargateProfile() *FargateProfile {
+	return &FargateProfile{
+		Name: DefaultFargateProfileName,
+		Selectors: []FargateProfileSelector{
+			{Namespace: "default"},
+			{Namespace: "kube-system"},
+		},
+	}
+}
+
+// NameString returns common name string
+func (fp *FargateProfile) NameString() string {
+	return fp.Name
+}
+
+// Matches reports whether the selector matches a pod in the given namespace
+// with the given labels
+func (s *FargateProfileSelector) Matches(namespace string, labels map[string]string) bool {
+	if s.Namespace != namespace {
+		return false
+	}
+	for k, v := range s.Labels {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// HasNodes reports whether the cluster config defines any nodegroups or managed nodegroups
+func (c *ClusterConfig) HasNodes() bool {
+	return len(c.NodeGroups) > 0 || len(c.ManagedNodeGroups) > 0
+}
+
+// ValidateFargateProfile checks compatible fields of a given Fargate profile
+func ValidateFargateProfile(i int, fp *FargateProfile) error {
+	path := fmt.Sprintf("fargateProfiles[%d]", i)
+
+	if len(fp.Selectors) == 0 {
+		return fmt.Errorf("%s.selectors must contain at least one selector", path)
+	}
+	if len(fp.Selectors) > MaxFargateProfileSelectors {
+		return fmt.Errorf("%s.selectors cannot contain more than %d selectors", path, MaxFargateProfileSelectors)
+	}
+	for j, selector := range fp.Selectors {
+		if selector.Namespace == "" {
+			return fmt.Errorf("%s.selectors[%d].namespace must be set", path, j)
+		}
+	}
+
+	return nil
+}
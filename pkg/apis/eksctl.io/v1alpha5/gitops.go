@@ -0,0 +1,86 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// Commonly-used constants
+const (
+	// DefaultGitBranch is the Git branch Flux syncs from by default
+	DefaultGitBranch = "master"
+	// DefaultGitUser is the username used as Git committer by default
+	DefaultGitUser = "Flux"
+	// DefaultGitFluxPath is the directory within the Git repository where
+	// eksctl commits the Flux manifests by default
+	DefaultGitFluxPath = "flux/"
+)
+
+// Git groups all configuration options related to enabling gitops on a
+// cluster and linking it to a Git repository
+type Git struct {
+	// +optional
+	Repo *Repo `json:"repo,omitempty"`
+}
+
+// Repo holds the configuration of the Git repository used for gitops
+type Repo struct {
+	// SSH URL of the Git repository, e.g. git@github.com:<github_org>/<repo_name>
+	// +optional
+	URL string `json:"url,omitempty"`
+	// +optional
+	Branch string `json:"branch,omitempty"`
+	// Relative paths within the Git repository Flux will be restricted to,
+	// defaults to the directory eksctl writes the Flux manifests to
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+	// Directory within the Git repository where eksctl commits the Flux manifests
+	// +optional
+	FluxPath string `json:"fluxPath,omitempty"`
+	// +optional
+	User string `json:"user,omitempty"`
+	// +optional
+	Email string `json:"email,omitempty"`
+	// +optional
+	PrivateSSHKeyPath string `json:"privateSSHKeyPath,omitempty"`
+}
+
+// HasGitRepo determines if a Git repository was configured for gitops
+func (c *ClusterConfig) HasGitRepo() bool {
+	return c.Git != nil && c.Git.Repo != nil
+}
+
+// SetGitRepoDefaults will set defaults for a given Git repository configuration
+func SetGitRepoDefaults(repo *Repo) {
+	if repo.Branch == "" {
+		repo.Branch = DefaultGitBranch
+	}
+	if repo.User == "" {
+		repo.User = DefaultGitUser
+	}
+	if repo.FluxPath == "" {
+		repo.FluxPath = DefaultGitFluxPath
+	}
+	if len(repo.Paths) == 0 {
+		repo.Paths = []string{repo.FluxPath}
+	}
+}
+
+// ValidateGitPaths checks that all given paths are relative to the root of
+// the Git repository, and do not point outside of it
+func ValidateGitPaths(paths []string) error {
+	for i, p := range paths {
+		if p == "" {
+			return fmt.Errorf("git path #%d must be non-empty", i)
+		}
+		if filepath.IsAbs(p) {
+			return fmt.Errorf("git path %q must be relative to the root of the repository", p)
+		}
+		cleanPath := filepath.Clean(p)
+		if cleanPath == ".." || strings.HasPrefix(cleanPath, "../") {
+			return fmt.Errorf("git path %q must not point outside of the repository", p)
+		}
+	}
+	return nil
+}
@@ -29,6 +29,10 @@ type ClusterIAMServiceAccount struct {
 	AttachPolicyARNs []string `json:"attachPolicyARNs,omitempty"`
 	// +optional
 	AttachPolicy InlineDocument `json:"attachPolicy,omitempty"`
+	// WithAddonPolicies attaches the policies needed by common controllers,
+	// using the same shortcuts as nodeGroups[*].iam.withAddonPolicies
+	// +optional
+	WithAddonPolicies *NodeGroupIAMAddonPolicies `json:"withAddonPolicies,omitempty"`
 	// +optional
 	Status *ClusterIAMServiceAccountStatus `json:"status,omitempty"`
 }
@@ -39,6 +43,11 @@ type ClusterIAMServiceAccountStatus struct {
 	RoleARN *string `json:"roleARN,omitempty"`
 }
 
+// HasAddonPolicies returns true if any of the addon policies is enabled
+func (sa *ClusterIAMServiceAccount) HasAddonPolicies() bool {
+	return sa.WithAddonPolicies != nil && sa.WithAddonPolicies.HasAny()
+}
+
 // NameString returns common name string
 func (sa *ClusterIAMServiceAccount) NameString() string {
 	return sa.Namespace + "/" + sa.Name
@@ -67,3 +76,25 @@ func (sa *ClusterIAMServiceAccount) SetAnnotations() {
 		sa.Annotations[AnnotationEKSRoleARN] = *sa.Status.RoleARN
 	}
 }
+
+// HasAny returns true if any of the addon policies is enabled
+func (p *NodeGroupIAMAddonPolicies) HasAny() bool {
+	for _, enabled := range []*bool{
+		p.ImageBuilder,
+		p.AutoScaler,
+		p.ExternalDNS,
+		p.CertManager,
+		p.AppMesh,
+		p.EBS,
+		p.FSX,
+		p.EFS,
+		p.ALBIngress,
+		p.XRay,
+		p.CloudWatch,
+	} {
+		if IsEnabled(enabled) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Types of identity providers
+const (
+	// OIDCIdentityProviderType is the type of OpenID Connect identity providers
+	OIDCIdentityProviderType = "oidc"
+)
+
+// IdentityProvider holds the configuration of an external identity provider that users
+// can authenticate to the cluster with, in addition to IAM
+type IdentityProvider struct {
+	// Name of the identity provider configuration
+	Name string `json:"name"`
+	// Type of the identity provider, only `oidc` is supported (defaults to `oidc`)
+	// +optional
+	Type string `json:"type,omitempty"`
+	// URL of the OpenID Connect issuer, it must use https
+	IssuerURL string `json:"issuerURL"`
+	// ID of the client application that makes authentication requests
+	ClientID string `json:"clientID"`
+	// JWT claim to use as the username (defaults to `sub`)
+	// +optional
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+	// Prefix prepended to username claims to prevent clashes with existing names
+	// +optional
+	UsernamePrefix string `json:"usernamePrefix,omitempty"`
+	// JWT claim to use as the user's groups
+	// +optional
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// Prefix prepended to group claims to prevent clashes with existing names
+	// +optional
+	GroupsPrefix string `json:"groupsPrefix,omitempty"`
+	// Key value pairs that describe required claims in the identity token
+	// +optional
+	RequiredClaims map[string]string `json:"requiredClaims,omitempty"`
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// SetIdentityProviderDefaults sets the defaults of an identity provider
+func SetIdentityProviderDefaults(idp *IdentityProvider) {
+	if idp.Type == "" {
+		idp.Type = OIDCIdentityProviderType
+	}
+}
+
+// ValidateIdentityProvider validates the configuration of the identity provider at the given path
+func ValidateIdentityProvider(path string, idp *IdentityProvider) error {
+	if idp.Name == "" {
+		return fmt.Errorf("%s.name must be set", path)
+	}
+	if idp.Type != OIDCIdentityProviderType {
+		return fmt.Errorf("%s.type %q is not supported, only %q is supported", path, idp.Type, OIDCIdentityProviderType)
+	}
+	if idp.IssuerURL == "" {
+		return fmt.Errorf("%s.issuerURL must be set", path)
+	}
+	issuerURL, err := url.Parse(idp.IssuerURL)
+	if err != nil || issuerURL.Scheme != "https" || issuerURL.Host == "" {
+		return fmt.Errorf("%s.issuerURL %q is not valid, it must be a https URL", path, idp.IssuerURL)
+	}
+	if idp.ClientID == "" {
+		return fmt.Errorf("%s.clientID must be set", path)
+	}
+	return validateTags(path+".tags", idp.Tags)
+}
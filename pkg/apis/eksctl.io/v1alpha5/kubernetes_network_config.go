@@ -0,0 +1,73 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
+)
+
+// KubernetesNetworkConfig holds the Kubernetes network configuration of the cluster
+type KubernetesNetworkConfig struct {
+	// ServiceIPv4CIDR is the CIDR block that Kubernetes service IP addresses are
+	// assigned from, EKS uses 10.100.0.0/16 or 172.20.0.0/16 when it's not set
+	// +optional
+	ServiceIPv4CIDR *ipnet.IPNet `json:"serviceIPv4CIDR,omitempty"`
+}
+
+// privateIPv4Blocks are the blocks that the service CIDR must be within
+var privateIPv4Blocks = []*ipnet.IPNet{
+	ipnet.MustParseCIDR("10.0.0.0/8"),
+	ipnet.MustParseCIDR("172.16.0.0/12"),
+	ipnet.MustParseCIDR("192.168.0.0/16"),
+}
+
+// HasServiceIPv4CIDR determines if a custom service CIDR was set
+func (c *ClusterConfig) HasServiceIPv4CIDR() bool {
+	return c.KubernetesNetworkConfig != nil && c.KubernetesNetworkConfig.ServiceIPv4CIDR != nil
+}
+
+// ClusterDNSIP returns the address of the cluster DNS service, which is the tenth
+// address of the service CIDR, or an empty string when no service CIDR was set
+func (c *ClusterConfig) ClusterDNSIP() string {
+	if !c.HasServiceIPv4CIDR() {
+		return ""
+	}
+	ip := c.KubernetesNetworkConfig.ServiceIPv4CIDR.IP.To4()
+	if ip == nil {
+		return ""
+	}
+	dnsIP := make(net.IP, len(ip))
+	copy(dnsIP, ip)
+	dnsIP[3] += 10
+	return dnsIP.String()
+}
+
+// ValidateKubernetesNetworkConfig checks that the service CIDR is a private block
+// that EKS accepts, and that it doesn't overlap with the VPC
+func ValidateKubernetesNetworkConfig(cfg *ClusterConfig) error {
+	if !cfg.HasServiceIPv4CIDR() {
+		return nil
+	}
+	cidr := cfg.KubernetesNetworkConfig.ServiceIPv4CIDR
+
+	if cidr.IP.To4() == nil {
+		return fmt.Errorf("kubernetesNetworkConfig.serviceIPv4CIDR (%s) must be an IPv4 CIDR", cidr)
+	}
+	if prefix, _ := cidr.Mask.Size(); prefix < 12 || prefix > 24 {
+		return fmt.Errorf("kubernetesNetworkConfig.serviceIPv4CIDR prefix must be between /12 and /24")
+	}
+
+	private := false
+	for _, block := range privateIPv4Blocks {
+		private = private || block.Contains(cidr.IP)
+	}
+	if !private {
+		return fmt.Errorf("kubernetesNetworkConfig.serviceIPv4CIDR (%s) must be within 10.0.0.0/8, 172.16.0.0/12 or 192.168.0.0/16", cidr)
+	}
+
+	if cfg.VPC != nil && cfg.VPC.CIDR != nil && (cfg.VPC.CIDR.Contains(cidr.IP) || cidr.Contains(cfg.VPC.CIDR.IP)) {
+		return fmt.Errorf("kubernetesNetworkConfig.serviceIPv4CIDR (%s) overlaps with vpc.cidr (%s)", cidr, cfg.VPC.CIDR)
+	}
+	return nil
+}
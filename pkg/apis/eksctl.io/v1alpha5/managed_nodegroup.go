@@ -0,0 +1,231 @@
+package v1alpha5
+
+import (
+	"fmt"
+)
+
+// NodeGroupType defines the type of a nodegroup
+type NodeGroupType string
+
+const (
+	// NodeGroupTypeManaged defines a managed nodegroup
+	NodeGroupTypeManaged NodeGroupType = "managed"
+)
+
+// Values for the AMI types of managed nodegroups
+const (
+	// ManagedNodeGroupAMITypeAL2 is the AMI type used for Amazon Linux 2 managed nodes
+	ManagedNodeGroupAMITypeAL2 = "AL2_x86_64"
+	// ManagedNodeGroupAMITypeAL2GPU is the AMI type used for GPU-enabled Amazon Linux 2 managed nodes
+	ManagedNodeGroupAMITypeAL2GPU = "AL2_x86_64_GPU"
+	// ManagedNodeGroupAMITypeAL2ARM is the AMI type used for ARM Amazon Linux 2 managed nodes
+	ManagedNodeGroupAMITypeAL2ARM = "AL2_ARM_64"
+)
+
+// ManagedNodeGroup holds all configuration attributes that are specific
+// to a nodegroup whose lifecycle is managed by EKS
+type ManagedNodeGroup struct {
+	Name string `json:"name"`
+	// Custom AMI to use for the nodes, it requires overrideBootstrapCommand
+	// to be set, and cannot be used along with launchTemplate
+	// +optional
+	AMI string `json:"ami,omitempty"`
+	// +optional
+	AMIFamily string `json:"amiFamily,omitempty"`
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// +optional
+	PrivateNetworking bool `json:"privateNetworking"`
+
+	// +optional
+	SecurityGroups *NodeGroupSGs `json:"securityGroups,omitempty"`
+
+	// +optional
+	DesiredCapacity *int `json:"desiredCapacity,omitempty"`
+	// +optional
+	MinSize *int `json:"minSize,omitempty"`
+	// +optional
+	MaxSize *int `json:"maxSize,omitempty"`
+
+	// +optional
+	VolumeSize *int `json:"volumeSize,omitempty"`
+
+	// +optional
+	SSH *NodeGroupSSH `json:"ssh,omitempty"`
+
+	// +optional
+	IAM *NodeGroupIAM `json:"iam,omitempty"`
+
+	// +optional
+	PreBootstrapCommands []string `json:"preBootstrapCommands,omitempty"`
+
+	// +optional
+	OverrideBootstrapCommand *string `json:"overrideBootstrapCommand,omitempty"`
+
+	// Existing EC2 launch template to use for the nodes, when it is not
+	// set eksctl will generate a launch template if any of ami,
+	// securityGroups.attachIDs, preBootstrapCommands or
+	// overrideBootstrapCommand are set
+	// +optional
+	LaunchTemplate *LaunchTemplate `json:"launchTemplate,omitempty"`
+}
+
+// LaunchTemplate references an existing EC2 launch template
+type LaunchTemplate struct {
+	// ID of the launch template
+	ID string `json:"id"`
+	// Version of the launch template, the default version of the
+	// launch template is used when it is not set
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// NewManagedNodeGroup creates new managed nodegroup, and returns pointer to it
+func NewManagedNodeGroup() *ManagedNodeGroup {
+	return &ManagedNodeGroup{
+		SecurityGroups: &NodeGroupSGs{
+			AttachIDs: []string{},
+		},
+		IAM: &NodeGroupIAM{},
+		SSH: &NodeGroupSSH{
+			Allow: Disabled(),
+		},
+	}
+}
+
+// NameString returns common name string
+func (m *ManagedNodeGroup) NameString() string {
+	return m.Name
+}
+
+// HasLaunchTemplate checks if the managed nodegroup references an existing launch template
+func (m *ManagedNodeGroup) HasLaunchTemplate() bool {
+	return m.LaunchTemplate != nil
+}
+
+// NeedsLaunchTemplate checks if the managed nodegroup uses any customisation
+// that eksctl has to express in a launch template it generates
+func (m *ManagedNodeGroup) NeedsLaunchTemplate() bool {
+	if m.HasLaunchTemplate() {
+		return false
+	}
+	return IsAMI(m.AMI) ||
+		(m.SecurityGroups != nil && len(m.SecurityGroups.AttachIDs) > 0) ||
+		len(m.PreBootstrapCommands) > 0 ||
+		m.OverrideBootstrapCommand != nil
+}
+
+// SetManagedNodeGroupDefaults will set defaults for a given managed nodegroup
+func SetManagedNodeGroupDefaults(_ int, ng *ManagedNodeGroup, meta *ClusterMeta) {
+	if ng.AMIFamily == "" {
+		ng.AMIFamily = DefaultNodeImageFamily
+	}
+	if ng.InstanceType == "" && !ng.HasLaunchTemplate() {
+		ng.InstanceType = DefaultNodeType
+	}
+
+	if ng.SecurityGroups == nil {
+		ng.SecurityGroups = &NodeGroupSGs{
+			AttachIDs: []string{},
+		}
+	}
+
+	if ng.SSH == nil {
+		ng.SSH = &NodeGroupSSH{
+			Allow: Disabled(),
+		}
+	}
+	numSSHFlagsEnabled := countEnabledFields(
+		ng.SSH.PublicKeyName,
+		ng.SSH.PublicKeyPath,
+		ng.SSH.PublicKey)
+	if numSSHFlagsEnabled > 0 {
+		ng.SSH.Allow = Enabled()
+	} else if IsEnabled(ng.SSH.Allow) {
+		ng.SSH.PublicKeyPath = &DefaultNodeSSHPublicKeyPath
+	} else {
+		ng.SSH.Allow = Disabled()
+	}
+
+	if ng.IAM == nil {
+		ng.IAM = &NodeGroupIAM{}
+	}
+
+	if ng.Labels == nil {
+		ng.Labels = make(map[string]string)
+	}
+	ng.Labels[ClusterNameLabel] = meta.Name
+	ng.Labels[NodeGroupNameLabel] = ng.Name
+}
+
+// ValidateManagedNodeGroup checks compatible fields of a given managed nodegroup
+func ValidateManagedNodeGroup(i int, ng *ManagedNodeGroup) error {
+	path := fmt.Sprintf("managedNodeGroups[%d]", i)
+
+	if err := validateTags(path+".tags", ng.Tags); err != nil {
+		return err
+	}
+
+	if ng.AMIFamily != "" && ng.AMIFamily != NodeImageFamilyAmazonLinux2 {
+		return fmt.Errorf("%s.amiFamily %q is not supported for managed nodegroups, only %q is supported", path, ng.AMIFamily, NodeImageFamilyAmazonLinux2)
+	}
+
+	if ng.AMI != "" && !IsAMI(ng.AMI) {
+		return fmt.Errorf("%s.ami must be an AMI ID (ami-...) when set, got %q", path, ng.AMI)
+	}
+
+	if ng.HasLaunchTemplate() {
+		if ng.LaunchTemplate.ID == "" {
+			return fmt.Errorf("%s.launchTemplate.id must be set", path)
+		}
+		if ng.LaunchTemplate.Version != nil && *ng.LaunchTemplate.Version == "" {
+			return fmt.Errorf("%s.launchTemplate.version must be non-empty when set", path)
+		}
+
+		errCantSet := func(field string) error {
+			return fmt.Errorf("%s.%s cannot be set when %s.launchTemplate is specified, it should be set in the launch template instead", path, field, path)
+		}
+		if ng.AMI != "" {
+			return errCantSet("ami")
+		}
+		if ng.VolumeSize != nil {
+			return errCantSet("volumeSize")
+		}
+		if ng.SSH != nil && (IsEnabled(ng.SSH.Allow) || countEnabledFields(ng.SSH.PublicKeyName, ng.SSH.PublicKeyPath, ng.SSH.PublicKey) > 0) {
+			return errCantSet("ssh")
+		}
+		if ng.SecurityGroups != nil && len(ng.SecurityGroups.AttachIDs) > 0 {
+			return errCantSet("securityGroups.attachIDs")
+		}
+		if len(ng.PreBootstrapCommands) > 0 {
+			return errCantSet("preBootstrapCommands")
+		}
+		if ng.OverrideBootstrapCommand != nil {
+			return errCantSet("overrideBootstrapCommand")
+		}
+	} else if IsAMI(ng.AMI) && ng.OverrideBootstrapCommand == nil {
+		return fmt.Errorf("%s.overrideBootstrapCommand is required when using a custom AMI (%s.ami)", path, path)
+	} else if !IsAMI(ng.AMI) && ng.OverrideBootstrapCommand != nil {
+		return fmt.Errorf("%s.overrideBootstrapCommand can only be set when using a custom AMI (%s.ami)", path, path)
+	}
+
+	if ng.SecurityGroups != nil && (ng.SecurityGroups.WithShared != nil || ng.SecurityGroups.WithLocal != nil) {
+		return fmt.Errorf("%s.securityGroups.withShared and %s.securityGroups.withLocal are not supported for managed nodegroups", path, path)
+	}
+
+	if ng.IAM != nil && ng.IAM.InstanceProfileARN != "" {
+		return fmt.Errorf("%s.iam.instanceProfileARN is not supported for managed nodegroups, use %s.iam.instanceRoleARN instead", path, path)
+	}
+
+	if err := validateNodeGroupSSH(ng.SSH); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package v1alpha5
+
+import (
+	"fmt"
+)
+
+// NodeTerminationHandlerLifecycleHookName is the name of the lifecycle hook of the auto
+// scaling groups of nodegroups with spot instances, which delays the termination of their
+// instances for the AWS Node Termination Handler to drain the nodes
+const NodeTerminationHandlerLifecycleHookName = "aws-node-termination-handler"
+
+// NodeTerminationHandler holds the configuration of the AWS Node Termination Handler,
+// which eksctl installs when nodegroups with spot instances are created
+type NodeTerminationHandler struct {
+	// Enabled installs the AWS Node Termination Handler, which drains nodes that are
+	// about to be interrupted or terminated, and adds a lifecycle hook to the auto
+	// scaling groups of nodegroups with spot instances
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Image overrides the image of the AWS Node Termination Handler
+	// +optional
+	Image string `json:"image,omitempty"`
+	// LifecycleHookTimeout is how long, in seconds, the termination of instances is
+	// delayed for nodes to be drained, defaults to 300
+	// +optional
+	LifecycleHookTimeout *int `json:"lifecycleHookTimeout,omitempty"`
+}
+
+// HasNodeTerminationHandler returns true if the AWS Node Termination Handler is to be installed
+func (c *ClusterConfig) HasNodeTerminationHandler() bool {
+	return c.NodeTerminationHandler != nil && IsEnabled(c.NodeTerminationHandler.Enabled)
+}
+
+// ValidateNodeTerminationHandler checks the lifecycle hook timeout
+func ValidateNodeTerminationHandler(cfg *ClusterConfig) error {
+	if !cfg.HasNodeTerminationHandler() {
+		return nil
+	}
+	// the limits of the heartbeat timeout of lifecycle hooks
+	if timeout := cfg.NodeTerminationHandler.LifecycleHookTimeout; timeout != nil && (*timeout < 30 || *timeout > 7200) {
+		return fmt.Errorf("nodeTerminationHandler.lifecycleHookTimeout must be between 30 and 7200 seconds")
+	}
+	return nil
+}
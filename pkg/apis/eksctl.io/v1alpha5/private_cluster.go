@@ -0,0 +1,88 @@
+package v1alpha5
+
+import (
+	"fmt"
+)
+
+// PrivateCluster defines the configuration for a fully-private cluster
+type PrivateCluster struct {
+	// Enabled creates a cluster without outbound internet access, i.e. the
+	// Kubernetes API endpoint is private-only, the VPC has no NAT or internet
+	// gateway, and VPC endpoints are created for the AWS services that nodes
+	// depend on
+	Enabled bool `json:"enabled"`
+
+	// AdditionalEndpointServices is a list of extra AWS services to create
+	// VPC endpoints for, e.g. "autoscaling" for the cluster autoscaler
+	// +optional
+	AdditionalEndpointServices []string `json:"additionalEndpointServices,omitempty"`
+}
+
+// RequiredEndpointServices returns the AWS services that VPC endpoints are
+// always created for in a fully-private cluster, so that nodes can join
+// and pull images
+func RequiredEndpointServices() []string {
+	return []string{"ec2", "ecr.api", "ecr.dkr", "s3", "sts", "logs"}
+}
+
+// IsFullyPrivate determines if the cluster is fully-private or not
+func (c *ClusterConfig) IsFullyPrivate() bool {
+	return c.PrivateCluster != nil && c.PrivateCluster.Enabled
+}
+
+// EndpointServices returns the AWS services to create VPC endpoints for
+func (c *ClusterConfig) EndpointServices() []string {
+	services := RequiredEndpointServices()
+	if c.PrivateCluster == nil {
+		return services
+	}
+	for _, service := range c.PrivateCluster.AdditionalEndpointServices {
+		if !containsString(services, service) {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+// ValidatePrivateCluster checks that the rest of the config is compatible with a fully-private cluster
+func ValidatePrivateCluster(cfg *ClusterConfig) error {
+	if !cfg.IsFullyPrivate() {
+		return nil
+	}
+
+	for i, service := range cfg.PrivateCluster.AdditionalEndpointServices {
+		if service == "" {
+			return fmt.Errorf("privateCluster.additionalEndpointServices[%d] must be non-empty", i)
+		}
+	}
+
+	if cfg.VPC != nil && cfg.VPC.NAT != nil && IsSetAndNonEmptyString(cfg.VPC.NAT.Gateway) && *cfg.VPC.NAT.Gateway != ClusterDisableNAT {
+		return fmt.Errorf("vpc.nat.gateway must be %q for a fully-private cluster", ClusterDisableNAT)
+	}
+
+	for i, ng := range cfg.NodeGroups {
+		if !ng.PrivateNetworking {
+			return fmt.Errorf("nodeGroups[%d].privateNetworking must be enabled for a fully-private cluster", i)
+		}
+		// the SSM agent is installed with yum when nodes boot, and it needs endpoints that are not created
+		if ng.SSH != nil && IsEnabled(ng.SSH.EnableSSM) {
+			return fmt.Errorf("nodeGroups[%d].ssh.enableSSM is not supported for a fully-private cluster, as the SSM agent cannot be installed without internet access", i)
+		}
+	}
+	for i, ng := range cfg.ManagedNodeGroups {
+		if !ng.PrivateNetworking {
+			return fmt.Errorf("managedNodeGroups[%d].privateNetworking must be enabled for a fully-private cluster", i)
+		}
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,8 @@
 package v1alpha5
 
 import (
+	"sync"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -32,9 +34,18 @@ func Resource(resource string) schema.GroupResource {
 	return SchemeGroupVersion.WithResource(resource).GroupResource()
 }
 
-// Register our API with the scheme
+var (
+	registerOnce sync.Once
+	registerErr  error
+)
+
+// Register our API with the scheme, it's safe to call it more than once,
+// also from concurrent goroutines
 func Register() error {
-	return AddToScheme(scheme.Scheme)
+	registerOnce.Do(func() {
+		registerErr = AddToScheme(scheme.Scheme)
+	})
+	return registerErr
 }
 
 func addKnownTypes(scheme *runtime.Scheme) error {
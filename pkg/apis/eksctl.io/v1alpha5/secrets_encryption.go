@@ -0,0 +1,36 @@
+package v1alpha5
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// SecretsEncryption defines the configuration for KMS encryption provider
+type SecretsEncryption struct {
+	// ARN of the KMS key used to encrypt Kubernetes secrets
+	KeyARN *string `json:"keyARN,omitempty"`
+}
+
+// HasSecretsEncryption determines if envelope encryption of Kubernetes secrets was enabled or not
+func (c *ClusterConfig) HasSecretsEncryption() bool {
+	return c.SecretsEncryption != nil && IsSetAndNonEmptyString(c.SecretsEncryption.KeyARN)
+}
+
+// ValidateSecretsEncryption checks that the KMS key ARN is set and well-formed
+func ValidateSecretsEncryption(cfg *ClusterConfig) error {
+	if cfg.SecretsEncryption == nil {
+		return nil
+	}
+	if !IsSetAndNonEmptyString(cfg.SecretsEncryption.KeyARN) {
+		return fmt.Errorf("secretsEncryption.keyARN is required")
+	}
+	keyARN, err := arn.Parse(*cfg.SecretsEncryption.KeyARN)
+	if err != nil {
+		return fmt.Errorf("invalid secretsEncryption.keyARN %q: %v", *cfg.SecretsEncryption.KeyARN, err)
+	}
+	if keyARN.Service != "kms" {
+		return fmt.Errorf("invalid secretsEncryption.keyARN %q: must be the ARN of a KMS key", *cfg.SecretsEncryption.KeyARN)
+	}
+	return nil
+}
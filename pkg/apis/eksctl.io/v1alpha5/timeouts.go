@@ -0,0 +1,98 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterTimeouts holds how long eksctl waits for each phase of creating
+// and deleting clusters, they override the --timeout flag
+type ClusterTimeouts struct {
+	// ClusterCreation is how long to wait for the cluster stack to be created
+	// +optional
+	ClusterCreation *metav1.Duration `json:"clusterCreation,omitempty"`
+	// NodeGroupCreation is how long to wait for each nodegroup stack to be created
+	// +optional
+	NodeGroupCreation *metav1.Duration `json:"nodeGroupCreation,omitempty"`
+	// NodeJoin is how long to wait for the nodes of each nodegroup to join the cluster
+	// +optional
+	NodeJoin *metav1.Duration `json:"nodeJoin,omitempty"`
+	// Update is how long to wait for each stack, control plane, add-on or
+	// instance refresh update to complete
+	// +optional
+	Update *metav1.Duration `json:"update,omitempty"`
+	// Deletion is how long to wait for each stack or add-on to be deleted
+	// +optional
+	Deletion *metav1.Duration `json:"deletion,omitempty"`
+}
+
+func timeoutOrDefault(timeout *metav1.Duration, defaultTimeout time.Duration) time.Duration {
+	if timeout == nil {
+		return defaultTimeout
+	}
+	return timeout.Duration
+}
+
+// ClusterCreationTimeout returns timeouts.clusterCreation, or defaultTimeout when it's not set
+func (c *ClusterConfig) ClusterCreationTimeout(defaultTimeout time.Duration) time.Duration {
+	if c.Timeouts == nil {
+		return defaultTimeout
+	}
+	return timeoutOrDefault(c.Timeouts.ClusterCreation, defaultTimeout)
+}
+
+// NodeGroupCreationTimeout returns timeouts.nodeGroupCreation, or defaultTimeout when it's not set
+func (c *ClusterConfig) NodeGroupCreationTimeout(defaultTimeout time.Duration) time.Duration {
+	if c.Timeouts == nil {
+		return defaultTimeout
+	}
+	return timeoutOrDefault(c.Timeouts.NodeGroupCreation, defaultTimeout)
+}
+
+// NodeJoinTimeout returns timeouts.nodeJoin, or defaultTimeout when it's not set
+func (c *ClusterConfig) NodeJoinTimeout(defaultTimeout time.Duration) time.Duration {
+	if c.Timeouts == nil {
+		return defaultTimeout
+	}
+	return timeoutOrDefault(c.Timeouts.NodeJoin, defaultTimeout)
+}
+
+// UpdateTimeout returns timeouts.update, or defaultTimeout when it's not set
+func (c *ClusterConfig) UpdateTimeout(defaultTimeout time.Duration) time.Duration {
+	if c.Timeouts == nil {
+		return defaultTimeout
+	}
+	return timeoutOrDefault(c.Timeouts.Update, defaultTimeout)
+}
+
+// DeletionTimeout returns timeouts.deletion, or defaultTimeout when it's not set
+func (c *ClusterConfig) DeletionTimeout(defaultTimeout time.Duration) time.Duration {
+	if c.Timeouts == nil {
+		return defaultTimeout
+	}
+	return timeoutOrDefault(c.Timeouts.Deletion, defaultTimeout)
+}
+
+// ValidateClusterTimeouts checks that the timeouts that are set are positive
+func ValidateClusterTimeouts(cfg *ClusterConfig) error {
+	if cfg.Timeouts == nil {
+		return nil
+	}
+	for _, t := range []struct {
+		name    string
+		timeout *metav1.Duration
+	}{
+		{"clusterCreation", cfg.Timeouts.ClusterCreation},
+		{"nodeGroupCreation", cfg.Timeouts.NodeGroupCreation},
+		{"nodeJoin", cfg.Timeouts.NodeJoin},
+		{"update", cfg.Timeouts.Update},
+		{"deletion", cfg.Timeouts.Deletion},
+	} {
+		if t.timeout != nil && t.timeout.Duration <= 0 {
+			return fmt.Errorf("timeouts.%s must be greater than 0", t.name)
+		}
+	}
+	return nil
+}
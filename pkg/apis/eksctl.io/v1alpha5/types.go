@@ -2,9 +2,11 @@ package v1alpha5
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -105,6 +107,8 @@ const (
 
 	// NodeVolumeTypeGP2 is General Purpose SSD
 	NodeVolumeTypeGP2 = "gp2"
+	// NodeVolumeTypeGP3 is General Purpose SSD with provisioned IOPS and throughput
+	NodeVolumeTypeGP3 = "gp3"
 	// NodeVolumeTypeIO1 is Provisioned IOPS SSD
 	NodeVolumeTypeIO1 = "io1"
 	// NodeVolumeTypeSC1 is Throughput Optimized HDD
@@ -112,12 +116,19 @@ const (
 	// NodeVolumeTypeST1 is Cold HDD
 	NodeVolumeTypeST1 = "st1"
 
+	// SpotAllocationStrategyLowestPrice launches spot instances from the lowest priced pools
+	SpotAllocationStrategyLowestPrice = "lowest-price"
+	// SpotAllocationStrategyCapacityOptimized launches spot instances from the pools with optimal capacity
+	SpotAllocationStrategyCapacityOptimized = "capacity-optimized"
+
 	// DefaultNodeImageFamily defines the default image family for the worker nodes
 	DefaultNodeImageFamily = NodeImageFamilyAmazonLinux2
 	// NodeImageFamilyAmazonLinux2 represents Amazon Linux 2 family
 	NodeImageFamilyAmazonLinux2 = "AmazonLinux2"
 	// NodeImageFamilyUbuntu1804 represents Ubuntu 18.04 family
 	NodeImageFamilyUbuntu1804 = "Ubuntu1804"
+	// NodeImageFamilyBottlerocket represents Bottlerocket family
+	NodeImageFamilyBottlerocket = "Bottlerocket"
 
 	// NodeImageFamilyWindowsServer2019CoreContainer represents Windows 2019 core container family
 	NodeImageFamilyWindowsServer2019CoreContainer = "WindowsServer2019CoreContainer"
@@ -145,18 +156,44 @@ const (
 	// OldNodeGroupNameTag defines the tag of the nodegroup name
 	OldNodeGroupNameTag = "eksctl.io/v1alpha2/nodegroup-name"
 
+	// NodeGroupTypeTag defines the tag of the nodegroup type, it is only set on managed nodegroups
+	NodeGroupTypeTag = "alpha.eksctl.io/nodegroup-type"
+
 	// OldNodeGroupIDTag defines the old version of tag of the nodegroup name
 	OldNodeGroupIDTag = "eksctl.cluster.k8s.io/v1alpha1/nodegroup-id"
 
 	// IAMServiceAccountNameTag defines the tag of the iamserviceaccount name
 	IAMServiceAccountNameTag = "alpha.eksctl.io/iamserviceaccount-name"
 
+	// FargateProfileNameTag defines the tag of the Fargate profile name
+	FargateProfileNameTag = "alpha.eksctl.io/fargate-profile-name"
+
+	// AddonNameTag defines the tag of the name of the EKS managed add-on an IAM role is for
+	AddonNameTag = "alpha.eksctl.io/addon-name"
+
 	// ClusterNameLabel defines the tag of the cluster name
 	ClusterNameLabel = "alpha.eksctl.io/cluster-name"
 
 	// NodeGroupNameLabel defines the label of the nodegroup name
 	NodeGroupNameLabel = "alpha.eksctl.io/nodegroup-name"
 
+	// NodeTemplateLabelTagPrefix is the prefix of ASG tags that tell cluster-autoscaler
+	// which labels nodes of a nodegroup have
+	NodeTemplateLabelTagPrefix = "k8s.io/cluster-autoscaler/node-template/label/"
+
+	// NodeTemplateTaintTagPrefix is the prefix of ASG tags that tell cluster-autoscaler
+	// which taints nodes of a nodegroup have
+	NodeTemplateTaintTagPrefix = "k8s.io/cluster-autoscaler/node-template/taint/"
+
+	// TaintEffectNoSchedule defines the NoSchedule taint effect
+	TaintEffectNoSchedule = "NoSchedule"
+
+	// TaintEffectPreferNoSchedule defines the PreferNoSchedule taint effect
+	TaintEffectPreferNoSchedule = "PreferNoSchedule"
+
+	// TaintEffectNoExecute defines the NoExecute taint effect
+	TaintEffectNoExecute = "NoExecute"
+
 	// ClusterHighlyAvailableNAT defines the highly available NAT configuration option
 	ClusterHighlyAvailableNAT = "HighlyAvailable"
 
@@ -256,16 +293,54 @@ func SupportedVersions() []string {
 	}
 }
 
+// IsSupportedVersion checks whether version is one of the SupportedVersions
+func IsSupportedVersion(version string) bool {
+	for _, v := range SupportedVersions() {
+		if version == v {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDeprecatedVersion checks whether version is one of the DeprecatedVersions
+func IsDeprecatedVersion(version string) bool {
+	for _, v := range DeprecatedVersions() {
+		if version == v {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportedTaintEffects are the effects that can be used in nodegroup taints
+func SupportedTaintEffects() []string {
+	return []string{
+		TaintEffectNoSchedule,
+		TaintEffectPreferNoSchedule,
+		TaintEffectNoExecute,
+	}
+}
+
 // SupportedNodeVolumeTypes are the volume types that can be used for a node root volume
 func SupportedNodeVolumeTypes() []string {
 	return []string{
 		NodeVolumeTypeGP2,
+		NodeVolumeTypeGP3,
 		NodeVolumeTypeIO1,
 		NodeVolumeTypeSC1,
 		NodeVolumeTypeST1,
 	}
 }
 
+// SupportedSpotAllocationStrategies are the allocation strategies that can be used for spot instances
+func SupportedSpotAllocationStrategies() []string {
+	return []string{
+		SpotAllocationStrategyLowestPrice,
+		SpotAllocationStrategyCapacityOptimized,
+	}
+}
+
 // EKSResourceAccountID provides worker node resources(ami/ecr image) in different aws account
 // for different aws partitions & opt-in regions.
 func EKSResourceAccountID(region string) string {
@@ -285,6 +360,8 @@ type ClusterMeta struct {
 	Region string `json:"region"`
 	// +optional
 	Version string `json:"version,omitempty"`
+	// Tags are applied to all CloudFormation stacks, and propagated
+	// to the cluster, the nodes and other AWS resources created by eksctl
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
 }
@@ -319,6 +396,7 @@ type ClusterProvider interface {
 	SSM() ssmiface.SSMAPI
 	IAM() iamiface.IAMAPI
 	CloudTrail() cloudtrailiface.CloudTrailAPI
+	ASG() autoscalingiface.AutoScalingAPI
 	Region() string
 	Profile() string
 	WaitTimeout() time.Duration
@@ -331,6 +409,14 @@ type ProviderConfig struct {
 	Region      string
 	Profile     string
 	WaitTimeout time.Duration
+
+	// NoCache disables the on-disk cache of lookups, such as AMIs and
+	// availability zones
+	NoCache bool
+
+	// StackTerminationProtection enables termination protection for the
+	// CloudFormation stacks that are created
+	StackTerminationProtection bool
 }
 
 // +genclient
@@ -348,15 +434,51 @@ type ClusterConfig struct {
 	// +optional
 	VPC *ClusterVPC `json:"vpc,omitempty"`
 
+	// +optional
+	KubernetesNetworkConfig *KubernetesNetworkConfig `json:"kubernetesNetworkConfig,omitempty"`
+
+	// +optional
+	PrivateCluster *PrivateCluster `json:"privateCluster,omitempty"`
+
 	// +optional
 	NodeGroups []*NodeGroup `json:"nodeGroups,omitempty"`
 
+	// +optional
+	ManagedNodeGroups []*ManagedNodeGroup `json:"managedNodeGroups,omitempty"`
+
+	// +optional
+	FargateProfiles []*FargateProfile `json:"fargateProfiles,omitempty"`
+
+	// EKS managed add-ons
+	// +optional
+	Addons []*Addon `json:"addons,omitempty"`
+
+	// External identity providers that users can authenticate with
+	// +optional
+	IdentityProviders []*IdentityProvider `json:"identityProviders,omitempty"`
+
 	// +optional
 	AvailabilityZones []string `json:"availabilityZones,omitempty"`
 
 	// +optional
 	CloudWatch *ClusterCloudWatch `json:"cloudWatch,omitempty"`
 
+	// +optional
+	SecretsEncryption *SecretsEncryption `json:"secretsEncryption,omitempty"`
+
+	// +optional
+	Git *Git `json:"git,omitempty"`
+
+	// Timeouts of the phases of cluster operations, e.g. "40m"
+	// +optional
+	Timeouts *ClusterTimeouts `json:"timeouts,omitempty"`
+
+	// +optional
+	ClusterAutoscaler *ClusterAutoscaler `json:"clusterAutoscaler,omitempty"`
+
+	// +optional
+	NodeTerminationHandler *NodeTerminationHandler `json:"nodeTerminationHandler,omitempty"`
+
 	Status *ClusterStatus `json:"status,omitempty"`
 }
 
@@ -476,10 +598,25 @@ type NodeGroup struct {
 	AMIFamily string `json:"amiFamily,omitempty"`
 	// +optional
 	InstanceType string `json:"instanceType,omitempty"`
+	// InstanceSelector selects the instance type, or the instance types of
+	// instancesDistribution, by their resources, it cannot be combined with instanceType
+	// +optional
+	InstanceSelector *InstanceSelector `json:"instanceSelector,omitempty"`
 	//+optional
 	InstancesDistribution *NodeGroupInstancesDistribution `json:"instancesDistribution,omitempty"`
 	// +optional
 	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+	// Subnets are the IDs of the subnets the nodes are launched in, instead of the
+	// subnets of the cluster, e.g. subnets in Local Zones, Wavelength Zones or on
+	// an Outpost, which cannot be used by the control plane
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+	// OutpostARN is the ARN of the Outpost the nodes are launched on, it is
+	// derived from the subnets when they are on an Outpost
+	// +optional
+	OutpostARN string `json:"outpostARN,omitempty"`
+	// Tags are applied to the nodegroup stack, and propagated
+	// to the instances and volumes of the nodegroup
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
 	// +optional
@@ -498,6 +635,37 @@ type NodeGroup struct {
 	// +optional
 	EBSOptimized *bool `json:"ebsOptimized,omitempty"`
 
+	// InstanceMetadataOptions configures the instance metadata service (IMDS) of the nodes
+	// +optional
+	InstanceMetadataOptions *InstanceMetadataOptions `json:"instanceMetadataOptions,omitempty"`
+
+	// Placement specifies the placement group of the nodes
+	// +optional
+	Placement *Placement `json:"placement,omitempty"`
+
+	// Tenancy of the nodes, "default" or "dedicated"
+	// +optional
+	Tenancy string `json:"tenancy,omitempty"`
+
+	// InstanceRefresh holds the settings that are used when the
+	// instances are replaced with `eksctl refresh nodegroup`
+	// +optional
+	InstanceRefresh *InstanceRefresh `json:"instanceRefresh,omitempty"`
+
+	// WarmPool keeps pre-initialized instances that are added to the nodegroup
+	// when it scales out
+	// +optional
+	WarmPool *WarmPool `json:"warmPool,omitempty"`
+
+	// ASGMetricsCollection enables CloudWatch metrics of the auto scaling
+	// group of the nodegroup, such as GroupInServiceInstances
+	// +optional
+	ASGMetricsCollection []MetricsCollection `json:"asgMetricsCollection,omitempty"`
+
+	// EnableDetailedMonitoring enables detailed (1-minute) CloudWatch monitoring of the nodes
+	// +optional
+	EnableDetailedMonitoring *bool `json:"enableDetailedMonitoring,omitempty"`
+
 	// +optional
 	VolumeSize *int `json:"volumeSize"`
 	// +optional
@@ -506,17 +674,27 @@ type NodeGroup struct {
 	VolumeName *string `json:"volumeName,omitempty"`
 	// +optional
 	VolumeEncrypted *bool `json:"volumeEncrypted,omitempty"`
+	// VolumeKmsKeyID is the ID or ARN of a customer managed KMS key used to
+	// encrypt the volume, the default EBS key is used when it is not set
 	// +optional
 	VolumeKmsKeyID *string `json:"volumeKmsKeyID,omitempty"`
+	// VolumeIOPS is required for io1 volumes, and optional for gp3 volumes
 	// +optional
 	VolumeIOPS *int `json:"volumeIOPS"`
+	// VolumeThroughput is the throughput of gp3 volumes in MiB/s
+	// +optional
+	VolumeThroughput *int `json:"volumeThroughput,omitempty"`
 
+	// MaxPodsPerNode overrides the maximum number of pods, which is otherwise
+	// derived from the number of ENIs and IPs the instance type supports
 	// +optional
 	MaxPodsPerNode int `json:"maxPodsPerNode,omitempty"`
 
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
 
+	// Taints are applied to the nodes when they register with the cluster,
+	// each taint is given as `key: value:Effect` (value can be empty, i.e. `key: :Effect`)
 	// +optional
 	Taints map[string]string `json:"taints,omitempty"`
 
@@ -529,17 +707,27 @@ type NodeGroup struct {
 	// +optional
 	IAM *NodeGroupIAM `json:"iam"`
 
+	// PreBootstrapCommands are shell commands that run before the node is bootstrapped
 	// +optional
 	PreBootstrapCommands []string `json:"preBootstrapCommands,omitempty"`
 
+	// OverrideBootstrapCommand replaces the bootstrap script of eksctl, the
+	// generated kubelet configuration is still written to /etc/eksctl
 	// +optional
 	OverrideBootstrapCommand *string `json:"overrideBootstrapCommand,omitempty"`
 
+	// ClusterDNS is the IP address of the DNS service that is configured for
+	// pods, defaults to the tenth address of the service CIDR
 	// +optional
 	ClusterDNS string `json:"clusterDNS,omitempty"`
 
+	// KubeletExtraConfig is merged into the generated KubeletConfiguration
 	// +optional
 	KubeletExtraConfig *InlineDocument `json:"kubeletExtraConfig,omitempty"`
+
+	// Settings that are specific to nodes using the Bottlerocket AMI family
+	// +optional
+	Bottlerocket *NodeGroupBottlerocket `json:"bottlerocket,omitempty"`
 }
 
 // ListOptions returns metav1.ListOptions with label selector for the nodegroup
@@ -613,6 +801,12 @@ type (
 		PublicKey *string `json:"publicKey,omitempty"`
 		// +optional
 		PublicKeyName *string `json:"publicKeyName,omitempty"`
+		// EnableSSM attaches the AmazonSSMManagedInstanceCore policy to the
+		// nodes, so that they can be accessed via SSM Session Manager; it
+		// cannot be combined with SSH access, and is only supported for the
+		// AmazonLinux2 AMI family
+		// +optional
+		EnableSSM *bool `json:"enableSSM,omitempty"`
 	}
 
 	// NodeGroupInstancesDistribution holds the configuration for spot instances
@@ -627,9 +821,149 @@ type (
 		OnDemandPercentageAboveBaseCapacity *int `json:"onDemandPercentageAboveBaseCapacity,omitEmpty"`
 		//+optional
 		SpotInstancePools *int `json:"spotInstancePools,omitEmpty"`
+		// Strategy used to allocate spot instances, one of "lowest-price" (default) or "capacity-optimized"
+		// +optional
+		SpotAllocationStrategy *string `json:"spotAllocationStrategy,omitempty"`
 	}
 )
 
+// Values for the strategy of Placement
+const (
+	// PlacementStrategyCluster packs nodes close together in a single availability zone
+	PlacementStrategyCluster = "cluster"
+	// PlacementStrategyPartition spreads nodes across partitions that don't share racks
+	PlacementStrategyPartition = "partition"
+	// PlacementStrategySpread places each node on distinct hardware
+	PlacementStrategySpread = "spread"
+)
+
+// Values for the tenancy of nodegroups
+const (
+	// TenancyDefault runs nodes on shared hardware
+	TenancyDefault = "default"
+	// TenancyDedicated runs nodes on hardware that is dedicated to the account
+	TenancyDedicated = "dedicated"
+)
+
+// Placement holds the placement group of a nodegroup
+type Placement struct {
+	// GroupName is the name of an existing placement group, a placement
+	// group is created along with the nodegroup when it is not set
+	// +optional
+	GroupName string `json:"groupName,omitempty"`
+	// Strategy of the placement group that is created, "cluster" (default),
+	// "partition" or "spread"
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+}
+
+// DefaultInstanceRefreshMinHealthyPercentage is the percentage of instances
+// that must stay in service while instances are refreshed
+const DefaultInstanceRefreshMinHealthyPercentage = 90
+
+// InstanceRefresh holds the settings of the instance refresh of a nodegroup
+type InstanceRefresh struct {
+	// MinHealthyPercentage is the percentage of the desired capacity that
+	// must remain in service while instances are replaced, defaults to 90
+	// +optional
+	MinHealthyPercentage *int `json:"minHealthyPercentage,omitempty"`
+	// InstanceWarmup is the number of seconds until a new instance is
+	// considered to be in service, defaults to the health check grace period
+	// of the auto scaling group
+	// +optional
+	InstanceWarmup *int `json:"instanceWarmup,omitempty"`
+}
+
+// Values for the poolState of WarmPool
+const (
+	// WarmPoolStateStopped keeps the instances of the warm pool stopped
+	WarmPoolStateStopped = "Stopped"
+	// WarmPoolStateRunning keeps the instances of the warm pool running
+	WarmPoolStateRunning = "Running"
+)
+
+// WarmPool holds the configuration of the warm pool of a nodegroup
+type WarmPool struct {
+	// MinSize is the minimum number of instances in the warm pool
+	// +optional
+	MinSize *int `json:"minSize,omitempty"`
+	// MaxGroupPreparedCapacity is the maximum number of instances in the
+	// nodegroup and its warm pool, defaults to the maxSize of the nodegroup
+	// +optional
+	MaxGroupPreparedCapacity *int `json:"maxGroupPreparedCapacity,omitempty"`
+	// PoolState is the state of the instances in the warm pool, "Stopped"
+	// (default) or "Running"
+	// +optional
+	PoolState string `json:"poolState,omitempty"`
+}
+
+// MetricsCollectionGranularity1Minute is the only granularity of auto scaling group metrics
+const MetricsCollectionGranularity1Minute = "1Minute"
+
+// MetricsCollection holds the auto scaling group metrics that are collected
+type MetricsCollection struct {
+	// Granularity of the metrics, only "1Minute" (default) is supported
+	// +optional
+	Granularity string `json:"granularity,omitempty"`
+	// Metrics to collect, e.g. "GroupMinSize" or "GroupInServiceInstances",
+	// all metrics are collected when not set
+	// +optional
+	Metrics []string `json:"metrics,omitempty"`
+}
+
+// Values for the httpTokens of InstanceMetadataOptions
+const (
+	// HTTPTokensRequired only allows IMDSv2 requests, which use session tokens
+	HTTPTokensRequired = "required"
+	// HTTPTokensOptional allows both IMDSv1 and IMDSv2 requests
+	HTTPTokensOptional = "optional"
+
+	// DefaultHTTPPutResponseHopLimit is the hop limit that is used when IMDSv2 is required,
+	// it allows pods that don't use the host network to get session tokens
+	DefaultHTTPPutResponseHopLimit = 2
+)
+
+// InstanceMetadataOptions holds the options of the instance metadata service (IMDS)
+type InstanceMetadataOptions struct {
+	// HTTPTokens is "required" to only allow IMDSv2 requests, or "optional" to allow
+	// IMDSv1 requests as well
+	// +optional
+	HTTPTokens string `json:"httpTokens,omitempty"`
+	// HTTPPutResponseHopLimit is the number of network hops that session tokens can travel,
+	// it defaults to 2 when IMDSv2 is required
+	// +optional
+	HTTPPutResponseHopLimit *int `json:"httpPutResponseHopLimit,omitempty"`
+}
+
+// InstanceSelector holds the resources that the instance types of a nodegroup
+// must have, instance types that are offered in the region of the cluster and
+// match all of them are used
+type InstanceSelector struct {
+	// Number of vCPUs
+	// +optional
+	VCPUs int `json:"vCPUs,omitempty"`
+	// Amount of memory, e.g. "16Gi"
+	// +optional
+	Memory string `json:"memory,omitempty"`
+	// Number of GPUs, instance types with GPUs are only selected when it's set
+	// +optional
+	GPUs int `json:"gpus,omitempty"`
+}
+
+// NodeGroupBottlerocket holds the configuration for Bottlerocket based
+// nodegroups
+type NodeGroupBottlerocket struct {
+	// Enables the admin container, which provides SSH access to the nodes,
+	// it defaults to the value of ssh.allow
+	// +optional
+	EnableAdminContainer *bool `json:"enableAdminContainer,omitempty"`
+	// Additional Bottlerocket settings, which are rendered into the TOML
+	// user data under the top-level settings table, see
+	// https://github.com/bottlerocket-os/bottlerocket#settings
+	// +optional
+	Settings *InlineDocument `json:"settings,omitempty"`
+}
+
 // InlineDocument holds any arbitrary JSON/YAML documents, such as extra config parameters or IAM policies
 type InlineDocument map[string]interface{}
 
@@ -648,6 +982,31 @@ func HasMixedInstances(ng *NodeGroup) bool {
 	return ng.InstancesDistribution != nil && ng.InstancesDistribution.InstanceTypes != nil && len(ng.InstancesDistribution.InstanceTypes) != 0
 }
 
+// armInstanceType matches instance types with ARM (Graviton) processors, e.g. a1.large,
+// m6g.large or c6gn.xlarge
+var armInstanceType = regexp.MustCompile(`^(a1|[a-z]+[0-9]+g[a-z]*)\.`)
+
+// IsARMInstanceType returns true if the instance type has an ARM processor
+func IsARMInstanceType(instanceType string) bool {
+	return armInstanceType.MatchString(instanceType)
+}
+
+// HasARMInstances returns true if the instance type of the nodegroup, or any
+// of its mixed instance types, has an ARM processor
+func HasARMInstances(ng *NodeGroup) bool {
+	if IsARMInstanceType(ng.InstanceType) {
+		return true
+	}
+	if ng.InstancesDistribution != nil {
+		for _, instanceType := range ng.InstancesDistribution.InstanceTypes {
+			if IsARMInstanceType(instanceType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // IsAMI returns true if the argument is an AMI id
 func IsAMI(amiFlag string) bool {
 	return strings.HasPrefix(amiFlag, "ami-")
@@ -2,10 +2,12 @@ package v1alpha5
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/pkg/errors"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
 
@@ -36,6 +38,19 @@ func (s nameSet) checkUnique(path, name string) (bool, error) {
 
 // ValidateClusterConfig checks compatible fields of a given ClusterConfig
 func ValidateClusterConfig(cfg *ClusterConfig) error {
+	if err := validateTags("metadata.tags", cfg.Metadata.Tags); err != nil {
+		return err
+	}
+
+	// checked first, as cluster-autoscaler adds its own iamserviceaccount
+	if err := ValidateClusterAutoscaler(cfg); err != nil {
+		return err
+	}
+
+	if err := ValidateNodeTerminationHandler(cfg); err != nil {
+		return err
+	}
+
 	if IsDisabled(cfg.IAM.WithOIDC) && len(cfg.IAM.ServiceAccounts) > 0 {
 		return fmt.Errorf("iam.withOIDC must be enabled explicitly for iam.serviceAccounts to be created")
 	}
@@ -49,8 +64,8 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		if ok, err := saNames.checkUnique("<namespace>/<name> of "+path, sa.NameString()); !ok {
 			return err
 		}
-		if len(sa.AttachPolicyARNs) == 0 && sa.AttachPolicy == nil {
-			return fmt.Errorf("%s.attachPolicyARNs or %s.attachPolicy must be set", path, path)
+		if len(sa.AttachPolicyARNs) == 0 && sa.AttachPolicy == nil && !sa.HasAddonPolicies() {
+			return fmt.Errorf("%s.attachPolicyARNs, %s.attachPolicy or %s.withAddonPolicies must be set", path, path, path)
 		}
 	}
 
@@ -65,6 +80,52 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		}
 	}
 
+	for i, ng := range cfg.ManagedNodeGroups {
+		path := fmt.Sprintf("managedNodeGroups[%d]", i)
+		if ng.Name == "" {
+			return fmt.Errorf("%s.name must be set", path)
+		}
+		if ok, err := ngNames.checkUnique(path+".name", ng.NameString()); !ok {
+			return err
+		}
+	}
+
+	fpNames := nameSet{}
+	for i, fp := range cfg.FargateProfiles {
+		path := fmt.Sprintf("fargateProfiles[%d]", i)
+		if fp.Name == "" {
+			return fmt.Errorf("%s.name must be set", path)
+		}
+		if ok, err := fpNames.checkUnique(path+".name", fp.NameString()); !ok {
+			return err
+		}
+		if err := ValidateFargateProfile(i, fp); err != nil {
+			return err
+		}
+	}
+
+	addonNames := nameSet{}
+	for i, addon := range cfg.Addons {
+		path := fmt.Sprintf("addons[%d]", i)
+		if err := ValidateAddon(path, addon, IsEnabled(cfg.IAM.WithOIDC)); err != nil {
+			return err
+		}
+		if ok, err := addonNames.checkUnique(path+".name", addon.Name); !ok {
+			return err
+		}
+	}
+
+	idpNames := nameSet{}
+	for i, idp := range cfg.IdentityProviders {
+		path := fmt.Sprintf("identityProviders[%d]", i)
+		if err := ValidateIdentityProvider(path, idp); err != nil {
+			return err
+		}
+		if ok, err := idpNames.checkUnique(path+".name", idp.Name); !ok {
+			return err
+		}
+	}
+
 	if cfg.HasClusterCloudWatchLogging() {
 		for i, logType := range cfg.CloudWatch.ClusterLogging.EnableTypes {
 			isUnknown := true
@@ -79,12 +140,92 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		}
 	}
 
+	if cfg.VPC != nil {
+		if cfg.VPC.NAT != nil && IsSetAndNonEmptyString(cfg.VPC.NAT.Gateway) {
+			if err := validateNATGatewayMode(*cfg.VPC.NAT.Gateway); err != nil {
+				return err
+			}
+		}
+		for i, id := range cfg.VPC.ControlPlaneSecurityGroupIDs {
+			if id == "" {
+				return fmt.Errorf("vpc.controlPlaneSecurityGroupIDs[%d] must be non-empty", i)
+			}
+		}
+	}
+
+	if err := ValidatePublicAccessCIDRs(cfg); err != nil {
+		return err
+	}
+
+	if err := validateControlPlaneZones(cfg); err != nil {
+		return err
+	}
+
+	if err := ValidateCustomNetworking(cfg); err != nil {
+		return err
+	}
+
+	if err := ValidateSecretsEncryption(cfg); err != nil {
+		return err
+	}
+
+	if err := ValidateKubernetesNetworkConfig(cfg); err != nil {
+		return err
+	}
+
+	if err := ValidateClusterTimeouts(cfg); err != nil {
+		return err
+	}
+
+	if err := ValidatePrivateCluster(cfg); err != nil {
+		return err
+	}
+
+	if cfg.HasGitRepo() {
+		if err := ValidateGitPaths(cfg.Git.Repo.Paths); err != nil {
+			return errors.Wrap(err, "git.repo.paths")
+		}
+	}
+
 	if !cfg.HasClusterEndpointAccess() {
 		return ErrClusterEndpointNoAccess
 	}
 	return nil
 }
 
+// validateTags checks tags against the restrictions of AWS, tags are
+// applied to CloudFormation stacks and propagated to the resources
+func validateTags(path string, tags map[string]string) error {
+	const (
+		maxKeyLength   = 127
+		maxValueLength = 255
+	)
+	for k, v := range tags {
+		if k == "" {
+			return fmt.Errorf("%s cannot have an empty key", path)
+		}
+		if strings.HasPrefix(strings.ToLower(k), "aws:") {
+			return fmt.Errorf("%s[%q] is invalid, the aws: prefix is reserved for use by AWS", path, k)
+		}
+		if len(k) > maxKeyLength {
+			return fmt.Errorf("%s[%q] is invalid, keys must be at most %d characters long", path, k, maxKeyLength)
+		}
+		if len(v) > maxValueLength {
+			return fmt.Errorf("%s[%q] is invalid, values must be at most %d characters long", path, k, maxValueLength)
+		}
+	}
+	return nil
+}
+
+func validateNATGatewayMode(mode string) error {
+	for _, supportedMode := range SupportedNATGatewayModes() {
+		if mode == supportedMode {
+			return nil
+		}
+	}
+	return fmt.Errorf("vpc.nat.gateway %q is not valid, supported modes are: %s", mode, strings.Join(SupportedNATGatewayModes(), ", "))
+}
+
 // ValidateClusterEndpointConfig checks the endpoint configuration for potential issues
 func (c *ClusterConfig) ValidateClusterEndpointConfig() error {
 	endpts := c.VPC.ClusterEndpoints
@@ -111,6 +252,26 @@ func PrivateOnly(ces *ClusterEndpoints) bool {
 func ValidateNodeGroup(i int, ng *NodeGroup) error {
 	path := fmt.Sprintf("nodeGroups[%d]", i)
 
+	if err := validateTags(path+".tags", ng.Tags); err != nil {
+		return err
+	}
+
+	if err := ValidateNodeGroupTaints(ng); err != nil {
+		return errors.Wrapf(err, "%s.taints", path)
+	}
+
+	if ng.MaxPodsPerNode < 0 {
+		return fmt.Errorf("%s.maxPodsPerNode cannot be negative", path)
+	}
+
+	if ng.OverrideBootstrapCommand != nil && strings.TrimSpace(*ng.OverrideBootstrapCommand) == "" {
+		return fmt.Errorf("%s.overrideBootstrapCommand cannot be empty", path)
+	}
+
+	if ng.ClusterDNS != "" && net.ParseIP(ng.ClusterDNS) == nil {
+		return fmt.Errorf("%s.clusterDNS (%q) must be an IP address", path, ng.ClusterDNS)
+	}
+
 	if ng.VolumeSize == nil {
 		errCantSet := func(field string) error {
 			return fmt.Errorf("%s.%s cannot be set without %s.volumeSize", path, field, path)
@@ -127,14 +288,13 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		if IsSetAndNonEmptyString(ng.VolumeKmsKeyID) {
 			return errCantSet("volumeKmsKeyID")
 		}
+		if ng.VolumeThroughput != nil {
+			return errCantSet("volumeThroughput")
+		}
 	}
 
-	if ng.VolumeType != nil && *ng.VolumeType == NodeVolumeTypeIO1 {
-		if ng.VolumeIOPS == nil {
-			return fmt.Errorf("%s.volumeIOPS is required for %s volume type", path, NodeVolumeTypeIO1)
-		}
-	} else if ng.VolumeIOPS != nil {
-		return fmt.Errorf("%s.volumeIOPS is only supported for %s volume type", path, NodeVolumeTypeIO1)
+	if err := validateNodeGroupVolumePerformance(path, ng); err != nil {
+		return err
 	}
 
 	if ng.VolumeEncrypted == nil || IsDisabled(ng.VolumeEncrypted) {
@@ -160,6 +320,15 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		}
 	}
 
+	if ng.Bottlerocket != nil && ng.AMIFamily != NodeImageFamilyBottlerocket {
+		return fmt.Errorf("%s.bottlerocket can only be set when %s.amiFamily is %q", path, path, NodeImageFamilyBottlerocket)
+	}
+
+	// the SSM agent is only installed by the bootstrap user data of AmazonLinux2
+	if ng.SSH != nil && IsEnabled(ng.SSH.EnableSSM) && ng.AMIFamily != "" && ng.AMIFamily != NodeImageFamilyAmazonLinux2 {
+		return fmt.Errorf("%s.ssh.enableSSM can only be set when %s.amiFamily is %q", path, path, NodeImageFamilyAmazonLinux2)
+	}
+
 	if IsWindowsImage(ng.AMIFamily) {
 		fieldNotSupported := func(field string) error {
 			return fmt.Errorf("%s is not supported for Windows node groups (path=%s.%s)", field, path, field)
@@ -174,10 +343,76 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 			return fieldNotSupported("overrideBootstrapCommand")
 		}
 
+	} else if ng.AMIFamily == NodeImageFamilyBottlerocket {
+		fieldNotSupported := func(field string) error {
+			return fmt.Errorf("%s is not supported for Bottlerocket node groups, use %s.bottlerocket.settings instead (path=%s.%s)", field, path, path, field)
+		}
+		if ng.KubeletExtraConfig != nil {
+			return fieldNotSupported("kubeletExtraConfig")
+		}
+		if ng.PreBootstrapCommands != nil {
+			return fieldNotSupported("preBootstrapCommands")
+		}
+		if ng.OverrideBootstrapCommand != nil {
+			return fieldNotSupported("overrideBootstrapCommand")
+		}
+		if ng.AMI == NodeImageResolverAuto {
+			return fmt.Errorf("%s.ami cannot be %q for Bottlerocket node groups, Bottlerocket AMIs can only be resolved with %q", path, NodeImageResolverAuto, NodeImageResolverAutoSSM)
+		}
+
 	} else if err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig); err != nil {
 		return err
 	}
 
+	if err := validateNodeGroupSubnets(path, ng); err != nil {
+		return err
+	}
+
+	if err := validatePlacement(path, ng); err != nil {
+		return err
+	}
+
+	if err := validateInstanceRefreshAndWarmPool(path, ng); err != nil {
+		return err
+	}
+
+	for i, metricsCollection := range ng.ASGMetricsCollection {
+		if metricsCollection.Granularity != "" && metricsCollection.Granularity != MetricsCollectionGranularity1Minute {
+			return fmt.Errorf("%s.asgMetricsCollection[%d].granularity must be %q", path, i, MetricsCollectionGranularity1Minute)
+		}
+		for _, metric := range metricsCollection.Metrics {
+			if metric == "" {
+				return fmt.Errorf("%s.asgMetricsCollection[%d].metrics cannot contain empty names", path, i)
+			}
+		}
+	}
+
+	if options := ng.InstanceMetadataOptions; options != nil {
+		if options.HTTPTokens != "" && options.HTTPTokens != HTTPTokensRequired && options.HTTPTokens != HTTPTokensOptional {
+			return fmt.Errorf("%s.instanceMetadataOptions.httpTokens must be %q or %q", path, HTTPTokensRequired, HTTPTokensOptional)
+		}
+		if hopLimit := options.HTTPPutResponseHopLimit; hopLimit != nil && (*hopLimit < 1 || *hopLimit > 64) {
+			return fmt.Errorf("%s.instanceMetadataOptions.httpPutResponseHopLimit must be between 1 and 64", path)
+		}
+	}
+
+	if err := validateInstanceSelector(path, ng); err != nil {
+		return err
+	}
+
+	if HasARMInstances(ng) {
+		if ng.AMIFamily != "" && ng.AMIFamily != NodeImageFamilyAmazonLinux2 && ng.AMIFamily != NodeImageFamilyBottlerocket {
+			return fmt.Errorf("ARM instance types are only supported by the %s and %s AMI families (path=%s.amiFamily)", NodeImageFamilyAmazonLinux2, NodeImageFamilyBottlerocket, path)
+		}
+		if ng.InstancesDistribution != nil {
+			for _, instanceType := range ng.InstancesDistribution.InstanceTypes {
+				if !IsARMInstanceType(instanceType) {
+					return fmt.Errorf("%s.instancesDistribution.instanceTypes cannot mix ARM and x86_64 instance types", path)
+				}
+			}
+		}
+	}
+
 	if err := validateInstancesDistribution(ng); err != nil {
 		return err
 	}
@@ -185,6 +420,199 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 	return nil
 }
 
+func validatePlacement(path string, ng *NodeGroup) error {
+	if ng.Tenancy != "" && ng.Tenancy != TenancyDefault && ng.Tenancy != TenancyDedicated {
+		return fmt.Errorf("%s.tenancy must be %q or %q", path, TenancyDefault, TenancyDedicated)
+	}
+
+	placement := ng.Placement
+	if placement == nil {
+		return nil
+	}
+
+	if placement.GroupName != "" {
+		if placement.Strategy != "" {
+			return fmt.Errorf("%s.placement.strategy cannot be set along with %s.placement.groupName, it only applies to placement groups created by eksctl", path, path)
+		}
+		return nil
+	}
+
+	switch placement.Strategy {
+	case "", PlacementStrategyCluster:
+		// cluster placement groups cannot span multiple availability zones
+		if len(ng.AvailabilityZones) != 1 && len(ng.Subnets) != 1 {
+			return fmt.Errorf("%s.availabilityZones must have exactly one availability zone when using a placement group with the %q strategy", path, PlacementStrategyCluster)
+		}
+	case PlacementStrategyPartition, PlacementStrategySpread:
+	default:
+		return fmt.Errorf("%s.placement.strategy must be one of %q, %q or %q", path, PlacementStrategyCluster, PlacementStrategyPartition, PlacementStrategySpread)
+	}
+
+	return nil
+}
+
+func validateInstanceRefreshAndWarmPool(path string, ng *NodeGroup) error {
+	if refresh := ng.InstanceRefresh; refresh != nil {
+		if p := refresh.MinHealthyPercentage; p != nil && (*p < 0 || *p > 100) {
+			return fmt.Errorf("%s.instanceRefresh.minHealthyPercentage must be between 0 and 100", path)
+		}
+		if w := refresh.InstanceWarmup; w != nil && *w < 0 {
+			return fmt.Errorf("%s.instanceRefresh.instanceWarmup must be 0 or greater", path)
+		}
+	}
+
+	warmPool := ng.WarmPool
+	if warmPool == nil {
+		return nil
+	}
+
+	if ng.InstancesDistribution != nil {
+		return fmt.Errorf("%s.warmPool cannot be used with %s.instancesDistribution", path, path)
+	}
+	// instances are kept from joining the cluster by the user data, which can't do that on Bottlerocket
+	if ng.AMIFamily == NodeImageFamilyBottlerocket {
+		return fmt.Errorf("%s.warmPool is not supported for Bottlerocket nodegroups", path)
+	}
+
+	switch warmPool.PoolState {
+	case "", WarmPoolStateStopped, WarmPoolStateRunning:
+	default:
+		return fmt.Errorf("%s.warmPool.poolState must be %q or %q", path, WarmPoolStateStopped, WarmPoolStateRunning)
+	}
+
+	if warmPool.MinSize != nil && *warmPool.MinSize < 0 {
+		return fmt.Errorf("%s.warmPool.minSize must be 0 or greater", path)
+	}
+	if maxCapacity := warmPool.MaxGroupPreparedCapacity; maxCapacity != nil {
+		if *maxCapacity < 0 {
+			return fmt.Errorf("%s.warmPool.maxGroupPreparedCapacity must be 0 or greater", path)
+		}
+		if ng.MaxSize != nil && *maxCapacity < *ng.MaxSize {
+			return fmt.Errorf("%s.warmPool.maxGroupPreparedCapacity must not be less than %s.maxSize", path, path)
+		}
+	}
+
+	return nil
+}
+
+func validateInstanceSelector(path string, ng *NodeGroup) error {
+	selector := ng.InstanceSelector
+	if selector == nil {
+		return nil
+	}
+
+	if ng.InstanceType != "" && ng.InstanceType != "mixed" {
+		return fmt.Errorf("%s.instanceType and %s.instanceSelector cannot be set at the same time", path, path)
+	}
+
+	if selector.VCPUs < 0 || selector.GPUs < 0 {
+		return fmt.Errorf("%s.instanceSelector.vCPUs and %s.instanceSelector.gpus cannot be negative", path, path)
+	}
+
+	if selector.Memory != "" {
+		if _, err := ParseInstanceSelectorMemory(selector.Memory); err != nil {
+			return errors.Wrapf(err, "%s.instanceSelector.memory", path)
+		}
+	}
+
+	if selector.VCPUs == 0 && selector.Memory == "" && selector.GPUs == 0 {
+		return fmt.Errorf("at least one of vCPUs, memory or gpus must be set in %s.instanceSelector", path)
+	}
+
+	return nil
+}
+
+// ParseInstanceSelectorMemory parses the memory of an instance selector, which is
+// a quantity such as "16Gi" or "16384Mi", and returns it in MiB
+func ParseInstanceSelectorMemory(memory string) (int64, error) {
+	quantity, err := resource.ParseQuantity(memory)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory %q, it should be a quantity such as \"16Gi\"", memory)
+	}
+	const mebibyte = 1024 * 1024
+	bytes := quantity.Value()
+	if bytes <= 0 || bytes%mebibyte != 0 {
+		return 0, fmt.Errorf("invalid memory %q, it should be a positive amount in whole MiB", memory)
+	}
+	return bytes / mebibyte, nil
+}
+
+// validateNodeGroupVolumePerformance checks volumeIOPS and volumeThroughput
+// against the limits of the volume type
+func validateNodeGroupVolumePerformance(path string, ng *NodeGroup) error {
+	volumeType := DefaultNodeVolumeType
+	if IsSetAndNonEmptyString(ng.VolumeType) {
+		volumeType = *ng.VolumeType
+	}
+
+	checkRange := func(field string, value *int, min, max int) error {
+		if value != nil && (*value < min || *value > max) {
+			return fmt.Errorf("%s.%s must be between %d and %d for %s volume type, got %d", path, field, min, max, volumeType, *value)
+		}
+		return nil
+	}
+
+	switch volumeType {
+	case NodeVolumeTypeIO1:
+		if ng.VolumeIOPS == nil {
+			return fmt.Errorf("%s.volumeIOPS is required for %s volume type", path, NodeVolumeTypeIO1)
+		}
+		if err := checkRange("volumeIOPS", ng.VolumeIOPS, 100, 64000); err != nil {
+			return err
+		}
+	case NodeVolumeTypeGP3:
+		if err := checkRange("volumeIOPS", ng.VolumeIOPS, 3000, 16000); err != nil {
+			return err
+		}
+		if err := checkRange("volumeThroughput", ng.VolumeThroughput, 125, 1000); err != nil {
+			return err
+		}
+	case NodeVolumeTypeGP2, NodeVolumeTypeSC1, NodeVolumeTypeST1:
+		if ng.VolumeIOPS != nil {
+			return fmt.Errorf("%s.volumeIOPS is only supported for %s and %s volume types", path, NodeVolumeTypeIO1, NodeVolumeTypeGP3)
+		}
+	default:
+		return fmt.Errorf("%s.volumeType %q is not supported, supported values: %s", path, volumeType, strings.Join(SupportedNodeVolumeTypes(), ", "))
+	}
+
+	if ng.VolumeThroughput != nil && volumeType != NodeVolumeTypeGP3 {
+		return fmt.Errorf("%s.volumeThroughput is only supported for %s volume type", path, NodeVolumeTypeGP3)
+	}
+	return nil
+}
+
+// ValidateNodeGroupTaints makes sure that taints are given in the format
+// expected by kubelet's --register-with-taints flag
+func ValidateNodeGroupTaints(ng *NodeGroup) error {
+	for key, value := range ng.Taints {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("taint key %q is invalid - %v", key, errs)
+		}
+		if _, _, err := ParseTaintValue(value); err != nil {
+			return errors.Wrapf(err, "taint %q", key)
+		}
+	}
+	return nil
+}
+
+// ParseTaintValue splits the value of a taint given as `value:Effect`
+func ParseTaintValue(taint string) (string, string, error) {
+	i := strings.LastIndex(taint, ":")
+	if i < 0 {
+		return "", "", fmt.Errorf("%q is not of the form value:Effect", taint)
+	}
+	value, effect := taint[:i], taint[i+1:]
+	if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+		return "", "", fmt.Errorf("invalid value %q - %v", value, errs)
+	}
+	for _, supportedEffect := range SupportedTaintEffects() {
+		if effect == supportedEffect {
+			return value, effect, nil
+		}
+	}
+	return "", "", fmt.Errorf("effect %q is not valid, supported effects are: %s", effect, strings.Join(SupportedTaintEffects(), ", "))
+}
+
 // ValidateNodeGroupLabels uses proper Kubernetes label validation,
 // it's designed to make sure users don't pass weird labels to the
 // nodes, which would prevent kubelets to startup properly
@@ -314,17 +742,24 @@ func validateInstancesDistribution(ng *NodeGroup) error {
 	}
 
 	distribution := ng.InstancesDistribution
-	if distribution.InstanceTypes == nil || len(distribution.InstanceTypes) == 0 {
-		return fmt.Errorf("at least two instance types have to be specified for mixed nodegroups")
-	}
+	if ng.InstanceSelector != nil {
+		// instance types are set by the instance selector
+		if len(distribution.InstanceTypes) > 0 {
+			return fmt.Errorf("instancesDistribution.instanceTypes cannot be set when using instanceSelector")
+		}
+	} else {
+		if distribution.InstanceTypes == nil || len(distribution.InstanceTypes) == 0 {
+			return fmt.Errorf("at least two instance types have to be specified for mixed nodegroups")
+		}
 
-	allInstanceTypes := make(map[string]bool)
-	for _, instanceType := range distribution.InstanceTypes {
-		allInstanceTypes[instanceType] = true
-	}
+		allInstanceTypes := make(map[string]bool)
+		for _, instanceType := range distribution.InstanceTypes {
+			allInstanceTypes[instanceType] = true
+		}
 
-	if len(allInstanceTypes) < 2 || len(allInstanceTypes) > 20 {
-		return fmt.Errorf("mixed nodegroups should have between 2 and 20 different instance types")
+		if len(allInstanceTypes) < 2 || len(allInstanceTypes) > 20 {
+			return fmt.Errorf("mixed nodegroups should have between 2 and 20 different instance types")
+		}
 	}
 
 	if distribution.OnDemandBaseCapacity != nil && *distribution.OnDemandBaseCapacity < 0 {
@@ -339,9 +774,28 @@ func validateInstancesDistribution(ng *NodeGroup) error {
 		return fmt.Errorf("spotInstancePools should be between 1 and 20")
 	}
 
+	if distribution.SpotAllocationStrategy != nil {
+		if !isSpotAllocationStrategySupported(*distribution.SpotAllocationStrategy) {
+			return fmt.Errorf("spotAllocationStrategy should be one of: %s", strings.Join(SupportedSpotAllocationStrategies(), ", "))
+		}
+
+		if *distribution.SpotAllocationStrategy == SpotAllocationStrategyCapacityOptimized && distribution.SpotInstancePools != nil {
+			return fmt.Errorf("spotInstancePools cannot be specified when also specifying spotAllocationStrategy: %s", SpotAllocationStrategyCapacityOptimized)
+		}
+	}
+
 	return nil
 }
 
+func isSpotAllocationStrategySupported(allocationStrategy string) bool {
+	for _, strategy := range SupportedSpotAllocationStrategies() {
+		if strategy == allocationStrategy {
+			return true
+		}
+	}
+	return false
+}
+
 func validateNodeGroupSSH(SSH *NodeGroupSSH) error {
 	if SSH == nil {
 		return nil
@@ -354,6 +808,9 @@ func validateNodeGroupSSH(SSH *NodeGroupSSH) error {
 	if numSSHFlagsEnabled > 1 {
 		return fmt.Errorf("only one ssh public key can be specified per node-group")
 	}
+	if IsEnabled(SSH.EnableSSM) && (numSSHFlagsEnabled > 0 || IsEnabled(SSH.Allow)) {
+		return fmt.Errorf("ssh.enableSSM cannot be used along with SSH access, as SSM access doesn't require an SSH key pair")
+	}
 	return nil
 }
 
@@ -387,6 +844,11 @@ func validateNodeGroupKubeletExtraConfig(kubeletConfig *InlineDocument) error {
 			return fmt.Errorf("cannot override %q in kubelet config, as it's critical to eksctl functionality", k)
 		}
 	}
+
+	// the bootstrap scripts always pass --max-pods to kubelet, which takes precedence over the config file
+	if _, exists := (*kubeletConfig)["maxPods"]; exists {
+		return fmt.Errorf("cannot set %q in kubelet config, use maxPodsPerNode instead", "maxPods")
+	}
 	return nil
 }
 
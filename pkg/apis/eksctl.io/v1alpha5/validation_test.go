@@ -1,8 +1,16 @@
 package v1alpha5
 
 import (
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	"github.com/aws/aws-sdk-go/aws"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 )
 
 var _ = Describe("ClusterConfig validation", func() {
@@ -203,6 +211,25 @@ var _ = Describe("ClusterConfig validation", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should pass when iam.serviceAccounts[0] only has addon policies", func() {
+			cfg.IAM.WithOIDC = Enabled()
+
+			cfg.IAM.ServiceAccounts = []*ClusterIAMServiceAccount{{}}
+
+			cfg.IAM.ServiceAccounts[0].Name = "external-dns"
+			cfg.IAM.ServiceAccounts[0].WithAddonPolicies = &NodeGroupIAMAddonPolicies{
+				ExternalDNS: Enabled(),
+			}
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			cfg.IAM.ServiceAccounts[0].WithAddonPolicies.ExternalDNS = Disabled()
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should fail when unnamed iam.serviceAccounts[1] is given", func() {
 			cfg.IAM.WithOIDC = Enabled()
 
@@ -230,7 +257,7 @@ var _ = Describe("ClusterConfig validation", func() {
 			err = ValidateClusterConfig(cfg)
 			Expect(err).To(HaveOccurred())
 
-			Expect(err.Error()).To(HavePrefix("iam.serviceAccounts[1].attachPolicyARNs or iam.serviceAccounts[1].attachPolicy must be set"))
+			Expect(err.Error()).To(HavePrefix("iam.serviceAccounts[1].attachPolicyARNs, iam.serviceAccounts[1].attachPolicy or iam.serviceAccounts[1].withAddonPolicies must be set"))
 		})
 
 		It("should fail when non-uniquely named iam.serviceAccounts are given", func() {
@@ -291,246 +318,1059 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
-	Describe("cluster endpoint access config", func() {
+	Describe("secretsEncryption", func() {
 		var (
 			cfg *ClusterConfig
-			vpc *ClusterVPC
 			err error
 		)
 
 		BeforeEach(func() {
 			cfg = NewClusterConfig()
-			vpc = NewClusterVPC()
-			cfg.VPC = vpc
 		})
 
-		It("should not error on private=true, public=true", func() {
-			cfg.VPC.ClusterEndpoints =
-				&ClusterEndpoints{PrivateAccess: Enabled(), PublicAccess: Enabled()}
-			err = cfg.ValidateClusterEndpointConfig()
+		It("should pass when secretsEncryption is unset", func() {
+			err = ValidateClusterConfig(cfg)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
-		It("should not error on private=false, public=true", func() {
-			cfg.VPC.ClusterEndpoints =
-				&ClusterEndpoints{PrivateAccess: Disabled(), PublicAccess: Enabled()}
-			err = cfg.ValidateClusterEndpointConfig()
+		It("should pass with a KMS key ARN", func() {
+			cfg.SecretsEncryption = &SecretsEncryption{
+				KeyARN: aws.String("arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012"),
+			}
+
+			err = ValidateClusterConfig(cfg)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
-		It("should error on private=true, public=false", func() {
-			cfg.VPC.ClusterEndpoints = &ClusterEndpoints{PrivateAccess: Enabled(), PublicAccess: Disabled()}
-			err = cfg.ValidateClusterEndpointConfig()
-			Expect(err).To(BeIdenticalTo(ErrClusterEndpointPrivateOnly))
+		It("should fail when keyARN is not set", func() {
+			cfg.SecretsEncryption = &SecretsEncryption{}
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("secretsEncryption.keyARN is required"))
 		})
 
-		It("should error on private=false, public=false", func() {
-			cfg.VPC.ClusterEndpoints = &ClusterEndpoints{PrivateAccess: Disabled(), PublicAccess: Disabled()}
-			err = cfg.ValidateClusterEndpointConfig()
-			Expect(err).To(BeIdenticalTo(ErrClusterEndpointNoAccess))
+		It("should fail when keyARN is not a KMS key ARN", func() {
+			cfg.SecretsEncryption = &SecretsEncryption{
+				KeyARN: aws.String("arn:aws:iam::123456789012:role/foo"),
+			}
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(HaveOccurred())
+
+			cfg.SecretsEncryption.KeyARN = aws.String("key-1")
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(HaveOccurred())
 		})
 	})
 
-	Describe("ssh flags", func() {
+	Describe("addons", func() {
 		var (
-			testKeyPath = "some/path/to/file.pub"
-			testKeyName = "id_rsa.pub"
-			testKey     = "THIS IS A KEY"
+			cfg *ClusterConfig
+			err error
 		)
 
-		It("fails when a key path and a key name are specified", func() {
-			SSHConfig := &NodeGroupSSH{
-				Allow:         Enabled(),
-				PublicKeyPath: &testKeyPath,
-				PublicKeyName: &testKeyName,
-			}
-
-			checkItDetectsError(SSHConfig)
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
 		})
 
-		It("fails when a key path and a key are specified", func() {
-			SSHConfig := &NodeGroupSSH{
-				Allow:         Enabled(),
-				PublicKeyPath: &testKeyPath,
-				PublicKey:     &testKey,
+		It("should pass with valid add-ons", func() {
+			cfg.IAM.WithOIDC = Enabled()
+			cfg.Addons = []*Addon{
+				{Name: VPCCNIAddon, AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"}},
+				{Name: CoreDNSAddon, Version: "v1.8.0-eksbuild.1", ResolveConflicts: AddonResolveConflictsOverwrite},
+				{Name: KubeProxyAddon, ServiceAccountRoleARN: "arn:aws:iam::123456789012:role/kube-proxy"},
 			}
 
-			checkItDetectsError(SSHConfig)
+			err = ValidateClusterConfig(cfg)
+			Expect(err).ToNot(HaveOccurred())
 		})
 
-		It("fails when a key name and a key are specified", func() {
-			SSHConfig := &NodeGroupSSH{
-				Allow:         Enabled(),
-				PublicKeyName: &testKeyName,
-				PublicKey:     &testKey,
-			}
+		It("should fail when an add-on has no name", func() {
+			cfg.Addons = []*Addon{{}}
 
-			checkItDetectsError(SSHConfig)
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("addons[0].name must be set"))
 		})
 
-		It("It doesn't panic when SSH is not enabled", func() {
-			err := validateNodeGroupSSH(nil)
-			Expect(err).ToNot(HaveOccurred())
+		It("should fail when add-ons are not uniquely named", func() {
+			cfg.Addons = []*Addon{{Name: CoreDNSAddon}, {Name: CoreDNSAddon}}
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(HaveOccurred())
 		})
 
-		Context("Instances distribution", func() {
+		It("should fail when serviceAccountRoleARN and attachPolicyARNs are set together", func() {
+			cfg.IAM.WithOIDC = Enabled()
+			cfg.Addons = []*Addon{{
+				Name:                  VPCCNIAddon,
+				ServiceAccountRoleARN: "arn:aws:iam::123456789012:role/aws-node",
+				AttachPolicyARNs:      []string{"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"},
+			}}
 
-			var ng *NodeGroup
-			BeforeEach(func() {
-				ng = &NodeGroup{
-					InstancesDistribution: &NodeGroupInstancesDistribution{
-						InstanceTypes: []string{"t3.medium", "t3.large"},
-					},
-				}
-			})
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("addons[0].serviceAccountRoleARN and addons[0].attachPolicyARNs cannot be used together"))
+		})
 
-			It("It doesn't panic when instance distribution is not enabled", func() {
-				ng.InstancesDistribution = nil
-				err := validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+		It("should fail when attachPolicyARNs is set without iam.withOIDC", func() {
+			cfg.Addons = []*Addon{{
+				Name:             VPCCNIAddon,
+				AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"},
+			}}
 
-			It("It fails when instance distribution is enabled and instanceType is not empty or \"mixed\"", func() {
-				err := validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("iam.withOIDC must be enabled for addons[0].attachPolicyARNs to be used"))
+		})
 
-				ng.InstanceType = "t3.small"
+		It("should fail when attachPolicyARNs is set for an add-on with an unknown service account", func() {
+			cfg.IAM.WithOIDC = Enabled()
+			cfg.Addons = []*Addon{{
+				Name:             CoreDNSAddon,
+				AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+			}}
 
-				err = validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
-			})
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(HaveOccurred())
+		})
 
-			It("It fails when the instance distribution doesn't have at least 2 different instance types", func() {
-				ng.InstanceType = "mixed"
-				ng.InstancesDistribution.InstanceTypes = []string{"t3.medium", "t3.medium"}
+		It("should fail with an unknown resolveConflicts value", func() {
+			cfg.Addons = []*Addon{{Name: KubeProxyAddon, ResolveConflicts: "preserve"}}
 
-				err := validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`addons[0].resolveConflicts "preserve" is not valid, supported values: none, overwrite`))
+		})
+	})
 
-				ng.InstanceType = "mixed"
-				ng.InstancesDistribution.InstanceTypes = []string{"t3.medium", "t3.small"}
+	Describe("identityProviders", func() {
+		var (
+			cfg *ClusterConfig
+			idp *IdentityProvider
+			err error
+		)
 
-				err = validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			idp = &IdentityProvider{
+				Name:      "okta",
+				IssuerURL: "https://example.okta.com",
+				ClientID:  "kubernetes",
+			}
+			cfg.IdentityProviders = []*IdentityProvider{idp}
+			SetClusterConfigDefaults(cfg)
+		})
 
-			It("It fails when the onDemandBaseCapacity is not above 0", func() {
-				ng.InstancesDistribution.OnDemandBaseCapacity = newInt(-1)
+		It("should default the type to oidc", func() {
+			Expect(idp.Type).To(Equal(OIDCIdentityProviderType))
 
-				err := validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+			err = ValidateClusterConfig(cfg)
+			Expect(err).ToNot(HaveOccurred())
+		})
 
-				ng.InstancesDistribution.OnDemandBaseCapacity = newInt(1)
+		It("should fail with an unsupported type", func() {
+			idp.Type = "saml"
 
-				err = validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`identityProviders[0].type "saml" is not supported, only "oidc" is supported`))
+		})
 
-			It("It fails when the spotInstancePools is not between 1 and 20", func() {
-				ng.InstancesDistribution.SpotInstancePools = newInt(0)
+		It("should fail when the issuer URL doesn't use https", func() {
+			idp.IssuerURL = "http://example.okta.com"
 
-				err := validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`identityProviders[0].issuerURL "http://example.okta.com" is not valid, it must be a https URL`))
+		})
 
-				ng.InstancesDistribution.SpotInstancePools = newInt(21)
-				err = validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+		It("should fail when the client ID is not set", func() {
+			idp.ClientID = ""
 
-				ng.InstancesDistribution.SpotInstancePools = newInt(2)
-				err = validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("identityProviders[0].clientID must be set"))
+		})
+
+		It("should fail when identity providers are not uniquely named", func() {
+			cfg.IdentityProviders = append(cfg.IdentityProviders, &IdentityProvider{
+				Name:      "okta",
+				Type:      OIDCIdentityProviderType,
+				IssuerURL: "https://other.okta.com",
+				ClientID:  "kubernetes",
 			})
 
-			It("It fails when the onDemandPercentageAboveBaseCapacity is not between 0 and 100", func() {
-				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(-1)
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(HaveOccurred())
+		})
+	})
 
-				err := validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+	Describe("vpc.controlPlaneSecurityGroupIDs", func() {
+		It("should pass with security group IDs", func() {
+			cfg := NewClusterConfig()
+			cfg.VPC.ControlPlaneSecurityGroupIDs = []string{"sg-1", "sg-2"}
 
-				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(101)
-				err = validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
 
-				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(50)
-				err = validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+		It("should fail with an empty security group ID", func() {
+			cfg := NewClusterConfig()
+			cfg.VPC.ControlPlaneSecurityGroupIDs = []string{"sg-1", ""}
+
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("vpc.controlPlaneSecurityGroupIDs[1] must be non-empty"))
 		})
 	})
 
-	Describe("kubelet extra config", func() {
-		Context("Instances distribution", func() {
+	Describe("tags", func() {
+		It("should pass with valid tags", func() {
+			cfg := NewClusterConfig()
+			cfg.Metadata.Tags = map[string]string{"team": "platform", "cost-center": ""}
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.Tags = map[string]string{"team": "data"}
 
-			var ng *NodeGroup
-			BeforeEach(func() {
-				ng = &NodeGroup{}
-			})
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
 
-			It("Forbids overriding basic fields", func() {
-				testKeys := []string{"kind", "apiVersion", "address", "clusterDomain", "authentication",
-					"authorization", "serverTLSBootstrap"}
+		It("should fail with the reserved aws: prefix", func() {
+			cfg := NewClusterConfig()
+			cfg.Metadata.Tags = map[string]string{"AWS:team": "platform"}
 
-				for _, key := range testKeys {
-					ng.KubeletExtraConfig = &InlineDocument{
-						key: "should-not-be-allowed",
-					}
-					err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig)
-					Expect(err).To(HaveOccurred())
-				}
-			})
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`metadata.tags["AWS:team"] is invalid, the aws: prefix is reserved for use by AWS`))
+		})
 
-			It("Allows other kubelet options", func() {
-				ng.KubeletExtraConfig = &InlineDocument{
-					"kubeReserved": map[string]string{
-						"cpu":               "300m",
-						"memory":            "300Mi",
-						"ephemeral-storage": "1Gi",
-					},
-					"kubeReservedCgroup": "/kube-reserved",
-					"cgroupDriver":       "systemd",
-					"featureGates": map[string]bool{
-						"VolumeScheduling":         false,
-						"VolumeSnapshotDataSource": true,
-					},
-				}
-				err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig)
-				Expect(err).ToNot(HaveOccurred())
-			})
+		It("should fail with a long value", func() {
+			cfg := NewClusterConfig()
+			ng := cfg.NewNodeGroup()
+			ng.Tags = map[string]string{"team": strings.Repeat("a", 256)}
+
+			err := ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(`nodeGroups[0].tags["team"] is invalid, values must be at most 255 characters long`))
+		})
+
+		It("should fail with an empty key in managed nodegroups", func() {
+			ng := NewManagedNodeGroup()
+			ng.Tags = map[string]string{"": "data"}
 
+			err := ValidateManagedNodeGroup(0, ng)
+			Expect(err).To(MatchError("managedNodeGroups[0].tags cannot have an empty key"))
 		})
 	})
 
-	Describe("ebs encryption", func() {
-		var (
-			nodegroup = "ng1"
-			volSize   = 50
-			kmsKeyID  = "36c0b54e-64ed-4f2d-a1c7-96558764311e"
-			disabled  = false
-			enabled   = true
-		)
+	Describe("nodeGroups[*].taints", func() {
+		var ng *NodeGroup
 
-		Context("Encrypted workers", func() {
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+		})
 
-			var ng *NodeGroup
-			BeforeEach(func() {
-				ng = &NodeGroup{}
-			})
+		It("should pass with valid taints", func() {
+			ng.Taints = map[string]string{
+				"nvidia.com/gpu": "true:NoSchedule",
+				"dedicated":      ":PreferNoSchedule",
+			}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
 
-			It("Forbids setting volumeKmsKeyID without volumeEncrypted", func() {
-				ng.Name = nodegroup
-				ng.VolumeSize = &volSize
-				ng.VolumeEncrypted = nil
-				ng.VolumeKmsKeyID = &kmsKeyID
-				err := ValidateNodeGroup(0, ng)
-				Expect(err).To(HaveOccurred())
-			})
+		It("should fail without an effect", func() {
+			ng.Taints = map[string]string{"dedicated": "true"}
+			err := ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(`nodeGroups[0].taints: taint "dedicated": "true" is not of the form value:Effect`))
+		})
 
-			It("Forbids setting volumeKmsKeyID with volumeEncrypted: false", func() {
-				ng.Name = nodegroup
-				ng.VolumeSize = &volSize
-				ng.VolumeEncrypted = &disabled
-				ng.VolumeKmsKeyID = &kmsKeyID
-				err := ValidateNodeGroup(0, ng)
+		It("should fail with an unknown effect", func() {
+			ng.Taints = map[string]string{"dedicated": "true:Sometimes"}
+			err := ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(`nodeGroups[0].taints: taint "dedicated": effect "Sometimes" is not valid, supported effects are: NoSchedule, PreferNoSchedule, NoExecute`))
+		})
+
+		It("should fail with an invalid key", func() {
+			ng.Taints = map[string]string{"not/a/key": "true:NoSchedule"}
+			err := ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring(`taint key "not/a/key" is invalid`)))
+		})
+	})
+
+	Describe("nodeGroups[*].ssh.enableSSM", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+			ng.SSH = &NodeGroupSSH{
+				Allow:     Disabled(),
+				EnableSSM: Enabled(),
+			}
+		})
+
+		It("should pass for AmazonLinux2", func() {
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			ng.AMIFamily = NodeImageFamilyAmazonLinux2
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should fail for other AMI families", func() {
+			for _, amiFamily := range []string{NodeImageFamilyUbuntu1804, NodeImageFamilyBottlerocket} {
+				ng.AMIFamily = amiFamily
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`nodeGroups[0].ssh.enableSSM can only be set when nodeGroups[0].amiFamily is "AmazonLinux2"`))
+			}
+		})
+	})
+
+	Describe("vpc.nat.gateway", func() {
+		It("should pass with supported modes", func() {
+			for _, mode := range SupportedNATGatewayModes() {
+				cfg := NewClusterConfig()
+				*cfg.VPC.NAT.Gateway = mode
+
+				Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			}
+		})
+
+		It("should fail with an unsupported mode", func() {
+			cfg := NewClusterConfig()
+			*cfg.VPC.NAT.Gateway = "Multiple"
+
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`vpc.nat.gateway "Multiple" is not valid, supported modes are: HighlyAvailable, Single, Disable`))
+		})
+	})
+
+	Describe("vpc.customNetworking", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.VPC.CustomNetworking = &ClusterCustomNetworking{}
+		})
+
+		It("should pass with a secondary CIDR", func() {
+			cfg.VPC.CustomNetworking.SecondaryCIDR = ipnet.MustParseCIDR("100.64.0.0/16")
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should pass with pod subnets", func() {
+			cfg.VPC.CustomNetworking.PodSubnets = map[string]Network{
+				"us-west-2a": {ID: "subnet-1"},
+			}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should fail when neither secondary CIDR nor pod subnets are set", func() {
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("either vpc.customNetworking.secondaryCIDR or vpc.customNetworking.podSubnets must be set"))
+		})
+
+		It("should fail when a pod subnet has no ID", func() {
+			cfg.VPC.CustomNetworking.PodSubnets = map[string]Network{
+				"us-west-2a": {},
+			}
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`vpc.customNetworking.podSubnets["us-west-2a"].id must be set`))
+		})
+
+		It("should fail with a secondary CIDR and an existing VPC", func() {
+			cfg.VPC.ID = "vpc-1"
+			cfg.VPC.CustomNetworking.SecondaryCIDR = ipnet.MustParseCIDR("100.64.0.0/16")
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("vpc.customNetworking.secondaryCIDR cannot be used with an existing VPC, use vpc.customNetworking.podSubnets instead"))
+		})
+
+		It("should fail when the secondary CIDR is too small", func() {
+			cfg.VPC.CustomNetworking.SecondaryCIDR = ipnet.MustParseCIDR("100.64.0.0/25")
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("vpc.customNetworking.secondaryCIDR prefix must be between /16 and /24"))
+		})
+
+		It("should fail when the secondary CIDR overlaps with the VPC CIDR", func() {
+			cfg.VPC.CustomNetworking.SecondaryCIDR = ipnet.MustParseCIDR("192.168.0.0/20")
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("vpc.customNetworking.secondaryCIDR (192.168.0.0/20) overlaps with vpc.cidr (192.168.0.0/16)"))
+		})
+	})
+
+	Describe("kubernetesNetworkConfig.serviceIPv4CIDR", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.KubernetesNetworkConfig = &KubernetesNetworkConfig{}
+		})
+
+		It("should accept a private CIDR that doesn't overlap with the VPC", func() {
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = ipnet.MustParseCIDR("10.200.0.0/16")
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.ClusterDNSIP()).To(Equal("10.200.0.10"))
+		})
+
+		It("should reject a CIDR with an invalid prefix", func() {
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = ipnet.MustParseCIDR("10.200.0.0/25")
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("kubernetesNetworkConfig.serviceIPv4CIDR prefix must be between /12 and /24"))
+		})
+
+		It("should reject a public CIDR", func() {
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = ipnet.MustParseCIDR("100.64.0.0/16")
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("kubernetesNetworkConfig.serviceIPv4CIDR (100.64.0.0/16) must be within 10.0.0.0/8, 172.16.0.0/12 or 192.168.0.0/16"))
+		})
+
+		It("should reject a CIDR that overlaps with the VPC", func() {
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = ipnet.MustParseCIDR("192.168.128.0/20")
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("kubernetesNetworkConfig.serviceIPv4CIDR (192.168.128.0/20) overlaps with vpc.cidr (192.168.0.0/16)"))
+		})
+	})
+
+	Describe("privateCluster", func() {
+		var (
+			cfg *ClusterConfig
+			err error
+		)
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.PrivateCluster = &PrivateCluster{Enabled: true}
+			*cfg.VPC.NAT.Gateway = ClusterDisableNAT
+
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.PrivateNetworking = true
+		})
+
+		It("should pass with private nodegroups and NAT disabled", func() {
+			err = ValidateClusterConfig(cfg)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail when NAT gateways are enabled", func() {
+			*cfg.VPC.NAT.Gateway = ClusterSingleNAT
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`vpc.nat.gateway must be "Disable" for a fully-private cluster`))
+		})
+
+		It("should fail with public nodegroups", func() {
+			cfg.NodeGroups[0].PrivateNetworking = false
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("nodeGroups[0].privateNetworking must be enabled for a fully-private cluster"))
+		})
+
+		It("should fail with SSM enabled on a nodegroup", func() {
+			cfg.NodeGroups[0].SSH.EnableSSM = Enabled()
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("nodeGroups[0].ssh.enableSSM is not supported for a fully-private cluster, as the SSM agent cannot be installed without internet access"))
+		})
+
+		It("should fail with empty additional endpoint services", func() {
+			cfg.PrivateCluster.AdditionalEndpointServices = []string{"autoscaling", ""}
+
+			err = ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError("privateCluster.additionalEndpointServices[1] must be non-empty"))
+		})
+
+		It("should add additional endpoint services only once", func() {
+			cfg.PrivateCluster.AdditionalEndpointServices = []string{"autoscaling", "ec2"}
+
+			Expect(cfg.EndpointServices()).To(Equal([]string{"ec2", "ecr.api", "ecr.dkr", "s3", "sts", "logs", "autoscaling"}))
+		})
+	})
+
+	Describe("cluster endpoint access config", func() {
+		var (
+			cfg *ClusterConfig
+			vpc *ClusterVPC
+			err error
+		)
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			vpc = NewClusterVPC()
+			cfg.VPC = vpc
+		})
+
+		It("should not error on private=true, public=true", func() {
+			cfg.VPC.ClusterEndpoints =
+				&ClusterEndpoints{PrivateAccess: Enabled(), PublicAccess: Enabled()}
+			err = cfg.ValidateClusterEndpointConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should not error on private=false, public=true", func() {
+			cfg.VPC.ClusterEndpoints =
+				&ClusterEndpoints{PrivateAccess: Disabled(), PublicAccess: Enabled()}
+			err = cfg.ValidateClusterEndpointConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should error on private=true, public=false", func() {
+			cfg.VPC.ClusterEndpoints = &ClusterEndpoints{PrivateAccess: Enabled(), PublicAccess: Disabled()}
+			err = cfg.ValidateClusterEndpointConfig()
+			Expect(err).To(BeIdenticalTo(ErrClusterEndpointPrivateOnly))
+		})
+
+		It("should error on private=false, public=false", func() {
+			cfg.VPC.ClusterEndpoints = &ClusterEndpoints{PrivateAccess: Disabled(), PublicAccess: Disabled()}
+			err = cfg.ValidateClusterEndpointConfig()
+			Expect(err).To(BeIdenticalTo(ErrClusterEndpointNoAccess))
+		})
+
+		It("should accept IPv4 public access CIDRs", func() {
+			cfg.VPC.PublicAccessCIDRs = []string{"1.2.3.4/32", "10.0.0.0/16"}
+			Expect(ValidatePublicAccessCIDRs(cfg)).To(Succeed())
+		})
+
+		It("should reject invalid public access CIDRs", func() {
+			cfg.VPC.PublicAccessCIDRs = []string{"1.2.3.4/32", "1.2.3.4"}
+			Expect(ValidatePublicAccessCIDRs(cfg)).To(MatchError(`vpc.publicAccessCIDRs[1] ("1.2.3.4") must be an IPv4 CIDR`))
+
+			cfg.VPC.PublicAccessCIDRs = []string{"2001:db8::/32"}
+			Expect(ValidatePublicAccessCIDRs(cfg)).ToNot(Succeed())
+		})
+
+		It("should reject public access CIDRs when public access is disabled", func() {
+			cfg.VPC.ClusterEndpoints = &ClusterEndpoints{PrivateAccess: Enabled(), PublicAccess: Disabled()}
+			cfg.VPC.PublicAccessCIDRs = []string{"1.2.3.4/32"}
+			Expect(ValidatePublicAccessCIDRs(cfg)).ToNot(Succeed())
+		})
+	})
+
+	Describe("nodeTerminationHandler", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.NodeTerminationHandler = &NodeTerminationHandler{Enabled: Enabled()}
+		})
+
+		It("should accept the default lifecycle hook timeout", func() {
+			Expect(ValidateNodeTerminationHandler(cfg)).To(Succeed())
+		})
+
+		It("should reject lifecycle hook timeouts out of range", func() {
+			cfg.NodeTerminationHandler.LifecycleHookTimeout = aws.Int(10)
+			Expect(ValidateNodeTerminationHandler(cfg)).To(MatchError(ContainSubstring("nodeTerminationHandler.lifecycleHookTimeout")))
+
+			cfg.NodeTerminationHandler.LifecycleHookTimeout = aws.Int(7201)
+			Expect(ValidateNodeTerminationHandler(cfg)).ToNot(Succeed())
+		})
+	})
+
+	Describe("ssh flags", func() {
+		var (
+			testKeyPath = "some/path/to/file.pub"
+			testKeyName = "id_rsa.pub"
+			testKey     = "THIS IS A KEY"
+		)
+
+		It("fails when a key path and a key name are specified", func() {
+			SSHConfig := &NodeGroupSSH{
+				Allow:         Enabled(),
+				PublicKeyPath: &testKeyPath,
+				PublicKeyName: &testKeyName,
+			}
+
+			checkItDetectsError(SSHConfig)
+		})
+
+		It("fails when a key path and a key are specified", func() {
+			SSHConfig := &NodeGroupSSH{
+				Allow:         Enabled(),
+				PublicKeyPath: &testKeyPath,
+				PublicKey:     &testKey,
+			}
+
+			checkItDetectsError(SSHConfig)
+		})
+
+		It("fails when a key name and a key are specified", func() {
+			SSHConfig := &NodeGroupSSH{
+				Allow:         Enabled(),
+				PublicKeyName: &testKeyName,
+				PublicKey:     &testKey,
+			}
+
+			checkItDetectsError(SSHConfig)
+		})
+
+		It("It doesn't panic when SSH is not enabled", func() {
+			err := validateNodeGroupSSH(nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("allows SSM access without SSH", func() {
+			SSHConfig := &NodeGroupSSH{
+				Allow:     Disabled(),
+				EnableSSM: Enabled(),
+			}
+
+			Expect(validateNodeGroupSSH(SSHConfig)).To(Succeed())
+		})
+
+		It("fails when SSM access and an SSH key are specified", func() {
+			SSHConfig := &NodeGroupSSH{
+				EnableSSM:     Enabled(),
+				PublicKeyName: &testKeyName,
+			}
+
+			checkItDetectsError(SSHConfig)
+		})
+
+		Context("Instances distribution", func() {
+
+			var ng *NodeGroup
+			BeforeEach(func() {
+				ng = &NodeGroup{
+					InstancesDistribution: &NodeGroupInstancesDistribution{
+						InstanceTypes: []string{"t3.medium", "t3.large"},
+					},
+				}
+			})
+
+			It("It doesn't panic when instance distribution is not enabled", func() {
+				ng.InstancesDistribution = nil
+				err := validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when instance distribution is enabled and instanceType is not empty or \"mixed\"", func() {
+				err := validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+
+				ng.InstanceType = "t3.small"
+
+				err = validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("It fails when the instance distribution doesn't have at least 2 different instance types", func() {
+				ng.InstanceType = "mixed"
+				ng.InstancesDistribution.InstanceTypes = []string{"t3.medium", "t3.medium"}
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstanceType = "mixed"
+				ng.InstancesDistribution.InstanceTypes = []string{"t3.medium", "t3.small"}
+
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when the onDemandBaseCapacity is not above 0", func() {
+				ng.InstancesDistribution.OnDemandBaseCapacity = newInt(-1)
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.OnDemandBaseCapacity = newInt(1)
+
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when the spotInstancePools is not between 1 and 20", func() {
+				ng.InstancesDistribution.SpotInstancePools = newInt(0)
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.SpotInstancePools = newInt(21)
+				err = validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.SpotInstancePools = newInt(2)
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when the onDemandPercentageAboveBaseCapacity is not between 0 and 100", func() {
+				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(-1)
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(101)
+				err = validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(50)
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when the spotAllocationStrategy is not supported", func() {
+				strategy := "random"
+				ng.InstancesDistribution.SpotAllocationStrategy = &strategy
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				strategy = SpotAllocationStrategyCapacityOptimized
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when spotInstancePools is set with the capacity-optimized spotAllocationStrategy", func() {
+				strategy := SpotAllocationStrategyCapacityOptimized
+				ng.InstancesDistribution.SpotAllocationStrategy = &strategy
+				ng.InstancesDistribution.SpotInstancePools = newInt(2)
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				strategy = SpotAllocationStrategyLowestPrice
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when instanceTypes are set along with an instance selector", func() {
+				ng.InstanceSelector = &InstanceSelector{VCPUs: 4}
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(MatchError("instancesDistribution.instanceTypes cannot be set when using instanceSelector"))
+
+				ng.InstancesDistribution.InstanceTypes = nil
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("Cluster DNS", func() {
+			It("It fails when clusterDNS is not an IP address", func() {
+				ng := NewNodeGroup()
+				ng.ClusterDNS = "kube-dns"
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`nodeGroups[0].clusterDNS ("kube-dns") must be an IP address`))
+
+				ng.ClusterDNS = "169.254.20.10"
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+		})
+
+		Context("Instance metadata options", func() {
+			It("It fails when httpTokens is invalid", func() {
+				ng := NewNodeGroup()
+				ng.InstanceMetadataOptions = &InstanceMetadataOptions{HTTPTokens: "always"}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`nodeGroups[0].instanceMetadataOptions.httpTokens must be "required" or "optional"`))
+
+				ng.InstanceMetadataOptions.HTTPTokens = HTTPTokensRequired
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+
+			It("It fails when httpPutResponseHopLimit is out of range", func() {
+				ng := NewNodeGroup()
+				ng.InstanceMetadataOptions = &InstanceMetadataOptions{HTTPPutResponseHopLimit: newInt(0)}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError("nodeGroups[0].instanceMetadataOptions.httpPutResponseHopLimit must be between 1 and 64"))
+
+				ng.InstanceMetadataOptions.HTTPPutResponseHopLimit = newInt(65)
+				Expect(ValidateNodeGroup(0, ng)).ToNot(Succeed())
+
+				ng.InstanceMetadataOptions.HTTPPutResponseHopLimit = newInt(1)
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+		})
+
+		Context("Placement and tenancy", func() {
+			It("It fails when tenancy is invalid", func() {
+				ng := NewNodeGroup()
+				ng.Tenancy = "host"
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`nodeGroups[0].tenancy must be "default" or "dedicated"`))
+
+				ng.Tenancy = TenancyDedicated
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+
+			It("It fails when a cluster placement group spans multiple availability zones", func() {
+				ng := NewNodeGroup()
+				ng.Placement = &Placement{}
+				ng.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`nodeGroups[0].availabilityZones must have exactly one availability zone when using a placement group with the "cluster" strategy`))
+
+				ng.AvailabilityZones = []string{"us-west-2a"}
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+
+				ng.AvailabilityZones = nil
+				ng.Placement.Strategy = PlacementStrategySpread
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+
+			It("It fails when strategy is invalid", func() {
+				ng := NewNodeGroup()
+				ng.Placement = &Placement{Strategy: "random"}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`nodeGroups[0].placement.strategy must be one of "cluster", "partition" or "spread"`))
+			})
+
+			It("It fails when strategy is set for an existing placement group", func() {
+				ng := NewNodeGroup()
+				ng.Placement = &Placement{GroupName: "hpc-group", Strategy: PlacementStrategyPartition}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.Placement.Strategy = ""
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+		})
+
+		Context("Instance refresh and warm pool", func() {
+			It("It fails when minHealthyPercentage is out of range", func() {
+				ng := NewNodeGroup()
+				ng.InstanceRefresh = &InstanceRefresh{MinHealthyPercentage: newInt(101)}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError("nodeGroups[0].instanceRefresh.minHealthyPercentage must be between 0 and 100"))
+
+				ng.InstanceRefresh.MinHealthyPercentage = newInt(100)
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+
+			It("It fails when instanceWarmup is negative", func() {
+				ng := NewNodeGroup()
+				ng.InstanceRefresh = &InstanceRefresh{InstanceWarmup: newInt(-1)}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError("nodeGroups[0].instanceRefresh.instanceWarmup must be 0 or greater"))
+			})
+
+			It("It fails when poolState is invalid", func() {
+				ng := NewNodeGroup()
+				ng.WarmPool = &WarmPool{PoolState: "Hibernated"}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError(`nodeGroups[0].warmPool.poolState must be "Stopped" or "Running"`))
+
+				ng.WarmPool.PoolState = WarmPoolStateRunning
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+
+			It("It fails when maxGroupPreparedCapacity is less than maxSize", func() {
+				ng := NewNodeGroup()
+				ng.MaxSize = newInt(4)
+				ng.WarmPool = &WarmPool{MinSize: newInt(1), MaxGroupPreparedCapacity: newInt(3)}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError("nodeGroups[0].warmPool.maxGroupPreparedCapacity must not be less than nodeGroups[0].maxSize"))
+
+				ng.WarmPool.MaxGroupPreparedCapacity = newInt(6)
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+
+				ng.WarmPool.MinSize = newInt(-1)
+				Expect(ValidateNodeGroup(0, ng)).ToNot(Succeed())
+			})
+
+			It("It fails when a warm pool is used with instancesDistribution", func() {
+				ng := NewNodeGroup()
+				ng.InstanceType = "mixed"
+				ng.InstancesDistribution = &NodeGroupInstancesDistribution{
+					InstanceTypes: []string{"m5.large", "m5a.large"},
+				}
+				ng.WarmPool = &WarmPool{}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError("nodeGroups[0].warmPool cannot be used with nodeGroups[0].instancesDistribution"))
+			})
+
+			It("It fails when a warm pool is used with Bottlerocket", func() {
+				ng := NewNodeGroup()
+				ng.AMIFamily = NodeImageFamilyBottlerocket
+				ng.WarmPool = &WarmPool{}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError("nodeGroups[0].warmPool is not supported for Bottlerocket nodegroups"))
+			})
+		})
+
+		Context("ARM instance types", func() {
+			It("It fails for AMI families without ARM AMIs", func() {
+				ng := NewNodeGroup()
+				ng.InstanceType = "a1.large"
+				ng.AMIFamily = NodeImageFamilyUbuntu1804
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError("ARM instance types are only supported by the AmazonLinux2 and Bottlerocket AMI families (path=nodeGroups[0].amiFamily)"))
+
+				ng.AMIFamily = NodeImageFamilyAmazonLinux2
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+
+			It("It fails when mixing ARM and x86_64 instance types", func() {
+				ng := NewNodeGroup()
+				ng.InstanceType = "mixed"
+				ng.InstancesDistribution = &NodeGroupInstancesDistribution{
+					InstanceTypes: []string{"m6g.large", "m5.large"},
+				}
+
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(MatchError("nodeGroups[0].instancesDistribution.instanceTypes cannot mix ARM and x86_64 instance types"))
+
+				ng.InstancesDistribution.InstanceTypes = []string{"m6g.large", "c6g.large"}
+				Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+			})
+		})
+
+		Context("Instance selector", func() {
+			var ng *NodeGroup
+			BeforeEach(func() {
+				ng = &NodeGroup{
+					InstanceSelector: &InstanceSelector{VCPUs: 4, Memory: "16Gi"},
+				}
+			})
+
+			It("It accepts resources of instance types", func() {
+				err := validateInstanceSelector("nodeGroups[0]", ng)
+				Expect(err).ToNot(HaveOccurred())
+
+				memory, err := ParseInstanceSelectorMemory(ng.InstanceSelector.Memory)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(memory).To(Equal(int64(16384)))
+			})
+
+			It("It fails when instanceType is set", func() {
+				ng.InstanceType = "m5.xlarge"
+
+				err := validateInstanceSelector("nodeGroups[0]", ng)
+				Expect(err).To(MatchError("nodeGroups[0].instanceType and nodeGroups[0].instanceSelector cannot be set at the same time"))
+			})
+
+			It("It fails when the memory is invalid", func() {
+				ng.InstanceSelector.Memory = "16 gigs"
+
+				err := validateInstanceSelector("nodeGroups[0]", ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstanceSelector.Memory = "100"
+				err = validateInstanceSelector("nodeGroups[0]", ng)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("It fails when no resources are set", func() {
+				ng.InstanceSelector = &InstanceSelector{}
+
+				err := validateInstanceSelector("nodeGroups[0]", ng)
+				Expect(err).To(MatchError("at least one of vCPUs, memory or gpus must be set in nodeGroups[0].instanceSelector"))
+			})
+		})
+	})
+
+	Describe("kubelet extra config", func() {
+		Context("Instances distribution", func() {
+
+			var ng *NodeGroup
+			BeforeEach(func() {
+				ng = &NodeGroup{}
+			})
+
+			It("Forbids overriding basic fields", func() {
+				testKeys := []string{"kind", "apiVersion", "address", "clusterDomain", "authentication",
+					"authorization", "serverTLSBootstrap"}
+
+				for _, key := range testKeys {
+					ng.KubeletExtraConfig = &InlineDocument{
+						key: "should-not-be-allowed",
+					}
+					err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("Allows other kubelet options", func() {
+				ng.KubeletExtraConfig = &InlineDocument{
+					"kubeReserved": map[string]string{
+						"cpu":               "300m",
+						"memory":            "300Mi",
+						"ephemeral-storage": "1Gi",
+					},
+					"kubeReservedCgroup": "/kube-reserved",
+					"cgroupDriver":       "systemd",
+					"featureGates": map[string]bool{
+						"VolumeScheduling":         false,
+						"VolumeSnapshotDataSource": true,
+					},
+				}
+				err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("Forbids maxPods in favour of maxPodsPerNode", func() {
+				ng.KubeletExtraConfig = &InlineDocument{
+					"maxPods": 20,
+				}
+				err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig)
+				Expect(err).To(MatchError(`cannot set "maxPods" in kubelet config, use maxPodsPerNode instead`))
+			})
+		})
+	})
+
+	Describe("bootstrap customization", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+		})
+
+		It("should pass with pre-bootstrap and override commands", func() {
+			ng.PreBootstrapCommands = []string{"yum install -y htop"}
+			override := "/etc/eks/bootstrap.sh my-cluster"
+			ng.OverrideBootstrapCommand = &override
+			ng.MaxPodsPerNode = 20
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should fail with negative maxPodsPerNode", func() {
+			ng.MaxPodsPerNode = -1
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].maxPodsPerNode cannot be negative"))
+		})
+
+		It("should fail with an empty overrideBootstrapCommand", func() {
+			override := " "
+			ng.OverrideBootstrapCommand = &override
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].overrideBootstrapCommand cannot be empty"))
+		})
+	})
+
+	Describe("ebs encryption", func() {
+		var (
+			nodegroup = "ng1"
+			volSize   = 50
+			kmsKeyID  = "36c0b54e-64ed-4f2d-a1c7-96558764311e"
+			disabled  = false
+			enabled   = true
+		)
+
+		Context("Encrypted workers", func() {
+
+			var ng *NodeGroup
+			BeforeEach(func() {
+				ng = &NodeGroup{}
+			})
+
+			It("Forbids setting volumeKmsKeyID without volumeEncrypted", func() {
+				ng.Name = nodegroup
+				ng.VolumeSize = &volSize
+				ng.VolumeEncrypted = nil
+				ng.VolumeKmsKeyID = &kmsKeyID
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("Forbids setting volumeKmsKeyID with volumeEncrypted: false", func() {
+				ng.Name = nodegroup
+				ng.VolumeSize = &volSize
+				ng.VolumeEncrypted = &disabled
+				ng.VolumeKmsKeyID = &kmsKeyID
+				err := ValidateNodeGroup(0, ng)
 				Expect(err).To(HaveOccurred())
 			})
 
@@ -546,6 +1386,341 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("volume performance", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+			ng.VolumeSize = newInt(80)
+		})
+
+		It("allows gp3 volumes with IOPS and throughput", func() {
+			ng.VolumeType = aws.String(NodeVolumeTypeGP3)
+			ng.VolumeIOPS = newInt(6000)
+			ng.VolumeThroughput = newInt(500)
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("allows gp3 volumes with the baseline performance", func() {
+			ng.VolumeType = aws.String(NodeVolumeTypeGP3)
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("requires IOPS for io1 volumes", func() {
+			ng.VolumeType = aws.String(NodeVolumeTypeIO1)
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].volumeIOPS is required for io1 volume type"))
+		})
+
+		It("rejects IOPS out of range", func() {
+			ng.VolumeType = aws.String(NodeVolumeTypeGP3)
+			ng.VolumeIOPS = newInt(20000)
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].volumeIOPS must be between 3000 and 16000 for gp3 volume type, got 20000"))
+		})
+
+		It("rejects throughput for volume types other than gp3", func() {
+			ng.VolumeType = aws.String(NodeVolumeTypeIO1)
+			ng.VolumeIOPS = newInt(1000)
+			ng.VolumeThroughput = newInt(250)
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].volumeThroughput is only supported for gp3 volume type"))
+		})
+
+		It("rejects IOPS for gp2 volumes", func() {
+			ng.VolumeIOPS = newInt(1000)
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].volumeIOPS is only supported for io1 and gp3 volume types"))
+		})
+
+		It("rejects unknown volume types", func() {
+			ng.VolumeType = aws.String("gp9")
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring(`nodeGroups[0].volumeType "gp9" is not supported`)))
+		})
+	})
+
+	Describe("git.repo", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Git = &Git{
+				Repo: &Repo{
+					URL:   "git@github.com:org/repo.git",
+					Email: "user@example.com",
+				},
+			}
+		})
+
+		It("should default paths to the Flux manifests directory", func() {
+			SetClusterConfigDefaults(cfg)
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			Expect(cfg.Git.Repo.FluxPath).To(Equal(DefaultGitFluxPath))
+			Expect(cfg.Git.Repo.Paths).To(Equal([]string{DefaultGitFluxPath}))
+		})
+
+		It("should allow multiple relative paths", func() {
+			cfg.Git.Repo.Paths = []string{"clusters/prod", "./base/", "."}
+			SetClusterConfigDefaults(cfg)
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should reject absolute paths", func() {
+			cfg.Git.Repo.Paths = []string{"/etc"}
+			SetClusterConfigDefaults(cfg)
+			Expect(ValidateClusterConfig(cfg)).ToNot(Succeed())
+		})
+
+		It("should reject paths outside of the repository", func() {
+			cfg.Git.Repo.Paths = []string{"base/../../elsewhere"}
+			SetClusterConfigDefaults(cfg)
+			Expect(ValidateClusterConfig(cfg)).ToNot(Succeed())
+		})
+	})
+
+	Describe("managedNodeGroups", func() {
+		var (
+			cfg *ClusterConfig
+			ng  *ManagedNodeGroup
+		)
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			ng = NewManagedNodeGroup()
+			ng.Name = "mng-1"
+			cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, ng)
+		})
+
+		It("should not allow names to clash with nodeGroups", func() {
+			cfg.NewNodeGroup().Name = "mng-1"
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`managedNodeGroups[0].name "mng-1" is not unique`))
+		})
+
+		It("should allow an existing launch template", func() {
+			ng.LaunchTemplate = &LaunchTemplate{ID: "lt-0123456789abcdef0"}
+			Expect(ValidateManagedNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should require launchTemplate.id", func() {
+			ng.LaunchTemplate = &LaunchTemplate{}
+			err := ValidateManagedNodeGroup(0, ng)
+			Expect(err).To(MatchError("managedNodeGroups[0].launchTemplate.id must be set"))
+		})
+
+		It("should not allow fields that belong in the launch template", func() {
+			ng.LaunchTemplate = &LaunchTemplate{ID: "lt-0123456789abcdef0"}
+			ng.AMI = "ami-0123456789abcdef0"
+			err := ValidateManagedNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring("managedNodeGroups[0].ami cannot be set when managedNodeGroups[0].launchTemplate is specified")))
+
+			ng.AMI = ""
+			ng.SecurityGroups.AttachIDs = []string{"sg-1"}
+			err = ValidateManagedNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring("managedNodeGroups[0].securityGroups.attachIDs cannot be set")))
+		})
+
+		It("should require overrideBootstrapCommand with a custom AMI", func() {
+			ng.AMI = "ami-0123456789abcdef0"
+			err := ValidateManagedNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring("managedNodeGroups[0].overrideBootstrapCommand is required")))
+
+			ng.OverrideBootstrapCommand = new(string)
+			*ng.OverrideBootstrapCommand = "/etc/eks/bootstrap.sh cluster-1"
+			Expect(ValidateManagedNodeGroup(0, ng)).To(Succeed())
+			Expect(ng.NeedsLaunchTemplate()).To(BeTrue())
+		})
+
+		It("should reject AMI resolvers", func() {
+			ng.AMI = NodeImageResolverAuto
+			Expect(ValidateManagedNodeGroup(0, ng)).ToNot(Succeed())
+		})
+
+		It("should reject image families other than AmazonLinux2", func() {
+			ng.AMIFamily = NodeImageFamilyUbuntu1804
+			Expect(ValidateManagedNodeGroup(0, ng)).ToNot(Succeed())
+		})
+	})
+
+	Describe("Bottlerocket nodegroups", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewNodeGroup()
+			ng.AMIFamily = NodeImageFamilyBottlerocket
+		})
+
+		It("should allow Bottlerocket settings", func() {
+			ng.Bottlerocket = &NodeGroupBottlerocket{
+				EnableAdminContainer: Enabled(),
+				Settings: &InlineDocument{
+					"motd": "Hello, eksctl!",
+				},
+			}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should reject bootstrap commands and kubelet extra config", func() {
+			ng.PreBootstrapCommands = []string{"echo foo"}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("preBootstrapCommands is not supported for Bottlerocket node groups")))
+
+			ng.PreBootstrapCommands = nil
+			ng.KubeletExtraConfig = &InlineDocument{"kubeReserved": map[string]interface{}{"cpu": "300m"}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("kubeletExtraConfig is not supported for Bottlerocket node groups")))
+		})
+
+		It("should reject the auto AMI resolver", func() {
+			ng.AMI = NodeImageResolverAuto
+			Expect(ValidateNodeGroup(0, ng)).ToNot(Succeed())
+		})
+
+		It("should reject Bottlerocket settings for other image families", func() {
+			ng.AMIFamily = NodeImageFamilyAmazonLinux2
+			ng.Bottlerocket = &NodeGroupBottlerocket{}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].bottlerocket can only be set")))
+		})
+
+		It("should default to the SSM resolver and follow SSH for the admin container", func() {
+			ng.AMI = NodeImageResolverStatic
+			ng.SSH.Allow = Enabled()
+			SetNodeGroupDefaults(0, ng)
+			Expect(ng.AMI).To(Equal(NodeImageResolverAutoSSM))
+			Expect(ng.Bottlerocket).ToNot(BeNil())
+			Expect(IsEnabled(ng.Bottlerocket.EnableAdminContainer)).To(BeTrue())
+		})
+	})
+
+	Describe("nodeGroups[*].asgMetricsCollection", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewNodeGroup()
+		})
+
+		It("should default the granularity to 1Minute", func() {
+			ng.ASGMetricsCollection = []MetricsCollection{{}}
+			SetNodeGroupDefaults(0, ng)
+			Expect(ng.ASGMetricsCollection[0].Granularity).To(Equal(MetricsCollectionGranularity1Minute))
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should reject other granularities", func() {
+			ng.ASGMetricsCollection = []MetricsCollection{{Granularity: "5Minute"}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].asgMetricsCollection[0].granularity must be \"1Minute\"")))
+		})
+
+		It("should reject empty metric names", func() {
+			ng.ASGMetricsCollection = []MetricsCollection{{Metrics: []string{"GroupMinSize", ""}}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("cannot contain empty names")))
+		})
+	})
+
+	Describe("timeouts", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+		})
+
+		It("should fall back to the default timeout when no timeouts are set", func() {
+			Expect(ValidateClusterTimeouts(cfg)).To(Succeed())
+			Expect(cfg.ClusterCreationTimeout(25 * time.Minute)).To(Equal(25 * time.Minute))
+			Expect(cfg.DeletionTimeout(25 * time.Minute)).To(Equal(25 * time.Minute))
+
+			cfg.Timeouts = &ClusterTimeouts{}
+			Expect(cfg.NodeJoinTimeout(25 * time.Minute)).To(Equal(25 * time.Minute))
+			Expect(cfg.UpdateTimeout(25 * time.Minute)).To(Equal(25 * time.Minute))
+		})
+
+		It("should override the default timeout per phase", func() {
+			cfg.Timeouts = &ClusterTimeouts{
+				ClusterCreation:   &metav1.Duration{Duration: time.Hour},
+				NodeGroupCreation: &metav1.Duration{Duration: 40 * time.Minute},
+				NodeJoin:          &metav1.Duration{Duration: 30 * time.Minute},
+				Update:            &metav1.Duration{Duration: 50 * time.Minute},
+			}
+			Expect(ValidateClusterTimeouts(cfg)).To(Succeed())
+			Expect(cfg.ClusterCreationTimeout(25 * time.Minute)).To(Equal(time.Hour))
+			Expect(cfg.NodeGroupCreationTimeout(25 * time.Minute)).To(Equal(40 * time.Minute))
+			Expect(cfg.NodeJoinTimeout(25 * time.Minute)).To(Equal(30 * time.Minute))
+			Expect(cfg.UpdateTimeout(25 * time.Minute)).To(Equal(50 * time.Minute))
+			Expect(cfg.DeletionTimeout(25 * time.Minute)).To(Equal(25 * time.Minute))
+		})
+
+		It("should reject timeouts that are not positive", func() {
+			cfg.Timeouts = &ClusterTimeouts{
+				Deletion: &metav1.Duration{Duration: 0},
+			}
+			Expect(ValidateClusterTimeouts(cfg)).To(MatchError("timeouts.deletion must be greater than 0"))
+		})
+	})
+
+	Describe("Local Zones, Wavelength Zones and Outposts", func() {
+		const outpostARN = "arn:aws:outposts:us-west-2:000000000000:outpost/op-0123456789abcdef0"
+
+		It("should reject Local Zones for the control plane", func() {
+			cfg := NewClusterConfig()
+			cfg.Metadata.Region = "us-west-2"
+			cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2-lax-1a"}
+
+			err := ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(ContainSubstring("us-west-2-lax-1a is a Local Zone or Wavelength Zone")))
+
+			cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+			cfg.VPC.Subnets = &ClusterSubnets{
+				Private: map[string]Network{"us-east-1-wl1-bos-wlz-1": {ID: "subnet-1"}},
+			}
+			cfg.Metadata.Region = "us-east-1"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("us-east-1-wl1-bos-wlz-1 is a Local Zone or Wavelength Zone")))
+		})
+
+		It("should not allow subnets along with availability zones", func() {
+			ng := NewNodeGroup()
+			ng.Subnets = []string{"subnet-1"}
+			ng.AvailabilityZones = []string{"us-west-2a"}
+
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].subnets cannot be used with nodeGroups[0].availabilityZones"))
+
+			ng.AvailabilityZones = nil
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should allow a cluster placement group in a single subnet", func() {
+			ng := NewNodeGroup()
+			ng.Subnets = []string{"subnet-1"}
+			ng.Placement = &Placement{}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should require the subnets of the Outpost", func() {
+			ng := NewNodeGroup()
+			ng.OutpostARN = outpostARN
+
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].subnets must be set to the subnets of the Outpost when nodeGroups[0].outpostARN is set"))
+
+			ng.Subnets = []string{"subnet-1"}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should reject ARNs that are not of an Outpost", func() {
+			ng := NewNodeGroup()
+			ng.Subnets = []string{"subnet-1"}
+			ng.OutpostARN = "arn:aws:ec2:us-west-2:000000000000:subnet/subnet-1"
+
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("must be the ARN of an Outpost")))
+		})
+
+		It("should reject features that Outposts don't support", func() {
+			ng := NewNodeGroup()
+			ng.Subnets = []string{"subnet-1"}
+			ng.OutpostARN = outpostARN
+
+			ng.InstancesDistribution = &NodeGroupInstancesDistribution{InstanceTypes: []string{"m5.large", "m5a.large"}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("instancesDistribution cannot be used on Outposts")))
+
+			ng.InstancesDistribution = nil
+			volumeType := NodeVolumeTypeGP3
+			ng.VolumeType = &volumeType
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].volumeType must be "gp2" on Outposts`))
+		})
+	})
 })
 
 func checkItDetectsError(SSHConfig *NodeGroupSSH) {
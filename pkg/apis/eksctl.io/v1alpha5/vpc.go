@@ -15,6 +15,10 @@ type (
 		Network `json:",inline"` // global CIDR and VPC ID
 		// +optional
 		SecurityGroup string `json:"securityGroup,omitempty"` // cluster SG
+		// additional security groups to attach to the control plane,
+		// e.g. to allow access from a bastion host or a peered VPC
+		// +optional
+		ControlPlaneSecurityGroupIDs []string `json:"controlPlaneSecurityGroupIDs,omitempty"`
 		// subnets are either public or private for use with separate nodegroups
 		// these are keyed by AZ for convenience
 		// +optional
@@ -31,6 +35,12 @@ type (
 		NAT *ClusterNAT `json:"nat,omitempty"`
 		// +optional
 		ClusterEndpoints *ClusterEndpoints `json:"clusterEndpoints,omitempty"`
+		// PublicAccessCIDRs restricts access to the public Kubernetes API endpoint,
+		// it is open to all addresses (0.0.0.0/0) when not set
+		// +optional
+		PublicAccessCIDRs []string `json:"publicAccessCIDRs,omitempty"`
+		// +optional
+		CustomNetworking *ClusterCustomNetworking `json:"customNetworking,omitempty"`
 	}
 	// ClusterSubnets holds private and public subnets
 	ClusterSubnets struct {
@@ -48,6 +58,9 @@ type (
 	}
 	// ClusterNAT holds NAT gateway configuration options
 	ClusterNAT struct {
+		// Valid variants are `"HighlyAvailable"` (one NAT gateway in each availability zone),
+		// `"Single"` (one NAT gateway shared by all availability zones) and `"Disable"` (no NAT gateway)
+		// +optional
 		Gateway *string `json:"gateway,omitempty"`
 	}
 
@@ -77,6 +90,11 @@ func SubnetTopologies() []SubnetTopology {
 	}
 }
 
+// SupportedNATGatewayModes returns all supported NAT gateway modes
+func SupportedNATGatewayModes() []string {
+	return []string{ClusterHighlyAvailableNAT, ClusterSingleNAT, ClusterDisableNAT}
+}
+
 // DefaultCIDR returns default global CIDR for VPC
 func DefaultCIDR() ipnet.IPNet {
 	return ipnet.IPNet{
@@ -214,6 +232,24 @@ func (c *ClusterConfig) UpdateEndpointsMsg() string {
 		"you can update Kubernetes API endpoint access with `eksctl utils update-cluster-endpoints --region=%s --name=%s --private-access=bool --public-access=bool`", c.Metadata.Region, c.Metadata.Name)
 }
 
+// ValidatePublicAccessCIDRs checks that the CIDRs are valid IPv4 CIDRs and that
+// the public endpoint, which they apply to, is enabled
+func ValidatePublicAccessCIDRs(cfg *ClusterConfig) error {
+	if cfg.VPC == nil || len(cfg.VPC.PublicAccessCIDRs) == 0 {
+		return nil
+	}
+	for i, cidr := range cfg.VPC.PublicAccessCIDRs {
+		ip, _, err := net.ParseCIDR(cidr)
+		if err != nil || ip.To4() == nil {
+			return fmt.Errorf("vpc.publicAccessCIDRs[%d] (%q) must be an IPv4 CIDR", i, cidr)
+		}
+	}
+	if cfg.IsFullyPrivate() || (cfg.VPC.ClusterEndpoints != nil && IsDisabled(cfg.VPC.ClusterEndpoints.PublicAccess)) {
+		return fmt.Errorf("vpc.publicAccessCIDRs cannot be set when public access to the Kubernetes API endpoint is disabled")
+	}
+	return nil
+}
+
 // EndpointsEqual returns true of two endpoints have same values after dereferencing any pointers
 func EndpointsEqual(a, b ClusterEndpoints) bool {
 	ajson, err := json.Marshal(a)
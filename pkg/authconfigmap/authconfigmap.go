@@ -10,18 +10,20 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/awsutil"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/yaml"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -242,14 +244,56 @@ func (a *AuthConfigMap) setIdentities(identities []iam.Identity) error {
 
 // Save persists the ConfigMap to the cluster. It determines
 // whether to create or update by looking at the ConfigMap's UID.
-func (a *AuthConfigMap) Save() (err error) {
+// The update is rejected with a conflict error if the ConfigMap
+// has been modified since it was read, and so is the creation
+// if the ConfigMap has been created in the meantime.
+func (a *AuthConfigMap) Save() error {
 	if a.cm.UID == "" {
-		a.cm, err = a.client.Create(a.cm)
+		cm, err := a.client.Create(a.cm)
+		if apierrors.IsAlreadyExists(err) {
+			return apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, ObjectName, err)
+		}
+		if err != nil {
+			return err
+		}
+		a.cm = cm
+		return nil
+	}
+
+	cm, err := a.client.Update(a.cm)
+	if err != nil {
 		return err
 	}
+	a.cm = cm
+	return nil
+}
 
-	a.cm, err = a.client.Update(a.cm)
-	return err
+// Update modifies the auth ConfigMap with a read-modify-write cycle: it fetches the
+// ConfigMap, applies the given modification and saves it. When the ConfigMap has been
+// changed by someone else in the meantime, the whole cycle is retried with the latest
+// version, so that concurrent changes are never overwritten. The AuthConfigMap that
+// was saved is returned.
+func Update(clientSet kubernetes.Interface, modify func(*AuthConfigMap) error) (*AuthConfigMap, error) {
+	var acm *AuthConfigMap
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var err error
+		acm, err = NewFromClientSet(clientSet)
+		if err != nil {
+			return err
+		}
+		if err := modify(acm); err != nil {
+			return err
+		}
+		err = acm.Save()
+		if apierrors.IsConflict(err) {
+			logger.Debug("auth ConfigMap was modified concurrently, retrying: %v", err)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "updating auth ConfigMap")
+	}
+	return acm, nil
 }
 
 // ObjectMeta constructs metadata for the ConfigMap.
@@ -263,11 +307,6 @@ func ObjectMeta() metav1.ObjectMeta {
 // AddNodeGroup creates or adds a nodegroup IAM role in the auth
 // ConfigMap for the given nodegroup.
 func AddNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
-	acm, err := NewFromClientSet(clientSet)
-	if err != nil {
-		return err
-	}
-
 	nodeGroupRoles := RoleNodeGroupGroups
 	if api.IsWindowsImage(ng.AMIFamily) {
 		nodeGroupRoles = append([]string{roleNodeGroupWindows}, nodeGroupRoles...)
@@ -278,11 +317,11 @@ func AddNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
 		return err
 	}
 
-	if err := acm.AddIdentity(identity); err != nil {
-		return errors.Wrap(err, "adding nodegroup to auth ConfigMap")
-	}
-	if err := acm.Save(); err != nil {
-		return errors.Wrap(err, "saving auth ConfigMap")
+	_, err = Update(clientSet, func(acm *AuthConfigMap) error {
+		return errors.Wrap(acm.AddIdentity(identity), "adding nodegroup to auth ConfigMap")
+	})
+	if err != nil {
+		return err
 	}
 	logger.Debug("saved auth ConfigMap for %q", ng.Name)
 	return nil
@@ -291,16 +330,12 @@ func AddNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
 // RemoveNodeGroup removes a nodegroup from the ConfigMap and
 // does a client update.
 func RemoveNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
-	acm, err := NewFromClientSet(clientSet)
+	_, err := Update(clientSet, func(acm *AuthConfigMap) error {
+		return errors.Wrap(acm.RemoveIdentity(ng.IAM.InstanceRoleARN, false), "removing nodegroup from auth ConfigMap")
+	})
 	if err != nil {
 		return err
 	}
-	if err := acm.RemoveIdentity(ng.IAM.InstanceRoleARN, false); err != nil {
-		return errors.Wrap(err, "removing nodegroup from auth ConfigMap")
-	}
-	if err := acm.Save(); err != nil {
-		return errors.Wrap(err, "updating auth ConfigMap after removing role")
-	}
 	logger.Debug("updated auth ConfigMap for %s", ng.Name)
 	return nil
 }
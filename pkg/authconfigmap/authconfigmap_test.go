@@ -5,8 +5,15 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/kubernetes/typed/core/v1"
+	k8stesting "k8s.io/client-go/testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -503,4 +510,117 @@ var _ = Describe("AuthConfigMap{}", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+	Describe("Update()", func() {
+		var (
+			clientSet *fake.Clientset
+			tracker   k8stesting.ObjectTracker
+			conflicts int
+		)
+
+		// newClientSet returns a fake clientset backed by a tracker that can be
+		// modified from reactors, to simulate changes made by another client
+		newClientSet := func(objects ...runtime.Object) *fake.Clientset {
+			tracker = k8stesting.NewObjectTracker(scheme.Scheme, scheme.Codecs.UniversalDecoder())
+			for _, obj := range objects {
+				Expect(tracker.Add(obj)).To(Succeed())
+			}
+			cs := &fake.Clientset{}
+			cs.AddReactor("*", "*", k8stesting.ObjectReaction(tracker))
+			return cs
+		}
+
+		getConfigMap := func() *corev1.ConfigMap {
+			cm, err := clientSet.CoreV1().ConfigMaps(ObjectNamespace).Get(ObjectName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return cm
+		}
+
+		// concurrentChange makes the first call of the given verb fail with the given error,
+		// after another client has changed the ConfigMap, adding roleB to it
+		concurrentChange := func(verb string, err error) {
+			clientSet.PrependReactor(verb, "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				if conflicts > 0 {
+					return false, nil, nil
+				}
+				conflicts++
+
+				cm := &corev1.ConfigMap{
+					ObjectMeta: ObjectMeta(),
+					Data:       map[string]string{"mapRoles": expectedRoleB},
+				}
+				cm.UID = "123456"
+				if verb == "create" {
+					Expect(tracker.Add(cm)).To(Succeed())
+				} else {
+					Expect(tracker.Update(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}, cm, ObjectNamespace)).To(Succeed())
+				}
+				return true, nil, err
+			})
+		}
+
+		addRoleA := func(acm *AuthConfigMap) error {
+			return acm.AddIdentity(mustIdentity(roleA, RoleNodeGroupUsername, RoleNodeGroupGroups))
+		}
+
+		BeforeEach(func() {
+			conflicts = 0
+		})
+
+		It("should update an existing configmap", func() {
+			existing := &corev1.ConfigMap{
+				ObjectMeta: ObjectMeta(),
+				Data:       map[string]string{},
+			}
+			existing.UID = "123456"
+			clientSet = newClientSet(existing)
+
+			acm, err := Update(clientSet, addRoleA)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acm).NotTo(BeNil())
+
+			Expect(getConfigMap().Data["mapRoles"]).To(MatchYAML(expectedRoleA))
+		})
+
+		It("should retry when the configmap was updated concurrently", func() {
+			existing := &corev1.ConfigMap{
+				ObjectMeta: ObjectMeta(),
+				Data:       map[string]string{},
+			}
+			existing.UID = "123456"
+			clientSet = newClientSet(existing)
+
+			concurrentChange("update", apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, ObjectName, fmt.Errorf("the object has been modified")))
+
+			_, err := Update(clientSet, addRoleA)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conflicts).To(Equal(1))
+
+			// the concurrent change is preserved
+			Expect(getConfigMap().Data["mapRoles"]).To(MatchYAML(expectedRoleB + expectedRoleA))
+		})
+
+		It("should retry when the configmap was created concurrently", func() {
+			clientSet = newClientSet()
+
+			concurrentChange("create", apierrors.NewAlreadyExists(schema.GroupResource{Resource: "configmaps"}, ObjectName))
+
+			_, err := Update(clientSet, addRoleA)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conflicts).To(Equal(1))
+
+			Expect(getConfigMap().Data["mapRoles"]).To(MatchYAML(expectedRoleB + expectedRoleA))
+		})
+
+		It("should not save the configmap when the modification fails", func() {
+			clientSet = newClientSet()
+
+			_, err := Update(clientSet, func(acm *AuthConfigMap) error {
+				return acm.RemoveIdentity(roleA, false)
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, err = clientSet.CoreV1().ConfigMaps(ObjectNamespace).Get(ObjectName, metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package az
 
 import (
+	"fmt"
 	"math/rand"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/cache"
 )
 
 const (
@@ -17,6 +19,9 @@ const (
 	RecommendedAvailabilityZones = api.RecommendedSubnets
 	// MinRequiredAvailabilityZones defines the minimum number of required availability zones
 	MinRequiredAvailabilityZones = api.MinRequiredSubnets
+
+	// availabilityZonesCacheTTL is how long the zones of a region are cached for
+	availabilityZonesCacheTTL = 24 * time.Hour
 )
 
 // SelectionStrategy provides an interface to allow changing the strategy used to
@@ -88,6 +93,9 @@ type AvailabilityZoneSelector struct {
 	ec2api   ec2iface.EC2API
 	strategy SelectionStrategy
 	rules    []ZoneUsageRule
+
+	cache     *cache.Cache
+	accountID string
 }
 
 // NewSelectorWithDefaults create a new AvailabilityZoneSelector with the
@@ -114,6 +122,14 @@ func NewSelectorWithMinRequired(ec2api ec2iface.EC2API) *AvailabilityZoneSelecto
 	}
 }
 
+// WithCache makes the selector cache the zones of regions, zone names are
+// mapped to different zones in each account, so the cache is per account
+func (a *AvailabilityZoneSelector) WithCache(c *cache.Cache, accountID string) *AvailabilityZoneSelector {
+	a.cache = c
+	a.accountID = accountID
+	return a
+}
+
 // SelectZones returns a list fo az zones to use for the supplied region
 func (a *AvailabilityZoneSelector) SelectZones(regionName string) ([]string, error) {
 	availableZones, err := a.getZonesForRegion(regionName)
@@ -145,6 +161,12 @@ func (a *AvailabilityZoneSelector) getUsableZones(availableZones []*ec2.Availabi
 }
 
 func (a *AvailabilityZoneSelector) getZonesForRegion(regionName string) ([]*ec2.AvailabilityZone, error) {
+	cacheKey := fmt.Sprintf("availability-zones/%s/%s", a.accountID, regionName)
+	zones := []*ec2.AvailabilityZone{}
+	if a.accountID != "" && a.cache.Get(cacheKey, &zones) {
+		return zones, nil
+	}
+
 	regionFilter := &ec2.Filter{
 		Name:   aws.String("region-name"),
 		Values: []*string{aws.String(regionName)},
@@ -163,5 +185,8 @@ func (a *AvailabilityZoneSelector) getZonesForRegion(regionName string) ([]*ec2.
 		return nil, errors.Wrapf(err, "getting availability zones for %s", regionName)
 	}
 
+	if a.accountID != "" {
+		a.cache.Set(cacheKey, output.AvailabilityZones, availabilityZonesCacheTTL)
+	}
 	return output.AvailabilityZones, nil
 }
@@ -2,6 +2,8 @@ package az_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/cache"
 )
 
 var _ = Describe("AZ", func() {
@@ -245,6 +248,49 @@ var _ = Describe("AZ", func() {
 				Expect(len(selectedZones)).To(Equal(2))
 			})
 		})
+
+		Context("with a cache", func() {
+			var (
+				cacheDir string
+				newCache func() *cache.Cache
+			)
+
+			BeforeEach(func() {
+				cacheDir, err = ioutil.TempDir("", "eksctl-cache")
+				Expect(err).NotTo(HaveOccurred())
+				newCache = func() *cache.Cache { return cache.New(cacheDir) }
+
+				_, p = createProviders()
+				p.MockEC2().On("DescribeAvailabilityZones", mock.Anything).Return(&ec2.DescribeAvailabilityZonesOutput{
+					AvailabilityZones: usWest2Zones(ec2.AvailabilityZoneStateAvailable),
+				}, nil)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(cacheDir)).To(Succeed())
+			})
+
+			It("should only describe the zones of a region once per account", func() {
+				for i := 0; i < 2; i++ {
+					zones, err := NewSelectorWithDefaults(p.MockEC2()).WithCache(newCache(), "123456789012").SelectZones("us-west-2")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(zones).To(HaveLen(3))
+				}
+				Expect(p.MockEC2().AssertNumberOfCalls(GinkgoT(), "DescribeAvailabilityZones", 1)).To(BeTrue())
+
+				_, err = NewSelectorWithDefaults(p.MockEC2()).WithCache(newCache(), "210987654321").SelectZones("us-west-2")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(p.MockEC2().AssertNumberOfCalls(GinkgoT(), "DescribeAvailabilityZones", 2)).To(BeTrue())
+			})
+
+			It("should not cache the zones when the account is unknown", func() {
+				for i := 0; i < 2; i++ {
+					_, err := NewSelectorWithDefaults(p.MockEC2()).WithCache(newCache(), "").SelectZones("us-west-2")
+					Expect(err).NotTo(HaveOccurred())
+				}
+				Expect(p.MockEC2().AssertNumberOfCalls(GinkgoT(), "DescribeAvailabilityZones", 2)).To(BeTrue())
+			})
+		})
 	})
 })
 
@@ -0,0 +1,265 @@
+package builder
+
+import (
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// addonPolicy is an inline policy that grants the permissions a common controller needs
+type addonPolicy struct {
+	name      string
+	resources interface{}
+	actions   []string
+}
+
+// makeAddonPolicies returns the inline policies for all of the addon policies that
+// were requested, they are used for both node instance roles and iamserviceaccounts
+func makeAddonPolicies(p *api.NodeGroupIAMAddonPolicies) []addonPolicy {
+	policies := []addonPolicy{}
+
+	if api.IsEnabled(p.AutoScaler) {
+		policies = append(policies, addonPolicy{
+			name:      "PolicyAutoScaling",
+			resources: "*",
+			actions: []string{
+				"autoscaling:DescribeAutoScalingGroups",
+				"autoscaling:DescribeAutoScalingInstances",
+				"autoscaling:DescribeLaunchConfigurations",
+				"autoscaling:DescribeTags",
+				"autoscaling:SetDesiredCapacity",
+				"autoscaling:TerminateInstanceInAutoScalingGroup",
+				"ec2:DescribeLaunchTemplateVersions",
+			},
+		})
+	}
+
+	if api.IsEnabled(p.CertManager) {
+		policies = append(policies, addonPolicy{
+			name:      "PolicyCertManagerChangeSet",
+			resources: "arn:aws:route53:::hostedzone/*",
+			actions: []string{
+				"route53:ChangeResourceRecordSets",
+			},
+		})
+		policies = append(policies, addonPolicy{
+			name:      "PolicyCertManagerHostedZones",
+			resources: "*",
+			actions: []string{
+				"route53:ListHostedZones",
+				"route53:ListResourceRecordSets",
+				"route53:ListHostedZonesByName",
+			},
+		})
+		policies = append(policies, addonPolicy{
+			name:      "PolicyCertManagerGetChange",
+			resources: "arn:aws:route53:::change/*",
+			actions: []string{
+				"route53:GetChange",
+			},
+		})
+	} else if api.IsEnabled(p.ExternalDNS) {
+		policies = append(policies, addonPolicy{
+			name:      "PolicyExternalDNSChangeSet",
+			resources: "arn:aws:route53:::hostedzone/*",
+			actions: []string{
+				"route53:ChangeResourceRecordSets",
+			},
+		})
+		policies = append(policies, addonPolicy{
+			name:      "PolicyExternalDNSHostedZones",
+			resources: "*",
+			actions: []string{
+				"route53:ListHostedZones",
+				"route53:ListResourceRecordSets",
+			},
+		})
+	}
+
+	if api.IsEnabled(p.AppMesh) {
+		policies = append(policies, addonPolicy{
+			name:      "PolicyAppMesh",
+			resources: "*",
+			actions: []string{
+				"appmesh:*",
+				"servicediscovery:CreateService",
+				"servicediscovery:GetService",
+				"servicediscovery:RegisterInstance",
+				"servicediscovery:DeregisterInstance",
+				"servicediscovery:ListInstances",
+				"servicediscovery:ListNamespaces",
+				"route53:GetHealthCheck",
+				"route53:CreateHealthCheck",
+				"route53:UpdateHealthCheck",
+				"route53:ChangeResourceRecordSets",
+				"route53:DeleteHealthCheck",
+			},
+		})
+	}
+
+	if api.IsEnabled(p.EBS) {
+		policies = append(policies, addonPolicy{
+			name:      "PolicyEBS",
+			resources: "*",
+			actions: []string{
+				"ec2:AttachVolume",
+				"ec2:CreateSnapshot",
+				"ec2:CreateTags",
+				"ec2:CreateVolume",
+				"ec2:DeleteSnapshot",
+				"ec2:DeleteTags",
+				"ec2:DeleteVolume",
+				"ec2:DescribeAvailabilityZones",
+				"ec2:DescribeInstances",
+				"ec2:DescribeSnapshots",
+				"ec2:DescribeTags",
+				"ec2:DescribeVolumes",
+				"ec2:DescribeVolumesModifications",
+				"ec2:DetachVolume",
+				"ec2:ModifyVolume",
+			},
+		})
+	}
+
+	if api.IsEnabled(p.FSX) {
+		policies = append(policies, addonPolicy{
+			name:      "PolicyFSX",
+			resources: "*",
+			actions: []string{
+				"fsx:*",
+			},
+		})
+		policies = append(policies, addonPolicy{
+			name:      "PolicyServiceLinkRole",
+			resources: "arn:aws:iam::*:role/aws-service-role/*",
+			actions: []string{
+				"iam:CreateServiceLinkedRole",
+				"iam:AttachRolePolicy",
+				"iam:PutRolePolicy",
+			},
+		})
+	}
+
+	if api.IsEnabled(p.EFS) {
+		policies = append(policies, addonPolicy{
+			name:      "PolicyEFS",
+			resources: "*",
+			actions: []string{
+				"elasticfilesystem:*",
+			},
+		})
+		policies = append(policies, addonPolicy{
+			name:      "PolicyEFSEC2",
+			resources: "*",
+			actions: []string{
+				"ec2:DescribeSubnets",
+				"ec2:CreateNetworkInterface",
+				"ec2:DescribeNetworkInterfaces",
+				"ec2:DeleteNetworkInterface",
+				"ec2:ModifyNetworkInterfaceAttribute",
+				"ec2:DescribeNetworkInterfaceAttribute",
+			},
+		})
+	}
+
+	if api.IsEnabled(p.ALBIngress) {
+		policies = append(policies, addonPolicy{
+			name:      "PolicyALBIngress",
+			resources: "*",
+			actions: []string{
+				"acm:DescribeCertificate",
+				"acm:ListCertificates",
+				"acm:GetCertificate",
+				"ec2:AuthorizeSecurityGroupIngress",
+				"ec2:CreateSecurityGroup",
+				"ec2:CreateTags",
+				"ec2:DeleteTags",
+				"ec2:DeleteSecurityGroup",
+				"ec2:DescribeAccountAttributes",
+				"ec2:DescribeAddresses",
+				"ec2:DescribeInstances",
+				"ec2:DescribeInstanceStatus",
+				"ec2:DescribeInternetGateways",
+				"ec2:DescribeNetworkInterfaces",
+				"ec2:DescribeSecurityGroups",
+				"ec2:DescribeSubnets",
+				"ec2:DescribeTags",
+				"ec2:DescribeVpcs",
+				"ec2:ModifyInstanceAttribute",
+				"ec2:ModifyNetworkInterfaceAttribute",
+				"ec2:RevokeSecurityGroupIngress",
+				"elasticloadbalancing:AddListenerCertificates",
+				"elasticloadbalancing:AddTags",
+				"elasticloadbalancing:CreateListener",
+				"elasticloadbalancing:CreateLoadBalancer",
+				"elasticloadbalancing:CreateRule",
+				"elasticloadbalancing:CreateTargetGroup",
+				"elasticloadbalancing:DeleteListener",
+				"elasticloadbalancing:DeleteLoadBalancer",
+				"elasticloadbalancing:DeleteRule",
+				"elasticloadbalancing:DeleteTargetGroup",
+				"elasticloadbalancing:DeregisterTargets",
+				"elasticloadbalancing:DescribeListenerCertificates",
+				"elasticloadbalancing:DescribeListeners",
+				"elasticloadbalancing:DescribeLoadBalancers",
+				"elasticloadbalancing:DescribeLoadBalancerAttributes",
+				"elasticloadbalancing:DescribeRules",
+				"elasticloadbalancing:DescribeSSLPolicies",
+				"elasticloadbalancing:DescribeTags",
+				"elasticloadbalancing:DescribeTargetGroups",
+				"elasticloadbalancing:DescribeTargetGroupAttributes",
+				"elasticloadbalancing:DescribeTargetHealth",
+				"elasticloadbalancing:ModifyListener",
+				"elasticloadbalancing:ModifyLoadBalancerAttributes",
+				"elasticloadbalancing:ModifyRule",
+				"elasticloadbalancing:ModifyTargetGroup",
+				"elasticloadbalancing:ModifyTargetGroupAttributes",
+				"elasticloadbalancing:RegisterTargets",
+				"elasticloadbalancing:RemoveListenerCertificates",
+				"elasticloadbalancing:RemoveTags",
+				"elasticloadbalancing:SetIpAddressType",
+				"elasticloadbalancing:SetSecurityGroups",
+				"elasticloadbalancing:SetSubnets",
+				"elasticloadbalancing:SetWebACL",
+				"iam:CreateServiceLinkedRole",
+				"iam:GetServerCertificate",
+				"iam:ListServerCertificates",
+				"waf-regional:GetWebACLForResource",
+				"waf-regional:GetWebACL",
+				"waf-regional:AssociateWebACL",
+				"waf-regional:DisassociateWebACL",
+				"tag:GetResources",
+				"tag:TagResources",
+				"waf:GetWebACL",
+			},
+		})
+	}
+
+	if api.IsEnabled(p.XRay) {
+		policies = append(policies, addonPolicy{
+			name:      "PolicyXRay",
+			resources: "*",
+			actions: []string{
+				"xray:PutTraceSegments",
+				"xray:PutTelemetryRecords",
+				"xray:GetSamplingRules",
+				"xray:GetSamplingTargets",
+				"xray:GetSamplingStatisticSummaries",
+			},
+		})
+	}
+
+	return policies
+}
+
+// makeAddonManagedPolicyARNs returns the ARNs of the managed policies for the addon
+// policies that were requested, which are needed by iamserviceaccounts only, as node
+// instance roles always get access to ECR
+func makeAddonManagedPolicyARNs(p *api.NodeGroupIAMAddonPolicies) []string {
+	policyARNs := []string{}
+	if api.IsEnabled(p.ImageBuilder) {
+		policyARNs = append(policyARNs, iamPolicyAmazonEC2ContainerRegistryPowerUserARN)
+	}
+	if api.IsEnabled(p.CloudWatch) {
+		policyARNs = append(policyARNs, iamPolicyCloudWatchAgentServerPolicyARN)
+	}
+	return policyARNs
+}
@@ -69,6 +69,10 @@ type Properties struct {
 
 	TargetGroupARNs                   []string
 	DesiredCapacity, MinSize, MaxSize string
+	MetricsCollection                 []struct {
+		Granularity string
+		Metrics     []string
+	}
 
 	CidrIp, CidrIpv6, IpProtocol string
 	FromPort, ToPort             int
@@ -83,12 +87,38 @@ type Properties struct {
 	AmazonProvidedIpv6CidrBlock         bool
 	AvailabilityZone, Domain, CidrBlock string
 
+	Strategy string
+
+	AutoScalingGroupName                interface{}
+	MaxGroupPreparedCapacity, PoolState string
+
+	LifecycleHookName, LifecycleTransition, DefaultResult string
+	HeartbeatTimeout                                      int
+
+	ServiceName                                interface{}
+	VpcEndpointType                            string
+	PrivateDnsEnabled                          bool
+	RouteTableIds, SubnetIds, SecurityGroupIds []interface{}
+	SecurityGroupIngress                       []struct {
+		CidrIp           string
+		FromPort, ToPort int
+	}
+
 	Name, Version      string
 	RoleArn            interface{}
 	ResourcesVpcConfig struct {
 		SecurityGroupIds []interface{}
 		SubnetIds        []interface{}
 	}
+	EncryptionConfig []struct {
+		Resources []string
+		Provider  struct {
+			KeyArn string
+		}
+	}
+	KubernetesNetworkConfig *struct {
+		ServiceIpv4Cidr string
+	}
 	MixedInstancesPolicy *struct {
 		LaunchTemplate struct {
 			LaunchTemplateSpecification struct {
@@ -104,6 +134,7 @@ type Properties struct {
 			OnDemandPercentageAboveBaseCapacity string
 			SpotMaxPrice                        string
 			SpotInstancePools                   string
+			SpotAllocationStrategy              string
 		}
 	}
 }
@@ -113,10 +144,26 @@ type LaunchTemplateData struct {
 	UserData, InstanceType, ImageId string
 	BlockDeviceMappings             []interface{}
 	EbsOptimized                    *bool
+	TagSpecifications               []struct {
+		ResourceType string
+		Tags         []Tag
+	}
 	NetworkInterfaces               []struct {
 		DeviceIndex              int
 		AssociatePublicIpAddress bool
 	}
+	MetadataOptions *struct {
+		HttpEndpoint            string
+		HttpTokens              string
+		HttpPutResponseHopLimit int
+	}
+	Placement *struct {
+		GroupName interface{}
+		Tenancy   string
+	}
+	Monitoring *struct {
+		Enabled bool
+	}
 	InstanceMarketOptions *struct {
 		MarketType  string
 		SpotOptions struct {
@@ -128,7 +175,10 @@ type LaunchTemplateData struct {
 
 type Template struct {
 	Description string
-	Resources   map[string]struct{ Properties Properties }
+	Resources   map[string]struct {
+		Properties Properties
+		DependsOn  []string
+	}
 }
 
 func kubeconfigBody(authenticator string) string {
@@ -335,14 +385,17 @@ var _ = Describe("CloudFormation template builder API", func() {
 		}
 		testVPC := testVPC()
 
-		p.MockEC2().On("DescribeVpcs", mock.MatchedBy(func(input *ec2.DescribeVpcsInput) bool {
+		p.MockEC2().On("DescribeVpcsPages", mock.MatchedBy(func(input *ec2.DescribeVpcsInput) bool {
 			return *input.VpcIds[0] == vpcID
-		})).Return(&ec2.DescribeVpcsOutput{
-			Vpcs: []*ec2.Vpc{{
-				VpcId:     aws.String(vpcID),
-				CidrBlock: aws.String("192.168.0.0/16"),
-			}},
-		}, nil)
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeVpcsOutput, bool) bool)
+			consume(&ec2.DescribeVpcsOutput{
+				Vpcs: []*ec2.Vpc{{
+					VpcId:     aws.String(vpcID),
+					CidrBlock: aws.String("192.168.0.0/16"),
+				}},
+			}, true)
+		}).Return(nil)
 
 		for t := range subnetLists {
 			fn := func(list string, subnetsByAz map[string]api.Network) {
@@ -365,10 +418,13 @@ var _ = Describe("CloudFormation template builder API", func() {
 					output.Subnets[i] = subnet
 
 				}
-				p.MockEC2().On("DescribeSubnets", mock.MatchedBy(func(input *ec2.DescribeSubnetsInput) bool {
+				p.MockEC2().On("DescribeSubnetsPages", mock.MatchedBy(func(input *ec2.DescribeSubnetsInput) bool {
 					fmt.Fprintf(GinkgoWriter, "%s subnets = %#v\n", t, output)
 					return joinCompare(input, list)
-				})).Return(output, nil)
+				}), mock.Anything).Run(func(args mock.Arguments) {
+					consume := args[1].(func(*ec2.DescribeSubnetsOutput, bool) bool)
+					consume(output, true)
+				}).Return(nil)
 			}
 			switch t {
 			case "Private":
@@ -1131,6 +1187,45 @@ var _ = Describe("CloudFormation template builder API", func() {
 		})
 	})
 
+	Context("NodeGroupSSM", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.AMIFamily = "AmazonLinux2"
+		ng.SSH.Allow = api.Disabled()
+		ng.SSH.EnableSSM = api.Enabled()
+
+		build(cfg, "eksctl-test-ssmenabled-cluster", ng)
+
+		roundtrip()
+
+		It("should attach the SSM managed policy", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeInstanceRole"))
+
+			role := ngTemplate.Resources["NodeInstanceRole"].Properties
+
+			Expect(role.ManagedPolicyArns).To(HaveLen(4))
+			Expect(role.ManagedPolicyArns[3]).To(Equal("arn:aws:iam::aws:policy/AmazonSSMManagedInstanceCore"))
+		})
+
+		It("should not allow SSH access", func() {
+			Expect(ngTemplate.Resources).ToNot(HaveKey("SSHIPv4"))
+			Expect(ngTemplate.Resources).ToNot(HaveKey("SSHIPv6"))
+		})
+
+		It("should install the SSM agent", func() {
+			userData := getLaunchTemplateData(ngTemplate).UserData
+			Expect(userData).ToNot(BeEmpty())
+
+			cloudConfig, err := cloudconfig.DecodeCloudConfig(userData)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cloudConfig.Commands).ToNot(BeEmpty())
+			c := cloudConfig.Commands[0].([]interface{})
+			Expect(c[2]).To(HavePrefix("(yum install -y amazon-ssm-agent && systemctl enable --now amazon-ssm-agent) || "))
+			Expect(c[2]).To(ContainSubstring("failed to install amazon-ssm-agent"))
+		})
+	})
+
 	Context("NodeGroupEBS", func() {
 		cfg, ng := newClusterConfigAndNodegroup(true)
 
@@ -1162,11 +1257,14 @@ var _ = Describe("CloudFormation template builder API", func() {
 				"ec2:DeleteSnapshot",
 				"ec2:DeleteTags",
 				"ec2:DeleteVolume",
+				"ec2:DescribeAvailabilityZones",
 				"ec2:DescribeInstances",
 				"ec2:DescribeSnapshots",
 				"ec2:DescribeTags",
 				"ec2:DescribeVolumes",
+				"ec2:DescribeVolumesModifications",
 				"ec2:DetachVolume",
+				"ec2:ModifyVolume",
 			}))
 
 			Expect(ngTemplate.Resources).ToNot(HaveKey("PolicyAutoScaling"))
@@ -1347,6 +1445,258 @@ var _ = Describe("CloudFormation template builder API", func() {
 		})
 	})
 
+	Context("Nodegroup{VolumeType=gp3 VolumeIOPS=4000 VolumeThroughput=250}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		*ng.VolumeType = api.NodeVolumeTypeGP3
+		ng.VolumeIOPS = aws.Int(4000)
+		ng.VolumeThroughput = aws.Int(250)
+		ng.VolumeEncrypted = api.Enabled()
+		*ng.VolumeKmsKeyID = "arn:aws:kms:us-west-2:123456789012:key/36c0b54e-64ed-4f2d-a1c7-96558764311e"
+
+		build(cfg, "eksctl-test-gp3-ng", ng)
+
+		roundtrip()
+
+		It("should have correct root volume", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.BlockDeviceMappings).To(HaveLen(1))
+
+			rootVolume := ltd.BlockDeviceMappings[0].(map[string]interface{})
+			Expect(rootVolume).To(HaveKeyWithValue("DeviceName", "/dev/xvda"))
+
+			ebs := rootVolume["Ebs"].(map[string]interface{})
+			Expect(ebs).To(HaveKeyWithValue("VolumeType", "gp3"))
+			Expect(ebs).To(HaveKeyWithValue("VolumeSize", 2.0))
+			Expect(ebs).To(HaveKeyWithValue("Iops", 4000.0))
+			Expect(ebs).To(HaveKeyWithValue("Throughput", 250.0))
+			Expect(ebs).To(HaveKeyWithValue("Encrypted", true))
+			Expect(ebs).To(HaveKeyWithValue("KmsKeyId", "arn:aws:kms:us-west-2:123456789012:key/36c0b54e-64ed-4f2d-a1c7-96558764311e"))
+		})
+
+		It("should keep the rest of the launch template", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.InstanceType).To(Equal("t2.medium"))
+			Expect(ltd.UserData).ToNot(BeEmpty())
+		})
+	})
+
+	Context("Nodegroup{InstanceMetadataOptions.HTTPTokens=required}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.InstanceMetadataOptions = &api.InstanceMetadataOptions{
+			HTTPTokens: api.HTTPTokensRequired,
+		}
+		api.SetNodeGroupDefaults(0, ng)
+
+		build(cfg, "eksctl-test-imdsv2-ng", ng)
+
+		roundtrip()
+
+		It("should require IMDSv2 with the default hop limit", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.MetadataOptions).ToNot(BeNil())
+			Expect(ltd.MetadataOptions.HttpEndpoint).To(Equal("enabled"))
+			Expect(ltd.MetadataOptions.HttpTokens).To(Equal("required"))
+			Expect(ltd.MetadataOptions.HttpPutResponseHopLimit).To(Equal(2))
+		})
+
+		It("should keep the rest of the launch template", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.InstanceType).To(Equal("t2.medium"))
+			Expect(ltd.UserData).ToNot(BeEmpty())
+			Expect(ltd.BlockDeviceMappings).To(HaveLen(1))
+		})
+	})
+
+	Context("Nodegroup{Placement.Strategy=spread Tenancy=dedicated}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.Placement = &api.Placement{Strategy: api.PlacementStrategySpread}
+		ng.Tenancy = api.TenancyDedicated
+		api.SetNodeGroupDefaults(0, ng)
+
+		build(cfg, "eksctl-test-placement-ng", ng)
+
+		roundtrip()
+
+		It("should create the placement group", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroupPlacementGroup"))
+			Expect(ngTemplate.Resources["NodeGroupPlacementGroup"].Properties.Strategy).To(Equal("spread"))
+		})
+
+		It("should place the nodes in the placement group with dedicated tenancy", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.Placement).ToNot(BeNil())
+			Expect(ltd.Placement.GroupName).To(Equal(map[string]interface{}{"Ref": "NodeGroupPlacementGroup"}))
+			Expect(ltd.Placement.Tenancy).To(Equal("dedicated"))
+		})
+	})
+
+	Context("Nodegroup{Placement.GroupName=existing}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.Placement = &api.Placement{GroupName: "hpc-group"}
+		api.SetNodeGroupDefaults(0, ng)
+
+		build(cfg, "eksctl-test-existing-placement-ng", ng)
+
+		roundtrip()
+
+		It("should use the existing placement group", func() {
+			Expect(ngTemplate.Resources).ToNot(HaveKey("NodeGroupPlacementGroup"))
+
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.Placement).ToNot(BeNil())
+			Expect(ltd.Placement.GroupName).To(Equal("hpc-group"))
+			Expect(ltd.Placement.Tenancy).To(BeEmpty())
+		})
+	})
+
+	Context("Nodegroup{WarmPool.MinSize=1}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.WarmPool = &api.WarmPool{MinSize: aws.Int(1), MaxGroupPreparedCapacity: aws.Int(5)}
+		api.SetNodeGroupDefaults(0, ng)
+
+		build(cfg, "eksctl-test-warm-pool-ng", ng)
+
+		roundtrip()
+
+		It("should create a warm pool for the auto scaling group", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroupWarmPool"))
+			warmPool := ngTemplate.Resources["NodeGroupWarmPool"].Properties
+			Expect(warmPool.AutoScalingGroupName).To(Equal(map[string]interface{}{"Ref": "NodeGroup"}))
+			Expect(warmPool.MinSize).To(Equal("1"))
+			Expect(warmPool.MaxGroupPreparedCapacity).To(Equal("5"))
+			Expect(warmPool.PoolState).To(Equal("Stopped"))
+		})
+	})
+
+	Context("NodeTerminationHandler{Enabled=true} with spot instances", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.NodeTerminationHandler = &api.NodeTerminationHandler{Enabled: api.Enabled()}
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes:                       []string{"m5.large", "m5a.large"},
+			OnDemandPercentageAboveBaseCapacity: aws.Int(0),
+		}
+		api.SetNodeGroupDefaults(0, ng)
+
+		build(cfg, "eksctl-test-spot-ng", ng)
+
+		roundtrip()
+
+		It("should add a termination lifecycle hook to the auto scaling group", func() {
+			Expect(ngTemplate.Resources).To(HaveKey("NodeGroupTerminationLifecycleHook"))
+			hook := ngTemplate.Resources["NodeGroupTerminationLifecycleHook"].Properties
+			Expect(hook.AutoScalingGroupName).To(Equal(map[string]interface{}{"Ref": "NodeGroup"}))
+			Expect(hook.LifecycleHookName).To(Equal("aws-node-termination-handler"))
+			Expect(hook.LifecycleTransition).To(Equal("autoscaling:EC2_INSTANCE_TERMINATING"))
+			Expect(hook.DefaultResult).To(Equal("CONTINUE"))
+			Expect(hook.HeartbeatTimeout).To(Equal(300))
+		})
+	})
+
+	Context("NodeTerminationHandler{Enabled=true} without spot instances", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.NodeTerminationHandler = &api.NodeTerminationHandler{Enabled: api.Enabled()}
+		api.SetNodeGroupDefaults(0, ng)
+
+		build(cfg, "eksctl-test-on-demand-ng", ng)
+
+		roundtrip()
+
+		It("should not add a lifecycle hook", func() {
+			Expect(ngTemplate.Resources).ToNot(HaveKey("NodeGroupTerminationLifecycleHook"))
+		})
+	})
+
+	Context("Nodegroup{Subnets=[Local Zone subnet] PrivateNetworking=true}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.Subnets = []string{"subnet-0a1b2c3d4e5f60718"}
+		ng.PrivateNetworking = true
+		api.SetNodeGroupDefaults(0, ng)
+
+		build(cfg, "eksctl-test-local-zone-ng", ng)
+
+		roundtrip()
+
+		It("should launch the nodes in the given subnets", func() {
+			x, ok := ngTemplate.Resources["NodeGroup"].Properties.VPCZoneIdentifier.([]interface{})
+			Expect(ok).To(BeTrue())
+			Expect(x).To(Equal([]interface{}{"subnet-0a1b2c3d4e5f60718"}))
+		})
+	})
+
+	Context("Nodegroup{ASGMetricsCollection EnableDetailedMonitoring=true}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.ASGMetricsCollection = []api.MetricsCollection{
+			{Metrics: []string{"GroupMinSize", "GroupInServiceInstances"}},
+		}
+		ng.EnableDetailedMonitoring = api.Enabled()
+		api.SetNodeGroupDefaults(0, ng)
+
+		build(cfg, "eksctl-test-metrics-ng", ng)
+
+		roundtrip()
+
+		It("should collect the metrics of the auto scaling group", func() {
+			ngProps := ngTemplate.Resources["NodeGroup"].Properties
+			Expect(ngProps.MetricsCollection).To(HaveLen(1))
+			Expect(ngProps.MetricsCollection[0].Granularity).To(Equal("1Minute"))
+			Expect(ngProps.MetricsCollection[0].Metrics).To(Equal([]string{"GroupMinSize", "GroupInServiceInstances"}))
+		})
+
+		It("should enable detailed monitoring of the nodes", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.Monitoring).ToNot(BeNil())
+			Expect(ltd.Monitoring.Enabled).To(BeTrue())
+		})
+	})
+
+	Context("Nodegroup{ASGMetricsCollection=nil}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		build(cfg, "eksctl-test-no-metrics-ng", ng)
+
+		roundtrip()
+
+		It("should not collect metrics or enable detailed monitoring", func() {
+			Expect(ngTemplate.Resources["NodeGroup"].Properties.MetricsCollection).To(BeEmpty())
+			Expect(getLaunchTemplateData(ngTemplate).Monitoring).To(BeNil())
+		})
+	})
+
+	Context("ClusterAutoscaler{Enabled=true}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.ClusterAutoscaler = &api.ClusterAutoscaler{Enabled: api.Enabled()}
+
+		build(cfg, "eksctl-test-cluster-autoscaler-ng", ng)
+
+		roundtrip()
+
+		It("should have auto-discovery tags without the node policy", func() {
+			Expect(ngTemplate.Resources).ToNot(HaveKey("PolicyAutoScaling"))
+
+			ngProps := getNodeGroupProperties(ngTemplate)
+			Expect(ngProps.Tags).To(ContainElement(Tag{
+				Key:               "k8s.io/cluster-autoscaler/enabled",
+				Value:             "true",
+				PropagateAtLaunch: "true",
+			}))
+			Expect(ngProps.Tags).To(ContainElement(Tag{
+				Key:               "k8s.io/cluster-autoscaler/" + clusterName,
+				Value:             "owned",
+				PropagateAtLaunch: "true",
+			}))
+		})
+	})
+
 	Context("Nodegroup{VolumeType=sc1 VolumeSize=2.0}", func() {
 		cfg, ng := newClusterConfigAndNodegroup(true)
 
@@ -2164,6 +2514,154 @@ var _ = Describe("CloudFormation template builder API", func() {
 
 	})
 
+	Context("with additional control plane security groups", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.Metadata.Name = "test-extra-sgs"
+		cfg.VPC.ControlPlaneSecurityGroupIDs = []string{"sg-extra-1", "sg-extra-2"}
+
+		build(cfg, "eksctl-test-extra-sgs-cluster", ng)
+
+		roundtrip()
+
+		It("should attach all security groups to the control plane", func() {
+			cp := clusterTemplate.Resources["ControlPlane"].Properties
+
+			Expect(cp.ResourcesVpcConfig.SecurityGroupIds).To(Equal([]interface{}{
+				cfg.VPC.SecurityGroup, "sg-extra-1", "sg-extra-2",
+			}))
+		})
+	})
+
+	Context("with user-defined tags", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.Metadata.Name = "test-tags"
+		cfg.Metadata.Tags = map[string]string{
+			"team":        "platform",
+			"cost-center": "1234",
+		}
+		ng.Tags = map[string]string{
+			"team": "data",
+		}
+
+		build(cfg, "eksctl-test-tags-cluster", ng)
+
+		roundtrip()
+
+		It("should tag the control plane with cluster tags", func() {
+			cp := clusterTemplate.Resources["ControlPlane"].Properties
+			Expect(cp.Tags).To(Equal([]Tag{
+				{Key: "cost-center", Value: "1234"},
+				{Key: "team", Value: "platform"},
+			}))
+		})
+
+		It("should propagate cluster and nodegroup tags to the instances", func() {
+			ngProps := getNodeGroupProperties(ngTemplate)
+			Expect(ngProps.Tags).To(ContainElement(Tag{Key: "cost-center", Value: "1234", PropagateAtLaunch: "true"}))
+			Expect(ngProps.Tags).To(ContainElement(Tag{Key: "team", Value: "data", PropagateAtLaunch: "true"}))
+			Expect(ngProps.Tags).ToNot(ContainElement(Tag{Key: "team", Value: "platform", PropagateAtLaunch: "true"}))
+		})
+
+		It("should tag the volumes with cluster and nodegroup tags", func() {
+			ltd := getLaunchTemplateData(ngTemplate)
+			Expect(ltd.TagSpecifications).To(HaveLen(1))
+			Expect(ltd.TagSpecifications[0].ResourceType).To(Equal("volume"))
+			Expect(ltd.TagSpecifications[0].Tags).To(Equal([]Tag{
+				{Key: "cost-center", Value: "1234"},
+				{Key: "team", Value: "data"},
+			}))
+		})
+	})
+
+	Context("with labels and taints", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.Metadata.Name = "test-taints"
+		ng.Labels = map[string]string{
+			"role": "gpu",
+		}
+		ng.Taints = map[string]string{
+			"nvidia.com/gpu": "true:NoSchedule",
+		}
+
+		build(cfg, "eksctl-test-taints-cluster", ng)
+
+		roundtrip()
+
+		It("should add cluster-autoscaler node template tags to the ASG", func() {
+			ngProps := getNodeGroupProperties(ngTemplate)
+			Expect(ngProps.Tags).To(ContainElement(Tag{Key: "k8s.io/cluster-autoscaler/node-template/label/role", Value: "gpu", PropagateAtLaunch: "false"}))
+			Expect(ngProps.Tags).To(ContainElement(Tag{Key: "k8s.io/cluster-autoscaler/node-template/taint/nvidia.com/gpu", Value: "true:NoSchedule", PropagateAtLaunch: "false"}))
+		})
+
+		It("should register the nodes with the taints", func() {
+			userData := getLaunchTemplateData(ngTemplate).UserData
+			Expect(userData).ToNot(BeEmpty())
+
+			cloudConfig, err := cloudconfig.DecodeCloudConfig(userData)
+			Expect(err).ToNot(HaveOccurred())
+
+			kubeletEnv := getFile(cloudConfig, "/etc/eksctl/kubelet.env")
+			Expect(kubeletEnv).ToNot(BeNil())
+			Expect(kubeletEnv.Content).To(ContainSubstring("NODE_TAINTS=nvidia.com/gpu=true:NoSchedule"))
+		})
+	})
+
+	Context("with secrets encryption", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		keyARN := "arn:aws:kms:us-west-2:000000000000:key/12345678-1234-1234-1234-123456789012"
+
+		cfg.Metadata.Name = "test-encrypted"
+		cfg.SecretsEncryption = &api.SecretsEncryption{
+			KeyARN: &keyARN,
+		}
+
+		build(cfg, "eksctl-test-encrypted-cluster", ng)
+
+		roundtrip()
+
+		It("should set the KMS key in EncryptionConfig", func() {
+			Expect(clusterTemplate.Resources).To(HaveKey("ControlPlane"))
+
+			cp := clusterTemplate.Resources["ControlPlane"].Properties
+
+			Expect(cp.EncryptionConfig).To(HaveLen(1))
+			Expect(cp.EncryptionConfig[0].Resources).To(Equal([]string{"secrets"}))
+			Expect(cp.EncryptionConfig[0].Provider.KeyArn).To(Equal(keyARN))
+		})
+	})
+
+	Context("with a custom service CIDR", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.Metadata.Name = "test-service-cidr"
+		cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+			ServiceIPv4CIDR: ipnet.MustParseCIDR("172.30.0.0/16"),
+		}
+
+		build(cfg, "eksctl-test-service-cidr-cluster", ng)
+
+		roundtrip()
+
+		extractCloudConfig()
+
+		It("should set the service CIDR of the cluster", func() {
+			cp := clusterTemplate.Resources["ControlPlane"].Properties
+
+			Expect(cp.KubernetesNetworkConfig).ToNot(BeNil())
+			Expect(cp.KubernetesNetworkConfig.ServiceIpv4Cidr).To(Equal("172.30.0.0/16"))
+		})
+
+		It("should use the cluster DNS address of the service CIDR", func() {
+			kubeletConfig := getFile(cc, "/etc/eksctl/kubelet.yaml")
+			Expect(kubeletConfig).ToNot(BeNil())
+			Expect(kubeletConfig.Content).To(ContainSubstring("clusterDNS:\n- 172.30.0.10"))
+		})
+	})
+
 	Context("without VPC", func() {
 		cfg, ng := newClusterConfigAndNodegroup(true)
 
@@ -2549,6 +3047,146 @@ var _ = Describe("CloudFormation template builder API", func() {
 
 	})
 
+	Context("fully-private cluster", func() {
+
+		zones := []string{"A", "B", "C"}
+		region := "USWEST2"
+
+		cfg, ng := newClusterConfigAndNodegroup(false)
+
+		cfg.Metadata.Name = "test-private-cluster"
+
+		disable := api.ClusterDisableNAT
+		cfg.VPC.NAT = &api.ClusterNAT{
+			Gateway: &disable,
+		}
+		cfg.PrivateCluster = &api.PrivateCluster{
+			Enabled:                    true,
+			AdditionalEndpointServices: []string{"autoscaling"},
+		}
+
+		It("should only create private subnets", func() {
+			Expect(vpc.SetSubnets(cfg)).To(Succeed())
+			Expect(cfg.VPC.Subnets.Private).To(HaveLen(3))
+			Expect(cfg.VPC.Subnets.Public).To(BeEmpty())
+		})
+
+		build(cfg, "eksctl-test-private-cluster", ng)
+
+		roundtrip()
+
+		It("should not have internet or NAT gateways", func() {
+			Expect(clusterTemplate.Resources).To(HaveKey("VPC"))
+
+			Expect(clusterTemplate.Resources).ToNot(HaveKey("InternetGateway"))
+			Expect(clusterTemplate.Resources).ToNot(HaveKey("VPCGatewayAttachment"))
+			Expect(clusterTemplate.Resources).ToNot(HaveKey("PublicRouteTable"))
+			Expect(clusterTemplate.Resources).ToNot(HaveKey("NATGateway"))
+
+			for _, zone := range zones {
+				Expect(clusterTemplate.Resources).To(HaveKey("SubnetPrivate" + region + zone))
+				Expect(clusterTemplate.Resources).To(HaveKey("PrivateRouteTable" + region + zone))
+				Expect(clusterTemplate.Resources).ToNot(HaveKey("SubnetPublic" + region + zone))
+				Expect(clusterTemplate.Resources).ToNot(HaveKey("NATPrivateSubnetRoute" + region + zone))
+			}
+
+			Expect(len(clusterTemplate.Resources)).To(Equal(25))
+		})
+
+		It("should have interface endpoints in private subnets", func() {
+			sg := clusterTemplate.Resources["VPCEndpointSecurityGroup"].Properties
+			isRefTo(sg.VpcId, "VPC")
+			Expect(sg.SecurityGroupIngress).To(HaveLen(1))
+			Expect(sg.SecurityGroupIngress[0].CidrIp).To(Equal("192.168.0.0/16"))
+			Expect(sg.SecurityGroupIngress[0].FromPort).To(Equal(443))
+			Expect(sg.SecurityGroupIngress[0].ToPort).To(Equal(443))
+
+			for name, service := range map[string]string{
+				"VPCEndpointEc2":         "ec2",
+				"VPCEndpointEcrApi":      "ecr.api",
+				"VPCEndpointEcrDkr":      "ecr.dkr",
+				"VPCEndpointSts":         "sts",
+				"VPCEndpointLogs":        "logs",
+				"VPCEndpointAutoscaling": "autoscaling",
+			} {
+				Expect(clusterTemplate.Resources).To(HaveKey(name))
+				endpoint := clusterTemplate.Resources[name].Properties
+				Expect(endpoint.ServiceName).To(Equal(map[string]interface{}{
+					"Fn::Sub": "com.amazonaws.${AWS::Region}." + service,
+				}))
+				Expect(endpoint.VpcEndpointType).To(Equal("Interface"))
+				Expect(endpoint.PrivateDnsEnabled).To(BeTrue())
+				Expect(endpoint.SubnetIds).To(HaveLen(3))
+				Expect(endpoint.SecurityGroupIds).To(HaveLen(1))
+				isRefTo(endpoint.SecurityGroupIds[0], "VPCEndpointSecurityGroup")
+			}
+		})
+
+		It("should have a gateway endpoint for S3 attached to private route tables", func() {
+			Expect(clusterTemplate.Resources).To(HaveKey("VPCEndpointS3"))
+			endpoint := clusterTemplate.Resources["VPCEndpointS3"].Properties
+			Expect(endpoint.VpcEndpointType).To(Equal("Gateway"))
+			Expect(endpoint.RouteTableIds).To(HaveLen(3))
+			// route tables follow the order of availability zones
+			for i, zone := range []string{"B", "A", "C"} {
+				isRefTo(endpoint.RouteTableIds[i], "PrivateRouteTable"+region+zone)
+			}
+		})
+	})
+
+	Context("VPC with custom networking", func() {
+
+		zones := []string{"A", "B", "C"}
+		region := "USWEST2"
+
+		cfg, ng := newClusterConfigAndNodegroup(false)
+
+		cfg.Metadata.Name = "test-custom-networking"
+		cfg.VPC.CustomNetworking = &api.ClusterCustomNetworking{
+			SecondaryCIDR: ipnet.MustParseCIDR("100.64.0.0/16"),
+		}
+
+		It("should derive pod subnets from the secondary CIDR", func() {
+			Expect(vpc.SetSubnets(cfg)).To(Succeed())
+			Expect(cfg.VPC.CustomNetworking.PodSubnets).To(HaveLen(3))
+			Expect(cfg.VPC.CustomNetworking.PodSubnets["us-west-2b"].CIDR.String()).To(Equal("100.64.0.0/19"))
+		})
+
+		build(cfg, "eksctl-test-custom-networking", ng)
+
+		roundtrip()
+
+		It("should associate the secondary CIDR and create pod subnets", func() {
+			Expect(clusterTemplate.Resources).To(HaveKey("SecondaryCIDR"))
+			secondaryCIDR := clusterTemplate.Resources["SecondaryCIDR"].Properties
+			isRefTo(secondaryCIDR.VpcId, "VPC")
+			Expect(secondaryCIDR.CidrBlock).To(Equal("100.64.0.0/16"))
+
+			for _, zone := range zones {
+				Expect(clusterTemplate.Resources).To(HaveKey("SubnetPod" + region + zone))
+				subnet := clusterTemplate.Resources["SubnetPod"+region+zone]
+				Expect(subnet.DependsOn).To(Equal([]string{"SecondaryCIDR"}))
+				isRefTo(subnet.Properties.VpcId, "VPC")
+
+				Expect(clusterTemplate.Resources).To(HaveKey("RouteTableAssociationPod" + region + zone))
+				association := clusterTemplate.Resources["RouteTableAssociationPod"+region+zone].Properties
+				isRefTo(association.SubnetId, "SubnetPod"+region+zone)
+				isRefTo(association.RouteTableId, "PrivateRouteTable"+region+zone)
+			}
+		})
+
+		It("should allow the control plane to reach pods", func() {
+			for _, name := range []string{
+				"IngressPodsFromControlPlane",
+				"EgressPodsFromControlPlane",
+				"IngressPodsFromControlPlaneHTTPS",
+				"EgressPodsFromControlPlaneHTTPS",
+			} {
+				Expect(clusterTemplate.Resources).To(HaveKey(name))
+			}
+		})
+	})
+
 	Context("Nodegroup with Mixed instances", func() {
 		cfg, ng := newClusterConfigAndNodegroup(true)
 
@@ -2595,7 +3233,30 @@ var _ = Describe("CloudFormation template builder API", func() {
 			Expect(nodeGroupProperties.MixedInstancesPolicy.InstancesDistribution.OnDemandPercentageAboveBaseCapacity).To(Equal("20"))
 			Expect(nodeGroupProperties.MixedInstancesPolicy.InstancesDistribution.SpotInstancePools).To(Equal("3"))
 			Expect(nodeGroupProperties.MixedInstancesPolicy.InstancesDistribution.SpotMaxPrice).To(Equal("0.045000"))
+			Expect(nodeGroupProperties.MixedInstancesPolicy.InstancesDistribution.SpotAllocationStrategy).To(BeEmpty())
+
+		})
+	})
+
+	Context("Nodegroup with Mixed instances and capacity-optimized spot allocation strategy", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
 
+		spotAllocationStrategy := api.SpotAllocationStrategyCapacityOptimized
+		ng.InstanceType = "mixed"
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes:          []string{"m5.large", "m5a.large", "m4.large"},
+			SpotAllocationStrategy: &spotAllocationStrategy,
+		}
+
+		build(cfg, "eksctl-test-spot-cluster", ng)
+
+		roundtrip()
+
+		It("should have mixed instances with correct spot allocation strategy", func() {
+			nodeGroupProperties := getNodeGroupProperties(ngTemplate)
+			Expect(nodeGroupProperties.MixedInstancesPolicy).To(Not(BeNil()))
+			Expect(nodeGroupProperties.MixedInstancesPolicy.InstancesDistribution.SpotAllocationStrategy).To(Equal("capacity-optimized"))
+			Expect(nodeGroupProperties.MixedInstancesPolicy.InstancesDistribution.SpotInstancePools).To(BeEmpty())
 		})
 	})
 })
@@ -19,6 +19,7 @@ type ClusterResourceSet struct {
 	provider       api.ClusterProvider
 	vpc            *gfn.Value
 	subnets        map[api.SubnetTopology][]*gfn.Value
+	podSubnets     []*gfn.Value
 	securityGroups []*gfn.Value
 }
 
@@ -48,17 +49,16 @@ func (c *ClusterResourceSet) AddAllResources() error {
 	}
 	c.addOutputsForVPC()
 
+	if c.spec.IsFullyPrivate() {
+		if err := c.addResourcesForVPCEndpoints(dedicatedVPC); err != nil {
+			return errors.Wrap(err, "error adding VPC endpoint resources")
+		}
+	}
+
 	c.addResourcesForSecurityGroups()
 	c.addResourcesForIAM()
 	c.addResourcesForControlPlane()
-
-	c.rs.defineOutput(outputs.ClusterStackName, gfn.RefStackName, false, func(v string) error {
-		if c.spec.Status == nil {
-			c.spec.Status = &api.ClusterStatus{}
-		}
-		c.spec.Status.StackName = v
-		return nil
-	})
+	c.addOutputForStackName()
 
 	c.rs.template.Description = fmt.Sprintf(
 		"%s (dedicated VPC: %v, dedicated IAM: %v) %s",
@@ -69,6 +69,53 @@ func (c *ClusterResourceSet) AddAllResources() error {
 	return nil
 }
 
+// AddAllResourcesForRegisteredCluster adds the resources that eksctl needs to manage
+// a cluster that was not created by eksctl; the control plane, its VPC and service
+// role are not managed by the stack, they are only exported for other stacks to use
+func (c *ClusterResourceSet) AddAllResourcesForRegisteredCluster() error {
+	if c.spec.Status == nil || c.spec.Status.ARN == "" || !api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRoleARN) {
+		return fmt.Errorf("control plane of cluster %q must be loaded before it can be registered", c.spec.Metadata.Name)
+	}
+	if c.spec.VPC.SecurityGroup == "" {
+		return fmt.Errorf("security group of the control plane of cluster %q is unknown", c.spec.Metadata.Name)
+	}
+	// the shared node security group is the only resource that is always
+	// created, and a stack needs to have at least one resource
+	if c.spec.VPC.SharedNodeSecurityGroup != "" {
+		return fmt.Errorf("vpc.sharedNodeSecurityGroup cannot be set when registering a cluster")
+	}
+
+	c.importResourcesForVPC()
+	c.addOutputsForVPC()
+	c.addResourcesForSecurityGroups()
+
+	c.rs.withIAM = false
+	c.rs.defineOutputWithoutCollector(outputs.ClusterServiceRoleARN, c.spec.IAM.ServiceRoleARN, true)
+
+	c.rs.defineOutputWithoutCollector(outputs.ClusterCertificateAuthorityData, base64.StdEncoding.EncodeToString(c.spec.Status.CertificateAuthorityData), false)
+	c.rs.defineOutputWithoutCollector(outputs.ClusterEndpoint, c.spec.Status.Endpoint, true)
+	c.rs.defineOutputWithoutCollector(outputs.ClusterARN, c.spec.Status.ARN, true)
+	c.rs.defineOutputWithoutCollector(outputs.ClusterFeatureRegistered, "true", false)
+	c.addOutputForStackName()
+
+	c.rs.template.Description = fmt.Sprintf(
+		"%s (registered) %s",
+		clusterTemplateDescription,
+		templateDescriptionSuffix)
+
+	return nil
+}
+
+func (c *ClusterResourceSet) addOutputForStackName() {
+	c.rs.defineOutput(outputs.ClusterStackName, gfn.RefStackName, false, func(v string) error {
+		if c.spec.Status == nil {
+			c.spec.Status = &api.ClusterStatus{}
+		}
+		c.spec.Status.StackName = v
+		return nil
+	})
+}
+
 // RenderJSON returns the rendered JSON
 func (c *ClusterResourceSet) RenderJSON() ([]byte, error) {
 	return c.rs.renderJSON()
@@ -96,11 +143,38 @@ func (c *ClusterResourceSet) addResourcesForControlPlane() {
 		serviceRoleARN = gfn.NewString(*c.spec.IAM.ServiceRoleARN)
 	}
 
-	c.newResource("ControlPlane", &gfn.AWSEKSCluster{
-		Name:               gfn.NewString(c.spec.Metadata.Name),
-		RoleArn:            serviceRoleARN,
-		Version:            gfn.NewString(c.spec.Metadata.Version),
-		ResourcesVpcConfig: clusterVPC,
+	controlPlaneProps := map[string]interface{}{
+		"Name":               gfn.NewString(c.spec.Metadata.Name),
+		"RoleArn":            serviceRoleARN,
+		"Version":            gfn.NewString(c.spec.Metadata.Version),
+		"ResourcesVpcConfig": clusterVPC,
+	}
+	if c.spec.HasServiceIPv4CIDR() {
+		controlPlaneProps["KubernetesNetworkConfig"] = map[string]interface{}{
+			"ServiceIpv4Cidr": c.spec.KubernetesNetworkConfig.ServiceIPv4CIDR.String(),
+		}
+	}
+	if c.spec.HasSecretsEncryption() {
+		controlPlaneProps["EncryptionConfig"] = []map[string]interface{}{
+			{
+				"Resources": []string{"secrets"},
+				"Provider": map[string]string{
+					"KeyArn": *c.spec.SecretsEncryption.KeyARN,
+				},
+			},
+		}
+	}
+
+	// stack tags are not propagated to the cluster
+	if len(c.spec.Metadata.Tags) > 0 {
+		controlPlaneProps["Tags"] = makeKeyValuePairs(c.spec.Metadata.Tags)
+	}
+
+	// goformation doesn't support EncryptionConfig and KubernetesNetworkConfig yet, so the cluster
+	// is defined as a generic resource
+	c.newResource("ControlPlane", &awsCloudFormationResource{
+		Type:       "AWS::EKS::Cluster",
+		Properties: controlPlaneProps,
 	})
 
 	if c.spec.Status == nil {
@@ -0,0 +1,92 @@
+package builder_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/template/matchers"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("template builder for registered clusters", func() {
+	const clusterARN = "arn:aws:eks:us-west-2:123456789012:cluster/registered-cluster"
+
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "registered-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		cfg.VPC.ID = "vpc-1"
+		cfg.VPC.SecurityGroup = "sg-control-plane"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Private: map[string]api.Network{
+				"us-west-2a": {ID: "subnet-private-a"},
+				"us-west-2b": {ID: "subnet-private-b"},
+			},
+			Public: map[string]api.Network{
+				"us-west-2a": {ID: "subnet-public-a"},
+			},
+		}
+		disable := api.ClusterDisableNAT
+		cfg.VPC.NAT = &api.ClusterNAT{Gateway: &disable}
+
+		cfg.IAM.ServiceRoleARN = aws.String("arn:aws:iam::123456789012:role/eks-service-role")
+		cfg.Status = &api.ClusterStatus{
+			Endpoint:                 "https://registered-cluster.eks.amazonaws.com",
+			CertificateAuthorityData: []byte("ca"),
+			ARN:                      clusterARN,
+		}
+	})
+
+	render := func() *cft.Template {
+		rs := NewClusterResourceSet(mockprovider.NewMockProvider(), cfg)
+		Expect(rs.AddAllResourcesForRegisteredCluster()).To(Succeed())
+
+		templateBody, err := rs.RenderJSON()
+		Expect(err).ToNot(HaveOccurred())
+
+		t := cft.NewTemplate()
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+		return t
+	}
+
+	It("only creates the shared node security group and exports the existing resources", func() {
+		t := render()
+
+		Expect(t.Description).To(Equal("EKS cluster (registered) [created and managed by eksctl]"))
+
+		Expect(t.Resources).To(HaveLen(2))
+		Expect(t).To(HaveResource("ClusterSharedNodeSecurityGroup", "AWS::EC2::SecurityGroup"))
+		Expect(t).To(HaveResource("IngressInterNodeGroupSG", "AWS::EC2::SecurityGroupIngress"))
+		Expect(t).ToNot(HaveResource("ControlPlane", "AWS::EKS::Cluster"))
+		Expect(t).ToNot(HaveResource("ServiceRole", "AWS::IAM::Role"))
+
+		Expect(t).To(HaveOutputWithValue("VPC", `"vpc-1"`))
+		Expect(t).To(HaveOutputExportedAs("VPC", `{ "Fn::Sub": "${AWS::StackName}::VPC" }`))
+		Expect(t).To(HaveOutputWithValue("SecurityGroup", `"sg-control-plane"`))
+		Expect(t).To(HaveOutputWithValue("SharedNodeSecurityGroup", `{ "Ref": "ClusterSharedNodeSecurityGroup" }`))
+		Expect(t).To(HaveOutputs("SubnetsPrivate", "SubnetsPublic", "ServiceRoleARN", "CertificateAuthorityData", "Endpoint", "ClusterStackName"))
+		Expect(t).To(HaveOutputWithValue("ARN", `"`+clusterARN+`"`))
+		Expect(t).To(HaveOutputWithValue("FeatureRegistered", `"true"`))
+	})
+
+	It("rejects an existing shared node security group", func() {
+		cfg.VPC.SharedNodeSecurityGroup = "sg-shared"
+		rs := NewClusterResourceSet(mockprovider.NewMockProvider(), cfg)
+		Expect(rs.AddAllResourcesForRegisteredCluster()).To(MatchError("vpc.sharedNodeSecurityGroup cannot be set when registering a cluster"))
+	})
+
+	It("requires the control plane to be loaded", func() {
+		cfg.Status = nil
+		rs := NewClusterResourceSet(mockprovider.NewMockProvider(), cfg)
+		Expect(rs.AddAllResourcesForRegisteredCluster()).ToNot(Succeed())
+	})
+})
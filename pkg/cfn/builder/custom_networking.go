@@ -0,0 +1,83 @@
+package builder
+
+import (
+	"strings"
+
+	gfn "github.com/awslabs/goformation/cloudformation"
+)
+
+// addResourcesForPodSubnets associates the secondary CIDR with the VPC and creates a
+// pod subnet in each availability zone, which are routed the same way as private subnets
+func (c *ClusterResourceSet) addResourcesForPodSubnets() {
+	cn := c.spec.VPC.CustomNetworking
+
+	c.newResource("SecondaryCIDR", &gfn.AWSEC2VPCCidrBlock{
+		VpcId:     c.vpc,
+		CidrBlock: gfn.NewString(cn.SecondaryCIDR.String()),
+	})
+
+	for _, az := range c.spec.AvailabilityZones {
+		subnet, ok := cn.PodSubnets[az]
+		if !ok {
+			continue
+		}
+		alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
+
+		name := "SubnetPod" + alphanumericUpperAZ
+		refSubnet := c.newResource(name, &awsCloudFormationResource{
+			Type: "AWS::EC2::Subnet",
+			Properties: map[string]interface{}{
+				"AvailabilityZone": az,
+				"CidrBlock":        subnet.CIDR.String(),
+				"VpcId":            c.vpc,
+				"Tags":             []gfn.Tag{makeAutoNameTag(name)},
+			},
+			// a subnet can only be created within the secondary CIDR once it's associated
+			DependsOn: []string{"SecondaryCIDR"},
+		})
+		c.newResource("RouteTableAssociationPod"+alphanumericUpperAZ, &gfn.AWSEC2SubnetRouteTableAssociation{
+			SubnetId:     refSubnet,
+			RouteTableId: gfn.MakeRef("PrivateRouteTable" + alphanumericUpperAZ),
+		})
+		c.podSubnets = append(c.podSubnets, refSubnet)
+	}
+}
+
+// addResourcesForPodSecurityGroupRules allows the control plane to reach pods that use the
+// shared node security group, as they don't use the nodegroup security groups with custom networking
+func (c *ClusterResourceSet) addResourcesForPodSecurityGroupRules(refControlPlaneSG, refClusterSharedNodeSG *gfn.Value) {
+	desc := "pods using custom networking"
+
+	c.newResource("IngressPodsFromControlPlane", &gfn.AWSEC2SecurityGroupIngress{
+		GroupId:               refClusterSharedNodeSG,
+		SourceSecurityGroupId: refControlPlaneSG,
+		Description:           gfn.NewString("Allow " + desc + " to receive traffic from the control plane (workload TCP ports)"),
+		IpProtocol:            sgProtoTCP,
+		FromPort:              sgMinNodePort,
+		ToPort:                sgMaxNodePort,
+	})
+	c.newResource("EgressPodsFromControlPlane", &gfn.AWSEC2SecurityGroupEgress{
+		GroupId:                    refControlPlaneSG,
+		DestinationSecurityGroupId: refClusterSharedNodeSG,
+		Description:                gfn.NewString("Allow control plane to communicate with " + desc + " (workload TCP ports)"),
+		IpProtocol:                 sgProtoTCP,
+		FromPort:                   sgMinNodePort,
+		ToPort:                     sgMaxNodePort,
+	})
+	c.newResource("IngressPodsFromControlPlaneHTTPS", &gfn.AWSEC2SecurityGroupIngress{
+		GroupId:               refClusterSharedNodeSG,
+		SourceSecurityGroupId: refControlPlaneSG,
+		Description:           gfn.NewString("Allow " + desc + " to receive traffic from the control plane (workloads using HTTPS port, commonly used with extension API servers)"),
+		IpProtocol:            sgProtoTCP,
+		FromPort:              sgPortHTTPS,
+		ToPort:                sgPortHTTPS,
+	})
+	c.newResource("EgressPodsFromControlPlaneHTTPS", &gfn.AWSEC2SecurityGroupEgress{
+		GroupId:                    refControlPlaneSG,
+		DestinationSecurityGroupId: refClusterSharedNodeSG,
+		Description:                gfn.NewString("Allow control plane to communicate with " + desc + " (workloads using HTTPS port, commonly used with extension API servers)"),
+		IpProtocol:                 sgProtoTCP,
+		FromPort:                   sgPortHTTPS,
+		ToPort:                     sgPortHTTPS,
+	})
+}
@@ -0,0 +1,155 @@
+package builder
+
+import (
+	"fmt"
+	"sort"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	gfn "github.com/awslabs/goformation/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const fargateProfileTemplateDescription = "EKS Fargate Profile"
+
+// FargateProfileResourceSet stores the resource information of a Fargate profile
+type FargateProfileResourceSet struct {
+	rs               *resourceSet
+	clusterSpec      *api.ClusterConfig
+	spec             *api.FargateProfile
+	clusterStackName string
+	podExecutionRole *gfn.Value
+}
+
+// NewFargateProfileResourceSet returns a resource set for a Fargate profile embedded in a cluster config
+func NewFargateProfileResourceSet(spec *api.ClusterConfig, clusterStackName string, fp *api.FargateProfile) *FargateProfileResourceSet {
+	return &FargateProfileResourceSet{
+		rs:               newResourceSet(),
+		clusterSpec:      spec,
+		spec:             fp,
+		clusterStackName: clusterStackName,
+	}
+}
+
+// AddAllResources adds all the information about the Fargate profile to the resource set
+func (f *FargateProfileResourceSet) AddAllResources() error {
+	f.rs.template.Description = fmt.Sprintf(
+		"%s (selectors: %d) %s",
+		fargateProfileTemplateDescription,
+		len(f.spec.Selectors),
+		templateDescriptionSuffix)
+
+	f.addResourcesForIAM()
+
+	return f.addResourcesForFargateProfile()
+}
+
+// WithIAM states, if IAM roles will be created or not
+func (f *FargateProfileResourceSet) WithIAM() bool {
+	return f.rs.withIAM
+}
+
+// WithNamedIAM states, if specifically named IAM roles will be created or not
+func (f *FargateProfileResourceSet) WithNamedIAM() bool {
+	return f.rs.withNamedIAM
+}
+
+// RenderJSON returns the rendered JSON
+func (f *FargateProfileResourceSet) RenderJSON() ([]byte, error) {
+	return f.rs.renderJSON()
+}
+
+// GetAllOutputs collects all outputs of the Fargate profile
+func (f *FargateProfileResourceSet) GetAllOutputs(stack cfn.Stack) error {
+	return f.rs.GetAllOutputs(stack)
+}
+
+func (f *FargateProfileResourceSet) addResourcesForIAM() {
+	f.rs.withNamedIAM = false
+
+	if f.spec.PodExecutionRoleARN != "" {
+		f.rs.withIAM = false
+
+		f.podExecutionRole = gfn.NewString(f.spec.PodExecutionRoleARN)
+		f.rs.defineOutputWithoutCollector(outputs.FargatePodExecutionRoleARN, f.spec.PodExecutionRoleARN, false)
+		return
+	}
+
+	f.rs.withIAM = true
+
+	f.rs.newResource("FargatePodExecutionRole", &gfn.AWSIAMRole{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices("eks-fargate-pods.amazonaws.com"),
+		ManagedPolicyArns: makeStringSlice(
+			iamPolicyAmazonEKSFargatePodExecutionRolePolicyARN,
+		),
+	})
+	f.podExecutionRole = gfn.MakeFnGetAttString("FargatePodExecutionRole.Arn")
+
+	f.rs.defineOutputFromAtt(outputs.FargatePodExecutionRoleARN, "FargatePodExecutionRole.Arn", false, func(v string) error {
+		f.spec.PodExecutionRoleARN = v
+		return nil
+	})
+}
+
+func (f *FargateProfileResourceSet) addResourcesForFargateProfile() error {
+	var subnets interface{}
+	if len(f.spec.Subnets) > 0 {
+		subnets = f.spec.Subnets
+	} else {
+		// Fargate only supports private subnets
+		var err error
+		subnets, err = makeNodeGroupSubnets(f.clusterSpec, f.clusterStackName, nil, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	selectors := []map[string]interface{}{}
+	for _, selector := range f.spec.Selectors {
+		s := map[string]interface{}{
+			"Namespace": selector.Namespace,
+		}
+		if len(selector.Labels) > 0 {
+			s["Labels"] = makeKeyValuePairs(selector.Labels)
+		}
+		selectors = append(selectors, s)
+	}
+
+	fpProps := map[string]interface{}{
+		"ClusterName":         f.clusterSpec.Metadata.Name,
+		"FargateProfileName":  f.spec.Name,
+		"PodExecutionRoleArn": f.podExecutionRole,
+		"Subnets":             subnets,
+		"Selectors":           selectors,
+	}
+	if len(f.spec.Tags) > 0 {
+		fpProps["Tags"] = makeKeyValuePairs(f.spec.Tags)
+	}
+
+	f.rs.newResource("FargateProfile", &awsCloudFormationResource{
+		Type:       "AWS::EKS::FargateProfile",
+		Properties: fpProps,
+	})
+
+	return nil
+}
+
+func makeKeyValuePairs(kv map[string]string) []map[string]string {
+	keys := []string{}
+	for k := range kv {
+		keys = append(keys, k)
+	}
+	// sort the keys, so that the template is stable
+	sort.Strings(keys)
+
+	pairs := []map[string]string{}
+	for _, k := range keys {
+		pairs = append(pairs, map[string]string{
+			"Key":   k,
+			"Value": kv[k],
+		})
+	}
+	return pairs
+}
@@ -0,0 +1,98 @@
+package builder_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/template/matchers"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("template builder for Fargate profiles", func() {
+	var (
+		cfg *api.ClusterConfig
+		fp  *api.FargateProfile
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		fp = &api.FargateProfile{
+			Name: "fp-1",
+			Selectors: []api.FargateProfileSelector{
+				{Namespace: "default"},
+				{Namespace: "dev", Labels: map[string]string{"env": "dev", "app": "web"}},
+			},
+		}
+		cfg.FargateProfiles = append(cfg.FargateProfiles, fp)
+	})
+
+	render := func() *cft.Template {
+		rs := NewFargateProfileResourceSet(cfg, "eksctl-test-cluster-cluster", fp)
+		Expect(rs.AddAllResources()).To(Succeed())
+
+		templateBody := []byte{}
+		Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+		t := cft.NewTemplate()
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+		return t
+	}
+
+	It("can construct a Fargate profile with a pod execution role", func() {
+		t := render()
+
+		Expect(t.Description).To(Equal("EKS Fargate Profile (selectors: 2) [created and managed by eksctl]"))
+
+		Expect(t).To(HaveResource("FargateProfile", "AWS::EKS::FargateProfile"))
+		Expect(t).To(HaveResource("FargatePodExecutionRole", "AWS::IAM::Role"))
+
+		Expect(t).To(HaveResourceWithPropertyValue("FargatePodExecutionRole", "AssumeRolePolicyDocument", `{
+			"Statement": [{
+				"Action": ["sts:AssumeRole"],
+				"Effect": "Allow",
+				"Principal": { "Service": ["eks-fargate-pods.amazonaws.com"] }
+			}],
+			"Version": "2012-10-17"
+		}`))
+		Expect(t).To(HaveResourceWithPropertyValue("FargatePodExecutionRole", "ManagedPolicyArns", `[
+			"arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy"
+		]`))
+
+		Expect(t).To(HaveResourceWithPropertyValue("FargateProfile", "ClusterName", `"test-cluster"`))
+		Expect(t).To(HaveResourceWithPropertyValue("FargateProfile", "FargateProfileName", `"fp-1"`))
+		Expect(t).To(HaveResourceWithPropertyValue("FargateProfile", "PodExecutionRoleArn", `{ "Fn::GetAtt": "FargatePodExecutionRole.Arn" }`))
+		Expect(t).To(HaveResourceWithPropertyValue("FargateProfile", "Subnets", `{
+			"Fn::Split": [ ",", { "Fn::ImportValue": "eksctl-test-cluster-cluster::SubnetsPrivate" } ]
+		}`))
+		Expect(t).To(HaveResourceWithPropertyValue("FargateProfile", "Selectors", `[
+			{ "Namespace": "default" },
+			{
+				"Namespace": "dev",
+				"Labels": [
+					{ "Key": "app", "Value": "web" },
+					{ "Key": "env", "Value": "dev" }
+				]
+			}
+		]`))
+
+		Expect(t).To(HaveOutputWithValue("PodExecutionRoleARN", `{ "Fn::GetAtt": "FargatePodExecutionRole.Arn" }`))
+	})
+
+	It("can use an existing pod execution role and subnets", func() {
+		fp.PodExecutionRoleARN = "arn:aws:iam::123456789012:role/pod-execution-role"
+		fp.Subnets = []string{"subnet-1", "subnet-2"}
+
+		t := render()
+
+		Expect(t.Resources).To(HaveLen(1))
+		Expect(t).To(HaveResourceWithPropertyValue("FargateProfile", "PodExecutionRoleArn", `"arn:aws:iam::123456789012:role/pod-execution-role"`))
+		Expect(t).To(HaveResourceWithPropertyValue("FargateProfile", "Subnets", `["subnet-1", "subnet-2"]`))
+	})
+})
@@ -17,11 +17,14 @@ const (
 	iamPolicyAmazonEKSServicePolicyARN = "arn:aws:iam::aws:policy/AmazonEKSServicePolicy"
 	iamPolicyAmazonEKSClusterPolicyARN = "arn:aws:iam::aws:policy/AmazonEKSClusterPolicy"
 
+	iamPolicyAmazonEKSFargatePodExecutionRolePolicyARN = "arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy"
+
 	iamPolicyAmazonEKSWorkerNodePolicyARN           = "arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy"
 	iamPolicyAmazonEKSCNIPolicyARN                  = "arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"
 	iamPolicyAmazonEC2ContainerRegistryPowerUserARN = "arn:aws:iam::aws:policy/AmazonEC2ContainerRegistryPowerUser"
 	iamPolicyAmazonEC2ContainerRegistryReadOnlyARN  = "arn:aws:iam::aws:policy/AmazonEC2ContainerRegistryReadOnly"
 	iamPolicyCloudWatchAgentServerPolicyARN         = "arn:aws:iam::aws:policy/CloudWatchAgentServerPolicy"
+	iamPolicyAmazonSSMManagedInstanceCoreARN        = "arn:aws:iam::aws:policy/AmazonSSMManagedInstanceCore"
 )
 
 var (
@@ -144,30 +147,7 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() {
 		n.rs.withNamedIAM = true
 	}
 
-	if len(n.spec.IAM.AttachPolicyARNs) == 0 {
-		n.spec.IAM.AttachPolicyARNs = iamDefaultNodePolicyARNs
-	}
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.ImageBuilder) {
-		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, iamPolicyAmazonEC2ContainerRegistryPowerUserARN)
-	} else {
-		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, iamPolicyAmazonEC2ContainerRegistryReadOnlyARN)
-	}
-
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.CloudWatch) {
-		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, iamPolicyCloudWatchAgentServerPolicyARN)
-	}
-
-	role := gfn.AWSIAMRole{
-		Path:                     gfn.NewString("/"),
-		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices("ec2.amazonaws.com"),
-		ManagedPolicyArns:        makeStringSlice(n.spec.IAM.AttachPolicyARNs...),
-	}
-
-	if n.spec.IAM.InstanceRoleName != "" {
-		role.RoleName = gfn.NewString(n.spec.IAM.InstanceRoleName)
-	}
-
-	refIR := n.newResource("NodeInstanceRole", &role)
+	refIR := n.rs.addResourcesForNodeInstanceRole(n.spec.IAM, n.spec.SSH)
 
 	n.newResource("NodeInstanceProfile", &gfn.AWSIAMInstanceProfile{
 		Path:  gfn.NewString("/"),
@@ -175,214 +155,53 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() {
 	})
 	n.instanceProfileARN = gfn.MakeFnGetAttString("NodeInstanceProfile.Arn")
 
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AutoScaler) {
-		n.rs.attachAllowPolicy("PolicyAutoScaling", refIR, "*",
-			[]string{
-				"autoscaling:DescribeAutoScalingGroups",
-				"autoscaling:DescribeAutoScalingInstances",
-				"autoscaling:DescribeLaunchConfigurations",
-				"autoscaling:DescribeTags",
-				"autoscaling:SetDesiredCapacity",
-				"autoscaling:TerminateInstanceInAutoScalingGroup",
-				"ec2:DescribeLaunchTemplateVersions",
-			},
-		)
-	}
+	n.rs.defineOutputFromAtt(outputs.NodeGroupInstanceProfileARN, "NodeInstanceProfile.Arn", true, func(v string) error {
+		n.spec.IAM.InstanceProfileARN = v
+		return nil
+	})
+	n.rs.defineOutputFromAtt(outputs.NodeGroupInstanceRoleARN, "NodeInstanceRole.Arn", true, func(v string) error {
+		n.spec.IAM.InstanceRoleARN = v
+		return nil
+	})
+}
 
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.CertManager) {
-		n.rs.attachAllowPolicy("PolicyCertManagerChangeSet", refIR, "arn:aws:route53:::hostedzone/*",
-			[]string{
-				"route53:ChangeResourceRecordSets",
-			},
-		)
-		n.rs.attachAllowPolicy("PolicyCertManagerHostedZones", refIR, "*",
-			[]string{
-				"route53:ListHostedZones",
-				"route53:ListResourceRecordSets",
-				"route53:ListHostedZonesByName",
-			},
-		)
-		n.rs.attachAllowPolicy("PolicyCertManagerGetChange", refIR, "arn:aws:route53:::change/*",
-			[]string{
-				"route53:GetChange",
-			},
-		)
-	} else if api.IsEnabled(n.spec.IAM.WithAddonPolicies.ExternalDNS) {
-		n.rs.attachAllowPolicy("PolicyExternalDNSChangeSet", refIR, "arn:aws:route53:::hostedzone/*",
-			[]string{
-				"route53:ChangeResourceRecordSets",
-			},
-		)
-		n.rs.attachAllowPolicy("PolicyExternalDNSHostedZones", refIR, "*",
-			[]string{
-				"route53:ListHostedZones",
-				"route53:ListResourceRecordSets",
-			},
-		)
+// addResourcesForNodeInstanceRole creates the IAM role used by nodes, along with all
+// of the addon policies that were requested, and returns a reference to the role
+func (rs *resourceSet) addResourcesForNodeInstanceRole(nodeIAM *api.NodeGroupIAM, ssh *api.NodeGroupSSH) *gfn.Value {
+	if len(nodeIAM.AttachPolicyARNs) == 0 {
+		nodeIAM.AttachPolicyARNs = iamDefaultNodePolicyARNs
 	}
-
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AppMesh) {
-		n.rs.attachAllowPolicy("PolicyAppMesh", refIR, "*",
-			[]string{
-				"appmesh:*",
-				"servicediscovery:CreateService",
-				"servicediscovery:GetService",
-				"servicediscovery:RegisterInstance",
-				"servicediscovery:DeregisterInstance",
-				"servicediscovery:ListInstances",
-				"servicediscovery:ListNamespaces",
-				"route53:GetHealthCheck",
-				"route53:CreateHealthCheck",
-				"route53:UpdateHealthCheck",
-				"route53:ChangeResourceRecordSets",
-				"route53:DeleteHealthCheck",
-			},
-		)
+	if api.IsEnabled(nodeIAM.WithAddonPolicies.ImageBuilder) {
+		nodeIAM.AttachPolicyARNs = append(nodeIAM.AttachPolicyARNs, iamPolicyAmazonEC2ContainerRegistryPowerUserARN)
+	} else {
+		nodeIAM.AttachPolicyARNs = append(nodeIAM.AttachPolicyARNs, iamPolicyAmazonEC2ContainerRegistryReadOnlyARN)
 	}
 
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.EBS) {
-		n.rs.attachAllowPolicy("PolicyEBS", refIR, "*",
-			[]string{
-				"ec2:AttachVolume",
-				"ec2:CreateSnapshot",
-				"ec2:CreateTags",
-				"ec2:CreateVolume",
-				"ec2:DeleteSnapshot",
-				"ec2:DeleteTags",
-				"ec2:DeleteVolume",
-				"ec2:DescribeInstances",
-				"ec2:DescribeSnapshots",
-				"ec2:DescribeTags",
-				"ec2:DescribeVolumes",
-				"ec2:DetachVolume",
-			},
-		)
+	if api.IsEnabled(nodeIAM.WithAddonPolicies.CloudWatch) {
+		nodeIAM.AttachPolicyARNs = append(nodeIAM.AttachPolicyARNs, iamPolicyCloudWatchAgentServerPolicyARN)
 	}
 
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.FSX) {
-		n.rs.attachAllowPolicy("PolicyFSX", refIR, "*",
-			[]string{
-				"fsx:*",
-			},
-		)
-		n.rs.attachAllowPolicy("PolicyServiceLinkRole", refIR, "arn:aws:iam::*:role/aws-service-role/*",
-			[]string{
-				"iam:CreateServiceLinkedRole",
-				"iam:AttachRolePolicy",
-				"iam:PutRolePolicy",
-			},
-		)
+	if ssh != nil && api.IsEnabled(ssh.EnableSSM) {
+		nodeIAM.AttachPolicyARNs = append(nodeIAM.AttachPolicyARNs, iamPolicyAmazonSSMManagedInstanceCoreARN)
 	}
 
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.EFS) {
-		n.rs.attachAllowPolicy("PolicyEFS", refIR, "*",
-			[]string{
-				"elasticfilesystem:*",
-			},
-		)
-		n.rs.attachAllowPolicy("PolicyEFSEC2", refIR, "*",
-			[]string{
-				"ec2:DescribeSubnets",
-				"ec2:CreateNetworkInterface",
-				"ec2:DescribeNetworkInterfaces",
-				"ec2:DeleteNetworkInterface",
-				"ec2:ModifyNetworkInterfaceAttribute",
-				"ec2:DescribeNetworkInterfaceAttribute",
-			},
-		)
+	role := gfn.AWSIAMRole{
+		Path:                     gfn.NewString("/"),
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices("ec2.amazonaws.com"),
+		ManagedPolicyArns:        makeStringSlice(nodeIAM.AttachPolicyARNs...),
 	}
 
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.ALBIngress) {
-		n.rs.attachAllowPolicy("PolicyALBIngress", refIR, "*",
-			[]string{
-				"acm:DescribeCertificate",
-				"acm:ListCertificates",
-				"acm:GetCertificate",
-				"ec2:AuthorizeSecurityGroupIngress",
-				"ec2:CreateSecurityGroup",
-				"ec2:CreateTags",
-				"ec2:DeleteTags",
-				"ec2:DeleteSecurityGroup",
-				"ec2:DescribeAccountAttributes",
-				"ec2:DescribeAddresses",
-				"ec2:DescribeInstances",
-				"ec2:DescribeInstanceStatus",
-				"ec2:DescribeInternetGateways",
-				"ec2:DescribeNetworkInterfaces",
-				"ec2:DescribeSecurityGroups",
-				"ec2:DescribeSubnets",
-				"ec2:DescribeTags",
-				"ec2:DescribeVpcs",
-				"ec2:ModifyInstanceAttribute",
-				"ec2:ModifyNetworkInterfaceAttribute",
-				"ec2:RevokeSecurityGroupIngress",
-				"elasticloadbalancing:AddListenerCertificates",
-				"elasticloadbalancing:AddTags",
-				"elasticloadbalancing:CreateListener",
-				"elasticloadbalancing:CreateLoadBalancer",
-				"elasticloadbalancing:CreateRule",
-				"elasticloadbalancing:CreateTargetGroup",
-				"elasticloadbalancing:DeleteListener",
-				"elasticloadbalancing:DeleteLoadBalancer",
-				"elasticloadbalancing:DeleteRule",
-				"elasticloadbalancing:DeleteTargetGroup",
-				"elasticloadbalancing:DeregisterTargets",
-				"elasticloadbalancing:DescribeListenerCertificates",
-				"elasticloadbalancing:DescribeListeners",
-				"elasticloadbalancing:DescribeLoadBalancers",
-				"elasticloadbalancing:DescribeLoadBalancerAttributes",
-				"elasticloadbalancing:DescribeRules",
-				"elasticloadbalancing:DescribeSSLPolicies",
-				"elasticloadbalancing:DescribeTags",
-				"elasticloadbalancing:DescribeTargetGroups",
-				"elasticloadbalancing:DescribeTargetGroupAttributes",
-				"elasticloadbalancing:DescribeTargetHealth",
-				"elasticloadbalancing:ModifyListener",
-				"elasticloadbalancing:ModifyLoadBalancerAttributes",
-				"elasticloadbalancing:ModifyRule",
-				"elasticloadbalancing:ModifyTargetGroup",
-				"elasticloadbalancing:ModifyTargetGroupAttributes",
-				"elasticloadbalancing:RegisterTargets",
-				"elasticloadbalancing:RemoveListenerCertificates",
-				"elasticloadbalancing:RemoveTags",
-				"elasticloadbalancing:SetIpAddressType",
-				"elasticloadbalancing:SetSecurityGroups",
-				"elasticloadbalancing:SetSubnets",
-				"elasticloadbalancing:SetWebACL",
-				"iam:CreateServiceLinkedRole",
-				"iam:GetServerCertificate",
-				"iam:ListServerCertificates",
-				"waf-regional:GetWebACLForResource",
-				"waf-regional:GetWebACL",
-				"waf-regional:AssociateWebACL",
-				"waf-regional:DisassociateWebACL",
-				"tag:GetResources",
-				"tag:TagResources",
-				"waf:GetWebACL",
-			},
-		)
+	if nodeIAM.InstanceRoleName != "" {
+		role.RoleName = gfn.NewString(nodeIAM.InstanceRoleName)
 	}
 
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.XRay) {
-		n.rs.attachAllowPolicy("PolicyXRay", refIR, "*",
-			[]string{
-				"xray:PutTraceSegments",
-				"xray:PutTelemetryRecords",
-				"xray:GetSamplingRules",
-				"xray:GetSamplingTargets",
-				"xray:GetSamplingStatisticSummaries",
-			},
-		)
+	refIR := rs.newResource("NodeInstanceRole", &role)
+
+	for _, policy := range makeAddonPolicies(&nodeIAM.WithAddonPolicies) {
+		rs.attachAllowPolicy(policy.name, refIR, policy.resources, policy.actions)
 	}
 
-	n.rs.defineOutputFromAtt(outputs.NodeGroupInstanceProfileARN, "NodeInstanceProfile.Arn", true, func(v string) error {
-		n.spec.IAM.InstanceProfileARN = v
-		return nil
-	})
-	n.rs.defineOutputFromAtt(outputs.NodeGroupInstanceRoleARN, "NodeInstanceRole.Arn", true, func(v string) error {
-		n.spec.IAM.InstanceRoleARN = v
-		return nil
-	})
+	return refIR
 }
 
 // IAMServiceAccountResourceSet holds iamserviceaccount stack build-time information
@@ -424,6 +243,9 @@ func (rs *IAMServiceAccountResourceSet) AddAllResources() error {
 		AssumeRolePolicyDocument: rs.oidc.MakeAssumeRolePolicyDocument(rs.spec.Namespace, rs.spec.Name),
 	}
 	role.ManagedPolicyArns = append(role.ManagedPolicyArns, rs.spec.AttachPolicyARNs...)
+	if rs.spec.WithAddonPolicies != nil {
+		role.ManagedPolicyArns = append(role.ManagedPolicyArns, makeAddonManagedPolicyARNs(rs.spec.WithAddonPolicies)...)
+	}
 
 	roleRef := rs.template.NewResource("Role1", role)
 
@@ -444,6 +266,16 @@ func (rs *IAMServiceAccountResourceSet) AddAllResources() error {
 		rs.template.AttachPolicy("Policy1", roleRef, cft.MapOfInterfaces(rs.spec.AttachPolicy))
 	}
 
+	if rs.spec.WithAddonPolicies != nil {
+		for _, policy := range makeAddonPolicies(rs.spec.WithAddonPolicies) {
+			rs.template.AttachPolicy(policy.name, roleRef, cft.MakePolicyDocument(cft.MapOfInterfaces{
+				"Effect":   "Allow",
+				"Resource": policy.resources,
+				"Action":   policy.actions,
+			}))
+		}
+	}
+
 	return nil
 }
 
@@ -184,6 +184,62 @@ var _ = Describe("template builder for IAM", func() {
 		Expect(t).To(HaveOutputWithValue("Role1", `{ "Fn::GetAtt": "Role1.Arn" }`))
 	})
 
+	It("can constuct an iamserviceaccount addon template with addon policies", func() {
+		serviceAccount := &api.ClusterIAMServiceAccount{}
+
+		serviceAccount.Name = "sa-1"
+
+		serviceAccount.WithAddonPolicies = &api.NodeGroupIAMAddonPolicies{
+			AutoScaler: api.Enabled(),
+			EBS:        api.Enabled(),
+			CloudWatch: api.Enabled(),
+		}
+
+		appendServiceAccountToClusterConfig(cfg, serviceAccount)
+
+		rs := NewIAMServiceAccountResourceSet(serviceAccount, oidc)
+
+		templateBody := []byte{}
+
+		Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+		t := cft.NewTemplate()
+
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+
+		Expect(t.Resources).To(HaveLen(3))
+		Expect(t.Outputs).To(HaveLen(1))
+
+		Expect(t).To(HaveResource("Role1", "AWS::IAM::Role"))
+		Expect(t).To(HaveResource("PolicyAutoScaling", "AWS::IAM::Policy"))
+		Expect(t).To(HaveResource("PolicyEBS", "AWS::IAM::Policy"))
+
+		Expect(t).To(HaveResourceWithPropertyValue("Role1", "ManagedPolicyArns", `[
+			"arn:aws:iam::aws:policy/CloudWatchAgentServerPolicy"
+		]`))
+
+		Expect(t).To(HaveResourceWithPropertyValue("PolicyAutoScaling", "PolicyDocument", `{
+            "Version": "2012-10-17",
+            "Statement": [
+                {
+                    "Effect": "Allow",
+                    "Action": [
+                        "autoscaling:DescribeAutoScalingGroups",
+                        "autoscaling:DescribeAutoScalingInstances",
+                        "autoscaling:DescribeLaunchConfigurations",
+                        "autoscaling:DescribeTags",
+                        "autoscaling:SetDesiredCapacity",
+                        "autoscaling:TerminateInstanceInAutoScalingGroup",
+                        "ec2:DescribeLaunchTemplateVersions"
+                    ],
+                    "Resource": "*"
+                }
+            ]
+        }`))
+
+		Expect(t).To(HaveOutputWithValue("Role1", `{ "Fn::GetAtt": "Role1.Arn" }`))
+	})
+
 	It("can parse an iamserviceaccount addon template", func() {
 		t := cft.NewTemplate()
 
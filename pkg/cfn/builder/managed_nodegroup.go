@@ -0,0 +1,247 @@
+package builder
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	gfn "github.com/awslabs/goformation/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+const (
+	managedNodeGroupTemplateDescription = "EKS Managed Nodes"
+
+	// root device of Amazon Linux 2 AMIs
+	managedNodeGroupRootDeviceName = "/dev/xvda"
+)
+
+// ManagedNodeGroupResourceSet stores the resource information of a managed nodegroup
+type ManagedNodeGroupResourceSet struct {
+	rs               *resourceSet
+	clusterSpec      *api.ClusterConfig
+	spec             *api.ManagedNodeGroup
+	clusterStackName string
+	nodeGroupName    string
+	nodeRoleARN      *gfn.Value
+}
+
+// NewManagedNodeGroupResourceSet returns a resource set for a managed nodegroup embedded in a cluster config
+func NewManagedNodeGroupResourceSet(spec *api.ClusterConfig, clusterStackName string, ng *api.ManagedNodeGroup) *ManagedNodeGroupResourceSet {
+	return &ManagedNodeGroupResourceSet{
+		rs:               newResourceSet(),
+		clusterSpec:      spec,
+		spec:             ng,
+		clusterStackName: clusterStackName,
+		nodeGroupName:    ng.Name,
+	}
+}
+
+// AddAllResources adds all the information about the managed nodegroup to the resource set
+func (m *ManagedNodeGroupResourceSet) AddAllResources() error {
+	launchTemplate := "none"
+	switch {
+	case m.spec.HasLaunchTemplate():
+		launchTemplate = m.spec.LaunchTemplate.ID
+	case m.spec.NeedsLaunchTemplate():
+		launchTemplate = "generated"
+	}
+	m.rs.template.Description = fmt.Sprintf(
+		"%s (launch template: %s, SSH access: %v, private networking: %v) %s",
+		managedNodeGroupTemplateDescription,
+		launchTemplate, api.IsEnabled(m.spec.SSH.Allow), m.spec.PrivateNetworking,
+		templateDescriptionSuffix)
+
+	m.rs.defineOutputWithoutCollector(outputs.NodeGroupFeaturePrivateNetworking, m.spec.PrivateNetworking, false)
+
+	if err := setNodeGroupSizeDefaults(m.nodeGroupName, m.spec.DesiredCapacity, &m.spec.MinSize, &m.spec.MaxSize); err != nil {
+		return err
+	}
+
+	m.addResourcesForIAM()
+
+	return m.addResourcesForNodeGroup()
+}
+
+// WithIAM states, if IAM roles will be created or not
+func (m *ManagedNodeGroupResourceSet) WithIAM() bool {
+	return m.rs.withIAM
+}
+
+// WithNamedIAM states, if specifically named IAM roles will be created or not
+func (m *ManagedNodeGroupResourceSet) WithNamedIAM() bool {
+	return m.rs.withNamedIAM
+}
+
+// RenderJSON returns the rendered JSON
+func (m *ManagedNodeGroupResourceSet) RenderJSON() ([]byte, error) {
+	return m.rs.renderJSON()
+}
+
+// GetAllOutputs collects all outputs of the managed nodegroup
+func (m *ManagedNodeGroupResourceSet) GetAllOutputs(stack cfn.Stack) error {
+	return m.rs.GetAllOutputs(stack)
+}
+
+func (m *ManagedNodeGroupResourceSet) addResourcesForIAM() {
+	if m.spec.IAM.InstanceRoleARN != "" {
+		m.rs.withIAM = false
+		m.rs.withNamedIAM = false
+
+		m.nodeRoleARN = gfn.NewString(m.spec.IAM.InstanceRoleARN)
+		m.rs.defineOutputWithoutCollector(outputs.NodeGroupInstanceRoleARN, m.spec.IAM.InstanceRoleARN, true)
+		return
+	}
+
+	m.rs.withIAM = true
+	if m.spec.IAM.InstanceRoleName != "" {
+		// setting role name requires additional capabilities
+		m.rs.withNamedIAM = true
+	}
+
+	m.rs.addResourcesForNodeInstanceRole(m.spec.IAM, m.spec.SSH)
+	m.nodeRoleARN = gfn.MakeFnGetAttString("NodeInstanceRole.Arn")
+
+	m.rs.defineOutputFromAtt(outputs.NodeGroupInstanceRoleARN, "NodeInstanceRole.Arn", true, func(v string) error {
+		m.spec.IAM.InstanceRoleARN = v
+		return nil
+	})
+}
+
+func (m *ManagedNodeGroupResourceSet) addResourcesForNodeGroup() error {
+	subnets, err := makeNodeGroupSubnets(m.clusterSpec, m.clusterStackName, m.spec.AvailabilityZones, m.spec.PrivateNetworking)
+	if err != nil {
+		return err
+	}
+
+	scalingConfig := map[string]interface{}{
+		"MinSize": *m.spec.MinSize,
+		"MaxSize": *m.spec.MaxSize,
+	}
+	if m.spec.DesiredCapacity != nil {
+		scalingConfig["DesiredSize"] = *m.spec.DesiredCapacity
+	}
+
+	ngProps := map[string]interface{}{
+		"ClusterName":   m.clusterSpec.Metadata.Name,
+		"NodegroupName": m.nodeGroupName,
+		"NodeRole":      m.nodeRoleARN,
+		"Subnets":       subnets,
+		"ScalingConfig": scalingConfig,
+	}
+	if m.spec.InstanceType != "" {
+		ngProps["InstanceTypes"] = []string{m.spec.InstanceType}
+	}
+	if len(m.spec.Labels) > 0 {
+		ngProps["Labels"] = m.spec.Labels
+	}
+	if len(m.spec.Tags) > 0 {
+		ngProps["Tags"] = m.spec.Tags
+	}
+
+	switch {
+	case m.spec.HasLaunchTemplate():
+		launchTemplate := map[string]interface{}{
+			"Id": m.spec.LaunchTemplate.ID,
+		}
+		if m.spec.LaunchTemplate.Version != nil {
+			launchTemplate["Version"] = *m.spec.LaunchTemplate.Version
+		}
+		ngProps["LaunchTemplate"] = launchTemplate
+
+	case m.spec.NeedsLaunchTemplate():
+		launchTemplateData, err := m.makeLaunchTemplateData()
+		if err != nil {
+			return err
+		}
+		refLaunchTemplate := m.rs.newResource("LaunchTemplate", &gfn.AWSEC2LaunchTemplate{
+			LaunchTemplateName: gfn.MakeFnSubString(fmt.Sprintf("${%s}", gfn.StackName)),
+			LaunchTemplateData: launchTemplateData,
+		})
+		ngProps["LaunchTemplate"] = map[string]interface{}{
+			"Id":      refLaunchTemplate,
+			"Version": gfn.MakeFnGetAttString("LaunchTemplate.LatestVersionNumber"),
+		}
+		if !api.IsAMI(m.spec.AMI) {
+			ngProps["AmiType"] = m.amiType()
+		}
+
+	default:
+		ngProps["AmiType"] = m.amiType()
+		if m.spec.VolumeSize != nil && *m.spec.VolumeSize > 0 {
+			ngProps["DiskSize"] = *m.spec.VolumeSize
+		}
+		if api.IsEnabled(m.spec.SSH.Allow) && api.IsSetAndNonEmptyString(m.spec.SSH.PublicKeyName) {
+			ngProps["RemoteAccess"] = map[string]interface{}{
+				"Ec2SshKey": *m.spec.SSH.PublicKeyName,
+			}
+		}
+	}
+
+	m.rs.newResource("ManagedNodeGroup", &awsCloudFormationResource{
+		Type:       "AWS::EKS::Nodegroup",
+		Properties: ngProps,
+	})
+
+	return nil
+}
+
+// makeLaunchTemplateData renders the data of the launch template eksctl generates for
+// nodegroups that use a custom AMI, custom user data or additional security groups
+func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData() (*gfn.AWSEC2LaunchTemplate_LaunchTemplateData, error) {
+	launchTemplateData := &gfn.AWSEC2LaunchTemplate_LaunchTemplateData{}
+
+	if api.IsAMI(m.spec.AMI) {
+		launchTemplateData.ImageId = gfn.NewString(m.spec.AMI)
+	}
+
+	userData, err := nodebootstrap.NewUserDataForManagedNodeGroup(m.spec)
+	if err != nil {
+		return nil, err
+	}
+	if userData != "" {
+		launchTemplateData.UserData = gfn.NewString(userData)
+	}
+
+	// when security groups are set in the launch template, EKS doesn't attach any
+	// security groups of its own, so nodes need the same security groups that
+	// eksctl uses for unmanaged nodegroups
+	securityGroups := []*gfn.Value{
+		makeImportValue(m.clusterStackName, outputs.ClusterSharedNodeSecurityGroup),
+		m.rs.addResourcesForNodeGroupLocalSecurityGroup(m.clusterSpec, m.clusterStackName, m.nodeGroupName, api.IsEnabled(m.spec.SSH.Allow), m.spec.PrivateNetworking),
+	}
+	for _, id := range m.spec.SecurityGroups.AttachIDs {
+		securityGroups = append(securityGroups, gfn.NewString(id))
+	}
+	launchTemplateData.SecurityGroupIds = securityGroups
+
+	if api.IsEnabled(m.spec.SSH.Allow) && api.IsSetAndNonEmptyString(m.spec.SSH.PublicKeyName) {
+		launchTemplateData.KeyName = gfn.NewString(*m.spec.SSH.PublicKeyName)
+	}
+
+	if m.spec.VolumeSize != nil && *m.spec.VolumeSize > 0 {
+		launchTemplateData.BlockDeviceMappings = []gfn.AWSEC2LaunchTemplate_BlockDeviceMapping{{
+			DeviceName: gfn.NewString(managedNodeGroupRootDeviceName),
+			Ebs: &gfn.AWSEC2LaunchTemplate_Ebs{
+				VolumeSize: gfn.NewInteger(*m.spec.VolumeSize),
+				VolumeType: gfn.NewString(api.DefaultNodeVolumeType),
+			},
+		}}
+	}
+
+	return launchTemplateData, nil
+}
+
+func (m *ManagedNodeGroupResourceSet) amiType() string {
+	switch {
+	case api.IsARMInstanceType(m.spec.InstanceType):
+		return api.ManagedNodeGroupAMITypeAL2ARM
+	case utils.IsGPUInstanceType(m.spec.InstanceType):
+		return api.ManagedNodeGroupAMITypeAL2GPU
+	default:
+		return api.ManagedNodeGroupAMITypeAL2
+	}
+}
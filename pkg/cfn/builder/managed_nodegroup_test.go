@@ -0,0 +1,131 @@
+package builder_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/template/matchers"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("template builder for managed nodegroups", func() {
+	var (
+		cfg *api.ClusterConfig
+		ng  *api.ManagedNodeGroup
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		ng = api.NewManagedNodeGroup()
+		ng.Name = "mng-1"
+		cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, ng)
+	})
+
+	render := func() *cft.Template {
+		Expect(api.ValidateManagedNodeGroup(0, ng)).To(Succeed())
+		api.SetManagedNodeGroupDefaults(0, ng, cfg.Metadata)
+
+		rs := NewManagedNodeGroupResourceSet(cfg, "eksctl-test-cluster-cluster", ng)
+		Expect(rs.AddAllResources()).To(Succeed())
+
+		templateBody := []byte{}
+		Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+		t := cft.NewTemplate()
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+		return t
+	}
+
+	It("can construct a managed nodegroup without a launch template", func() {
+		ng.VolumeSize = new(int)
+		*ng.VolumeSize = 50
+
+		t := render()
+
+		Expect(t.Description).To(Equal("EKS Managed Nodes (launch template: none, SSH access: false, private networking: false) [created and managed by eksctl]"))
+
+		Expect(t).To(HaveResource("ManagedNodeGroup", "AWS::EKS::Nodegroup"))
+		Expect(t).To(HaveResource("NodeInstanceRole", "AWS::IAM::Role"))
+		Expect(t).ToNot(HaveResource("LaunchTemplate", "AWS::EC2::LaunchTemplate"))
+
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "ClusterName", `"test-cluster"`))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "NodegroupName", `"mng-1"`))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "NodeRole", `{ "Fn::GetAtt": "NodeInstanceRole.Arn" }`))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "AmiType", `"AL2_x86_64"`))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "InstanceTypes", `["m5.large"]`))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "DiskSize", `50`))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "ScalingConfig", `{ "MinSize": 2, "MaxSize": 2 }`))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "Subnets", `{
+			"Fn::Split": [ ",", { "Fn::ImportValue": "eksctl-test-cluster-cluster::SubnetsPublic" } ]
+		}`))
+		Expect(t).ToNot(HaveResourceWithProperties("ManagedNodeGroup", "LaunchTemplate"))
+
+		Expect(t).To(HaveOutputWithValue("InstanceRoleARN", `{ "Fn::GetAtt": "NodeInstanceRole.Arn" }`))
+	})
+
+	It("uses the ARM AMI type for ARM instance types", func() {
+		ng.InstanceType = "m6g.large"
+
+		t := render()
+
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "AmiType", `"AL2_ARM_64"`))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "InstanceTypes", `["m6g.large"]`))
+	})
+
+	It("can reference an existing launch template", func() {
+		ng.InstanceType = ""
+		ng.IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/node-role"
+		ng.LaunchTemplate = &api.LaunchTemplate{
+			ID:      "lt-0123456789abcdef0",
+			Version: new(string),
+		}
+		*ng.LaunchTemplate.Version = "3"
+
+		t := render()
+
+		Expect(t.Description).To(ContainSubstring("launch template: lt-0123456789abcdef0"))
+
+		Expect(t.Resources).To(HaveLen(1))
+		Expect(t).To(HaveResource("ManagedNodeGroup", "AWS::EKS::Nodegroup"))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "NodeRole", `"arn:aws:iam::123456789012:role/node-role"`))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "LaunchTemplate", `{
+			"Id": "lt-0123456789abcdef0",
+			"Version": "3"
+		}`))
+		Expect(t).ToNot(HaveResourceWithProperties("ManagedNodeGroup", "AmiType", "InstanceTypes", "DiskSize", "RemoteAccess"))
+	})
+
+	It("generates a launch template for a custom AMI", func() {
+		ng.AMI = "ami-0123456789abcdef0"
+		ng.OverrideBootstrapCommand = new(string)
+		*ng.OverrideBootstrapCommand = "/etc/eks/bootstrap.sh test-cluster"
+		ng.SecurityGroups.AttachIDs = []string{"sg-1", "sg-2"}
+
+		t := render()
+
+		Expect(t.Description).To(ContainSubstring("launch template: generated"))
+
+		Expect(t).To(HaveResource("LaunchTemplate", "AWS::EC2::LaunchTemplate"))
+		Expect(t).To(HaveResource("SG", "AWS::EC2::SecurityGroup"))
+		Expect(t).To(HaveResourceWithPropertyValue("ManagedNodeGroup", "LaunchTemplate", `{
+			"Id": { "Ref": "LaunchTemplate" },
+			"Version": { "Fn::GetAtt": "LaunchTemplate.LatestVersionNumber" }
+		}`))
+		Expect(t).ToNot(HaveResourceWithProperties("ManagedNodeGroup", "AmiType", "DiskSize", "RemoteAccess"))
+
+		lt := t.Resources["LaunchTemplate"].Properties.(map[string]interface{})
+		ltData := lt["LaunchTemplateData"].(map[string]interface{})
+		Expect(ltData["ImageId"]).To(Equal("ami-0123456789abcdef0"))
+		Expect(ltData["UserData"]).ToNot(BeEmpty())
+		Expect(ltData["SecurityGroupIds"]).To(HaveLen(4))
+		Expect(ltData["SecurityGroupIds"]).To(ContainElement("sg-1"))
+		Expect(ltData["SecurityGroupIds"]).To(ContainElement("sg-2"))
+	})
+})
@@ -1,15 +1,17 @@
 package builder
 
 import (
+	"encoding/json"
 	"fmt"
 
-	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	gfn "github.com/awslabs/goformation/cloudformation"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
 )
 
@@ -59,31 +61,8 @@ func (n *NodeGroupResourceSet) AddAllResources() error {
 	}
 	n.userData = gfn.NewString(userData)
 
-	// Ensure MinSize is set, as it is required by the ASG cfn resource
-	if n.spec.MinSize == nil {
-		if n.spec.DesiredCapacity == nil {
-			defaultNodeCount := api.DefaultNodeCount
-			n.spec.MinSize = &defaultNodeCount
-		} else {
-			n.spec.MinSize = n.spec.DesiredCapacity
-		}
-		logger.Info("--nodes-min=%d was set automatically for nodegroup %s", *n.spec.MinSize, n.nodeGroupName)
-	} else if n.spec.DesiredCapacity != nil && *n.spec.DesiredCapacity < *n.spec.MinSize {
-		return fmt.Errorf("cannot use --nodes-min=%d and --nodes=%d at the same time", *n.spec.MinSize, *n.spec.DesiredCapacity)
-	}
-
-	// Ensure MaxSize is set, as it is required by the ASG cfn resource
-	if n.spec.MaxSize == nil {
-		if n.spec.DesiredCapacity == nil {
-			n.spec.MaxSize = n.spec.MinSize
-		} else {
-			n.spec.MaxSize = n.spec.DesiredCapacity
-		}
-		logger.Info("--nodes-max=%d was set automatically for nodegroup %s", *n.spec.MaxSize, n.nodeGroupName)
-	} else if n.spec.DesiredCapacity != nil && *n.spec.DesiredCapacity > *n.spec.MaxSize {
-		return fmt.Errorf("cannot use --nodes-max=%d and --nodes=%d at the same time", *n.spec.MaxSize, *n.spec.DesiredCapacity)
-	} else if *n.spec.MaxSize < *n.spec.MinSize {
-		return fmt.Errorf("cannot use --nodes-min=%d and --nodes-max=%d at the same time", *n.spec.MinSize, *n.spec.MaxSize)
+	if err := setNodeGroupSizeDefaults(n.nodeGroupName, n.spec.DesiredCapacity, &n.spec.MinSize, &n.spec.MaxSize); err != nil {
+		return err
 	}
 
 	n.addResourcesForIAM()
@@ -123,7 +102,7 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 			kmsKeyID = gfn.NewString(*n.spec.VolumeKmsKeyID)
 		}
 
-		if *n.spec.VolumeType == api.NodeVolumeTypeIO1 {
+		if n.spec.VolumeIOPS != nil && (*n.spec.VolumeType == api.NodeVolumeTypeIO1 || *n.spec.VolumeType == api.NodeVolumeTypeGP3) {
 			volumeIOPS = gfn.NewInteger(*n.spec.VolumeIOPS)
 		}
 
@@ -139,38 +118,44 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 		}}
 	}
 
-	n.newResource("NodeGroupLaunchTemplate", &gfn.AWSEC2LaunchTemplate{
+	// the instances get tagged by the ASG, but the volumes can only be tagged by the launch template
+	if volumeTags := n.makeUserDefinedTags(); len(volumeTags) > 0 {
+		launchTemplateData.TagSpecifications = []gfn.AWSEC2LaunchTemplate_TagSpecification{{
+			ResourceType: gfn.NewString("volume"),
+			Tags:         volumeTags,
+		}}
+	}
+
+	if placement := n.spec.Placement; placement != nil && placement.GroupName == "" {
+		n.newResource("NodeGroupPlacementGroup", &gfn.AWSEC2PlacementGroup{
+			Strategy: gfn.NewString(placement.Strategy),
+		})
+	}
+
+	launchTemplate, err := newLaunchTemplateResource(&gfn.AWSEC2LaunchTemplate{
 		LaunchTemplateName: launchTemplateName,
 		LaunchTemplateData: launchTemplateData,
-	})
+	}, n.spec)
+	if err != nil {
+		return err
+	}
+	n.newResource("NodeGroupLaunchTemplate", launchTemplate)
 
 	// currently goformation type system doesn't allow specifying `VPCZoneIdentifier: { "Fn::ImportValue": ... }`,
 	// and tags don't have `PropagateAtLaunch` field, so we have a custom method here until this gets resolved
 	var vpcZoneIdentifier interface{}
-	if numNodeGroupsAZs := len(n.spec.AvailabilityZones); numNodeGroupsAZs > 0 {
-		subnets := n.clusterSpec.VPC.Subnets.Private
-		if !n.spec.PrivateNetworking {
-			subnets = n.clusterSpec.VPC.Subnets.Public
-		}
-		errorDesc := fmt.Sprintf("(subnets=%#v AZs=%#v)", subnets, n.spec.AvailabilityZones)
-		if len(subnets) < numNodeGroupsAZs {
-			return fmt.Errorf("VPC doesn't have enough subnets for nodegroup AZs %s", errorDesc)
-		}
-		vpcZoneIdentifier = make([]interface{}, numNodeGroupsAZs)
-		for i, az := range n.spec.AvailabilityZones {
-			subnet, ok := subnets[az]
-			if !ok {
-				return fmt.Errorf("VPC doesn't have subnets in %s %s", az, errorDesc)
-			}
-			vpcZoneIdentifier.([]interface{})[i] = subnet.ID
+	if len(n.spec.Subnets) > 0 {
+		// subnets in Local Zones, Wavelength Zones or on Outposts are not part of the cluster subnets
+		subnets := []interface{}{}
+		for _, subnet := range n.spec.Subnets {
+			subnets = append(subnets, subnet)
 		}
+		vpcZoneIdentifier = subnets
 	} else {
-		subnets := makeImportValue(n.clusterStackName, outputs.ClusterSubnetsPrivate)
-		if !n.spec.PrivateNetworking {
-			subnets = makeImportValue(n.clusterStackName, outputs.ClusterSubnetsPublic)
-		}
-		vpcZoneIdentifier = map[string][]interface{}{
-			gfn.FnSplit: {",", subnets},
+		var err error
+		vpcZoneIdentifier, err = makeNodeGroupSubnets(n.clusterSpec, n.clusterStackName, n.spec.AvailabilityZones, n.spec.PrivateNetworking)
+		if err != nil {
+			return err
 		}
 	}
 	tags := []map[string]interface{}{
@@ -185,7 +170,8 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 			"PropagateAtLaunch": "true",
 		},
 	}
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AutoScaler) {
+	// cluster-autoscaler discovers the auto scaling groups it scales by these tags
+	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AutoScaler) || n.clusterSpec.HasClusterAutoscaler() {
 		tags = append(tags,
 			map[string]interface{}{
 				"Key":               "k8s.io/cluster-autoscaler/enabled",
@@ -200,17 +186,221 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 		)
 	}
 
+	// user-defined tags are also set on the stack, but they are added explicitly,
+	// so that the ASG is certain to propagate them to the instances
+	for _, tag := range n.makeUserDefinedTags() {
+		tags = append(tags, map[string]interface{}{
+			"Key":               tag.Key,
+			"Value":             tag.Value,
+			"PropagateAtLaunch": "true",
+		})
+	}
+
+	// cluster-autoscaler relies on these tags to know about labels and taints
+	// of the nodes when scaling up from zero
+	for _, tag := range n.makeNodeTemplateTags() {
+		tags = append(tags, map[string]interface{}{
+			"Key":               tag["Key"],
+			"Value":             tag["Value"],
+			"PropagateAtLaunch": "false",
+		})
+	}
+
 	asg := nodeGroupResource(launchTemplateName, &vpcZoneIdentifier, tags, n.spec)
 	n.newResource("NodeGroup", asg)
 
+	if n.spec.WarmPool != nil {
+		n.newResource("NodeGroupWarmPool", warmPoolResource(n.spec.WarmPool))
+	}
+
+	// the AWS Node Termination Handler drains the nodes of instances that are being
+	// terminated, which takes a while, so their termination is delayed by a lifecycle hook
+	if n.clusterSpec.HasNodeTerminationHandler() && api.HasMixedInstances(n.spec) {
+		n.newResource("NodeGroupTerminationLifecycleHook", terminationLifecycleHookResource(n.clusterSpec.NodeTerminationHandler))
+	}
+
 	return nil
 }
 
+// goformation doesn't support warm pools yet, so they are defined as a generic resource
+func warmPoolResource(warmPool *api.WarmPool) *awsCloudFormationResource {
+	props := map[string]interface{}{
+		"AutoScalingGroupName": gfn.MakeRef("NodeGroup"),
+	}
+	if warmPool.MinSize != nil {
+		props["MinSize"] = fmt.Sprintf("%d", *warmPool.MinSize)
+	}
+	if warmPool.MaxGroupPreparedCapacity != nil {
+		props["MaxGroupPreparedCapacity"] = fmt.Sprintf("%d", *warmPool.MaxGroupPreparedCapacity)
+	}
+	if warmPool.PoolState != "" {
+		props["PoolState"] = warmPool.PoolState
+	}
+	return &awsCloudFormationResource{
+		Type:       "AWS::AutoScaling::WarmPool",
+		Properties: props,
+	}
+}
+
+func terminationLifecycleHookResource(nth *api.NodeTerminationHandler) *gfn.AWSAutoScalingLifecycleHook {
+	timeout := 300
+	if nth.LifecycleHookTimeout != nil {
+		timeout = *nth.LifecycleHookTimeout
+	}
+	return &gfn.AWSAutoScalingLifecycleHook{
+		AutoScalingGroupName: gfn.MakeRef("NodeGroup"),
+		LifecycleHookName:    gfn.NewString(api.NodeTerminationHandlerLifecycleHookName),
+		LifecycleTransition:  gfn.NewString("autoscaling:EC2_INSTANCE_TERMINATING"),
+		// the handler doesn't complete the lifecycle action, termination
+		// continues once the nodes had the time to be drained
+		DefaultResult:    gfn.NewString("CONTINUE"),
+		HeartbeatTimeout: gfn.NewInteger(timeout),
+	}
+}
+
+// makeUserDefinedTags merges cluster and nodegroup tags, the latter take precedence
+func (n *NodeGroupResourceSet) makeUserDefinedTags() []gfn.Tag {
+	merged := map[string]string{}
+	for k, v := range n.clusterSpec.Metadata.Tags {
+		merged[k] = v
+	}
+	for k, v := range n.spec.Tags {
+		merged[k] = v
+	}
+
+	tags := []gfn.Tag{}
+	for _, tag := range makeKeyValuePairs(merged) {
+		tags = append(tags, gfn.Tag{
+			Key:   gfn.NewString(tag["Key"]),
+			Value: gfn.NewString(tag["Value"]),
+		})
+	}
+	return tags
+}
+
+// makeNodeTemplateTags returns tags that describe labels and taints of the nodes
+func (n *NodeGroupResourceSet) makeNodeTemplateTags() []map[string]string {
+	nodeTemplate := map[string]string{}
+	for k, v := range n.spec.Labels {
+		nodeTemplate[api.NodeTemplateLabelTagPrefix+k] = v
+	}
+	for k, v := range n.spec.Taints {
+		nodeTemplate[api.NodeTemplateTaintTagPrefix+k] = v
+	}
+	return makeKeyValuePairs(nodeTemplate)
+}
+
+// setNodeGroupSizeDefaults ensures that minimum and maximum sizes of a nodegroup are set,
+// deriving them from the desired capacity when needed, and checks that all sizes are consistent
+func setNodeGroupSizeDefaults(nodeGroupName string, desiredCapacity *int, minSize, maxSize **int) error {
+	// Ensure MinSize is set, as it is required by the ASG cfn resource
+	if *minSize == nil {
+		if desiredCapacity == nil {
+			defaultNodeCount := api.DefaultNodeCount
+			*minSize = &defaultNodeCount
+		} else {
+			*minSize = desiredCapacity
+		}
+		logger.Info("--nodes-min=%d was set automatically for nodegroup %s", **minSize, nodeGroupName)
+	} else if desiredCapacity != nil && *desiredCapacity < **minSize {
+		return fmt.Errorf("cannot use --nodes-min=%d and --nodes=%d at the same time", **minSize, *desiredCapacity)
+	}
+
+	// Ensure MaxSize is set, as it is required by the ASG cfn resource
+	if *maxSize == nil {
+		if desiredCapacity == nil {
+			*maxSize = *minSize
+		} else {
+			*maxSize = desiredCapacity
+		}
+		logger.Info("--nodes-max=%d was set automatically for nodegroup %s", **maxSize, nodeGroupName)
+	} else if desiredCapacity != nil && *desiredCapacity > **maxSize {
+		return fmt.Errorf("cannot use --nodes-max=%d and --nodes=%d at the same time", **maxSize, *desiredCapacity)
+	} else if **maxSize < **minSize {
+		return fmt.Errorf("cannot use --nodes-min=%d and --nodes-max=%d at the same time", **minSize, **maxSize)
+	}
+
+	return nil
+}
+
+// makeNodeGroupSubnets returns subnets of the nodegroup, either as a list of subnet IDs
+// from the given AZs, or as an import of all public or private subnets of the cluster
+func makeNodeGroupSubnets(clusterSpec *api.ClusterConfig, clusterStackName string, availabilityZones []string, privateNetworking bool) (interface{}, error) {
+	var vpcZoneIdentifier interface{}
+	if numNodeGroupsAZs := len(availabilityZones); numNodeGroupsAZs > 0 {
+		subnets := clusterSpec.VPC.Subnets.Private
+		if !privateNetworking {
+			subnets = clusterSpec.VPC.Subnets.Public
+		}
+		errorDesc := fmt.Sprintf("(subnets=%#v AZs=%#v)", subnets, availabilityZones)
+		if len(subnets) < numNodeGroupsAZs {
+			return nil, fmt.Errorf("VPC doesn't have enough subnets for nodegroup AZs %s", errorDesc)
+		}
+		vpcZoneIdentifier = make([]interface{}, numNodeGroupsAZs)
+		for i, az := range availabilityZones {
+			subnet, ok := subnets[az]
+			if !ok {
+				return nil, fmt.Errorf("VPC doesn't have subnets in %s %s", az, errorDesc)
+			}
+			vpcZoneIdentifier.([]interface{})[i] = subnet.ID
+		}
+	} else {
+		subnets := makeImportValue(clusterStackName, outputs.ClusterSubnetsPrivate)
+		if !privateNetworking {
+			subnets = makeImportValue(clusterStackName, outputs.ClusterSubnetsPublic)
+		}
+		vpcZoneIdentifier = map[string][]interface{}{
+			gfn.FnSplit: {",", subnets},
+		}
+	}
+	return vpcZoneIdentifier, nil
+}
+
 // GetAllOutputs collects all outputs of the nodegroup
 func (n *NodeGroupResourceSet) GetAllOutputs(stack cfn.Stack) error {
 	return n.rs.GetAllOutputs(stack)
 }
 
+// newLaunchTemplateResource returns the launch template as is, unless it needs
+// properties that the goformation type system doesn't support yet, i.e. the
+// throughput of gp3 volumes and the metadata options, in which case a custom
+// resource is returned
+func newLaunchTemplateResource(launchTemplate *gfn.AWSEC2LaunchTemplate, ng *api.NodeGroup) (interface{}, error) {
+	withThroughput := ng.VolumeThroughput != nil && len(launchTemplate.LaunchTemplateData.BlockDeviceMappings) > 0
+	if !withThroughput && ng.InstanceMetadataOptions == nil {
+		return launchTemplate, nil
+	}
+
+	data, err := json.Marshal(launchTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "serialising launch template")
+	}
+	resource := &awsCloudFormationResource{}
+	if err := json.Unmarshal(data, resource); err != nil {
+		return nil, errors.Wrap(err, "deserialising launch template")
+	}
+
+	launchTemplateData := resource.Properties["LaunchTemplateData"].(map[string]interface{})
+	if withThroughput {
+		blockDeviceMapping := launchTemplateData["BlockDeviceMappings"].([]interface{})[0].(map[string]interface{})
+		blockDeviceMapping["Ebs"].(map[string]interface{})["Throughput"] = *ng.VolumeThroughput
+	}
+	if options := ng.InstanceMetadataOptions; options != nil {
+		metadataOptions := map[string]interface{}{
+			"HttpEndpoint": "enabled",
+		}
+		if options.HTTPTokens != "" {
+			metadataOptions["HttpTokens"] = options.HTTPTokens
+		}
+		if options.HTTPPutResponseHopLimit != nil {
+			metadataOptions["HttpPutResponseHopLimit"] = *options.HTTPPutResponseHopLimit
+		}
+		launchTemplateData["MetadataOptions"] = metadataOptions
+	}
+
+	return resource, nil
+}
+
 func newLaunchTemplateData(n *NodeGroupResourceSet) *gfn.AWSEC2LaunchTemplate_LaunchTemplateData {
 	launchTemplateData := &gfn.AWSEC2LaunchTemplate_LaunchTemplateData{
 		IamInstanceProfile: &gfn.AWSEC2LaunchTemplate_IamInstanceProfile{
@@ -232,6 +422,24 @@ func newLaunchTemplateData(n *NodeGroupResourceSet) *gfn.AWSEC2LaunchTemplate_La
 	if n.spec.EBSOptimized != nil {
 		launchTemplateData.EbsOptimized = gfn.NewBoolean(*n.spec.EBSOptimized)
 	}
+	if api.IsEnabled(n.spec.EnableDetailedMonitoring) {
+		launchTemplateData.Monitoring = &gfn.AWSEC2LaunchTemplate_Monitoring{
+			Enabled: gfn.True(),
+		}
+	}
+	if n.spec.Placement != nil || n.spec.Tenancy != "" {
+		launchTemplateData.Placement = &gfn.AWSEC2LaunchTemplate_Placement{}
+		if n.spec.Placement != nil {
+			if n.spec.Placement.GroupName != "" {
+				launchTemplateData.Placement.GroupName = gfn.NewString(n.spec.Placement.GroupName)
+			} else {
+				launchTemplateData.Placement.GroupName = gfn.MakeRef("NodeGroupPlacementGroup")
+			}
+		}
+		if n.spec.Tenancy != "" {
+			launchTemplateData.Placement.Tenancy = gfn.NewString(n.spec.Tenancy)
+		}
+	}
 
 	return launchTemplateData
 }
@@ -253,6 +461,18 @@ func nodeGroupResource(launchTemplateName *gfn.Value, vpcZoneIdentifier *interfa
 	if len(ng.TargetGroupARNs) > 0 {
 		ngProps["TargetGroupARNs"] = ng.TargetGroupARNs
 	}
+	if len(ng.ASGMetricsCollection) > 0 {
+		metricsCollection := make([]map[string]interface{}, len(ng.ASGMetricsCollection))
+		for i, m := range ng.ASGMetricsCollection {
+			metricsCollection[i] = map[string]interface{}{
+				"Granularity": m.Granularity,
+			}
+			if len(m.Metrics) > 0 {
+				metricsCollection[i]["Metrics"] = m.Metrics
+			}
+		}
+		ngProps["MetricsCollection"] = metricsCollection
+	}
 	if api.HasMixedInstances(ng) {
 		ngProps["MixedInstancesPolicy"] = *mixedInstancesPolicy(launchTemplateName, ng)
 	} else {
@@ -309,6 +529,9 @@ func mixedInstancesPolicy(launchTemplateName *gfn.Value, ng *api.NodeGroup) *map
 	if ng.InstancesDistribution.SpotInstancePools != nil {
 		instancesDistribution["SpotInstancePools"] = fmt.Sprintf("%d", *ng.InstancesDistribution.SpotInstancePools)
 	}
+	if ng.InstancesDistribution.SpotAllocationStrategy != nil {
+		instancesDistribution["SpotAllocationStrategy"] = *ng.InstancesDistribution.SpotAllocationStrategy
+	}
 
 	policy["InstancesDistribution"] = instancesDistribution
 
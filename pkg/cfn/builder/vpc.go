@@ -94,6 +94,16 @@ func (c *ClusterResourceSet) addResourcesForVPC() error {
 
 	c.subnets = make(map[api.SubnetTopology][]*gfn.Value)
 
+	if c.spec.IsFullyPrivate() {
+		// no internet or NAT gateways, nodes reach AWS services via VPC endpoints
+		c.noNAT()
+		c.addSubnets(nil, api.SubnetTopologyPrivate, c.spec.VPC.Subnets.Private)
+		if c.spec.HasCustomNetworking() {
+			c.addResourcesForPodSubnets()
+		}
+		return nil
+	}
+
 	refIG := c.newResource("InternetGateway", &gfn.AWSEC2InternetGateway{})
 	c.newResource("VPCGatewayAttachment", &gfn.AWSEC2VPCGatewayAttachment{
 		InternetGatewayId: refIG,
@@ -117,6 +127,9 @@ func (c *ClusterResourceSet) addResourcesForVPC() error {
 	}
 
 	c.addSubnets(nil, api.SubnetTopologyPrivate, c.spec.VPC.Subnets.Private)
+	if c.spec.HasCustomNetworking() {
+		c.addResourcesForPodSubnets()
+	}
 	return nil
 }
 
@@ -131,7 +144,7 @@ func (c *ClusterResourceSet) addNATGateways() error {
 	case api.ClusterDisableNAT:
 		c.noNAT()
 	default:
-		// TODO validate this before starting to add resources
+		// this is normally caught by api.ValidateClusterConfig
 		return fmt.Errorf("%s is not a valid NAT gateway mode", *c.spec.VPC.NAT.Gateway)
 	}
 	return nil
@@ -146,6 +159,9 @@ func (c *ClusterResourceSet) importResourcesForVPC() {
 	for _, subnet := range c.spec.PublicSubnetIDs() {
 		c.subnets[api.SubnetTopologyPublic] = append(c.subnets[api.SubnetTopologyPublic], gfn.NewString(subnet))
 	}
+	for _, subnet := range c.spec.PodSubnetIDs() {
+		c.podSubnets = append(c.podSubnets, gfn.NewString(subnet))
+	}
 
 }
 
@@ -168,6 +184,11 @@ func (c *ClusterResourceSet) addOutputsForVPC() {
 			return vpc.ImportSubnetsFromList(c.provider, c.spec, api.SubnetTopologyPublic, strings.Split(v, ","))
 		})
 	}
+	if len(c.podSubnets) > 0 {
+		c.rs.defineJoinedOutput(outputs.ClusterSubnetsPod, c.podSubnets, true, func(v string) error {
+			return vpc.ImportPodSubnetsFromList(c.provider, c.spec, strings.Split(v, ","))
+		})
+	}
 }
 
 var (
@@ -194,7 +215,10 @@ func (c *ClusterResourceSet) addResourcesForSecurityGroups() {
 	} else {
 		refControlPlaneSG = gfn.NewString(c.spec.VPC.SecurityGroup)
 	}
-	c.securityGroups = []*gfn.Value{refControlPlaneSG} // only this one SG is passed to EKS API, nodes are isolated
+	c.securityGroups = []*gfn.Value{refControlPlaneSG} // only this SG and user-supplied ones are passed to EKS API, nodes are isolated
+	for _, id := range c.spec.VPC.ControlPlaneSecurityGroupIDs {
+		c.securityGroups = append(c.securityGroups, gfn.NewString(id))
+	}
 
 	if c.spec.VPC.SharedNodeSecurityGroup == "" {
 		refClusterSharedNodeSG = c.newResource("ClusterSharedNodeSecurityGroup", &gfn.AWSEC2SecurityGroup{
@@ -209,6 +233,9 @@ func (c *ClusterResourceSet) addResourcesForSecurityGroups() {
 			FromPort:              sgPortZero,
 			ToPort:                sgMaxNodePort,
 		})
+		if c.spec.HasCustomNetworking() {
+			c.addResourcesForPodSecurityGroupRules(refControlPlaneSG, refClusterSharedNodeSG)
+		}
 	} else {
 		refClusterSharedNodeSG = gfn.NewString(c.spec.VPC.SharedNodeSecurityGroup)
 	}
@@ -240,24 +267,29 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		return
 	}
 
-	desc := "worker nodes in group " + n.nodeGroupName
+	refNodeGroupLocalSG := n.rs.addResourcesForNodeGroupLocalSecurityGroup(n.clusterSpec, n.clusterStackName, n.nodeGroupName, *n.spec.SSH.Allow, n.spec.PrivateNetworking)
+	n.securityGroups = append(n.securityGroups, refNodeGroupLocalSG)
+}
+
+// addResourcesForNodeGroupLocalSecurityGroup creates a security group that allows communication
+// between the control plane and the nodes of the given nodegroup, it returns a reference to it
+func (rs *resourceSet) addResourcesForNodeGroupLocalSecurityGroup(clusterSpec *api.ClusterConfig, clusterStackName, nodeGroupName string, allowSSH, privateNetworking bool) *gfn.Value {
+	desc := "worker nodes in group " + nodeGroupName
 
-	allInternalIPv4 := gfn.NewString(n.clusterSpec.VPC.CIDR.String())
+	allInternalIPv4 := gfn.NewString(clusterSpec.VPC.CIDR.String())
 
-	refControlPlaneSG := makeImportValue(n.clusterStackName, outputs.ClusterSecurityGroup)
+	refControlPlaneSG := makeImportValue(clusterStackName, outputs.ClusterSecurityGroup)
 
-	refNodeGroupLocalSG := n.newResource("SG", &gfn.AWSEC2SecurityGroup{
-		VpcId:            makeImportValue(n.clusterStackName, outputs.ClusterVPC),
+	refNodeGroupLocalSG := rs.newResource("SG", &gfn.AWSEC2SecurityGroup{
+		VpcId:            makeImportValue(clusterStackName, outputs.ClusterVPC),
 		GroupDescription: gfn.NewString("Communication between the control plane and " + desc),
 		Tags: []gfn.Tag{{
-			Key:   gfn.NewString("kubernetes.io/cluster/" + n.clusterSpec.Metadata.Name),
+			Key:   gfn.NewString("kubernetes.io/cluster/" + clusterSpec.Metadata.Name),
 			Value: gfn.NewString("owned"),
 		}},
 	})
 
-	n.securityGroups = append(n.securityGroups, refNodeGroupLocalSG)
-
-	n.newResource("IngressInterCluster", &gfn.AWSEC2SecurityGroupIngress{
+	rs.newResource("IngressInterCluster", &gfn.AWSEC2SecurityGroupIngress{
 		GroupId:               refNodeGroupLocalSG,
 		SourceSecurityGroupId: refControlPlaneSG,
 		Description:           gfn.NewString("Allow " + desc + " to communicate with control plane (kubelet and workload TCP ports)"),
@@ -265,7 +297,7 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		FromPort:              sgMinNodePort,
 		ToPort:                sgMaxNodePort,
 	})
-	n.newResource("EgressInterCluster", &gfn.AWSEC2SecurityGroupEgress{
+	rs.newResource("EgressInterCluster", &gfn.AWSEC2SecurityGroupEgress{
 		GroupId:                    refControlPlaneSG,
 		DestinationSecurityGroupId: refNodeGroupLocalSG,
 		Description:                gfn.NewString("Allow control plane to communicate with " + desc + " (kubelet and workload TCP ports)"),
@@ -273,7 +305,7 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		FromPort:                   sgMinNodePort,
 		ToPort:                     sgMaxNodePort,
 	})
-	n.newResource("IngressInterClusterAPI", &gfn.AWSEC2SecurityGroupIngress{
+	rs.newResource("IngressInterClusterAPI", &gfn.AWSEC2SecurityGroupIngress{
 		GroupId:               refNodeGroupLocalSG,
 		SourceSecurityGroupId: refControlPlaneSG,
 		Description:           gfn.NewString("Allow " + desc + " to communicate with control plane (workloads using HTTPS port, commonly used with extension API servers)"),
@@ -281,7 +313,7 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		FromPort:              sgPortHTTPS,
 		ToPort:                sgPortHTTPS,
 	})
-	n.newResource("EgressInterClusterAPI", &gfn.AWSEC2SecurityGroupEgress{
+	rs.newResource("EgressInterClusterAPI", &gfn.AWSEC2SecurityGroupEgress{
 		GroupId:                    refControlPlaneSG,
 		DestinationSecurityGroupId: refNodeGroupLocalSG,
 		Description:                gfn.NewString("Allow control plane to communicate with " + desc + " (workloads using HTTPS port, commonly used with extension API servers)"),
@@ -289,7 +321,7 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		FromPort:                   sgPortHTTPS,
 		ToPort:                     sgPortHTTPS,
 	})
-	n.newResource("IngressInterClusterCP", &gfn.AWSEC2SecurityGroupIngress{
+	rs.newResource("IngressInterClusterCP", &gfn.AWSEC2SecurityGroupIngress{
 		GroupId:               refControlPlaneSG,
 		SourceSecurityGroupId: refNodeGroupLocalSG,
 		Description:           gfn.NewString("Allow control plane to receive API requests from " + desc),
@@ -297,9 +329,9 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		FromPort:              sgPortHTTPS,
 		ToPort:                sgPortHTTPS,
 	})
-	if *n.spec.SSH.Allow {
-		if n.spec.PrivateNetworking {
-			n.newResource("SSHIPv4", &gfn.AWSEC2SecurityGroupIngress{
+	if allowSSH {
+		if privateNetworking {
+			rs.newResource("SSHIPv4", &gfn.AWSEC2SecurityGroupIngress{
 				GroupId:     refNodeGroupLocalSG,
 				CidrIp:      allInternalIPv4,
 				Description: gfn.NewString("Allow SSH access to " + desc + " (private, only inside VPC)"),
@@ -308,7 +340,7 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 				ToPort:      sgPortSSH,
 			})
 		} else {
-			n.newResource("SSHIPv4", &gfn.AWSEC2SecurityGroupIngress{
+			rs.newResource("SSHIPv4", &gfn.AWSEC2SecurityGroupIngress{
 				GroupId:     refNodeGroupLocalSG,
 				CidrIp:      sgSourceAnywhereIPv4,
 				Description: gfn.NewString("Allow SSH access to " + desc),
@@ -316,7 +348,7 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 				FromPort:    sgPortSSH,
 				ToPort:      sgPortSSH,
 			})
-			n.newResource("SSHIPv6", &gfn.AWSEC2SecurityGroupIngress{
+			rs.newResource("SSHIPv6", &gfn.AWSEC2SecurityGroupIngress{
 				GroupId:     refNodeGroupLocalSG,
 				CidrIpv6:    sgSourceAnywhereIPv6,
 				Description: gfn.NewString("Allow SSH access to " + desc),
@@ -326,6 +358,8 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 			})
 		}
 	}
+
+	return refNodeGroupLocalSG
 }
 
 func (c *ClusterResourceSet) haNAT() {
@@ -0,0 +1,88 @@
+package builder
+
+import (
+	"strings"
+
+	gfn "github.com/awslabs/goformation/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// s3 is only available as a gateway endpoint, all other services use interface endpoints
+const s3EndpointService = "s3"
+
+func makeEndpointServiceName(service string) *gfn.Value {
+	return gfn.MakeFnSubString("com.amazonaws.${AWS::Region}." + service)
+}
+
+func makeEndpointResourceName(service string) string {
+	alias := ""
+	for _, part := range strings.FieldsFunc(service, func(r rune) bool { return r == '.' || r == '-' }) {
+		alias += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return "VPCEndpoint" + alias
+}
+
+// addResourcesForVPCEndpoints creates the VPC endpoints that are needed
+// for nodes to join the cluster without access to the internet
+func (c *ClusterResourceSet) addResourcesForVPCEndpoints(dedicatedVPC bool) error {
+	refEndpointSG := c.newResource("VPCEndpointSecurityGroup", &gfn.AWSEC2SecurityGroup{
+		GroupDescription: gfn.NewString("Allow HTTPS access to VPC endpoints from within the VPC"),
+		VpcId:            c.vpc,
+		SecurityGroupIngress: []gfn.AWSEC2SecurityGroup_Ingress{{
+			CidrIp:      gfn.NewString(c.spec.VPC.CIDR.String()),
+			Description: gfn.NewString("Allow HTTPS access to VPC endpoints"),
+			IpProtocol:  sgProtoTCP,
+			FromPort:    sgPortHTTPS,
+			ToPort:      sgPortHTTPS,
+		}},
+	})
+
+	for _, service := range c.spec.EndpointServices() {
+		if service == s3EndpointService {
+			refRouteTables, err := c.privateRouteTables(dedicatedVPC)
+			if err != nil {
+				return err
+			}
+			c.newResource(makeEndpointResourceName(service), &gfn.AWSEC2VPCEndpoint{
+				ServiceName:     makeEndpointServiceName(service),
+				VpcId:           c.vpc,
+				VpcEndpointType: gfn.NewString("Gateway"),
+				RouteTableIds:   refRouteTables,
+			})
+			continue
+		}
+		c.newResource(makeEndpointResourceName(service), &gfn.AWSEC2VPCEndpoint{
+			ServiceName:       makeEndpointServiceName(service),
+			VpcId:             c.vpc,
+			VpcEndpointType:   gfn.NewString("Interface"),
+			PrivateDnsEnabled: gfn.True(),
+			SubnetIds:         c.subnets[api.SubnetTopologyPrivate],
+			SecurityGroupIds:  []*gfn.Value{refEndpointSG},
+		})
+	}
+	return nil
+}
+
+// privateRouteTables returns references to the route tables of the private subnets,
+// for an existing VPC these have to be looked up
+func (c *ClusterResourceSet) privateRouteTables(dedicatedVPC bool) ([]*gfn.Value, error) {
+	var refRouteTables []*gfn.Value
+	if dedicatedVPC {
+		for _, az := range c.spec.AvailabilityZones {
+			alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
+			refRouteTables = append(refRouteTables, gfn.MakeRef("PrivateRouteTable"+alphanumericUpperAZ))
+		}
+		return refRouteTables, nil
+	}
+
+	routeTableIDs, err := vpc.GetRouteTableIDs(c.provider, c.spec.VPC.ID, c.spec.PrivateSubnetIDs())
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range routeTableIDs {
+		refRouteTables = append(refRouteTables, gfn.NewString(id))
+	}
+	return refRouteTables, nil
+}
@@ -0,0 +1,147 @@
+package manager
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// makeAddonStackName generates the name of the stack holding the IAM role of an EKS managed add-on, isolated by the cluster this StackCollection operates on
+func (c *StackCollection) makeAddonStackName(name string) string {
+	return fmt.Sprintf("eksctl-%s-addon-%s", c.spec.Metadata.Name, name)
+}
+
+// DescribeAddonStacks calls DescribeStacks and filters out the stacks holding IAM roles of EKS managed add-ons
+func (c *StackCollection) DescribeAddonStacks() ([]*Stack, error) {
+	stacks, err := c.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	addonStacks := []*Stack{}
+	for _, s := range stacks {
+		if *s.StackStatus == cfn.StackStatusDeleteComplete {
+			continue
+		}
+		if c.GetAddonName(s) != "" {
+			addonStacks = append(addonStacks, s)
+		}
+	}
+	logger.Debug("addons = %v", addonStacks)
+	return addonStacks, nil
+}
+
+// DescribeAddonStack returns the stack holding the IAM role of an EKS managed add-on, or nil if there is none
+func (c *StackCollection) DescribeAddonStack(name string) (*Stack, error) {
+	stacks, err := c.DescribeAddonStacks()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stacks {
+		if c.GetAddonName(s) == name {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateAddonIAMRole creates a stack with an IAM role for the given service account of an EKS
+// managed add-on, and returns the ARN of the role; when the stack exists already, the ARN of
+// its role is returned
+func (c *StackCollection) CreateAddonIAMRole(addonName string, serviceAccount *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) (string, error) {
+	existing, err := c.DescribeAddonStack(addonName)
+	if err != nil {
+		return "", err
+	}
+	if existing != nil {
+		logger.Info("using the IAM role of existing stack %q for add-on %q", *existing.StackName, addonName)
+		return getAddonRoleARN(existing)
+	}
+
+	name := c.makeAddonStackName(addonName)
+	logger.Info("building IAM role stack %q for add-on %q", name, addonName)
+	stack := builder.NewIAMServiceAccountResourceSet(serviceAccount, oidc)
+	if err := stack.AddAllResources(); err != nil {
+		return "", err
+	}
+
+	tags := map[string]string{api.AddonNameTag: addonName}
+
+	errs := make(chan error)
+	if err := c.CreateStack(name, stack, tags, nil, errs); err != nil {
+		return "", err
+	}
+	if err := <-errs; err != nil {
+		return "", errors.Wrapf(err, "creating IAM role stack for add-on %q", addonName)
+	}
+	if serviceAccount.Status == nil || serviceAccount.Status.RoleARN == nil {
+		return "", fmt.Errorf("no role ARN in the outputs of stack %q", name)
+	}
+	return *serviceAccount.Status.RoleARN, nil
+}
+
+// DeleteAddonIAMRole deletes the stack with the IAM role of an EKS managed add-on, if there is one
+func (c *StackCollection) DeleteAddonIAMRole(addonName string) error {
+	s, err := c.DescribeAddonStack(addonName)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return nil
+	}
+	errs := make(chan error)
+	if err := c.DeleteStackBySpecSync(s, errs); err != nil {
+		return err
+	}
+	if err := <-errs; err != nil {
+		return errors.Wrapf(err, "deleting IAM role stack for add-on %q", addonName)
+	}
+	return nil
+}
+
+// GetAddonName will return the name of the EKS managed add-on based on tags
+func (*StackCollection) GetAddonName(s *Stack) string {
+	for _, tag := range s.Tags {
+		if *tag.Key == api.AddonNameTag {
+			return *tag.Value
+		}
+	}
+	return ""
+}
+
+func getAddonRoleARN(s *Stack) (string, error) {
+	var roleARN string
+	collectors := outputs.NewCollectorSet(map[string]outputs.Collector{
+		"Role1": func(v string) error {
+			roleARN = v
+			return nil
+		},
+	})
+	if err := collectors.MustCollect(*s); err != nil {
+		return "", err
+	}
+	return roleARN, nil
+}
+
+// GetAddonIAMRolePolicyARNs returns the ARNs of the policies attached to the IAM role eksctl
+// created for the given add-on, or nil if the add-on doesn't use such a role
+func (c *StackCollection) GetAddonIAMRolePolicyARNs(addonName string) ([]string, error) {
+	s, err := c.DescribeAddonStack(addonName)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	template, err := c.GetStackTemplate(*s.StackName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting Cloudformation template for stack %s", *s.StackName)
+	}
+	serviceAccount := &api.ClusterIAMServiceAccount{}
+	mapTemplateToIAMServiceAccountPolicies(template, serviceAccount)
+	return serviceAccount.AttachPolicyARNs, nil
+}
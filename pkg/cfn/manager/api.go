@@ -3,15 +3,17 @@ package manager
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -42,6 +44,13 @@ type StackCollection struct {
 	provider   api.ClusterProvider
 	spec       *api.ClusterConfig
 	sharedTags []*cloudformation.Tag
+	// terminationProtection is enabled for stacks that are created
+	terminationProtection bool
+
+	// deletionRequestedStackIDs are the IDs of the stacks that deletion was
+	// requested for, so that they can be reported when it's not waited for
+	deletionRequestedStackIDs []string
+	deletionRequestedMutex    sync.Mutex
 }
 
 func newTag(key, value string) *cloudformation.Tag {
@@ -91,6 +100,10 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateBody []byte, ta
 		input = input.SetRoleARN(cfnRole)
 	}
 
+	if c.terminationProtection {
+		input.SetEnableTerminationProtection(true)
+	}
+
 	for k, v := range parameters {
 		p := &cloudformation.Parameter{
 			ParameterKey:   aws.String(k),
@@ -317,6 +330,9 @@ func (c *StackCollection) DeleteStackByNameSync(name string, errs chan error) er
 
 // DeleteStackBySpec sends a request to delete the stack
 func (c *StackCollection) DeleteStackBySpec(s *Stack) (*Stack, error) {
+	if aws.BoolValue(s.EnableTerminationProtection) {
+		return nil, fmt.Errorf("cannot delete stack %q as termination protection is enabled for it, disable it first with 'eksctl utils set-stack-protection --disable'", *s.StackName)
+	}
 	for _, tag := range s.Tags {
 		if matchesClusterName(*tag.Key, *tag.Value, c.spec.Metadata.Name) {
 			input := &cloudformation.DeleteStackInput{
@@ -330,7 +346,10 @@ func (c *StackCollection) DeleteStackBySpec(s *Stack) (*Stack, error) {
 			if _, err := c.provider.CloudFormation().DeleteStack(input); err != nil {
 				return nil, errors.Wrapf(err, "not able to delete stack %q", *s.StackName)
 			}
-			logger.Info("will delete stack %q", *s.StackName)
+			logger.Info("will delete stack %q (%s)", *s.StackName, *s.StackId)
+			c.deletionRequestedMutex.Lock()
+			c.deletionRequestedStackIDs = append(c.deletionRequestedStackIDs, *s.StackId)
+			c.deletionRequestedMutex.Unlock()
 			return s, nil
 		}
 	}
@@ -340,6 +359,14 @@ func (c *StackCollection) DeleteStackBySpec(s *Stack) (*Stack, error) {
 		fmt.Sprintf("%s:%s", api.ClusterNameTag, c.spec.Metadata.Name))
 }
 
+// DeletionRequestedStackIDs returns the IDs of the stacks that deletion
+// has been requested for by this stack manager
+func (c *StackCollection) DeletionRequestedStackIDs() []string {
+	c.deletionRequestedMutex.Lock()
+	defer c.deletionRequestedMutex.Unlock()
+	return append([]string(nil), c.deletionRequestedStackIDs...)
+}
+
 func matchesClusterName(key, value, name string) bool {
 	if key == api.ClusterNameTag && value == name {
 		return true
@@ -368,7 +395,7 @@ func (c *StackCollection) DeleteStackBySpecSync(s *Stack, errs chan error) error
 }
 
 func fmtStacksRegexForCluster(name string) string {
-	const ourStackRegexFmt = "^(eksctl|EKS)-%s-((cluster|nodegroup-.+|addon-.+)|(VPC|ServiceRole|ControlPlane|DefaultNodeGroup))$"
+	const ourStackRegexFmt = "^(eksctl|EKS)-%s-((cluster|nodegroup-.+|fargate-.+|addon-.+)|(VPC|ServiceRole|ControlPlane|DefaultNodeGroup))$"
 	return fmt.Sprintf(ourStackRegexFmt, name)
 }
 
@@ -410,6 +437,65 @@ func (c *StackCollection) DescribeStackEvents(i *Stack) ([]*cloudformation.Stack
 	return events, nil
 }
 
+// FailedStackEvents picks failure events from events of the most recent operation, which CloudFormation
+// returns newest first, and which start with a "User Initiated" event of the stack itself; resources
+// that were only cancelled due to other failures are left out, unless nothing else failed
+func FailedStackEvents(stackName string, events []*cloudformation.StackEvent) []*cloudformation.StackEvent {
+	failed, cancelled := []*cloudformation.StackEvent{}, []*cloudformation.StackEvent{}
+	for _, e := range events {
+		isStack := aws.StringValue(e.LogicalResourceId) == stackName && aws.StringValue(e.ResourceType) == "AWS::CloudFormation::Stack"
+		if isStack {
+			if aws.StringValue(e.ResourceStatusReason) == "User Initiated" {
+				break
+			}
+			continue
+		}
+		switch aws.StringValue(e.ResourceStatus) {
+		case cloudformation.ResourceStatusCreateFailed, cloudformation.ResourceStatusUpdateFailed, cloudformation.ResourceStatusDeleteFailed:
+			if strings.HasSuffix(aws.StringValue(e.ResourceStatusReason), " cancelled") {
+				cancelled = append(cancelled, e)
+			} else {
+				failed = append(failed, e)
+			}
+		}
+	}
+	if len(failed) == 0 {
+		return cancelled
+	}
+	return failed
+}
+
+// FormatStackEvent formats the resource, status and status reason of the event
+func FormatStackEvent(e *cloudformation.StackEvent) string {
+	msg := fmt.Sprintf("%s/%s: %s", aws.StringValue(e.ResourceType), aws.StringValue(e.LogicalResourceId), aws.StringValue(e.ResourceStatus))
+	if e.ResourceStatusReason != nil {
+		msg = fmt.Sprintf("%s – %#v", msg, *e.ResourceStatusReason)
+	}
+	return msg
+}
+
+// ListStackResources lists all resources of the stack
+func (c *StackCollection) ListStackResources(i *Stack) ([]*cloudformation.StackResourceSummary, error) {
+	input := &cloudformation.ListStackResourcesInput{
+		StackName: i.StackName,
+	}
+	if api.IsSetAndNonEmptyString(i.StackId) {
+		input.StackName = i.StackId
+	}
+
+	resources := []*cloudformation.StackResourceSummary{}
+
+	pager := func(p *cloudformation.ListStackResourcesOutput, _ bool) bool {
+		resources = append(resources, p.StackResourceSummaries...)
+		return true
+	}
+	if err := c.provider.CloudFormation().ListStackResourcesPages(input, pager); err != nil {
+		return nil, errors.Wrapf(err, "listing resources of CloudFormation stack %q", *i.StackName)
+	}
+
+	return resources, nil
+}
+
 // LookupCloudTrailEvents looks up stack events in CloudTrail
 func (c *StackCollection) LookupCloudTrailEvents(i *Stack) ([]*cloudtrail.Event, error) {
 	input := &cloudtrail.LookupEventsInput{
@@ -5,7 +5,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
@@ -14,6 +13,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // MakeChangeSetName builds a consistent name for a changeset.
@@ -38,6 +38,33 @@ func (c *StackCollection) createClusterTask(errs chan error) error {
 	return c.CreateStack(name, stack, nil, nil, errs)
 }
 
+// createRegisteredClusterTask creates the cluster stack of a cluster that was not created by eksctl
+func (c *StackCollection) createRegisteredClusterTask(errs chan error) error {
+	name := c.makeClusterStackName()
+	logger.Info("building cluster stack %q", name)
+	stack := builder.NewClusterResourceSet(c.provider, c.spec)
+	if err := stack.AddAllResourcesForRegisteredCluster(); err != nil {
+		return err
+	}
+
+	return c.CreateStack(name, stack, nil, nil, errs)
+}
+
+// HasClusterStack returns true when the cluster has a cluster stack, i.e. when it
+// was created or registered by eksctl
+func (c *StackCollection) HasClusterStack() (bool, error) {
+	stacks, err := c.ListStacks(fmtStacksRegexForCluster(c.spec.Metadata.Name))
+	if err != nil {
+		return false, errors.Wrapf(err, "describing CloudFormation stacks for %q", c.spec.Metadata.Name)
+	}
+	for _, s := range stacks {
+		if getClusterName(s) != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // DescribeClusterStack calls DescribeStacks and filters out cluster stack
 func (c *StackCollection) DescribeClusterStack() (*Stack, error) {
 	stacks, err := c.DescribeStacks()
@@ -9,8 +9,9 @@ import (
 )
 
 // NewTasksToCreateClusterWithNodeGroups defines all tasks required to create a cluster along
-// with some nodegroups; see CreateAllNodeGroups for how onlyNodeGroupSubset works
-func (c *StackCollection) NewTasksToCreateClusterWithNodeGroups(nodeGroups []*api.NodeGroup) *TaskTree {
+// with some nodegroups, managed nodegroups and Fargate profiles; see CreateAllNodeGroups for how
+// onlyNodeGroupSubset works
+func (c *StackCollection) NewTasksToCreateClusterWithNodeGroups(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup, fargateProfiles []*api.FargateProfile, postClusterCreationTasks ...Task) *TaskTree {
 	tasks := &TaskTree{Parallel: false}
 
 	tasks.Append(
@@ -20,12 +21,35 @@ func (c *StackCollection) NewTasksToCreateClusterWithNodeGroups(nodeGroups []*ap
 		},
 	)
 
-	nodeGroupTasks := c.NewTasksToCreateNodeGroups(nodeGroups)
+	// these run before any nodes are created, e.g. to configure networking
+	tasks.Append(postClusterCreationTasks...)
+
+	nodeGroupTasks := c.NewTasksToCreateAllNodeGroups(nodeGroups, managedNodeGroups)
 	if nodeGroupTasks.Len() > 0 {
 		nodeGroupTasks.IsSubTask = true
 		tasks.Append(nodeGroupTasks)
 	}
 
+	fargateProfileTasks := c.NewTasksToCreateFargateProfiles(fargateProfiles)
+	if fargateProfileTasks.Len() > 0 {
+		fargateProfileTasks.IsSubTask = true
+		tasks.Append(fargateProfileTasks)
+	}
+
+	return tasks
+}
+
+// NewTasksToRegisterCluster defines tasks required to register a cluster that was not created by eksctl
+func (c *StackCollection) NewTasksToRegisterCluster() *TaskTree {
+	tasks := &TaskTree{Parallel: false}
+
+	tasks.Append(
+		&taskWithoutParams{
+			info: fmt.Sprintf("register cluster %q", c.spec.Metadata.Name),
+			call: c.createRegisteredClusterTask,
+		},
+	)
+
 	return tasks
 }
 
@@ -45,6 +69,45 @@ func (c *StackCollection) NewTasksToCreateNodeGroups(nodeGroups []*api.NodeGroup
 	return tasks
 }
 
+// NewTasksToCreateAllNodeGroups defines tasks required to create all of the nodegroups
+// and managed nodegroups, all of which get created in parallel
+func (c *StackCollection) NewTasksToCreateAllNodeGroups(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup) *TaskTree {
+	tasks := c.NewTasksToCreateNodeGroups(nodeGroups)
+	tasks.Append(c.NewTasksToCreateManagedNodeGroups(managedNodeGroups).tasks...)
+	return tasks
+}
+
+// NewTasksToCreateManagedNodeGroups defines tasks required to create all of the managed nodegroups
+func (c *StackCollection) NewTasksToCreateManagedNodeGroups(nodeGroups []*api.ManagedNodeGroup) *TaskTree {
+	tasks := &TaskTree{Parallel: true}
+
+	for _, ng := range nodeGroups {
+		tasks.Append(&taskWithManagedNodeGroupSpec{
+			info:      fmt.Sprintf("create managed nodegroup %q", ng.NameString()),
+			nodeGroup: ng,
+			call:      c.createManagedNodeGroupTask,
+		})
+	}
+
+	return tasks
+}
+
+// NewTasksToCreateFargateProfiles defines tasks required to create all of the Fargate profiles,
+// they get created one after another, as EKS doesn't allow concurrent changes of Fargate profiles
+func (c *StackCollection) NewTasksToCreateFargateProfiles(fargateProfiles []*api.FargateProfile) *TaskTree {
+	tasks := &TaskTree{Parallel: false}
+
+	for _, fp := range fargateProfiles {
+		tasks.Append(&taskWithFargateProfileSpec{
+			info:           fmt.Sprintf("create Fargate profile %q", fp.NameString()),
+			fargateProfile: fp,
+			call:           c.createFargateProfileTask,
+		})
+	}
+
+	return tasks
+}
+
 // NewTasksToCreateIAMServiceAccounts defines tasks required to create all of the IAM ServiceAccounts
 func (c *StackCollection) NewTasksToCreateIAMServiceAccounts(serviceAccounts []*api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *TaskTree {
 	tasks := &TaskTree{Parallel: true}
@@ -6,14 +6,16 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 )
 
 func deleteAll(_ string) bool { return true }
 
-// NewTasksToDeleteClusterWithNodeGroups defines tasks required to delete the given cluster along with all of its resources
-func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider bool, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, wait bool, cleanup func(chan error, string) error) (*TaskTree, error) {
+// NewTasksToDeleteClusterWithNodeGroups defines tasks required to delete the given cluster along with all of its resources,
+// preClusterDeletionTasks run after all other stacks are deleted and before the cluster stack is deleted
+func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider bool, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, wait bool, cleanup func(chan error, string) error, preClusterDeletionTasks ...Task) (*TaskTree, error) {
 	tasks := &TaskTree{Parallel: false}
 
 	nodeGroupTasks, err := c.NewTasksToDeleteNodeGroups(deleteAll, true, cleanup)
@@ -26,6 +28,24 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvid
 		tasks.Append(nodeGroupTasks)
 	}
 
+	fargateProfileTasks, err := c.NewTasksToDeleteFargateProfiles(deleteAll, true)
+	if err != nil {
+		return nil, err
+	}
+	if fargateProfileTasks.Len() > 0 {
+		fargateProfileTasks.IsSubTask = true
+		tasks.Append(fargateProfileTasks)
+	}
+
+	addonTasks, err := c.NewTasksToDeleteAddonIAMRoles(deleteAll, true)
+	if err != nil {
+		return nil, err
+	}
+	if addonTasks.Len() > 0 {
+		addonTasks.IsSubTask = true
+		tasks.Append(addonTasks)
+	}
+
 	if deleteOIDCProvider {
 		serviceAccountAndOIDCTasks, err := c.NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(oidc, clientSetGetter)
 		if err != nil {
@@ -43,7 +63,17 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvid
 		return nil, err
 	}
 
+	if clusterStack != nil {
+		for _, t := range preClusterDeletionTasks {
+			tasks.Append(t)
+		}
+	}
+
 	info := fmt.Sprintf("delete cluster control plane %q", c.spec.Metadata.Name)
+	if outputs.Exists(*clusterStack, outputs.ClusterFeatureRegistered) {
+		// the control plane of a registered cluster is not part of its stack
+		info = fmt.Sprintf("delete cluster stack of registered cluster %q, its control plane is left as it is", c.spec.Metadata.Name)
+	}
 	if wait {
 		tasks.Append(&taskWithStackSpec{
 			info:  info,
@@ -61,7 +91,8 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvid
 	return tasks, nil
 }
 
-// NewTasksToDeleteNodeGroups defines tasks required to delete all of the nodegroups
+// NewTasksToDeleteNodeGroups defines tasks required to delete all of the nodegroups,
+// stacks of different nodegroups get deleted in parallel
 func (c *StackCollection) NewTasksToDeleteNodeGroups(shouldDelete func(string) bool, wait bool, cleanup func(chan error, string) error) (*TaskTree, error) {
 	nodeGroupStacks, err := c.DescribeNodeGroupStacks()
 	if err != nil {
@@ -76,13 +107,92 @@ func (c *StackCollection) NewTasksToDeleteNodeGroups(shouldDelete func(string) b
 		if !shouldDelete(name) {
 			continue
 		}
+		var deleteTask Task
+		info := fmt.Sprintf("delete nodegroup %q", name)
+		if wait {
+			deleteTask = &taskWithStackSpec{
+				info:  info,
+				stack: s,
+				call:  c.DeleteStackBySpecSync,
+			}
+		} else {
+			deleteTask = &asyncTaskWithStackSpec{
+				info:  info,
+				stack: s,
+				call:  c.DeleteStackBySpec,
+			}
+		}
+
 		if *s.StackStatus == cloudformation.StackStatusDeleteFailed && cleanup != nil {
-			tasks.Append(&taskWithNameParam{
+			// cleanup has to complete before the stack is deleted, while
+			// stacks of other nodegroups are still deleted in parallel
+			nodeGroupTasks := &TaskTree{Parallel: false, IsSubTask: true}
+			nodeGroupTasks.Append(&taskWithNameParam{
 				info: fmt.Sprintf("cleanup for nodegroup %q", name),
+				name: name,
 				call: cleanup,
 			})
+			nodeGroupTasks.Append(deleteTask)
+			tasks.Append(nodeGroupTasks)
+			continue
 		}
-		info := fmt.Sprintf("delete nodegroup %q", name)
+		tasks.Append(deleteTask)
+	}
+
+	return tasks, nil
+}
+
+// NewTasksToDeleteFargateProfiles defines tasks required to delete all of the Fargate profiles,
+// they get deleted one after another, as EKS doesn't allow concurrent changes of Fargate profiles
+func (c *StackCollection) NewTasksToDeleteFargateProfiles(shouldDelete func(string) bool, wait bool) (*TaskTree, error) {
+	fargateProfileStacks, err := c.DescribeFargateProfileStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := &TaskTree{Parallel: false}
+
+	for _, s := range fargateProfileStacks {
+		name := c.GetFargateProfileName(s)
+
+		if !shouldDelete(name) {
+			continue
+		}
+		info := fmt.Sprintf("delete Fargate profile %q", name)
+		if wait {
+			tasks.Append(&taskWithStackSpec{
+				info:  info,
+				stack: s,
+				call:  c.DeleteStackBySpecSync,
+			})
+		} else {
+			tasks.Append(&asyncTaskWithStackSpec{
+				info:  info,
+				stack: s,
+				call:  c.DeleteStackBySpec,
+			})
+		}
+	}
+
+	return tasks, nil
+}
+
+// NewTasksToDeleteAddonIAMRoles defines tasks required to delete the IAM roles of EKS managed add-ons
+func (c *StackCollection) NewTasksToDeleteAddonIAMRoles(shouldDelete func(string) bool, wait bool) (*TaskTree, error) {
+	addonStacks, err := c.DescribeAddonStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := &TaskTree{Parallel: true}
+
+	for _, s := range addonStacks {
+		name := c.GetAddonName(s)
+
+		if !shouldDelete(name) {
+			continue
+		}
+		info := fmt.Sprintf("delete IAM role of add-on %q", name)
 		if wait {
 			tasks.Append(&taskWithStackSpec{
 				info:  info,
@@ -0,0 +1,49 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StackCollection events", func() {
+	const stackName = "eksctl-test-cluster-cluster"
+
+	newEvent := func(logicalID, resourceType, status, reason string) *cfn.StackEvent {
+		e := &cfn.StackEvent{
+			LogicalResourceId: aws.String(logicalID),
+			ResourceType:      aws.String(resourceType),
+			ResourceStatus:    aws.String(status),
+		}
+		if reason != "" {
+			e.ResourceStatusReason = aws.String(reason)
+		}
+		return e
+	}
+
+	It("should only return failures of the most recent operation", func() {
+		events := []*cfn.StackEvent{
+			newEvent(stackName, "AWS::CloudFormation::Stack", cfn.StackStatusRollbackInProgress, "The following resource(s) failed to create: [ControlPlane]."),
+			newEvent("ServiceRole", "AWS::IAM::Role", cfn.ResourceStatusCreateFailed, "Resource creation cancelled"),
+			newEvent("ControlPlane", "AWS::EKS::Cluster", cfn.ResourceStatusCreateFailed, "Cannot create cluster, subnets are in the same AZ"),
+			newEvent("VPC", "AWS::EC2::VPC", cfn.ResourceStatusCreateComplete, ""),
+			newEvent(stackName, "AWS::CloudFormation::Stack", cfn.StackStatusCreateInProgress, "User Initiated"),
+			newEvent("VPC", "AWS::EC2::VPC", cfn.ResourceStatusDeleteFailed, "an earlier failure"),
+		}
+
+		failed := FailedStackEvents(stackName, events)
+		Expect(failed).To(HaveLen(1))
+		Expect(FormatStackEvent(failed[0])).To(Equal(`AWS::EKS::Cluster/ControlPlane: CREATE_FAILED – "Cannot create cluster, subnets are in the same AZ"`))
+	})
+
+	It("should return cancelled resources when nothing else failed", func() {
+		events := []*cfn.StackEvent{
+			newEvent("ServiceRole", "AWS::IAM::Role", cfn.ResourceStatusCreateFailed, "Resource creation cancelled"),
+			newEvent(stackName, "AWS::CloudFormation::Stack", cfn.StackStatusCreateInProgress, "User Initiated"),
+		}
+
+		Expect(FailedStackEvents(stackName, events)).To(Equal(events[:1]))
+		Expect(FailedStackEvents(stackName, events[1:])).To(BeEmpty())
+	})
+})
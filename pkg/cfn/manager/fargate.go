@@ -0,0 +1,158 @@
+package manager
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	fargateProfilePropertiesPath = resourcesRootPath + ".FargateProfile.Properties"
+)
+
+// makeFargateProfileStackName generates the name of the Fargate profile stack identified by its name, isolated by the cluster this StackCollection operates on
+func (c *StackCollection) makeFargateProfileStackName(name string) string {
+	return fmt.Sprintf("eksctl-%s-fargate-%s", c.spec.Metadata.Name, name)
+}
+
+// createFargateProfileTask creates the Fargate profile
+func (c *StackCollection) createFargateProfileTask(errs chan error, fp *api.FargateProfile) error {
+	name := c.makeFargateProfileStackName(fp.Name)
+	logger.Info("building Fargate profile stack %q", name)
+	stack := builder.NewFargateProfileResourceSet(c.spec, c.makeClusterStackName(), fp)
+	if err := stack.AddAllResources(); err != nil {
+		return err
+	}
+
+	tags := map[string]string{}
+	for k, v := range fp.Tags {
+		tags[k] = v
+	}
+	tags[api.FargateProfileNameTag] = fp.Name
+
+	return c.CreateStack(name, stack, tags, nil, errs)
+}
+
+// DescribeFargateProfileStacks calls DescribeStacks and filters out Fargate profiles
+func (c *StackCollection) DescribeFargateProfileStacks() ([]*Stack, error) {
+	stacks, err := c.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	fargateProfileStacks := []*Stack{}
+	for _, s := range stacks {
+		if *s.StackStatus == cfn.StackStatusDeleteComplete {
+			continue
+		}
+		if c.GetFargateProfileName(s) != "" {
+			fargateProfileStacks = append(fargateProfileStacks, s)
+		}
+	}
+	logger.Debug("fargateprofiles = %v", fargateProfileStacks)
+	return fargateProfileStacks, nil
+}
+
+// ListFargateProfileStacks calls DescribeFargateProfileStacks and returns only Fargate profile names
+func (c *StackCollection) ListFargateProfileStacks() ([]string, error) {
+	stacks, err := c.DescribeFargateProfileStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, s := range stacks {
+		names = append(names, c.GetFargateProfileName(s))
+	}
+	return names, nil
+}
+
+// GetFargateProfiles calls DescribeFargateProfileStacks and returns native Fargate profiles,
+// which are reconstructed from the templates and outputs of the stacks
+func (c *StackCollection) GetFargateProfiles() ([]*api.FargateProfile, error) {
+	stacks, err := c.DescribeFargateProfileStacks()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting Fargate profile stacks")
+	}
+
+	results := []*api.FargateProfile{}
+	for _, s := range stacks {
+		fp, err := c.mapStackToFargateProfile(s)
+		if err != nil {
+			return nil, errors.Wrap(err, "mapping stack to Fargate profile")
+		}
+		results = append(results, fp)
+	}
+	return results, nil
+}
+
+func (c *StackCollection) mapStackToFargateProfile(s *Stack) (*api.FargateProfile, error) {
+	template, err := c.GetStackTemplate(*s.StackName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting Cloudformation template for stack %s", *s.StackName)
+	}
+
+	fp := &api.FargateProfile{
+		Name:      c.GetFargateProfileName(s),
+		Selectors: []api.FargateProfileSelector{},
+	}
+
+	properties := gjson.Get(template, fargateProfilePropertiesPath)
+	for _, selector := range properties.Get("Selectors").Array() {
+		fp.Selectors = append(fp.Selectors, api.FargateProfileSelector{
+			Namespace: selector.Get("Namespace").String(),
+			Labels:    keyValuePairsToMap(selector.Get("Labels")),
+		})
+	}
+	// subnets are only known when they were given explicitly, otherwise
+	// they are imported from the cluster stack
+	for _, subnet := range properties.Get("Subnets").Array() {
+		if subnet.Type == gjson.String {
+			fp.Subnets = append(fp.Subnets, subnet.String())
+		}
+	}
+	if tags := keyValuePairsToMap(properties.Get("Tags")); len(tags) > 0 {
+		fp.Tags = tags
+	}
+
+	// outputs are not available until the stack is created
+	optionalOutputs := map[string]outputs.Collector{
+		outputs.FargatePodExecutionRoleARN: func(v string) error {
+			fp.PodExecutionRoleARN = v
+			return nil
+		},
+	}
+	if err := outputs.Collect(*s, nil, optionalOutputs); err != nil {
+		return nil, err
+	}
+
+	return fp, nil
+}
+
+func keyValuePairsToMap(pairs gjson.Result) map[string]string {
+	if !pairs.IsArray() {
+		return nil
+	}
+	kv := map[string]string{}
+	for _, pair := range pairs.Array() {
+		kv[pair.Get("Key").String()] = pair.Get("Value").String()
+	}
+	return kv
+}
+
+// GetFargateProfileName will return Fargate profile name based on tags
+func (*StackCollection) GetFargateProfileName(s *Stack) string {
+	for _, tag := range s.Tags {
+		if *tag.Key == api.FargateProfileNameTag {
+			return *tag.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,129 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection Fargate profiles", func() {
+	var (
+		sc *StackCollection
+		p  *mockprovider.MockProvider
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Name = "test-cluster"
+
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.ListStacksOutput{
+				StackSummaries: []*cfn.StackSummary{
+					{StackName: aws.String("eksctl-test-cluster-fargate-fp-1")},
+					{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")},
+				},
+			}, true)
+		}).Return(nil)
+
+		p.MockCloudFormation().On("DescribeStacks", mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
+			return input.StackName != nil && *input.StackName == "eksctl-test-cluster-fargate-fp-1"
+		})).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{
+				{
+					StackName:   aws.String("eksctl-test-cluster-fargate-fp-1"),
+					StackStatus: aws.String(cfn.StackStatusCreateComplete),
+					Tags: []*cfn.Tag{
+						{
+							Key:   aws.String(api.FargateProfileNameTag),
+							Value: aws.String("fp-1"),
+						},
+					},
+					Outputs: []*cfn.Output{
+						{
+							OutputKey:   aws.String("PodExecutionRoleARN"),
+							OutputValue: aws.String("arn:aws:iam::123456789012:role/pod-execution-role"),
+						},
+					},
+				},
+			},
+		}, nil)
+
+		p.MockCloudFormation().On("DescribeStacks", mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
+			return input.StackName != nil && *input.StackName == "eksctl-test-cluster-nodegroup-ng-1"
+		})).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{
+				{
+					StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+					StackStatus: aws.String(cfn.StackStatusCreateComplete),
+					Tags: []*cfn.Tag{
+						{
+							Key:   aws.String(api.NodeGroupNameTag),
+							Value: aws.String("ng-1"),
+						},
+					},
+				},
+			},
+		}, nil)
+
+		p.MockCloudFormation().On("GetTemplate", mock.MatchedBy(func(input *cfn.GetTemplateInput) bool {
+			return input.StackName != nil && *input.StackName == "eksctl-test-cluster-fargate-fp-1"
+		})).Return(&cfn.GetTemplateOutput{
+			TemplateBody: aws.String(`{
+				"Resources": {
+					"FargateProfile": {
+						"Type": "AWS::EKS::FargateProfile",
+						"Properties": {
+							"Selectors": [
+								{ "Namespace": "default" },
+								{ "Namespace": "dev", "Labels": [ { "Key": "env", "Value": "dev" } ] }
+							],
+							"Subnets": { "Fn::Split": [ ",", { "Fn::ImportValue": "eksctl-test-cluster-cluster::SubnetsPrivate" } ] }
+						}
+					}
+				}
+			}`),
+		}, nil)
+
+		p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(nil, fmt.Errorf("GetTemplate failed"))
+	})
+
+	It("lists only Fargate profile stacks", func() {
+		names, err := sc.ListFargateProfileStacks()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).To(Equal([]string{"fp-1"}))
+	})
+
+	It("reconstructs Fargate profiles from their stacks", func() {
+		fargateProfiles, err := sc.GetFargateProfiles()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fargateProfiles).To(HaveLen(1))
+
+		fp := fargateProfiles[0]
+		Expect(fp.Name).To(Equal("fp-1"))
+		Expect(fp.PodExecutionRoleARN).To(Equal("arn:aws:iam::123456789012:role/pod-execution-role"))
+		Expect(fp.Subnets).To(BeEmpty())
+		Expect(fp.Selectors).To(Equal([]api.FargateProfileSelector{
+			{Namespace: "default"},
+			{Namespace: "dev", Labels: map[string]string{"env": "dev"}},
+		}))
+	})
+
+	It("describes the tasks to delete Fargate profiles", func() {
+		tasks, err := sc.NewTasksToDeleteFargateProfiles(func(name string) bool { return name == "fp-1" }, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Describe()).To(Equal(`1 task: { delete Fargate profile "fp-1" }`))
+	})
+})
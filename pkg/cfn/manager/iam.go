@@ -4,12 +4,14 @@ import (
 	"fmt"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // makeIAMServiceAccountStackName generates the name of the iamserviceaccount stack identified by its name, isolated by the cluster this StackCollection operates on and 'addon' suffix
@@ -114,3 +116,42 @@ func (*StackCollection) GetIAMServiceAccountName(s *Stack) string {
 	}
 	return ""
 }
+
+// ExportIAMServiceAccounts reconstructs the iamserviceaccounts of the cluster, including
+// the policies attached to their roles, from the templates of their stacks
+func (c *StackCollection) ExportIAMServiceAccounts() ([]*api.ClusterIAMServiceAccount, error) {
+	stacks, err := c.DescribeIAMServiceAccountStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	results := []*api.ClusterIAMServiceAccount{}
+	for _, s := range stacks {
+		meta, err := api.ClusterIAMServiceAccountNameStringToObjectMeta(c.GetIAMServiceAccountName(s))
+		if err != nil {
+			return nil, err
+		}
+		template, err := c.GetStackTemplate(*s.StackName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting Cloudformation template for stack %s", *s.StackName)
+		}
+		serviceAccount := &api.ClusterIAMServiceAccount{ObjectMeta: *meta}
+		mapTemplateToIAMServiceAccountPolicies(template, serviceAccount)
+		results = append(results, serviceAccount)
+	}
+	return results, nil
+}
+
+// mapTemplateToIAMServiceAccountPolicies sets the policies of the role in the template
+// on the iamserviceaccount; policies added by withAddonPolicies are returned as
+// attachPolicyARNs, as it's not possible to tell them apart from other policies
+func mapTemplateToIAMServiceAccountPolicies(template string, serviceAccount *api.ClusterIAMServiceAccount) {
+	for _, arn := range gjson.Get(template, resourcesRootPath+".Role1.Properties.ManagedPolicyArns").Array() {
+		if arn.Type == gjson.String {
+			serviceAccount.AttachPolicyARNs = append(serviceAccount.AttachPolicyARNs, arn.String())
+		}
+	}
+	if policyDocument, ok := gjson.Get(template, resourcesRootPath+".Policy1.Properties.PolicyDocument").Value().(map[string]interface{}); ok {
+		serviceAccount.AttachPolicy = api.InlineDocument(policyDocument)
+	}
+}
@@ -3,18 +3,21 @@ package manager
 import (
 	"bytes"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 
+	"github.com/aws/aws-sdk-go/aws"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -23,9 +26,22 @@ const (
 	minSizePath         = resourcesRootPath + ".NodeGroup.Properties.MinSize"
 	instanceTypePath    = resourcesRootPath + ".NodeGroupLaunchTemplate.Properties.LaunchTemplateData.InstanceType"
 	imageIDPath         = resourcesRootPath + ".NodeGroupLaunchTemplate.Properties.LaunchTemplateData.ImageId"
+	updatePolicyPath    = resourcesRootPath + ".NodeGroup.UpdatePolicy"
+
+	managedDesiredSizePath = resourcesRootPath + ".ManagedNodeGroup.Properties.ScalingConfig.DesiredSize"
+	managedMaxSizePath     = resourcesRootPath + ".ManagedNodeGroup.Properties.ScalingConfig.MaxSize"
+	managedMinSizePath     = resourcesRootPath + ".ManagedNodeGroup.Properties.ScalingConfig.MinSize"
+
+	nodeGroupResourceName = "NodeGroup"
 )
 
-// NodeGroupSummary represents a summary of a nodegroup stack
+// amiFamilyDescriptionRegexp matches the AMI family in the description of nodegroup templates,
+// which is the only place where it's recorded
+var amiFamilyDescriptionRegexp = regexp.MustCompile(`\(AMI family: ([^,)]+)[,)]`)
+
+// NodeGroupSummary represents a summary of a nodegroup stack, its fields are
+// printed as they are by `eksctl get nodegroup -o json/yaml`, so renaming them
+// would break scripts that rely on that output
 type NodeGroupSummary struct {
 	StackName       string
 	Cluster         string
@@ -61,6 +77,24 @@ func (c *StackCollection) createNodeGroupTask(errs chan error, ng *api.NodeGroup
 	return c.CreateStack(name, stack, ng.Tags, nil, errs)
 }
 
+// createManagedNodeGroupTask creates the managed nodegroup
+func (c *StackCollection) createManagedNodeGroupTask(errs chan error, ng *api.ManagedNodeGroup) error {
+	name := c.makeNodeGroupStackName(ng.Name)
+	logger.Info("building managed nodegroup stack %q", name)
+	stack := builder.NewManagedNodeGroupResourceSet(c.spec, c.makeClusterStackName(), ng)
+	if err := stack.AddAllResources(); err != nil {
+		return err
+	}
+
+	if ng.Tags == nil {
+		ng.Tags = make(map[string]string)
+	}
+	ng.Tags[api.NodeGroupNameTag] = ng.Name
+	ng.Tags[api.NodeGroupTypeTag] = string(api.NodeGroupTypeManaged)
+
+	return c.CreateStack(name, stack, ng.Tags, nil, errs)
+}
+
 // DescribeNodeGroupStacks calls DescribeStacks and filters out nodegroups
 func (c *StackCollection) DescribeNodeGroupStacks() ([]*Stack, error) {
 	stacks, err := c.DescribeStacks()
@@ -95,6 +129,24 @@ func (c *StackCollection) ListNodeGroupStacks() ([]string, error) {
 	return names, nil
 }
 
+// ListUnmanagedNodeGroupStacks calls DescribeNodeGroupStacks and returns only the names of
+// nodegroups that are not managed by EKS, which eksctl needs to drain itself
+func (c *StackCollection) ListUnmanagedNodeGroupStacks() ([]string, error) {
+	stacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, s := range stacks {
+		if isManagedNodeGroupStack(s) {
+			continue
+		}
+		names = append(names, c.GetNodeGroupName(s))
+	}
+	return names, nil
+}
+
 // DescribeNodeGroupStacksAndResources calls DescribeNodeGroupStacks and fetches all resources,
 // then returns it in a map by nodegroup name
 func (c *StackCollection) DescribeNodeGroupStacksAndResources() (map[string]StackInfo, error) {
@@ -127,7 +179,9 @@ func (c *StackCollection) DescribeNodeGroupStacksAndResources() (map[string]Stac
 	return allResources, nil
 }
 
-// ScaleNodeGroup will scale an existing nodegroup
+// ScaleNodeGroup will scale an existing nodegroup, it updates desired capacity, min size and max size
+// of both unmanaged and managed nodegroups in a single stack update; when only the desired capacity
+// is given, min size and max size are extended as needed to allow it
 func (c *StackCollection) ScaleNodeGroup(ng *api.NodeGroup) error {
 	clusterName := c.makeClusterStackName()
 	c.spec.Status = &api.ClusterStatus{StackName: clusterName}
@@ -144,50 +198,223 @@ func (c *StackCollection) ScaleNodeGroup(ng *api.NodeGroup) error {
 	//TODO: In the future we might want to use Goformation for strongly typed
 	//manipulation of the template.
 
-	var descriptionBuffer bytes.Buffer
-	descriptionBuffer.WriteString("scaling nodegroup, ")
+	paths := nodeGroupScalingPaths{
+		desiredCapacity: desiredCapacityPath,
+		minSize:         minSizePath,
+		maxSize:         maxSizePath,
+	}
+	if gjson.Get(template, managedDesiredSizePath).Exists() || gjson.Get(template, managedMinSizePath).Exists() {
+		paths = nodeGroupScalingPaths{
+			desiredCapacity: managedDesiredSizePath,
+			minSize:         managedMinSizePath,
+			maxSize:         managedMaxSizePath,
+		}
+	}
 
 	// Get the current values
-	currentCapacity := gjson.Get(template, desiredCapacityPath)
-	currentMaxSize := gjson.Get(template, maxSizePath)
-	currentMinSize := gjson.Get(template, minSizePath)
+	current := nodeGroupScaling{
+		desiredCapacity: int(gjson.Get(template, paths.desiredCapacity).Int()),
+		minSize:         int(gjson.Get(template, paths.minSize).Int()),
+		maxSize:         int(gjson.Get(template, paths.maxSize).Int()),
+	}
 
-	if ng.DesiredCapacity != nil && int64(*ng.DesiredCapacity) == currentCapacity.Int() {
+	if ng.MinSize == nil && ng.MaxSize == nil && ng.DesiredCapacity != nil && *ng.DesiredCapacity == current.desiredCapacity {
 		logger.Info("desired capacity of nodegroup %q in cluster %q is already %d", ng.Name, clusterName, *ng.DesiredCapacity)
 		return nil
 	}
 
-	// Set the new values
-	newCapacity := fmt.Sprintf("%d", *ng.DesiredCapacity)
-	template, err = sjson.Set(template, desiredCapacityPath, newCapacity)
+	desired, err := newNodeGroupScaling(current, ng)
 	if err != nil {
-		return errors.Wrap(err, "setting desired capacity")
+		return err
 	}
-	descriptionBuffer.WriteString(fmt.Sprintf("desired capacity from %s to %d", currentCapacity.Str, *ng.DesiredCapacity))
 
-	// If the desired number of nodes is less than the min then update the min
-	if int64(*ng.DesiredCapacity) < currentMinSize.Int() {
-		newMinSize := fmt.Sprintf("%d", *ng.DesiredCapacity)
-		template, err = sjson.Set(template, minSizePath, newMinSize)
-		if err != nil {
-			return errors.Wrap(err, "setting min size")
-		}
-		descriptionBuffer.WriteString(fmt.Sprintf(", min size from %s to %d", currentMinSize.Str, *ng.DesiredCapacity))
+	if desired == current {
+		logger.Info("desired capacity, min size and max size of nodegroup %q in cluster %q are already %d, %d and %d",
+			ng.Name, clusterName, current.desiredCapacity, current.minSize, current.maxSize)
+		return nil
 	}
-	// If the desired number of nodes is greater than the max then update the max
-	if int64(*ng.DesiredCapacity) > currentMaxSize.Int() {
-		newMaxSize := fmt.Sprintf("%d", *ng.DesiredCapacity)
-		template, err = sjson.Set(template, maxSizePath, newMaxSize)
+
+	var descriptionBuffer bytes.Buffer
+	descriptionBuffer.WriteString("scaling nodegroup")
+
+	// Set the new values
+	for _, v := range []struct {
+		description      string
+		path             string
+		current, desired int
+	}{
+		{"desired capacity", paths.desiredCapacity, current.desiredCapacity, desired.desiredCapacity},
+		{"min size", paths.minSize, current.minSize, desired.minSize},
+		{"max size", paths.maxSize, current.maxSize, desired.maxSize},
+	} {
+		if v.current == v.desired {
+			continue
+		}
+		template, err = sjson.Set(template, v.path, fmt.Sprintf("%d", v.desired))
 		if err != nil {
-			return errors.Wrap(err, "setting max size")
+			return errors.Wrapf(err, "setting %s", v.description)
 		}
-		descriptionBuffer.WriteString(fmt.Sprintf(", max size from %s to %d", currentMaxSize.Str, *ng.DesiredCapacity))
+		descriptionBuffer.WriteString(fmt.Sprintf(", %s from %d to %d", v.description, v.current, v.desired))
 	}
 	logger.Debug("stack template (post-scale change): %s", template)
 
 	return c.UpdateStack(name, c.MakeChangeSetName("scale-nodegroup"), descriptionBuffer.String(), []byte(template), nil)
 }
 
+type nodeGroupScalingPaths struct {
+	desiredCapacity, minSize, maxSize string
+}
+
+type nodeGroupScaling struct {
+	desiredCapacity, minSize, maxSize int
+}
+
+// newNodeGroupScaling returns the scaling of the nodegroup after applying the values set in ng,
+// it extends min size and max size to allow the desired capacity, unless they are set explicitly,
+// and it keeps the desired capacity within min size and max size, unless it's set explicitly
+func newNodeGroupScaling(current nodeGroupScaling, ng *api.NodeGroup) (nodeGroupScaling, error) {
+	desired := current
+
+	if ng.MinSize != nil {
+		desired.minSize = *ng.MinSize
+	}
+	if ng.MaxSize != nil {
+		desired.maxSize = *ng.MaxSize
+	}
+
+	if ng.DesiredCapacity != nil {
+		desired.desiredCapacity = *ng.DesiredCapacity
+		if desired.desiredCapacity < desired.minSize {
+			if ng.MinSize != nil {
+				return desired, fmt.Errorf("cannot use --nodes-min=%d and --nodes=%d at the same time", *ng.MinSize, *ng.DesiredCapacity)
+			}
+			desired.minSize = desired.desiredCapacity
+		}
+		if desired.desiredCapacity > desired.maxSize {
+			if ng.MaxSize != nil {
+				return desired, fmt.Errorf("cannot use --nodes-max=%d and --nodes=%d at the same time", *ng.MaxSize, *ng.DesiredCapacity)
+			}
+			desired.maxSize = desired.desiredCapacity
+		}
+	}
+
+	if desired.maxSize < desired.minSize {
+		return desired, fmt.Errorf("min size (%d) of nodegroup %q cannot be greater than its max size (%d)", desired.minSize, ng.Name, desired.maxSize)
+	}
+
+	if ng.DesiredCapacity == nil {
+		if desired.desiredCapacity < desired.minSize {
+			desired.desiredCapacity = desired.minSize
+		}
+		if desired.desiredCapacity > desired.maxSize {
+			desired.desiredCapacity = desired.maxSize
+		}
+	}
+
+	return desired, nil
+}
+
+// UpgradeNodeGroupImage updates the nodegroup stack so that new instances use imageID; the
+// rolling update policy is removed from the stack, as existing instances are replaced by the
+// caller, which allows to drain each node before it's terminated; the policy that was removed
+// is returned, so that it can be restored with RestoreNodeGroupUpdatePolicy once all instances
+// are replaced, it's empty when the nodegroup already uses the given image or had no policy
+func (c *StackCollection) UpgradeNodeGroupImage(ng *api.NodeGroup, imageID string) (string, error) {
+	name := c.makeNodeGroupStackName(ng.Name)
+
+	template, err := c.GetStackTemplate(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting stack template %s", name)
+	}
+	logger.Debug("stack template (pre-upgrade change): %s", template)
+
+	currentImageID := gjson.Get(template, imageIDPath)
+	if !currentImageID.Exists() {
+		return "", fmt.Errorf("unable to find image ID in stack %q, only unmanaged nodegroups with a launch template can be upgraded", name)
+	}
+	if currentImageID.String() == imageID {
+		logger.Info("nodegroup %q in cluster %q is already using image %q", ng.Name, c.spec.Metadata.Name, imageID)
+		return "", nil
+	}
+
+	updatePolicy := gjson.Get(template, updatePolicyPath).Raw
+
+	template, err = sjson.Set(template, imageIDPath, imageID)
+	if err != nil {
+		return "", errors.Wrap(err, "setting image ID")
+	}
+	template, err = sjson.Delete(template, updatePolicyPath)
+	if err != nil {
+		return "", errors.Wrap(err, "removing update policy")
+	}
+	logger.Debug("stack template (post-upgrade change): %s", template)
+
+	description := fmt.Sprintf("upgrading nodegroup %q, image from %s to %s", ng.Name, currentImageID.String(), imageID)
+	if err := c.UpdateStack(name, c.MakeChangeSetName("upgrade-nodegroup"), description, []byte(template), nil); err != nil {
+		return "", err
+	}
+	return updatePolicy, nil
+}
+
+// RestoreNodeGroupUpdatePolicy adds the rolling update policy that was removed by
+// UpgradeNodeGroupImage back to the nodegroup stack, which doesn't replace any instances
+func (c *StackCollection) RestoreNodeGroupUpdatePolicy(ng *api.NodeGroup, updatePolicy string) error {
+	if updatePolicy == "" {
+		return nil
+	}
+	name := c.makeNodeGroupStackName(ng.Name)
+
+	template, err := c.GetStackTemplate(name)
+	if err != nil {
+		return errors.Wrapf(err, "error getting stack template %s", name)
+	}
+
+	template, err = sjson.SetRaw(template, updatePolicyPath, updatePolicy)
+	if err != nil {
+		return errors.Wrap(err, "restoring update policy")
+	}
+
+	description := fmt.Sprintf("restoring the rolling update policy of nodegroup %q", ng.Name)
+	return c.UpdateStack(name, c.MakeChangeSetName("restore-update-policy"), description, []byte(template), nil)
+}
+
+// GetNodeGroupAMIFamily returns the AMI family the nodegroup was created with, or an
+// empty string if it can't be determined from its stack, e.g. for managed nodegroups
+func (c *StackCollection) GetNodeGroupAMIFamily(ng *api.NodeGroup) (string, error) {
+	name := c.makeNodeGroupStackName(ng.Name)
+
+	template, err := c.GetStackTemplate(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting stack template %s", name)
+	}
+	return amiFamilyFromTemplate(template), nil
+}
+
+func amiFamilyFromTemplate(template string) string {
+	match := amiFamilyDescriptionRegexp.FindStringSubmatch(gjson.Get(template, "Description").String())
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// GetNodeGroupAutoScalingGroupName returns the name of the auto scaling group of the nodegroup
+func (c *StackCollection) GetNodeGroupAutoScalingGroupName(ng *api.NodeGroup) (string, error) {
+	name := c.makeNodeGroupStackName(ng.Name)
+	input := &cfn.DescribeStackResourceInput{
+		StackName:         &name,
+		LogicalResourceId: aws.String(nodeGroupResourceName),
+	}
+	resource, err := c.provider.CloudFormation().DescribeStackResource(input)
+	if err != nil {
+		return "", errors.Wrapf(err, "describing auto scaling group of stack %q", name)
+	}
+	if resource.StackResourceDetail == nil || resource.StackResourceDetail.PhysicalResourceId == nil {
+		return "", fmt.Errorf("auto scaling group of stack %q not found", name)
+	}
+	return *resource.StackResourceDetail.PhysicalResourceId, nil
+}
+
 // GetNodeGroupSummaries returns a list of summaries for the nodegroups of a cluster
 func (c *StackCollection) GetNodeGroupSummaries(name string) ([]*NodeGroupSummary, error) {
 	stacks, err := c.DescribeNodeGroupStacks()
@@ -262,3 +489,212 @@ func (*StackCollection) GetNodeGroupName(s *Stack) string {
 	}
 	return ""
 }
+
+// GetNodeGroups reconstructs the nodegroups and managed nodegroups of the cluster from their
+// stacks, only the settings that can be read back from the templates are set
+func (c *StackCollection) GetNodeGroups() ([]*api.NodeGroup, []*api.ManagedNodeGroup, error) {
+	stacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "getting nodegroup stacks")
+	}
+
+	nodeGroups := []*api.NodeGroup{}
+	managedNodeGroups := []*api.ManagedNodeGroup{}
+	for _, s := range stacks {
+		template, err := c.GetStackTemplate(*s.StackName)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error getting Cloudformation template for stack %s", *s.StackName)
+		}
+		if isManagedNodeGroupStack(s) {
+			ng, err := c.mapStackToManagedNodeGroup(s, template)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "mapping stack to managed nodegroup")
+			}
+			managedNodeGroups = append(managedNodeGroups, ng)
+			continue
+		}
+		ng, err := c.mapStackToNodeGroup(s, template)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "mapping stack to nodegroup")
+		}
+		nodeGroups = append(nodeGroups, ng)
+	}
+	return nodeGroups, managedNodeGroups, nil
+}
+
+func (c *StackCollection) mapStackToNodeGroup(s *Stack, template string) (*api.NodeGroup, error) {
+	ng := &api.NodeGroup{
+		Name:         c.GetNodeGroupName(s),
+		InstanceType: gjson.Get(template, instanceTypePath).String(),
+		IAM:          &api.NodeGroupIAM{},
+		SSH:          &api.NodeGroupSSH{Allow: api.Disabled()},
+	}
+
+	launchTemplateData := gjson.Get(template, resourcesRootPath+".NodeGroupLaunchTemplate.Properties.LaunchTemplateData")
+	if imageID := launchTemplateData.Get("ImageId"); imageID.Type == gjson.String {
+		ng.AMI = imageID.String()
+	}
+	if keyName := launchTemplateData.Get("KeyName"); keyName.Type == gjson.String {
+		ng.SSH.Allow = api.Enabled()
+		ng.SSH.PublicKeyName = aws.String(keyName.String())
+	}
+	if ebs := launchTemplateData.Get("BlockDeviceMappings.0.Ebs"); ebs.Exists() {
+		ng.VolumeSize = intValue(ebs.Get("VolumeSize"))
+		if volumeType := ebs.Get("VolumeType"); volumeType.Exists() {
+			ng.VolumeType = aws.String(volumeType.String())
+		}
+		if encrypted := ebs.Get("Encrypted"); encrypted.Exists() {
+			ng.VolumeEncrypted = aws.Bool(encrypted.Bool())
+		}
+	}
+
+	properties := gjson.Get(template, resourcesRootPath+".NodeGroup.Properties")
+	ng.MinSize = intValue(properties.Get("MinSize"))
+	ng.MaxSize = intValue(properties.Get("MaxSize"))
+	ng.DesiredCapacity = intValue(properties.Get("DesiredCapacity"))
+	if overrides := properties.Get("MixedInstancesPolicy.LaunchTemplate.Overrides"); overrides.IsArray() {
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{}
+		for _, override := range overrides.Array() {
+			ng.InstancesDistribution.InstanceTypes = append(ng.InstancesDistribution.InstanceTypes, override.Get("InstanceType").String())
+		}
+		ng.InstanceType = "mixed"
+	}
+
+	for k, v := range keyValuePairsToMap(properties.Get("Tags")) {
+		switch {
+		case k == "k8s.io/cluster-autoscaler/enabled":
+			ng.IAM.WithAddonPolicies.AutoScaler = api.Enabled()
+		case strings.HasPrefix(k, api.NodeTemplateLabelTagPrefix):
+			if ng.Labels == nil {
+				ng.Labels = map[string]string{}
+			}
+			ng.Labels[strings.TrimPrefix(k, api.NodeTemplateLabelTagPrefix)] = v
+		case strings.HasPrefix(k, api.NodeTemplateTaintTagPrefix):
+			if ng.Taints == nil {
+				ng.Taints = map[string]string{}
+			}
+			ng.Taints[strings.TrimPrefix(k, api.NodeTemplateTaintTagPrefix)] = v
+		}
+	}
+	ng.Tags = c.userDefinedNodeGroupTags(s)
+
+	optionalOutputs := map[string]outputs.Collector{
+		outputs.NodeGroupFeaturePrivateNetworking: func(v string) error {
+			ng.PrivateNetworking = v == "true"
+			return nil
+		},
+	}
+	if err := outputs.Collect(*s, nil, optionalOutputs); err != nil {
+		return nil, err
+	}
+
+	return ng, nil
+}
+
+func (c *StackCollection) mapStackToManagedNodeGroup(s *Stack, template string) (*api.ManagedNodeGroup, error) {
+	ng := &api.ManagedNodeGroup{
+		Name: c.GetNodeGroupName(s),
+		SSH:  &api.NodeGroupSSH{Allow: api.Disabled()},
+	}
+
+	properties := gjson.Get(template, resourcesRootPath+".ManagedNodeGroup.Properties")
+	ng.InstanceType = properties.Get("InstanceTypes.0").String()
+	ng.MinSize = intValue(properties.Get("ScalingConfig.MinSize"))
+	ng.MaxSize = intValue(properties.Get("ScalingConfig.MaxSize"))
+	ng.DesiredCapacity = intValue(properties.Get("ScalingConfig.DesiredSize"))
+	ng.VolumeSize = intValue(properties.Get("DiskSize"))
+	if keyName := properties.Get("RemoteAccess.Ec2SshKey"); keyName.Exists() {
+		ng.SSH.Allow = api.Enabled()
+		ng.SSH.PublicKeyName = aws.String(keyName.String())
+	}
+	if launchTemplateID := properties.Get("LaunchTemplate.Id"); launchTemplateID.Type == gjson.String {
+		// only launch templates that were not generated by eksctl are referenced by ID
+		ng.LaunchTemplate = &api.LaunchTemplate{ID: launchTemplateID.String()}
+		if version := properties.Get("LaunchTemplate.Version"); version.Type == gjson.String {
+			ng.LaunchTemplate.Version = aws.String(version.String())
+		}
+	}
+	if imageID := gjson.Get(template, resourcesRootPath+".LaunchTemplate.Properties.LaunchTemplateData.ImageId"); imageID.Type == gjson.String {
+		ng.AMI = imageID.String()
+	}
+	if labels := properties.Get("Labels"); labels.IsObject() {
+		ng.Labels = map[string]string{}
+		labels.ForEach(func(k, v gjson.Result) bool {
+			ng.Labels[k.String()] = v.String()
+			return true
+		})
+	}
+	ng.Tags = c.userDefinedNodeGroupTags(s)
+
+	optionalOutputs := map[string]outputs.Collector{
+		outputs.NodeGroupFeaturePrivateNetworking: func(v string) error {
+			ng.PrivateNetworking = v == "true"
+			return nil
+		},
+	}
+	if err := outputs.Collect(*s, nil, optionalOutputs); err != nil {
+		return nil, err
+	}
+
+	return ng, nil
+}
+
+func isManagedNodeGroupStack(s *Stack) bool {
+	for _, tag := range s.Tags {
+		if *tag.Key == api.NodeGroupTypeTag && *tag.Value == string(api.NodeGroupTypeManaged) {
+			return true
+		}
+	}
+	return false
+}
+
+// userDefinedNodeGroupTags returns the tags of a nodegroup stack that were set by the user
+// on the nodegroup, i.e. without the tags eksctl sets and the tags inherited from the cluster
+func (c *StackCollection) userDefinedNodeGroupTags(s *Stack) map[string]string {
+	tags := map[string]string{}
+	for k, v := range GetUserDefinedStackTags(s) {
+		if clusterValue, ok := c.spec.Metadata.Tags[k]; ok && clusterValue == v {
+			continue
+		}
+		tags[k] = v
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+// GetUserDefinedStackTags returns the tags of the stack, except for the ones eksctl uses
+// to keep track of the stacks it creates
+func GetUserDefinedStackTags(s *Stack) map[string]string {
+	tags := map[string]string{}
+	for _, tag := range s.Tags {
+		if isEksctlTag(*tag.Key) {
+			continue
+		}
+		tags[*tag.Key] = *tag.Value
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
+func isEksctlTag(key string) bool {
+	for _, prefix := range []string{"alpha.eksctl.io/", "eksctl.io/", "eksctl.cluster.k8s.io/"} {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// intValue returns nil when the value is not set in the template, sizes
+// are rendered as strings by some versions of eksctl and as numbers by others
+func intValue(value gjson.Result) *int {
+	if !value.Exists() {
+		return nil
+	}
+	i := int(value.Int())
+	return &i
+}
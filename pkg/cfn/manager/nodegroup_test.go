@@ -1,6 +1,7 @@
 package manager
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -83,9 +84,286 @@ var _ = Describe("StackCollection NodeGroup", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+
+		Context("With an existing managed NodeGroup", func() {
+			JustBeforeEach(func() {
+				cc = newClusterConfig("test-cluster")
+				ng = newNodeGroup(cc)
+				ng.Name = "12345"
+				sc = NewStackCollection(p, cc)
+
+				p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+					TemplateBody: aws.String(`{
+						"Resources": {
+							"ManagedNodeGroup": {
+								"Properties": {
+									"ScalingConfig": {
+										"DesiredSize": 2,
+										"MinSize": 2,
+										"MaxSize": 2
+									}
+								}
+							}
+						}
+					}`),
+				}, nil)
+			})
+
+			It("should be a no-op if min size and max size are unchanged", func() {
+				min, max := 2, 2
+				ng.MinSize, ng.MaxSize = &min, &max
+
+				Expect(sc.ScaleNodeGroup(ng)).To(Succeed())
+				Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "CreateChangeSet", 0)).To(BeTrue())
+			})
+
+			It("should fail if desired capacity is above the given max size", func() {
+				desired, max := 5, 4
+				ng.DesiredCapacity, ng.MaxSize = &desired, &max
+
+				Expect(sc.ScaleNodeGroup(ng)).To(MatchError("cannot use --nodes-max=4 and --nodes=5 at the same time"))
+			})
+		})
+	})
+
+	Describe("newNodeGroupScaling", func() {
+		current := nodeGroupScaling{desiredCapacity: 2, minSize: 1, maxSize: 3}
+		intPtr := func(i int) *int { return &i }
+
+		It("should extend max size to the desired capacity", func() {
+			ng := &api.NodeGroup{DesiredCapacity: intPtr(5)}
+			Expect(newNodeGroupScaling(current, ng)).To(Equal(nodeGroupScaling{desiredCapacity: 5, minSize: 1, maxSize: 5}))
+		})
+
+		It("should extend min size to the desired capacity", func() {
+			ng := &api.NodeGroup{DesiredCapacity: intPtr(0)}
+			Expect(newNodeGroupScaling(current, ng)).To(Equal(nodeGroupScaling{desiredCapacity: 0, minSize: 0, maxSize: 3}))
+		})
+
+		It("should keep the desired capacity within new min size and max size", func() {
+			ng := &api.NodeGroup{MinSize: intPtr(4), MaxSize: intPtr(6)}
+			Expect(newNodeGroupScaling(current, ng)).To(Equal(nodeGroupScaling{desiredCapacity: 4, minSize: 4, maxSize: 6}))
+
+			ng = &api.NodeGroup{MaxSize: intPtr(1)}
+			Expect(newNodeGroupScaling(current, ng)).To(Equal(nodeGroupScaling{desiredCapacity: 1, minSize: 1, maxSize: 1}))
+		})
+
+		It("should update all values at once", func() {
+			ng := &api.NodeGroup{DesiredCapacity: intPtr(3), MinSize: intPtr(2), MaxSize: intPtr(10)}
+			Expect(newNodeGroupScaling(current, ng)).To(Equal(nodeGroupScaling{desiredCapacity: 3, minSize: 2, maxSize: 10}))
+		})
+
+		It("should fail if min size is greater than max size", func() {
+			ng := &api.NodeGroup{Name: "ng-1", MinSize: intPtr(5)}
+			_, err := newNodeGroupScaling(current, ng)
+			Expect(err).To(MatchError(`min size (5) of nodegroup "ng-1" cannot be greater than its max size (3)`))
+		})
+	})
+
+	Describe("UpgradeNodeGroupImage", func() {
+		var (
+			ng *api.NodeGroup
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			ng = newNodeGroup(cc)
+			ng.Name = "12345"
+			sc = NewStackCollection(p, cc)
+		})
+
+		It("should be a no-op if the nodegroup already uses the image", func() {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{
+					"Resources": {
+						"NodeGroupLaunchTemplate": {
+							"Properties": {
+								"LaunchTemplateData": {
+									"ImageId": "ami-123"
+								}
+							}
+						}
+					}
+				}`),
+			}, nil)
+
+			updatePolicy, err := sc.UpgradeNodeGroupImage(ng, "ami-123")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatePolicy).To(BeEmpty())
+			Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "CreateChangeSet", 0)).To(BeTrue())
+		})
+
+		It("should set the image and remove the update policy", func() {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{
+					"Resources": {
+						"NodeGroup": {
+							"UpdatePolicy": {"AutoScalingRollingUpdate": {"MaxBatchSize": "1", "MinInstancesInService": "0"}}
+						},
+						"NodeGroupLaunchTemplate": {
+							"Properties": {
+								"LaunchTemplateData": {
+									"ImageId": "ami-old"
+								}
+							}
+						}
+					}
+				}`),
+			}, nil)
+
+			var template string
+			p.MockCloudFormation().On("CreateChangeSet", mock.MatchedBy(func(input *cfn.CreateChangeSetInput) bool {
+				template = *input.TemplateBody
+				return true
+			})).Return(nil, fmt.Errorf("stop"))
+
+			_, err := sc.UpgradeNodeGroupImage(ng, "ami-123")
+			Expect(err).To(MatchError(ContainSubstring("stop")))
+
+			Expect(template).To(MatchJSON(`{
+				"Resources": {
+					"NodeGroup": {},
+					"NodeGroupLaunchTemplate": {
+						"Properties": {
+							"LaunchTemplateData": {
+								"ImageId": "ami-123"
+							}
+						}
+					}
+				}
+			}`))
+		})
+
+		It("should fail if the stack has no launch template image", func() {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {"NodeGroup": {}}}`),
+			}, nil)
+
+			_, err := sc.UpgradeNodeGroupImage(ng, "ami-123")
+
+			Expect(err).To(MatchError(`unable to find image ID in stack "eksctl-test-cluster-nodegroup-12345", only unmanaged nodegroups with a launch template can be upgraded`))
+		})
+	})
+
+	Describe("RestoreNodeGroupUpdatePolicy", func() {
+		var (
+			ng *api.NodeGroup
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			ng = newNodeGroup(cc)
+			ng.Name = "12345"
+			sc = NewStackCollection(p, cc)
+		})
+
+		It("should be a no-op if no update policy was removed", func() {
+			Expect(sc.RestoreNodeGroupUpdatePolicy(ng, "")).To(Succeed())
+			Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "GetTemplate", 0)).To(BeTrue())
+		})
+
+		It("should add the update policy back to the stack", func() {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {"NodeGroup": {"Type": "AWS::AutoScaling::AutoScalingGroup"}}}`),
+			}, nil)
+
+			var template string
+			p.MockCloudFormation().On("CreateChangeSet", mock.MatchedBy(func(input *cfn.CreateChangeSetInput) bool {
+				template = *input.TemplateBody
+				return true
+			})).Return(nil, fmt.Errorf("stop"))
+
+			err := sc.RestoreNodeGroupUpdatePolicy(ng, `{"AutoScalingRollingUpdate": {"MaxBatchSize": "1", "MinInstancesInService": "0"}}`)
+			Expect(err).To(MatchError(ContainSubstring("stop")))
+
+			Expect(template).To(MatchJSON(`{
+				"Resources": {
+					"NodeGroup": {
+						"Type": "AWS::AutoScaling::AutoScalingGroup",
+						"UpdatePolicy": {"AutoScalingRollingUpdate": {"MaxBatchSize": "1", "MinInstancesInService": "0"}}
+					}
+				}
+			}`))
+		})
+	})
+
+	Describe("GetNodeGroupAMIFamily", func() {
+		var (
+			ng *api.NodeGroup
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			ng = newNodeGroup(cc)
+			ng.Name = "12345"
+			sc = NewStackCollection(p, cc)
+		})
+
+		It("should return the AMI family from the template description", func() {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{
+					"Description": "EKS nodes (AMI family: Ubuntu1804, SSH access: false, private networking: false) [created and managed by eksctl]",
+					"Resources": {}
+				}`),
+			}, nil)
+
+			amiFamily, err := sc.GetNodeGroupAMIFamily(ng)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(amiFamily).To(Equal("Ubuntu1804"))
+		})
+
+		It("should return an empty string if the description doesn't include the AMI family", func() {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Description": "EKS Managed Nodes (SSH access: false) [created by eksctl]"}`),
+			}, nil)
+
+			amiFamily, err := sc.GetNodeGroupAMIFamily(ng)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(amiFamily).To(BeEmpty())
+		})
+	})
+
+	Describe("GetNodeGroupAutoScalingGroupName", func() {
+		It("should return the physical ID of the auto scaling group", func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			ng := newNodeGroup(cc)
+			ng.Name = "12345"
+			sc = NewStackCollection(p, cc)
+
+			p.MockCloudFormation().On("DescribeStackResource", mock.MatchedBy(func(input *cfn.DescribeStackResourceInput) bool {
+				return *input.StackName == "eksctl-test-cluster-nodegroup-12345" && *input.LogicalResourceId == "NodeGroup"
+			})).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{
+					PhysicalResourceId: aws.String("eksctl-test-cluster-nodegroup-12345-NodeGroup-ABCDEF"),
+				},
+			}, nil)
+
+			name, err := sc.GetNodeGroupAutoScalingGroupName(ng)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal("eksctl-test-cluster-nodegroup-12345-NodeGroup-ABCDEF"))
+		})
 	})
 
 	Describe("GetNodeGroupSummaries", func() {
+		It("should keep the field names in the JSON output", func() {
+			data, err := json.Marshal(&NodeGroupSummary{StackName: "stack", Name: "ng", DesiredCapacity: 2})
+			Expect(err).NotTo(HaveOccurred())
+
+			summary := map[string]interface{}{}
+			Expect(json.Unmarshal(data, &summary)).To(Succeed())
+			Expect(summary).To(HaveKeyWithValue("StackName", "stack"))
+			Expect(summary).To(HaveKeyWithValue("Name", "ng"))
+			Expect(summary).To(HaveKeyWithValue("DesiredCapacity", 2.0))
+		})
+
 		Context("With a cluster name", func() {
 			var (
 				clusterName string
@@ -197,4 +475,104 @@ var _ = Describe("StackCollection NodeGroup", func() {
 			})
 		})
 	})
+
+	Describe("GetNodeGroups", func() {
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			cc.Metadata.Tags = map[string]string{"team": "platform"}
+			sc = NewStackCollection(p, cc)
+		})
+
+		newStack := func(name string, tags map[string]string) *Stack {
+			s := &Stack{
+				StackName: aws.String("eksctl-test-cluster-nodegroup-" + name),
+				Tags: []*cfn.Tag{
+					{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")},
+					{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(name)},
+					{Key: aws.String("team"), Value: aws.String("platform")},
+				},
+				Outputs: []*cfn.Output{
+					{OutputKey: aws.String("FeaturePrivateNetworking"), OutputValue: aws.String("true")},
+				},
+			}
+			for k, v := range tags {
+				s.Tags = append(s.Tags, &cfn.Tag{Key: aws.String(k), Value: aws.String(v)})
+			}
+			return s
+		}
+
+		It("should map a nodegroup stack to a nodegroup", func() {
+			template := `{
+				"Resources": {
+					"NodeGroupLaunchTemplate": {"Properties": {"LaunchTemplateData": {
+						"InstanceType": "m5.large",
+						"ImageId": "ami-123",
+						"KeyName": "my-key",
+						"BlockDeviceMappings": [{"Ebs": {"VolumeSize": 100, "VolumeType": "gp3", "Encrypted": true}}]
+					}}},
+					"NodeGroup": {"Properties": {
+						"MinSize": "1",
+						"MaxSize": "4",
+						"DesiredCapacity": "2",
+						"Tags": [
+							{"Key": "k8s.io/cluster-autoscaler/enabled", "Value": "true"},
+							{"Key": "k8s.io/cluster-autoscaler/node-template/label/role", "Value": "workers"},
+							{"Key": "k8s.io/cluster-autoscaler/node-template/taint/dedicated", "Value": "workers:NoSchedule"}
+						]
+					}}
+				}
+			}`
+
+			ng, err := sc.mapStackToNodeGroup(newStack("ng-1", map[string]string{"owner": "data"}), template)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ng.Name).To(Equal("ng-1"))
+			Expect(ng.InstanceType).To(Equal("m5.large"))
+			Expect(ng.AMI).To(Equal("ami-123"))
+			Expect(*ng.SSH.Allow).To(BeTrue())
+			Expect(*ng.SSH.PublicKeyName).To(Equal("my-key"))
+			Expect(*ng.VolumeSize).To(Equal(100))
+			Expect(*ng.VolumeType).To(Equal("gp3"))
+			Expect(*ng.VolumeEncrypted).To(BeTrue())
+			Expect(*ng.MinSize).To(Equal(1))
+			Expect(*ng.MaxSize).To(Equal(4))
+			Expect(*ng.DesiredCapacity).To(Equal(2))
+			Expect(*ng.IAM.WithAddonPolicies.AutoScaler).To(BeTrue())
+			Expect(ng.Labels).To(Equal(map[string]string{"role": "workers"}))
+			Expect(ng.Taints).To(Equal(map[string]string{"dedicated": "workers:NoSchedule"}))
+			Expect(ng.Tags).To(Equal(map[string]string{"owner": "data"}))
+			Expect(ng.PrivateNetworking).To(BeTrue())
+		})
+
+		It("should map a managed nodegroup stack to a managed nodegroup", func() {
+			template := `{
+				"Resources": {
+					"ManagedNodeGroup": {"Properties": {
+						"InstanceTypes": ["t3.large"],
+						"ScalingConfig": {"MinSize": 2, "MaxSize": 5, "DesiredSize": 3},
+						"DiskSize": 50,
+						"Labels": {"role": "workers"}
+					}}
+				}
+			}`
+
+			s := newStack("mng-1", map[string]string{api.NodeGroupTypeTag: string(api.NodeGroupTypeManaged)})
+			Expect(isManagedNodeGroupStack(s)).To(BeTrue())
+
+			ng, err := sc.mapStackToManagedNodeGroup(s, template)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(ng.Name).To(Equal("mng-1"))
+			Expect(ng.InstanceType).To(Equal("t3.large"))
+			Expect(*ng.MinSize).To(Equal(2))
+			Expect(*ng.MaxSize).To(Equal(5))
+			Expect(*ng.DesiredCapacity).To(Equal(3))
+			Expect(*ng.VolumeSize).To(Equal(50))
+			Expect(*ng.SSH.Allow).To(BeFalse())
+			Expect(ng.LaunchTemplate).To(BeNil())
+			Expect(ng.Labels).To(Equal(map[string]string{"role": "workers"}))
+			Expect(ng.Tags).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,281 @@
+package manager
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// ResourceChange describes a change to a single resource of a stack
+type ResourceChange struct {
+	Action      string
+	LogicalID   string
+	PhysicalID  string
+	Type        string
+	Replacement string
+}
+
+// StackPlan describes the changes that would be made to a stack
+type StackPlan struct {
+	StackName string
+	Changes   []ResourceChange
+}
+
+// PlanCreateClusterWithNodeGroups plans the creation of the cluster stack along with the stacks
+// of nodegroups, managed nodegroups and Fargate profiles; the cluster stack is planned using a
+// change set, other stacks import outputs of the cluster stack that don't exist yet, so they are
+// planned from their templates
+func (c *StackCollection) PlanCreateClusterWithNodeGroups(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup, fargateProfiles []*api.FargateProfile) ([]*StackPlan, error) {
+	stack := builder.NewClusterResourceSet(c.provider, c.spec)
+	if err := stack.AddAllResources(); err != nil {
+		return nil, err
+	}
+	clusterPlan, err := c.planCreateStackWithChangeSet(c.makeClusterStackName(), stack, nil)
+	if err != nil {
+		return nil, err
+	}
+	plans := []*StackPlan{clusterPlan}
+
+	for _, ng := range nodeGroups {
+		stack := builder.NewNodeGroupResourceSet(c.provider, c.spec, c.makeClusterStackName(), ng)
+		if err := stack.AddAllResources(); err != nil {
+			return nil, err
+		}
+		plan, err := planCreateStackFromTemplate(c.makeNodeGroupStackName(ng.Name), stack)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	for _, ng := range managedNodeGroups {
+		stack := builder.NewManagedNodeGroupResourceSet(c.spec, c.makeClusterStackName(), ng)
+		if err := stack.AddAllResources(); err != nil {
+			return nil, err
+		}
+		plan, err := planCreateStackFromTemplate(c.makeNodeGroupStackName(ng.Name), stack)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	for _, fp := range fargateProfiles {
+		stack := builder.NewFargateProfileResourceSet(c.spec, c.makeClusterStackName(), fp)
+		if err := stack.AddAllResources(); err != nil {
+			return nil, err
+		}
+		plan, err := planCreateStackFromTemplate(c.makeFargateProfileStackName(fp.Name), stack)
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// PlanCreateAllNodeGroups plans the creation of nodegroups and managed nodegroups
+// in an existing cluster using change sets
+func (c *StackCollection) PlanCreateAllNodeGroups(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup) ([]*StackPlan, error) {
+	plans := []*StackPlan{}
+
+	for _, ng := range nodeGroups {
+		stack := builder.NewNodeGroupResourceSet(c.provider, c.spec, c.makeClusterStackName(), ng)
+		if err := stack.AddAllResources(); err != nil {
+			return nil, err
+		}
+		tags := map[string]string{
+			api.NodeGroupNameTag:    ng.Name,
+			api.OldNodeGroupNameTag: ng.Name,
+		}
+		plan, err := c.planCreateStackWithChangeSet(c.makeNodeGroupStackName(ng.Name), stack, mergeTags(ng.Tags, tags))
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	for _, ng := range managedNodeGroups {
+		stack := builder.NewManagedNodeGroupResourceSet(c.spec, c.makeClusterStackName(), ng)
+		if err := stack.AddAllResources(); err != nil {
+			return nil, err
+		}
+		tags := map[string]string{
+			api.NodeGroupNameTag: ng.Name,
+			api.NodeGroupTypeTag: string(api.NodeGroupTypeManaged),
+		}
+		plan, err := c.planCreateStackWithChangeSet(c.makeNodeGroupStackName(ng.Name), stack, mergeTags(ng.Tags, tags))
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// PlanDeleteStacks plans the deletion of the given stacks, which removes all of their resources
+func (c *StackCollection) PlanDeleteStacks(stacks []*Stack) ([]*StackPlan, error) {
+	plans := []*StackPlan{}
+
+	for _, s := range stacks {
+		resources, err := c.ListStackResources(s)
+		if err != nil {
+			return nil, err
+		}
+		plan := &StackPlan{StackName: *s.StackName}
+		for _, r := range resources {
+			if aws.StringValue(r.ResourceStatus) == cfn.ResourceStatusDeleteComplete {
+				continue
+			}
+			plan.Changes = append(plan.Changes, ResourceChange{
+				Action:     cfn.ChangeActionRemove,
+				LogicalID:  aws.StringValue(r.LogicalResourceId),
+				PhysicalID: aws.StringValue(r.PhysicalResourceId),
+				Type:       aws.StringValue(r.ResourceType),
+			})
+		}
+		plans = append(plans, plan)
+	}
+
+	return plans, nil
+}
+
+// planCreateStackWithChangeSet creates a change set of type CREATE, which CloudFormation
+// validates against the account, and reads the changes from it; CloudFormation creates
+// an empty stack in REVIEW_IN_PROGRESS state to hold the change set, which gets deleted
+// before returning, so nothing is left behind
+func (c *StackCollection) planCreateStackWithChangeSet(name string, stack builder.ResourceSet, tags map[string]string) (*StackPlan, error) {
+	templateBody, err := stack.RenderJSON()
+	if err != nil {
+		return nil, errors.Wrapf(err, "rendering template for %q stack", name)
+	}
+
+	changeSetName := c.MakeChangeSetName("plan")
+	input := &cfn.CreateChangeSetInput{
+		StackName:     &name,
+		ChangeSetName: &changeSetName,
+		Description:   aws.String(fmt.Sprintf("plan creation of stack %q", name)),
+	}
+	input.SetChangeSetType(cfn.ChangeSetTypeCreate)
+	input.SetTemplateBody(string(templateBody))
+
+	for _, t := range c.sharedTags {
+		input.Tags = append(input.Tags, t)
+	}
+	for k, v := range tags {
+		input.Tags = append(input.Tags, newTag(k, v))
+	}
+
+	if stack.WithIAM() {
+		input.SetCapabilities(stackCapabilitiesIAM)
+	}
+	if stack.WithNamedIAM() {
+		input.SetCapabilities(stackCapabilitiesNamedIAM)
+	}
+	if cfnRole := c.provider.CloudFormationRoleARN(); cfnRole != "" {
+		input.SetRoleARN(cfnRole)
+	}
+
+	logger.Debug("creating changeSet, input = %#v", input)
+	out, err := c.provider.CloudFormation().CreateChangeSet(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "creating ChangeSet %q for stack %q", changeSetName, name)
+	}
+
+	i := &Stack{StackName: &name, StackId: out.StackId}
+	defer func() {
+		if _, err := c.provider.CloudFormation().DeleteStack(&cfn.DeleteStackInput{StackName: out.StackId}); err != nil {
+			logger.Warning("failed to clean up stack %q used for planning: %s", name, err.Error())
+		}
+	}()
+
+	if err := c.doWaitUntilChangeSetIsCreated(i, changeSetName); err != nil {
+		logger.Warning("changes to stack %q cannot be read from a change set, showing the resources in its template instead: %s", name, err.Error())
+		return planCreateStackFromTemplate(name, stack)
+	}
+
+	plan := &StackPlan{StackName: name}
+	var nextToken *string
+	for {
+		changeSet, err := c.provider.CloudFormation().DescribeChangeSet(&cfn.DescribeChangeSetInput{
+			StackName:     out.StackId,
+			ChangeSetName: &changeSetName,
+			NextToken:     nextToken,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing CloudFormation ChangeSet %s for stack %s", changeSetName, name)
+		}
+		plan.Changes = append(plan.Changes, changesFromChangeSet(changeSet)...)
+		if nextToken = changeSet.NextToken; nextToken == nil {
+			break
+		}
+	}
+	return plan, nil
+}
+
+func changesFromChangeSet(changeSet *ChangeSet) []ResourceChange {
+	changes := []ResourceChange{}
+	for _, change := range changeSet.Changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		changes = append(changes, ResourceChange{
+			Action:      aws.StringValue(rc.Action),
+			LogicalID:   aws.StringValue(rc.LogicalResourceId),
+			PhysicalID:  aws.StringValue(rc.PhysicalResourceId),
+			Type:        aws.StringValue(rc.ResourceType),
+			Replacement: aws.StringValue(rc.Replacement),
+		})
+	}
+	return changes
+}
+
+// planCreateStackFromTemplate lists all resources of the rendered template as resources to be added
+func planCreateStackFromTemplate(name string, stack builder.ResourceSet) (*StackPlan, error) {
+	templateBody, err := stack.RenderJSON()
+	if err != nil {
+		return nil, errors.Wrapf(err, "rendering template for %q stack", name)
+	}
+
+	resources := gjson.Get(string(templateBody), resourcesRootPath)
+	if !resources.IsObject() {
+		return nil, fmt.Errorf("unexpected template format of stack %q", name)
+	}
+
+	plan := &StackPlan{StackName: name}
+	resources.ForEach(func(k, v gjson.Result) bool {
+		plan.Changes = append(plan.Changes, ResourceChange{
+			Action:    cfn.ChangeActionAdd,
+			LogicalID: k.String(),
+			Type:      v.Get("Type").String(),
+		})
+		return true
+	})
+	sort.Slice(plan.Changes, func(i, j int) bool {
+		return plan.Changes[i].LogicalID < plan.Changes[j].LogicalID
+	})
+	return plan, nil
+}
+
+// mergeTags returns a new map with tags from all of the given maps, so that
+// the tags of a nodegroup can be planned without modifying its spec
+func mergeTags(tagMaps ...map[string]string) map[string]string {
+	merged := map[string]string{}
+	for _, tags := range tagMaps {
+		for k, v := range tags {
+			merged[k] = v
+		}
+	}
+	return merged
+}
@@ -0,0 +1,119 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection plans", func() {
+	var (
+		cfg *api.ClusterConfig
+		sc  *StackCollection
+		p   *mockprovider.MockProvider
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Name = "test-cluster"
+
+		sc = NewStackCollection(p, cfg)
+	})
+
+	It("should list all resources of a template as resources to be added", func() {
+		fp := &api.FargateProfile{
+			Name: "fp-1",
+			Selectors: []api.FargateProfileSelector{
+				{Namespace: "default"},
+			},
+		}
+		stack := builder.NewFargateProfileResourceSet(cfg, sc.makeClusterStackName(), fp)
+		Expect(stack.AddAllResources()).To(Succeed())
+
+		plan, err := planCreateStackFromTemplate(sc.makeFargateProfileStackName(fp.Name), stack)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plan.StackName).To(Equal("eksctl-test-cluster-fargate-fp-1"))
+		Expect(plan.Changes).NotTo(BeEmpty())
+		Expect(plan.Changes).To(ContainElement(ResourceChange{
+			Action:    cfn.ChangeActionAdd,
+			LogicalID: "FargateProfile",
+			Type:      "AWS::EKS::FargateProfile",
+		}))
+	})
+
+	It("should read resource changes from a change set", func() {
+		changes := changesFromChangeSet(&ChangeSet{
+			Changes: []*cfn.Change{
+				{
+					ResourceChange: &cfn.ResourceChange{
+						Action:             aws.String(cfn.ChangeActionModify),
+						LogicalResourceId:  aws.String("NodeGroup"),
+						PhysicalResourceId: aws.String("asg-1"),
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						Replacement:        aws.String(cfn.ReplacementFalse),
+					},
+				},
+				{},
+			},
+		})
+		Expect(changes).To(Equal([]ResourceChange{
+			{
+				Action:      cfn.ChangeActionModify,
+				LogicalID:   "NodeGroup",
+				PhysicalID:  "asg-1",
+				Type:        "AWS::AutoScaling::AutoScalingGroup",
+				Replacement: cfn.ReplacementFalse,
+			},
+		}))
+	})
+
+	It("should plan the removal of all resources of deleted stacks", func() {
+		p.MockCloudFormation().On("ListStackResourcesPages", mock.MatchedBy(func(input *cfn.ListStackResourcesInput) bool {
+			return input.StackName != nil && *input.StackName == "eksctl-test-cluster-nodegroup-ng-1"
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListStackResourcesOutput, last bool) (shouldContinue bool))
+			consume(&cfn.ListStackResourcesOutput{
+				StackResourceSummaries: []*cfn.StackResourceSummary{
+					{
+						LogicalResourceId:  aws.String("NodeGroup"),
+						PhysicalResourceId: aws.String("asg-1"),
+						ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+						ResourceStatus:     aws.String(cfn.ResourceStatusCreateComplete),
+					},
+				},
+			}, false)
+			consume(&cfn.ListStackResourcesOutput{
+				StackResourceSummaries: []*cfn.StackResourceSummary{
+					{
+						LogicalResourceId:  aws.String("SG"),
+						PhysicalResourceId: aws.String("sg-1"),
+						ResourceType:       aws.String("AWS::EC2::SecurityGroup"),
+						ResourceStatus:     aws.String(cfn.ResourceStatusDeleteComplete),
+					},
+				},
+			}, true)
+		}).Return(nil)
+
+		plans, err := sc.PlanDeleteStacks([]*Stack{{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1")}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plans).To(HaveLen(1))
+		Expect(plans[0].StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-1"))
+		Expect(plans[0].Changes).To(Equal([]ResourceChange{
+			{
+				Action:     cfn.ChangeActionRemove,
+				LogicalID:  "NodeGroup",
+				PhysicalID: "asg-1",
+				Type:       "AWS::AutoScaling::AutoScalingGroup",
+			},
+		}))
+	})
+})
@@ -5,12 +5,12 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/kris-nova/logger"
 	"k8s.io/client-go/kubernetes"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // Task is a common interface for the stack manager tasks
@@ -25,6 +25,9 @@ type TaskTree struct {
 	Parallel  bool
 	PlanMode  bool
 	IsSubTask bool
+	// MaxConcurrency limits how many parallel tasks run at the same time,
+	// there is no limit when it's 0
+	MaxConcurrency int
 }
 
 // Append new tasks to the set
@@ -32,6 +35,16 @@ func (t *TaskTree) Append(newTasks ...Task) {
 	t.tasks = append(t.tasks, newTasks...)
 }
 
+// SetMaxConcurrency sets MaxConcurrency of the set and of all of its sub-tasks
+func (t *TaskTree) SetMaxConcurrency(maxConcurrency int) {
+	t.MaxConcurrency = maxConcurrency
+	for _, task := range t.tasks {
+		if subTask, ok := task.(*TaskTree); ok {
+			subTask.SetMaxConcurrency(maxConcurrency)
+		}
+	}
+}
+
 // Len returns number of tasks in the set
 func (t *TaskTree) Len() int {
 	if t == nil {
@@ -87,7 +100,7 @@ func (t *TaskTree) Do(allErrs chan error) error {
 	errs := make(chan error)
 
 	if t.Parallel {
-		go doParallelTasks(errs, t.tasks)
+		go doParallelTasks(errs, t.tasks, t.MaxConcurrency)
 	} else {
 		go doSequentialTasks(errs, t.tasks)
 	}
@@ -113,7 +126,7 @@ func (t *TaskTree) DoAllSync() []error {
 	errs := make(chan error)
 
 	if t.Parallel {
-		go doParallelTasks(errs, t.tasks)
+		go doParallelTasks(errs, t.tasks, t.MaxConcurrency)
 	} else {
 		go doSequentialTasks(errs, t.tasks)
 	}
@@ -153,6 +166,28 @@ func (t *taskWithNodeGroupSpec) Do(errs chan error) error {
 	return t.call(errs, t.nodeGroup)
 }
 
+type taskWithManagedNodeGroupSpec struct {
+	info      string
+	nodeGroup *api.ManagedNodeGroup
+	call      func(chan error, *api.ManagedNodeGroup) error
+}
+
+func (t *taskWithManagedNodeGroupSpec) Describe() string { return t.info }
+func (t *taskWithManagedNodeGroupSpec) Do(errs chan error) error {
+	return t.call(errs, t.nodeGroup)
+}
+
+type taskWithFargateProfileSpec struct {
+	info           string
+	fargateProfile *api.FargateProfile
+	call           func(chan error, *api.FargateProfile) error
+}
+
+func (t *taskWithFargateProfileSpec) Describe() string { return t.info }
+func (t *taskWithFargateProfileSpec) Do(errs chan error) error {
+	return t.call(errs, t.fargateProfile)
+}
+
 type taskWithClusterIAMServiceAccountSpec struct {
 	info           string
 	serviceAccount *api.ClusterIAMServiceAccount
@@ -229,26 +264,44 @@ func doSingleTask(allErrs chan error, task Task) bool {
 		allErrs <- err
 		return false
 	}
-	if err := <-errs; err != nil {
-		allErrs <- err
+	// sub-tasks may report more than one error, all of them are collected
+	// before the channel gets closed
+	ok := true
+	for err := range errs {
+		if err != nil {
+			allErrs <- err
+			ok = false
+		}
+	}
+	if !ok {
 		return false
 	}
 	logger.Debug("completed task: %s", desc)
 	return true
 }
 
-func doParallelTasks(allErrs chan error, tasks []Task) {
+func doParallelTasks(allErrs chan error, tasks []Task, maxConcurrency int) {
+	if maxConcurrency <= 0 || maxConcurrency > len(tasks) {
+		maxConcurrency = len(tasks)
+	}
+	// slots limits the number of tasks running at the same time
+	slots := make(chan struct{}, maxConcurrency)
+
 	wg := &sync.WaitGroup{}
 	wg.Add(len(tasks))
 	for t := range tasks {
+		slots <- struct{}{}
 		go func(t int) {
-			defer wg.Done()
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
 			if ok := doSingleTask(allErrs, tasks[t]); !ok {
 				logger.Debug("failed task: %s (will continue until other parallel tasks are completed)", tasks[t].Describe())
 			}
 		}(t)
 	}
-	logger.Debug("waiting for %d parallel tasks to complete", len(tasks))
+	logger.Debug("waiting for %d parallel tasks to complete (at most %d at a time)", len(tasks), maxConcurrency)
 	wg.Wait()
 	close(allErrs)
 }
@@ -622,6 +622,55 @@ var _ = Describe("StackCollection Tasks", func() {
 					Expect(errs[0].Error()).To(Equal("t1.3 always fails"))
 				}
 			})
+
+			It("should limit the number of parallel tasks running at the same time", func() {
+				var running, maxRunning int32
+
+				newTask := func(i int, fail bool) Task {
+					return &taskWithoutParams{
+						info: fmt.Sprintf("t%d", i),
+						call: func(errs chan error) error {
+							n := atomic.AddInt32(&running, 1)
+							for {
+								current := atomic.LoadInt32(&maxRunning)
+								if n <= current || atomic.CompareAndSwapInt32(&maxRunning, current, n) {
+									break
+								}
+							}
+							go func() {
+								time.Sleep(20 * time.Millisecond)
+								atomic.AddInt32(&running, -1)
+								if fail {
+									errs <- fmt.Errorf("t%d always fails", i)
+								}
+								close(errs)
+							}()
+							return nil
+						},
+					}
+				}
+
+				tasks := &TaskTree{Parallel: false}
+				subTask := &TaskTree{Parallel: true, IsSubTask: true}
+				for i := 0; i < 8; i++ {
+					subTask.Append(newTask(i, i%4 == 0))
+				}
+				tasks.Append(subTask)
+
+				tasks.SetMaxConcurrency(3)
+				Expect(subTask.MaxConcurrency).To(Equal(3))
+
+				errs := tasks.DoAllSync()
+				Expect(errs).To(HaveLen(2))
+				Expect(atomic.LoadInt32(&maxRunning)).To(Equal(int32(3)))
+
+				atomic.StoreInt32(&maxRunning, 0)
+				tasks.SetMaxConcurrency(0)
+
+				errs = tasks.DoAllSync()
+				Expect(errs).To(HaveLen(2))
+				Expect(atomic.LoadInt32(&maxRunning)).To(Equal(int32(8)))
+			})
 		})
 
 		Context("With real tasks", func() {
@@ -646,6 +695,26 @@ var _ = Describe("StackCollection Tasks", func() {
 					return nodeGroups
 				}
 
+				makeManagedNodeGroups := func(names ...string) []*api.ManagedNodeGroup {
+					var nodeGroups []*api.ManagedNodeGroup
+					for _, name := range names {
+						ng := api.NewManagedNodeGroup()
+						ng.Name = name
+						nodeGroups = append(nodeGroups, ng)
+					}
+					return nodeGroups
+				}
+
+				makeFargateProfiles := func(names ...string) []*api.FargateProfile {
+					var fargateProfiles []*api.FargateProfile
+					for _, name := range names {
+						fp := api.NewDefaultFargateProfile()
+						fp.Name = name
+						fargateProfiles = append(fargateProfiles, fp)
+					}
+					return fargateProfiles
+				}
+
 				{
 					tasks := stackManager.NewTasksToCreateNodeGroups(makeNodeGroups("bar", "foo"))
 					Expect(tasks.Describe()).To(Equal(`2 parallel tasks: { create nodegroup "bar", create nodegroup "foo" }`))
@@ -663,17 +732,38 @@ var _ = Describe("StackCollection Tasks", func() {
 					Expect(tasks.Describe()).To(Equal(`no tasks`))
 				}
 				{
-					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar", "foo"))
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar", "foo"), nil, nil)
 					Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { create cluster control plane "test-cluster", 2 parallel sub-tasks: { create nodegroup "bar", create nodegroup "foo" } }`))
 				}
 				{
-					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar"))
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar"), nil, nil)
 					Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { create cluster control plane "test-cluster", create nodegroup "bar" }`))
 				}
 				{
-					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(nil)
+					tasks := stackManager.NewTasksToCreateAllNodeGroups(makeNodeGroups("bar"), makeManagedNodeGroups("foo"))
+					Expect(tasks.Describe()).To(Equal(`2 parallel tasks: { create nodegroup "bar", create managed nodegroup "foo" }`))
+				}
+				{
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar"), makeManagedNodeGroups("foo"), nil)
+					Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { create cluster control plane "test-cluster", 2 parallel sub-tasks: { create nodegroup "bar", create managed nodegroup "foo" } }`))
+				}
+				{
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(nil, nil, nil)
 					Expect(tasks.Describe()).To(Equal(`1 task: { create cluster control plane "test-cluster" }`))
 				}
+				{
+					postClusterCreationTask := &taskWithoutParams{info: "configure networking", call: func(_ chan error) error { return nil }}
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar"), nil, nil, postClusterCreationTask)
+					Expect(tasks.Describe()).To(Equal(`3 sequential tasks: { create cluster control plane "test-cluster", configure networking, create nodegroup "bar" }`))
+				}
+				{
+					tasks := stackManager.NewTasksToCreateFargateProfiles(makeFargateProfiles("fp-1", "fp-2"))
+					Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { create Fargate profile "fp-1", create Fargate profile "fp-2" }`))
+				}
+				{
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(nil, makeManagedNodeGroups("foo"), makeFargateProfiles("fp-1", "fp-2"))
+					Expect(tasks.Describe()).To(Equal(`3 sequential tasks: { create cluster control plane "test-cluster", create managed nodegroup "foo", 2 sequential sub-tasks: { create Fargate profile "fp-1", create Fargate profile "fp-2" } }`))
+				}
 			})
 		})
 
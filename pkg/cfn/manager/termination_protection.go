@@ -0,0 +1,48 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+)
+
+// EnableTerminationProtection makes the stacks created by the collection
+// protected against deletion
+func (c *StackCollection) EnableTerminationProtection() {
+	c.terminationProtection = true
+}
+
+// UpdateTerminationProtection enables or disables termination protection of the stack
+func (c *StackCollection) UpdateTerminationProtection(s *Stack, enabled bool) error {
+	input := &cloudformation.UpdateTerminationProtectionInput{
+		StackName:                   s.StackName,
+		EnableTerminationProtection: aws.Bool(enabled),
+	}
+	if _, err := c.provider.CloudFormation().UpdateTerminationProtection(input); err != nil {
+		return errors.Wrapf(err, "updating termination protection of stack %q", *s.StackName)
+	}
+	return nil
+}
+
+// CheckTerminationProtection returns an error when any of the stacks of the cluster
+// is protected against deletion, so that the cluster isn't partly deleted
+func (c *StackCollection) CheckTerminationProtection() error {
+	stacks, err := c.ListStacks(fmtStacksRegexForCluster(c.spec.Metadata.Name))
+	if err != nil {
+		return errors.Wrapf(err, "describing CloudFormation stacks for %q", c.spec.Metadata.Name)
+	}
+	protected := []string{}
+	for _, s := range stacks {
+		if aws.BoolValue(s.EnableTerminationProtection) {
+			protected = append(protected, *s.StackName)
+		}
+	}
+	if len(protected) > 0 {
+		return fmt.Errorf("termination protection is enabled for stack(s) %s of cluster %q, disable it first with 'eksctl utils set-stack-protection --disable --cluster=%s'",
+			strings.Join(protected, ", "), c.spec.Metadata.Name, c.spec.Metadata.Name)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection termination protection", func() {
+	var (
+		sc *StackCollection
+		p  *mockprovider.MockProvider
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Name = "test-cluster"
+
+		sc = NewStackCollection(p, cfg)
+	})
+
+	mockStacks := func(protected map[string]bool) {
+		summaries := []*cfn.StackSummary{}
+		for name, enabled := range protected {
+			name, enabled := name, enabled
+			summaries = append(summaries, &cfn.StackSummary{StackName: aws.String(name)})
+			p.MockCloudFormation().On("DescribeStacks", mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
+				return input.StackName != nil && *input.StackName == name
+			})).Return(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{
+					{
+						StackName:                   aws.String(name),
+						StackStatus:                 aws.String(cfn.StackStatusCreateComplete),
+						EnableTerminationProtection: aws.Bool(enabled),
+					},
+				},
+			}, nil)
+		}
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.ListStacksOutput{StackSummaries: summaries}, true)
+		}).Return(nil)
+	}
+
+	It("should create stacks with termination protection when it is enabled", func() {
+		var input *cfn.CreateStackInput
+		p.MockCloudFormation().On("CreateStack", mock.Anything).Run(func(args mock.Arguments) {
+			input = args[0].(*cfn.CreateStackInput)
+		}).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-1")}, nil)
+
+		stack := &Stack{StackName: aws.String("eksctl-test-cluster-cluster")}
+		Expect(sc.DoCreateStackRequest(stack, []byte("{}"), nil, nil, false, false)).To(Succeed())
+		Expect(input.EnableTerminationProtection).To(BeNil())
+
+		sc.EnableTerminationProtection()
+		Expect(sc.DoCreateStackRequest(stack, []byte("{}"), nil, nil, false, false)).To(Succeed())
+		Expect(*input.EnableTerminationProtection).To(BeTrue())
+	})
+
+	It("should update termination protection of a stack", func() {
+		p.MockCloudFormation().On("UpdateTerminationProtection", mock.MatchedBy(func(input *cfn.UpdateTerminationProtectionInput) bool {
+			return *input.StackName == "eksctl-test-cluster-cluster" && !*input.EnableTerminationProtection
+		})).Return(&cfn.UpdateTerminationProtectionOutput{}, nil)
+
+		Expect(sc.UpdateTerminationProtection(&Stack{StackName: aws.String("eksctl-test-cluster-cluster")}, false)).To(Succeed())
+	})
+
+	It("should report the protected stacks of the cluster", func() {
+		mockStacks(map[string]bool{
+			"eksctl-test-cluster-cluster":        true,
+			"eksctl-test-cluster-nodegroup-ng-1": false,
+		})
+
+		err := sc.CheckTerminationProtection()
+		Expect(err).To(MatchError(ContainSubstring("termination protection is enabled for stack(s) eksctl-test-cluster-cluster of cluster \"test-cluster\"")))
+	})
+
+	It("should not report anything when no stack is protected", func() {
+		mockStacks(map[string]bool{
+			"eksctl-test-cluster-cluster": false,
+		})
+
+		Expect(sc.CheckTerminationProtection()).To(Succeed())
+	})
+
+	It("should not delete protected stacks", func() {
+		stack := &Stack{
+			StackName:                   aws.String("eksctl-test-cluster-cluster"),
+			EnableTerminationProtection: aws.Bool(true),
+			Tags:                        []*cfn.Tag{newTag(api.ClusterNameTag, "test-cluster")},
+		}
+		_, err := sc.DeleteStackBySpec(stack)
+		Expect(err).To(MatchError(ContainSubstring("termination protection is enabled")))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DeleteStack", mock.Anything)
+	})
+})
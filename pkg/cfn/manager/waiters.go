@@ -2,14 +2,16 @@ package manager
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/request"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
@@ -22,7 +24,7 @@ const (
 // so this is custom version that is more suitable for our use, as there is no way to add any
 // custom acceptors
 
-func (c *StackCollection) waitWithAcceptors(i *Stack, acceptors []request.WaiterAcceptor) error {
+func (c *StackCollection) waitWithAcceptors(i *Stack, timeout time.Duration, acceptors []request.WaiterAcceptor) error {
 	msg := fmt.Sprintf("waiting for CloudFormation stack %q", *i.StackName)
 
 	newRequest := func() *request.Request {
@@ -46,7 +48,7 @@ func (c *StackCollection) waitWithAcceptors(i *Stack, acceptors []request.Waiter
 		}
 	}
 
-	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.provider.WaitTimeout(), troubleshoot)
+	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, timeout, troubleshoot)
 }
 
 func (c *StackCollection) waitWithAcceptorsChangeSet(i *Stack, changesetName string, acceptors []request.WaiterAcceptor) error {
@@ -70,7 +72,7 @@ func (c *StackCollection) waitWithAcceptorsChangeSet(i *Stack, changesetName str
 		}
 	}
 
-	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.provider.WaitTimeout(), troubleshoot)
+	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.spec.UpdateTimeout(c.provider.WaitTimeout()), troubleshoot)
 }
 
 func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus string) {
@@ -81,39 +83,38 @@ func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus
 		return
 	}
 	for _, e := range events {
-		msg := fmt.Sprintf("%s/%s: %s", *e.ResourceType, *e.LogicalResourceId, *e.ResourceStatus)
-		if e.ResourceStatusReason != nil {
-			msg = fmt.Sprintf("%s – %#v", msg, *e.ResourceStatusReason)
-		}
-		switch desiredStatus {
-		case cfn.StackStatusCreateComplete:
-			switch *e.ResourceStatus {
-			case cfn.ResourceStatusCreateFailed:
-				logger.Critical(msg)
-			case cfn.ResourceStatusDeleteInProgress:
-				logger.Warning(msg)
-			default:
-				logger.Debug(msg) // only output this when verbose logging is enabled
-			}
-		case cfn.StackStatusDeleteComplete:
-			switch *e.ResourceStatus {
-			case cfn.ResourceStatusDeleteFailed:
-				logger.Critical(msg)
-			case cfn.ResourceStatusDeleteSkipped:
-				logger.Warning(msg)
-			default:
-				logger.Debug(msg) // only output this when verbose logging is enabled
-			}
-		default:
-			logger.Info(msg)
-		}
+		logger.Debug(FormatStackEvent(e)) // only output all events when verbose logging is enabled
+	}
+
+	failed := FailedStackEvents(*i.StackName, events)
+	if len(failed) == 0 {
+		logger.Warning("no failed resources found in events of stack %q, run 'eksctl utils describe-stacks --events' to see all events", *i.StackName)
+		return
+	}
+	logger.Critical("%d resource(s) of stack %q failed while waiting for %s:", len(failed), *i.StackName, desiredStatus)
+	for _, e := range failed {
+		logger.Critical(FormatStackEvent(e))
+	}
+}
+
+// stackCreationTimeout returns how long to wait for the stack to be created, cluster
+// and nodegroup stacks have their own timeouts
+func (c *StackCollection) stackCreationTimeout(name string) time.Duration {
+	timeout := c.provider.WaitTimeout()
+	switch {
+	case name == c.makeClusterStackName():
+		return c.spec.ClusterCreationTimeout(timeout)
+	case strings.HasPrefix(name, c.makeNodeGroupStackName("")):
+		return c.spec.NodeGroupCreationTimeout(timeout)
+	default:
+		return timeout
 	}
 }
 
 // DoWaitUntilStackIsCreated blocks until the given stack's
 // creation has completed.
 func (c *StackCollection) DoWaitUntilStackIsCreated(i *Stack) error {
-	return c.waitWithAcceptors(i,
+	return c.waitWithAcceptors(i, c.stackCreationTimeout(*i.StackName),
 		waiters.MakeAcceptors(
 			stackStatus,
 			cfn.StackStatusCreateComplete,
@@ -155,7 +156,7 @@ func (c *StackCollection) waitUntilStackIsCreated(i *Stack, stack builder.Resour
 }
 
 func (c *StackCollection) doWaitUntilStackIsDeleted(i *Stack) error {
-	return c.waitWithAcceptors(i,
+	return c.waitWithAcceptors(i, c.spec.DeletionTimeout(c.provider.WaitTimeout()),
 		waiters.MakeAcceptors(
 			stackStatus,
 			cfn.StackStatusDeleteComplete,
@@ -199,7 +200,7 @@ func (c *StackCollection) waitUntilStackIsDeleted(i *Stack, errs chan error) {
 }
 
 func (c *StackCollection) doWaitUntilStackIsUpdated(i *Stack) error {
-	return c.waitWithAcceptors(i,
+	return c.waitWithAcceptors(i, c.spec.UpdateTimeout(c.provider.WaitTimeout()),
 		waiters.MakeAcceptors(
 			stackStatus,
 			cfn.StackStatusUpdateComplete,
@@ -0,0 +1,68 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection waiters", func() {
+	var (
+		cfg *api.ClusterConfig
+		sc  *StackCollection
+		p   *mockprovider.MockProvider
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Name = "test-cluster"
+
+		sc = NewStackCollection(p, cfg)
+	})
+
+	It("should wait for the provider timeout when no timeouts are set", func() {
+		Expect(sc.stackCreationTimeout("eksctl-test-cluster-cluster")).To(Equal(p.WaitTimeout()))
+		Expect(sc.stackCreationTimeout("eksctl-test-cluster-nodegroup-ng-1")).To(Equal(p.WaitTimeout()))
+	})
+
+	It("should wait for the cluster and nodegroup stacks with their own timeouts", func() {
+		cfg.Timeouts = &api.ClusterTimeouts{
+			ClusterCreation:   &metav1.Duration{Duration: time.Hour},
+			NodeGroupCreation: &metav1.Duration{Duration: 40 * time.Minute},
+		}
+		Expect(sc.stackCreationTimeout("eksctl-test-cluster-cluster")).To(Equal(time.Hour))
+		Expect(sc.stackCreationTimeout("eksctl-test-cluster-nodegroup-ng-1")).To(Equal(40 * time.Minute))
+		Expect(sc.stackCreationTimeout("eksctl-test-cluster-fargate-fp-1")).To(Equal(p.WaitTimeout()))
+	})
+
+	It("should report the IDs of the stacks that deletion was requested for", func() {
+		p.MockCloudFormation().On("DeleteStack", mock.Anything).Return(&cfn.DeleteStackOutput{}, nil)
+
+		Expect(sc.DeletionRequestedStackIDs()).To(BeEmpty())
+
+		for _, name := range []string{"eksctl-test-cluster-nodegroup-ng-1", "eksctl-test-cluster-cluster"} {
+			_, err := sc.DeleteStackBySpec(&Stack{
+				StackName: aws.String(name),
+				StackId:   aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/" + name + "/1"),
+				Tags:      []*cfn.Tag{newTag(api.ClusterNameTag, "test-cluster")},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(sc.DeletionRequestedStackIDs()).To(Equal([]string{
+			"arn:aws:cloudformation:us-west-2:123456789012:stack/eksctl-test-cluster-nodegroup-ng-1/1",
+			"arn:aws:cloudformation:us-west-2:123456789012:stack/eksctl-test-cluster-cluster/1",
+		}))
+	})
+})
@@ -16,6 +16,7 @@ const (
 	ClusterSecurityGroup  = "SecurityGroup"
 	ClusterSubnetsPrivate = string("Subnets" + api.SubnetTopologyPrivate)
 	ClusterSubnetsPublic  = string("Subnets" + api.SubnetTopologyPublic)
+	ClusterSubnetsPod     = "SubnetsPod"
 
 	ClusterSubnetsPublicLegacy = "Subnets"
 
@@ -27,11 +28,15 @@ const (
 	ClusterServiceRoleARN           = "ServiceRoleARN"
 	ClusterFeatureNATMode           = "FeatureNATMode"
 	ClusterFeatureEndpointAccess    = "FeatureEndpointAccess"
+	ClusterFeatureRegistered        = "FeatureRegistered"
 
 	// outputs from nodegroup stack
 	NodeGroupInstanceRoleARN    = "InstanceRoleARN"
 	NodeGroupInstanceProfileARN = "InstanceProfileARN"
 
+	// outputs from Fargate profile stack
+	FargatePodExecutionRoleARN = "PodExecutionRoleARN"
+
 	// outputs to indicate configuration attributes that may have critical effect
 	// on critical effect on forward-compatibility with respect to overal functionality
 	// and integrity, e.g. networking
@@ -8,9 +8,9 @@ import (
 	"io"
 	"io/ioutil"
 
-	"github.com/kris-nova/logger"
-
 	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -91,7 +91,13 @@ func (c *CloudConfig) AddScript(p, s string) {
 
 // RunScript adds and runs a script on the node
 func (c *CloudConfig) RunScript(name, s string) {
-	p := scriptDir + name
+	c.RunScriptFrom(scriptDir, name, s)
+}
+
+// RunScriptFrom adds a script to the given directory and runs it on the node, unlike
+// the ones added with RunScript, cloud-init doesn't run it on its own
+func (c *CloudConfig) RunScriptFrom(dir, name, s string) {
+	p := dir + name
 	c.AddScript(p, s)
 	c.AddCommand(p)
 }
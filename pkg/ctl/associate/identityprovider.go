@@ -0,0 +1,72 @@
+package associate
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func associateIdentityProviderCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	idp := &api.IdentityProvider{}
+	cfg.IdentityProviders = append(cfg.IdentityProviders, idp)
+
+	cmd.SetDescription("identityprovider", "Associate an OIDC identity provider with a cluster",
+		"Users can then authenticate to the cluster with tokens issued by the identity provider, in addition to IAM",
+		"identityproviders")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doAssociateIdentityProvider(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to associate the identity provider with")
+
+		fs.StringVar(&idp.Name, "name", "", "name of the identity provider configuration")
+		fs.StringVar(&idp.IssuerURL, "issuer-url", "", "URL of the OpenID Connect issuer")
+		fs.StringVar(&idp.ClientID, "client-id", "", "ID of the client application that makes authentication requests")
+		fs.StringVar(&idp.UsernameClaim, "username-claim", "", "JWT claim to use as the username")
+		fs.StringVar(&idp.UsernamePrefix, "username-prefix", "", "prefix prepended to username claims")
+		fs.StringVar(&idp.GroupsClaim, "groups-claim", "", "JWT claim to use as the user's groups")
+		fs.StringVar(&idp.GroupsPrefix, "groups-prefix", "", "prefix prepended to group claims")
+		fs.StringToStringVar(&idp.RequiredClaims, "required-claims", map[string]string{}, `claims that must be present in the identity token (e.g. "hd=example.com")`)
+		fs.StringToStringVar(&idp.Tags, "tags", map[string]string{}, `A list of KV pairs used to tag the identity provider configuration (e.g. "Owner=John Doe,Team=Some Team")`)
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doAssociateIdentityProvider(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewAssociateIdentityProviderLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	logger.Info("associating %d identity provider(s) with cluster %q, this may take a long time", len(cfg.IdentityProviders), meta.Name)
+
+	return ctl.AssociateIdentityProviders(cfg, cfg.IdentityProviders)
+}
@@ -0,0 +1,24 @@
+package cmdutils
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// AddAddonFlags adds the flags that are common to 'eksctl create addon' and 'eksctl update addon'
+func AddAddonFlags(fs *pflag.FlagSet, addon *api.Addon, force *bool) {
+	fs.StringVar(&addon.Name, "name", "", "name of the add-on, e.g. vpc-cni, coredns or kube-proxy")
+	fs.StringVar(&addon.Version, "version", "", "version of the add-on (defaults to the default version for the Kubernetes version of the cluster)")
+	fs.StringVar(&addon.ServiceAccountRoleARN, "service-account-role-arn", "", "ARN of an existing IAM role to use for the service account of the add-on")
+	fs.StringSliceVar(&addon.AttachPolicyARNs, "attach-policy-arn", []string{}, "ARN of the policy to attach to an IAM role created for the service account of the add-on")
+	fs.StringToStringVar(&addon.Tags, "tags", map[string]string{}, `A list of KV pairs used to tag the add-on (e.g. "Owner=John Doe,Team=Some Team")`)
+	fs.BoolVar(force, "force", false, "overwrite changes made to the Kubernetes resources of the add-on that conflict with its configuration")
+}
+
+// SetAddonResolveConflicts sets how to resolve conflicts for the add-on given by flags
+func SetAddonResolveConflicts(addon *api.Addon, force bool) {
+	if force {
+		addon.ResolveConflicts = api.AddonResolveConflictsOverwrite
+	}
+}
@@ -3,11 +3,11 @@ package cmdutils
 import (
 	"os"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // Cmd holds attributes that are common between commands;
@@ -18,14 +18,21 @@ type Cmd struct {
 
 	Plan, Wait, Validate bool
 
+	DryRun bool
+
 	NameArg string
 
 	ClusterConfigFile string
+	// clusterConfigData is loaded instead of ClusterConfigFile when set,
+	// it holds one of the documents of a batch run with --all
+	clusterConfigData []byte
 
 	ProviderConfig *api.ProviderConfig
 	ClusterConfig  *api.ClusterConfig
 
 	Include, Exclude []string
+
+	MaxConcurrency int
 }
 
 // NewCtl performs common defaulting and validation and constructs a new
@@ -53,6 +60,16 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 		api.SetNodeGroupDefaults(i, ng)
 	}
 
+	for i, ng := range c.ClusterConfig.ManagedNodeGroups {
+		if err := api.ValidateManagedNodeGroup(i, ng); err != nil {
+			if c.Validate {
+				return nil, err
+			}
+			logger.Warning("ignoring validation error: %s", err.Error())
+		}
+		api.SetManagedNodeGroupDefaults(i, ng, c.ClusterConfig.Metadata)
+	}
+
 	ctl := eks.New(c.ProviderConfig, c.ClusterConfig)
 
 	if !ctl.IsSupportedRegion() {
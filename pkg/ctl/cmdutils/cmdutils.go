@@ -6,10 +6,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/kris-nova/logger"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
@@ -68,9 +71,30 @@ func LogPlanModeWarning(plan bool) {
 	}
 }
 
+// LogNoWaitHint will log the IDs of stacks that are still being deleted when
+// they are not waited for, and inform user how to follow their progress
+func LogNoWaitHint(wait, plan bool, meta *api.ClusterMeta, stackIDs []string) {
+	if wait || plan || len(stackIDs) == 0 {
+		return
+	}
+	logger.Info("%d stack(s) are still being deleted:", len(stackIDs))
+	for _, id := range stackIDs {
+		logger.Info("- %s", id)
+	}
+	logger.Info("run 'eksctl utils describe-stacks --region=%s --cluster=%s' to follow their progress", meta.Region, meta.Name)
+}
+
+// clusterLogFields is false while more than one cluster is processed at the
+// same time, see RunForAllClusterConfigs
+var clusterLogFields = true
+
 // LogRegionAndVersionInfo will log the selected region and build version
 func LogRegionAndVersionInfo(meta *api.ClusterMeta) {
 	if meta != nil {
+		if clusterLogFields {
+			logger.SetField("cluster", meta.Name)
+			logger.SetField("region", meta.Region)
+		}
 		logger.Info("eksctl version %s", version.GetVersion())
 		logger.Info("using region %s", meta.Region)
 	}
@@ -86,6 +110,41 @@ func AddApproveFlag(fs *pflag.FlagSet, cmd *Cmd) {
 	})
 }
 
+// AddDryRunFlag adds common `--dry-run` flag
+func AddDryRunFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.BoolVar(&cmd.DryRun, "dry-run", false, "print the CloudFormation changes that would be made, without making any changes")
+}
+
+// LogStackPlans prints the resource changes of each of the stacks and a summary of all of them
+func LogStackPlans(plans []*manager.StackPlan) {
+	var added, modified, removed int
+	for _, plan := range plans {
+		logger.Info("stack %q:", plan.StackName)
+		if len(plan.Changes) == 0 {
+			logger.Info("    (no changes)")
+		}
+		for _, change := range plan.Changes {
+			switch change.Action {
+			case cloudformation.ChangeActionAdd:
+				added++
+				logger.Info("  + %s (%s)", change.LogicalID, change.Type)
+			case cloudformation.ChangeActionModify:
+				modified++
+				if change.Replacement == cloudformation.ReplacementTrue {
+					logger.Info("  ~ %s (%s) [replacement]", change.LogicalID, change.Type)
+				} else {
+					logger.Info("  ~ %s (%s)", change.LogicalID, change.Type)
+				}
+			case cloudformation.ChangeActionRemove:
+				removed++
+				logger.Info("  - %s (%s) %s", change.LogicalID, change.Type, change.PhysicalID)
+			}
+		}
+	}
+	logger.Info("%d resources to create, %d to modify, %d to destroy", added, modified, removed)
+	logger.Warning("no changes were made, run again without '--dry-run' to apply the changes")
+}
+
 // GetNameArg tests to ensure there is only 1 name argument
 func GetNameArg(args []string) string {
 	if len(args) > 1 {
@@ -108,6 +167,7 @@ func AddCommonFlagsForAWS(group *NamedFlagSetGroup, p *api.ProviderConfig, cfnRo
 		if err := fs.MarkHidden("aws-api-timeout"); err != nil {
 			logger.Debug("ignoring error %q", err.Error())
 		}
+		fs.BoolVar(&p.NoCache, "no-cache", false, "Don't use cached results of lookups, such as AMIs and availability zones, from previous runs")
 		if cfnRole {
 			fs.StringVar(&p.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf")
 		}
@@ -124,6 +184,12 @@ func AddTimeoutFlag(fs *pflag.FlagSet, p *time.Duration) {
 	AddTimeoutFlagWithValue(fs, p, api.DefaultWaitTimeout)
 }
 
+// AddStackTerminationProtectionFlag adds a flag to enable termination protection
+// for the CloudFormation stacks created by the command
+func AddStackTerminationProtectionFlag(fs *pflag.FlagSet, p *api.ProviderConfig) {
+	fs.BoolVar(&p.StackTerminationProtection, "enable-stack-termination-protection", false, "Enable termination protection for the CloudFormation stacks that are created, so they cannot be deleted until it is disabled with 'eksctl utils set-stack-protection --disable'")
+}
+
 // AddClusterFlag adds a common --cluster flag for cluster name.
 // Use this for commands whose principal resource is *not* a cluster.
 func AddClusterFlag(fs *pflag.FlagSet, meta *api.ClusterMeta) {
@@ -167,15 +233,41 @@ func AddWaitFlag(fs *pflag.FlagSet, wait *bool, description string) {
 	fs.BoolVarP(wait, "wait", "w", *wait, fmt.Sprintf("wait for %s before exiting", description))
 }
 
+// AddMaxConcurrencyFlag adds common --max-concurrency flag
+func AddMaxConcurrencyFlag(fs *pflag.FlagSet, maxConcurrency *int, verb string) {
+	fs.IntVar(maxConcurrency, "max-concurrency", 0, fmt.Sprintf("maximum number of stacks to %s, or of other tasks to run, at the same time (no limit when set to 0)", verb))
+}
+
 // AddUpdateAuthConfigMap adds common --update-auth-configmap flag
 func AddUpdateAuthConfigMap(fs *pflag.FlagSet, updateAuthConfigMap *bool, description string) {
 	fs.BoolVar(updateAuthConfigMap, "update-auth-configmap", true, description)
 }
 
+// AddInstallNvidiaPluginFlag adds common --install-nvidia-plugin flag
+func AddInstallNvidiaPluginFlag(fs *pflag.FlagSet, installNvidiaPlugin *bool) {
+	fs.BoolVar(installNvidiaPlugin, "install-nvidia-plugin", true, "install the NVIDIA Kubernetes device plugin when a GPU instance type is used (the manifest is committed to the gitops repository instead when git.repo is set)")
+}
+
+// AddInstallNodeTerminationHandlerFlag adds common --install-node-termination-handler flag
+func AddInstallNodeTerminationHandlerFlag(fs *pflag.FlagSet, installNodeTerminationHandler *bool) {
+	fs.BoolVar(installNodeTerminationHandler, "install-node-termination-handler", false, "install the AWS Node Termination Handler when nodegroups use spot instances, and add a lifecycle hook to their auto scaling groups for nodes to be drained (the manifest is committed to the gitops repository instead when git.repo is set)")
+}
+
+// EnableNodeTerminationHandler enables nodeTerminationHandler in the config
+func EnableNodeTerminationHandler(cfg *api.ClusterConfig) {
+	if cfg.NodeTerminationHandler == nil {
+		cfg.NodeTerminationHandler = &api.NodeTerminationHandler{}
+	}
+	cfg.NodeTerminationHandler.Enabled = api.Enabled()
+}
+
 // AddCommonFlagsForKubeconfig adds common flags for controlling how output kubeconfig is written
-func AddCommonFlagsForKubeconfig(fs *pflag.FlagSet, outputPath, authenticatorRoleARN *string, setContext, autoPath *bool, exampleName string) {
+func AddCommonFlagsForKubeconfig(fs *pflag.FlagSet, outputPath *string, options *kubeconfig.Options, setContext, autoPath *bool, exampleName string) {
 	fs.StringVar(outputPath, "kubeconfig", kubeconfig.DefaultPath, "path to write kubeconfig (incompatible with --auto-kubeconfig)")
-	fs.StringVar(authenticatorRoleARN, "authenticator-role-arn", "", "AWS IAM role to assume for authenticator")
+	fs.StringVar(&options.Authenticator, "authenticator", "", fmt.Sprintf("authenticator command to use in kubeconfig, %q uses 'aws eks get-token' (valid options: %s; auto-detected if unspecified)", kubeconfig.AWSEKSAuthenticator, strings.Join(kubeconfig.AuthenticatorCommands(), ", ")))
+	fs.StringVar(&options.RoleARN, "authenticator-role-arn", "", "AWS IAM role to assume for authenticator")
+	fs.StringVar(&options.Profile, "authenticator-profile", "", "AWS credentials profile to set as AWS_PROFILE for authenticator (defaults to the value of --profile)")
+	fs.StringVar(&options.ContextAlias, "context-alias", "", "name of the context in kubeconfig (defaults to \"<user>@<cluster>.<region>.eksctl.io\")")
 	fs.BoolVar(setContext, "set-kubeconfig-context", true, "if true then current-context will be set in kubeconfig; if a context is already set then it will be overwritten")
 	fs.BoolVar(autoPath, "auto-kubeconfig", false, fmt.Sprintf("save kubeconfig file by cluster name, e.g. %q", kubeconfig.AutoPath(exampleName)))
 }
@@ -183,7 +275,21 @@ func AddCommonFlagsForKubeconfig(fs *pflag.FlagSet, outputPath, authenticatorRol
 // AddCommonFlagsForGetCmd adds common flafs for get commands
 func AddCommonFlagsForGetCmd(fs *pflag.FlagSet, chunkSize *int, outputMode *string) {
 	fs.IntVar(chunkSize, "chunk-size", 100, "return large lists in chunks rather than all at once, pass 0 to disable")
-	fs.StringVarP(outputMode, "output", "o", "table", "specifies the output format (valid option: table, json, yaml)")
+	fs.StringVarP(outputMode, "output", "o", printers.TableType, fmt.Sprintf("specifies the output format (valid option: %s)", strings.Join(printers.SupportedTypes(), ", ")))
+}
+
+// NewOutputPrinter creates the printer for the given output format, it should be called
+// before making any API calls, so that an invalid format is reported straight away;
+// addTableColumns is only called when the output format is a table
+func NewOutputPrinter(outputMode string, addTableColumns func(*printers.TablePrinter)) (printers.OutputPrinter, error) {
+	printer, err := printers.NewPrinter(outputMode)
+	if err != nil {
+		return nil, err
+	}
+	if outputMode == printers.TableType && addTableColumns != nil {
+		addTableColumns(printer.(*printers.TablePrinter))
+	}
+	return printer, nil
 }
 
 // ErrUnsupportedRegion is a common error message
@@ -12,7 +12,7 @@ import (
 
 // AddConfigFileFlag adds common --config-file flag
 func AddConfigFileFlag(fs *pflag.FlagSet, path *string) {
-	fs.StringVarP(path, "config-file", "f", "", "load configuration from a file (or stdin if set to '-')")
+	fs.VarP(&configFilesValue{paths: path}, "config-file", "f", "load configuration from a file (or stdin if set to '-'), can be given multiple times to merge files, later files override earlier ones")
 }
 
 // ClusterConfigLoader is an inteface that loaders should implement
@@ -67,14 +67,21 @@ func (l *commonClusterConfigLoader) Load() error {
 		return l.validateWithoutConfigFile()
 	}
 
-	var err error
-
 	// The reference to ClusterConfig should only be reassigned if ClusterConfigFile is specified
 	// because other parts of the code store the pointer locally and access it directly instead of via
 	// the Cmd reference
-	if l.ClusterConfig, err = eks.LoadConfigFromFile(l.ClusterConfigFile); err != nil {
+	data := l.clusterConfigData
+	if data == nil {
+		var err error
+		if data, err = readConfigFiles(splitConfigFiles(l.ClusterConfigFile)); err != nil {
+			return err
+		}
+	}
+	cfg, err := eks.LoadConfig(data, l.ClusterConfigFile)
+	if err != nil {
 		return err
 	}
+	l.ClusterConfig = cfg
 	meta := l.ClusterConfig.Metadata
 
 	if meta == nil {
@@ -151,6 +158,27 @@ func NewEnableProfileLoader(cmd *Cmd) ClusterConfigLoader {
 func NewInstallFluxLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
 
+	l.validateWithConfigFile = func() error {
+		if !l.ClusterConfig.HasGitRepo() {
+			return nil
+		}
+		// when git.repo is set in the config file, it is the only source of truth
+		for _, f := range []string{
+			"git-url",
+			"git-branch",
+			"git-paths",
+			"git-user",
+			"git-email",
+			"git-flux-subdir",
+			"git-private-ssh-key-path",
+		} {
+			if flag := l.CobraCommand.Flag(f); flag != nil && flag.Changed {
+				return ErrCannotUseWithConfigFile(fmt.Sprintf("--%s when git.repo is set", f))
+			}
+		}
+		return nil
+	}
+
 	l.validateWithoutConfigFile = func() error {
 		meta := l.ClusterConfig.Metadata
 		if meta.Name == "" {
@@ -182,6 +210,7 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *NodeGroupFilter, ng *api.NodeGro
 		"node-ami-family",
 		"ssh-access",
 		"ssh-public-key",
+		"enable-ssm",
 		"node-private-networking",
 		"node-security-groups",
 		"node-labels",
@@ -194,6 +223,7 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *NodeGroupFilter, ng *api.NodeGro
 		"vpc-cidr",
 		"vpc-nat-mode",
 		"vpc-from-kops-cluster",
+		"fargate",
 	)
 
 	l.flagsIncompatibleWithoutConfigFile.Insert("install-vpc-controllers")
@@ -208,7 +238,20 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *NodeGroupFilter, ng *api.NodeGro
 		}
 
 		if !api.IsSetAndNonEmptyString(l.ClusterConfig.VPC.NAT.Gateway) {
-			*l.ClusterConfig.VPC.NAT.Gateway = api.ClusterSingleNAT
+			if l.ClusterConfig.IsFullyPrivate() {
+				*l.ClusterConfig.VPC.NAT.Gateway = api.ClusterDisableNAT
+			} else {
+				*l.ClusterConfig.VPC.NAT.Gateway = api.ClusterSingleNAT
+			}
+		}
+
+		if l.ClusterConfig.IsFullyPrivate() {
+			// public access is needed while the cluster is being created,
+			// it gets disabled once all resources are ready
+			l.ClusterConfig.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+				PrivateAccess: api.Enabled(),
+				PublicAccess:  api.Enabled(),
+			}
 		}
 
 		if l.ClusterConfig.VPC.ClusterEndpoints == nil {
@@ -267,6 +310,7 @@ func NewCreateNodeGroupLoader(cmd *Cmd, ngFilter *NodeGroupFilter) ClusterConfig
 		"node-ami-family",
 		"ssh-access",
 		"ssh-public-key",
+		"enable-ssm",
 		"node-private-networking",
 		"node-security-groups",
 		"node-labels",
@@ -376,6 +420,7 @@ func NewUtilsEnableEndpointAccessLoader(cmd *Cmd) ClusterConfigLoader {
 	l.flagsIncompatibleWithConfigFile.Insert(
 		"private-access",
 		"public-access",
+		"public-access-cidrs",
 	)
 
 	l.validateWithoutConfigFile = l.validateMetadataWithoutConfigFile
@@ -514,3 +559,310 @@ func NewDeleteIAMServiceAccountLoader(cmd *Cmd, sa *api.ClusterIAMServiceAccount
 
 	return l
 }
+
+// NewCreateFargateProfileLoader will load config or use flags for 'eksctl create fargateprofile'
+func NewCreateFargateProfileLoader(cmd *Cmd, fp *api.FargateProfile) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"name",
+		"namespace",
+		"labels",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.FargateProfiles) == 0 {
+			return fmt.Errorf("no Fargate profiles defined in %q", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if fp.Name != "" && l.NameArg != "" {
+			return ErrClusterFlagAndArg(l.Cmd, fp.Name, l.NameArg)
+		}
+
+		if l.NameArg != "" {
+			fp.Name = l.NameArg
+		}
+
+		if fp.Name == "" {
+			return ErrMustBeSet("--name")
+		}
+
+		if len(fp.Selectors) != 1 || fp.Selectors[0].Namespace == "" {
+			return ErrMustBeSet("--namespace")
+		}
+
+		return nil
+	}
+
+	return l
+}
+
+// NewGetFargateProfileLoader will load config or use flags for 'eksctl get fargateprofile'
+func NewGetFargateProfileLoader(cmd *Cmd, fp *api.FargateProfile) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if fp.Name != "" && l.NameArg != "" {
+			return ErrClusterFlagAndArg(l.Cmd, fp.Name, l.NameArg)
+		}
+
+		if l.NameArg != "" {
+			fp.Name = l.NameArg
+		}
+
+		l.Plan = false
+
+		return nil
+	}
+
+	return l
+}
+
+// NewDeleteFargateProfileLoader will load config or use flags for 'eksctl delete fargateprofile'
+func NewDeleteFargateProfileLoader(cmd *Cmd, fp *api.FargateProfile) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.FargateProfiles) == 0 {
+			return fmt.Errorf("no Fargate profiles defined in %q", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.flagsIncompatibleWithoutConfigFile.Insert(
+		"approve",
+	)
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if fp.Name != "" && l.NameArg != "" {
+			return ErrClusterFlagAndArg(l.Cmd, fp.Name, l.NameArg)
+		}
+
+		if l.NameArg != "" {
+			fp.Name = l.NameArg
+		}
+
+		if fp.Name == "" {
+			return ErrMustBeSet("--name")
+		}
+
+		l.Plan = false
+
+		return nil
+	}
+
+	return l
+}
+
+// NewCreateAddonLoader will load config or use flags for 'eksctl create addon' and 'eksctl update addon'
+func NewCreateAddonLoader(cmd *Cmd, addon *api.Addon) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"name",
+		"service-account-role-arn",
+		"attach-policy-arn",
+		"tags",
+		"force",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.Addons) == 0 {
+			return fmt.Errorf("no add-ons defined in %q", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if addon.Name != "" && l.NameArg != "" {
+			return ErrClusterFlagAndArg(l.Cmd, addon.Name, l.NameArg)
+		}
+
+		if l.NameArg != "" {
+			addon.Name = l.NameArg
+		}
+
+		if addon.Name == "" {
+			return ErrMustBeSet("--name")
+		}
+
+		if len(addon.AttachPolicyARNs) > 0 {
+			l.ClusterConfig.IAM.WithOIDC = api.Enabled()
+		}
+
+		l.Plan = false
+
+		return nil
+	}
+
+	return l
+}
+
+// NewGetAddonLoader will load config or use flags for 'eksctl get addon'
+func NewGetAddonLoader(cmd *Cmd, addon *api.Addon) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if addon.Name != "" && l.NameArg != "" {
+			return ErrClusterFlagAndArg(l.Cmd, addon.Name, l.NameArg)
+		}
+
+		if l.NameArg != "" {
+			addon.Name = l.NameArg
+		}
+
+		l.Plan = false
+
+		return nil
+	}
+
+	return l
+}
+
+// NewDeleteAddonLoader will load config or use flags for 'eksctl delete addon'
+func NewDeleteAddonLoader(cmd *Cmd, addon *api.Addon) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"name",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.Addons) == 0 {
+			return fmt.Errorf("no add-ons defined in %q", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.flagsIncompatibleWithoutConfigFile.Insert(
+		"approve",
+	)
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if addon.Name != "" && l.NameArg != "" {
+			return ErrClusterFlagAndArg(l.Cmd, addon.Name, l.NameArg)
+		}
+
+		if l.NameArg != "" {
+			addon.Name = l.NameArg
+		}
+
+		if addon.Name == "" {
+			return ErrMustBeSet("--name")
+		}
+
+		l.Plan = false
+
+		return nil
+	}
+
+	return l
+}
+
+// NewUtilsEnableSecretsEncryptionLoader will load config or use flags for 'eksctl utils enable-secrets-encryption'
+func NewUtilsEnableSecretsEncryptionLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"key-arn",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if !l.ClusterConfig.HasSecretsEncryption() {
+			return fmt.Errorf("'secretsEncryption.keyARN' is not set in %q", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if !l.ClusterConfig.HasSecretsEncryption() {
+			return ErrMustBeSet("--key-arn")
+		}
+		return l.validateMetadataWithoutConfigFile()
+	}
+
+	return l
+}
+
+// NewAssociateIdentityProviderLoader will load config or use flags for 'eksctl associate identityprovider'
+func NewAssociateIdentityProviderLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"name",
+		"issuer-url",
+		"client-id",
+		"username-claim",
+		"username-prefix",
+		"groups-claim",
+		"groups-prefix",
+		"required-claims",
+		"tags",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.IdentityProviders) == 0 {
+			return fmt.Errorf("no identity providers defined in %q", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		idp := l.ClusterConfig.IdentityProviders[0]
+
+		if idp.Name != "" && l.NameArg != "" {
+			return ErrClusterFlagAndArg(l.Cmd, idp.Name, l.NameArg)
+		}
+
+		if l.NameArg != "" {
+			idp.Name = l.NameArg
+		}
+
+		if idp.Name == "" {
+			return ErrMustBeSet("--name")
+		}
+		if idp.IssuerURL == "" {
+			return ErrMustBeSet("--issuer-url")
+		}
+		if idp.ClientID == "" {
+			return ErrMustBeSet("--client-id")
+		}
+
+		l.Plan = false
+
+		return nil
+	}
+
+	return l
+}
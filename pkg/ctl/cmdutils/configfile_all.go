@@ -0,0 +1,239 @@
+package cmdutils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// defaultClusterConcurrency is the default number of clusters processed at the same time with --all
+const defaultClusterConcurrency = 4
+
+// AllClusterConfigs holds the flags of commands that can process all of the
+// ClusterConfigs given via --config-file/-f at once
+type AllClusterConfigs struct {
+	Enabled     bool
+	Concurrency int
+}
+
+// AddAllClusterConfigsFlags adds common --all and --cluster-concurrency flags
+func AddAllClusterConfigsFlags(fs *pflag.FlagSet, all *AllClusterConfigs, verb string) {
+	fs.BoolVar(&all.Enabled, "all", false, fmt.Sprintf("%s all clusters given via --config-file/-f, which may also be a directory; each document is a separate ClusterConfig", verb))
+	fs.IntVar(&all.Concurrency, "cluster-concurrency", defaultClusterConcurrency, fmt.Sprintf("maximum number of clusters to %s at the same time when --all is set", verb))
+}
+
+// clusterConfigDocument is a single ClusterConfig out of all given config files
+type clusterConfigDocument struct {
+	source string
+	data   []byte
+}
+
+type clusterConfigResult struct {
+	source, name, region string
+	err                  error
+}
+
+// RunForAllClusterConfigs loads each of the ClusterConfigs given via --config-file/-f
+// separately and runs the command for each of them, with at most the given number of
+// them at the same time; the outcome for each cluster is reported once all are done
+func RunForAllClusterConfigs(cmd *Cmd, all AllClusterConfigs, verb string, run func(*Cmd) error) error {
+	if cmd.ClusterConfigFile == "" {
+		return fmt.Errorf("cannot use --all unless a config file is specified via --config-file/-f")
+	}
+	if cmd.NameArg != "" {
+		return fmt.Errorf("cannot use name argument %q with --all", cmd.NameArg)
+	}
+
+	documents, err := readClusterConfigDocuments(splitConfigFiles(cmd.ClusterConfigFile))
+	if err != nil {
+		return err
+	}
+	if len(documents) == 0 {
+		return fmt.Errorf("no ClusterConfigs found in %s", strings.Join(splitConfigFiles(cmd.ClusterConfigFile), ", "))
+	}
+	if err := checkDuplicateClusterConfigs(documents); err != nil {
+		return err
+	}
+
+	concurrency := all.Concurrency
+	// the output of dry-runs would be interleaved otherwise
+	if concurrency < 1 || cmd.DryRun {
+		concurrency = 1
+	}
+	logger.Info("will %s %d cluster(s), %d at a time", verb, len(documents), concurrency)
+
+	if concurrency > 1 {
+		// the cluster and region fields would be set by each of the clusters in turn
+		clusterLogFields = false
+		defer func() { clusterLogFields = true }()
+	}
+
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, concurrency)
+		results   = make([]clusterConfigResult, len(documents))
+	)
+	for i := range documents {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			label := documents[i].label()
+			if concurrency == 1 {
+				// all messages are about this cluster until it's done
+				defer logger.SetPrefix(label)()
+			}
+			logger.WithPrefix(label).Info("will %s cluster from %s", verb, documents[i].source)
+
+			clusterCmd := cmd.withClusterConfigDocument(documents[i])
+			result := clusterConfigResult{
+				source: documents[i].source,
+				err:    run(clusterCmd),
+			}
+			if meta := clusterCmd.ClusterConfig.Metadata; meta != nil {
+				result.name, result.region = meta.Name, meta.Region
+			}
+			results[i] = result
+		}(i)
+	}
+	wg.Wait()
+
+	return reportClusterConfigResults(verb, results)
+}
+
+// withClusterConfigDocument returns a copy of the command that loads the
+// given document, instead of the config files set via --config-file/-f
+func (c *Cmd) withClusterConfigDocument(document clusterConfigDocument) *Cmd {
+	clusterCmd := *c
+	providerConfig := *c.ProviderConfig
+	clusterCmd.ProviderConfig = &providerConfig
+	clusterCmd.ClusterConfig = api.NewClusterConfig()
+	clusterCmd.ClusterConfigFile = document.source
+	clusterCmd.clusterConfigData = document.data
+	return &clusterCmd
+}
+
+// readClusterConfigDocuments reads all documents of the given config files, and of the
+// config files in the given directories, each document is a separate ClusterConfig
+func readClusterConfigDocuments(paths []string) ([]clusterConfigDocument, error) {
+	documents := []clusterConfigDocument{}
+	for _, path := range paths {
+		files := []string{path}
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			if files, err = configFilesInDir(path); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, file := range files {
+			data, err := eks.ReadConfig(file)
+			if err != nil {
+				return nil, errors.Wrapf(err, "reading config file %q", file)
+			}
+			fileDocuments := []string{}
+			for _, d := range documentSeparator.Split(string(expandEnvVars(data)), -1) {
+				if strings.TrimSpace(d) != "" {
+					fileDocuments = append(fileDocuments, d)
+				}
+			}
+			for i, d := range fileDocuments {
+				source := file
+				if len(fileDocuments) > 1 {
+					source = fmt.Sprintf("%s[%d]", file, i)
+				}
+				documents = append(documents, clusterConfigDocument{source: source, data: []byte(d)})
+			}
+		}
+	}
+	return documents, nil
+}
+
+// configFilesInDir returns the paths of the YAML and JSON files in dir, sorted by name
+func configFilesInDir(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading config directory %q", dir)
+	}
+	files := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch filepath.Ext(entry.Name()) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+// checkDuplicateClusterConfigs makes sure that no cluster is defined twice, as
+// that would make the command run for the same cluster at the same time
+func checkDuplicateClusterConfigs(documents []clusterConfigDocument) error {
+	sources := map[string]string{}
+	for _, d := range documents {
+		meta := d.metadata()
+		if meta == nil {
+			continue
+		}
+		key := meta.Name + "/" + meta.Region
+		if source, ok := sources[key]; ok {
+			return fmt.Errorf("cluster %q in region %q is defined in both %s and %s", meta.Name, meta.Region, source, d.source)
+		}
+		sources[key] = d.source
+	}
+	return nil
+}
+
+// metadata returns the metadata of the document, or nil if it has no cluster name;
+// any errors are reported when the document is loaded
+func (d clusterConfigDocument) metadata() *api.ClusterMeta {
+	cfg := api.ClusterConfig{}
+	if err := yaml.Unmarshal(d.data, &cfg); err != nil || cfg.Metadata == nil || cfg.Metadata.Name == "" {
+		return nil
+	}
+	return cfg.Metadata
+}
+
+// label is the prefix of the messages logged for the document, it's the cluster
+// name, or the source of the document when it doesn't have one
+func (d clusterConfigDocument) label() string {
+	if meta := d.metadata(); meta != nil {
+		return meta.Name
+	}
+	return d.source
+}
+
+func reportClusterConfigResults(verb string, results []clusterConfigResult) error {
+	failed := 0
+	for _, r := range results {
+		name := r.source
+		if r.name != "" {
+			name = fmt.Sprintf("cluster %q in region %q (%s)", r.name, r.region, r.source)
+		}
+		if r.err != nil {
+			failed++
+			logger.Critical("failed to %s %s: %s", verb, name, r.err.Error())
+			continue
+		}
+		logger.Success("%s %s: done", verb, name)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to %s %d of %d cluster(s)", verb, failed, len(results))
+	}
+	logger.Success("all %d cluster(s) done", len(results))
+	return nil
+}
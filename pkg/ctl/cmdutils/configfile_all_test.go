@@ -0,0 +1,230 @@
+package cmdutils_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+)
+
+var _ = Describe("cmdutils configfile with --all", func() {
+	const clusterConfig = `apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: %s
+  region: %s
+`
+
+	var (
+		dir     string
+		mutex   sync.Mutex
+		created []string
+	)
+
+	newCmd := func(configFiles ...string) *Cmd {
+		cobraCmd := &cobra.Command{Use: "test", Run: func(_ *cobra.Command, _ []string) {}}
+		// flags are set up lazily, which cobra does when the command is executed
+		Expect(cobraCmd.ParseFlags(nil)).To(Succeed())
+		return &Cmd{
+			CobraCommand:      cobraCmd,
+			ProviderConfig:    &api.ProviderConfig{},
+			ClusterConfig:     api.NewClusterConfig(),
+			ClusterConfigFile: strings.Join(configFiles, ","),
+		}
+	}
+
+	create := func(cmd *Cmd) error {
+		if err := NewMetadataLoader(cmd).Load(); err != nil {
+			return err
+		}
+		meta := cmd.ClusterConfig.Metadata
+		if meta.Name == "fail" {
+			return fmt.Errorf("failed")
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		created = append(created, fmt.Sprintf("%s/%s/%s", meta.Name, meta.Region, cmd.ProviderConfig.Region))
+		return nil
+	}
+
+	writeFile := func(name string, documents ...string) string {
+		path := filepath.Join(dir, name)
+		data := ""
+		for i, d := range documents {
+			if i > 0 {
+				data += "---\n"
+			}
+			data += d
+		}
+		Expect(ioutil.WriteFile(path, []byte(data), 0600)).To(Succeed())
+		return path
+	}
+
+	var level int
+
+	// captureLogs writes JSON records to the returned buffer until the test is done
+	captureLogs := func() *gbytes.Buffer {
+		out := gbytes.NewBuffer()
+		logger.Level = 3
+		Expect(logger.Configure(logger.Options{Format: logger.FormatJSON, Output: out})).To(Succeed())
+		return out
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "eksctl-configs")
+		Expect(err).ToNot(HaveOccurred())
+		created = nil
+		level = logger.Level
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+		logger.Level = level
+		Expect(logger.Configure(logger.Options{Format: logger.FormatText})).To(Succeed())
+	})
+
+	It("should run for each document of each file of a directory and given files", func() {
+		writeFile("a.yaml",
+			fmt.Sprintf(clusterConfig, "a-1", "us-west-2"),
+			fmt.Sprintf(clusterConfig, "a-2", "eu-west-1"),
+		)
+		writeFile("b.yml", fmt.Sprintf(clusterConfig, "b", "us-east-1"))
+		writeFile("README.md", "not a config file")
+		other := filepath.Join(dir, "other")
+		Expect(os.Mkdir(other, 0700)).To(Succeed())
+
+		cmd := newCmd(dir, writeFile(filepath.Join("other", "c.yaml"), fmt.Sprintf(clusterConfig, "c", "ap-south-1")))
+		Expect(RunForAllClusterConfigs(cmd, AllClusterConfigs{Concurrency: 2}, "create", create)).To(Succeed())
+
+		sort.Strings(created)
+		Expect(created).To(Equal([]string{
+			"a-1/us-west-2/us-west-2",
+			"a-2/eu-west-1/eu-west-1",
+			"b/us-east-1/us-east-1",
+			"c/ap-south-1/ap-south-1",
+		}))
+	})
+
+	It("should run for documents concurrently and write all kubeconfigs", func() {
+		path := writeFile("clusters.yaml",
+			fmt.Sprintf(clusterConfig, "first", "us-west-2"),
+			fmt.Sprintf(clusterConfig, "second", "eu-west-1"),
+		)
+		kubeconfigPath := filepath.Join(dir, "kubeconfig")
+
+		out := captureLogs()
+
+		started := sync.WaitGroup{}
+		started.Add(2)
+		err := RunForAllClusterConfigs(newCmd(path), AllClusterConfigs{Concurrency: 2}, "create", func(cmd *Cmd) error {
+			if err := NewMetadataLoader(cmd).Load(); err != nil {
+				return err
+			}
+			LogRegionAndVersionInfo(cmd.ClusterConfig.Metadata)
+			// both documents have to be processed at the same time for this to return
+			started.Done()
+			done := make(chan struct{})
+			go func() {
+				started.Wait()
+				logger.Info("creating cluster")
+				close(done)
+			}()
+			select {
+			case <-done:
+			case <-time.After(10 * time.Second):
+				return fmt.Errorf("timed out waiting for the other cluster")
+			}
+
+			cmd.ClusterConfig.Status = &api.ClusterStatus{Endpoint: "https://" + cmd.ClusterConfig.Metadata.Name}
+			config, _, _ := kubeconfig.New(cmd.ClusterConfig, "test", "")
+			_, err := kubeconfig.Write(kubeconfigPath, *config, false)
+			return err
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(string(out.Contents())).To(ContainSubstring(`"prefix":"first","message":"will create cluster from`))
+		Expect(string(out.Contents())).To(ContainSubstring(`"prefix":"second","message":"will create cluster from`))
+		// messages logged by the clusters themselves can't be told apart
+		Expect(string(out.Contents())).To(ContainSubstring(`"level":"info","timestamp"`))
+		Expect(string(out.Contents())).ToNot(ContainSubstring(`"fields"`))
+
+		config, err := clientcmd.LoadFromFile(kubeconfigPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.Clusters).To(HaveKey("first.us-west-2.eksctl.io"))
+		Expect(config.Clusters).To(HaveKey("second.eu-west-1.eksctl.io"))
+	})
+
+	It("should prefix all messages with the cluster name when clusters are processed one at a time", func() {
+		path := writeFile("clusters.yaml",
+			fmt.Sprintf(clusterConfig, "first", "us-west-2"),
+			fmt.Sprintf(clusterConfig, "second", "eu-west-1"),
+		)
+
+		out := captureLogs()
+
+		err := RunForAllClusterConfigs(newCmd(path), AllClusterConfigs{Concurrency: 1}, "create", func(cmd *Cmd) error {
+			if err := NewMetadataLoader(cmd).Load(); err != nil {
+				return err
+			}
+			LogRegionAndVersionInfo(cmd.ClusterConfig.Metadata)
+			logger.Info("creating cluster")
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(string(out.Contents())).To(ContainSubstring(`"prefix":"first","message":"creating cluster"`))
+		Expect(string(out.Contents())).To(ContainSubstring(`"prefix":"second","message":"creating cluster"`))
+		Expect(string(out.Contents())).To(ContainSubstring(`"fields":{"cluster":"second","region":"eu-west-1"}`))
+	})
+
+	It("should run for all clusters and report the ones that failed", func() {
+		path := writeFile("clusters.yaml",
+			fmt.Sprintf(clusterConfig, "ok", "us-west-2"),
+			fmt.Sprintf(clusterConfig, "fail", "us-west-2"),
+		)
+
+		err := RunForAllClusterConfigs(newCmd(path), AllClusterConfigs{Concurrency: 1}, "create", create)
+		Expect(err).To(MatchError("failed to create 1 of 2 cluster(s)"))
+		Expect(created).To(Equal([]string{"ok/us-west-2/us-west-2"}))
+	})
+
+	It("should reject clusters that are defined more than once", func() {
+		path := writeFile("clusters.yaml",
+			fmt.Sprintf(clusterConfig, "twice", "us-west-2"),
+			fmt.Sprintf(clusterConfig, "twice", "us-west-2"),
+		)
+
+		err := RunForAllClusterConfigs(newCmd(path), AllClusterConfigs{Concurrency: 1}, "create", create)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`cluster "twice" in region "us-west-2" is defined in both`))
+		Expect(created).To(BeEmpty())
+	})
+
+	It("should require a config file", func() {
+		err := RunForAllClusterConfigs(newCmd(), AllClusterConfigs{Concurrency: 1}, "create", create)
+		Expect(err).To(MatchError("cannot use --all unless a config file is specified via --config-file/-f"))
+	})
+
+	It("should only load directories with --all", func() {
+		cmd := newCmd(dir)
+		err := NewMetadataLoader(cmd).Load()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("directories can only be used with --all"))
+	})
+})
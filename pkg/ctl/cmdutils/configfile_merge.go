@@ -0,0 +1,170 @@
+package cmdutils
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const configFileSeparator = ","
+
+var (
+	// envVarPlaceholder matches ${VAR} placeholders, as well as $${VAR}, which is an escaped placeholder
+	envVarPlaceholder = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+	// documentSeparator matches lines that separate YAML documents
+	documentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+)
+
+// configFilesValue implements pflag.Value, it allows --config-file to be given multiple
+// times, all of the given paths are stored in a single string, separated by commas
+type configFilesValue struct {
+	paths *string
+}
+
+func (v *configFilesValue) String() string { return *v.paths }
+
+func (v *configFilesValue) Set(path string) error {
+	if *v.paths == "" {
+		*v.paths = path
+	} else {
+		*v.paths += configFileSeparator + path
+	}
+	return nil
+}
+
+func (v *configFilesValue) Type() string { return "stringSlice" }
+
+var _ pflag.Value = &configFilesValue{}
+
+// splitConfigFiles returns the paths of all config files set via --config-file
+func splitConfigFiles(configFiles string) []string {
+	paths := []string{}
+	for _, path := range strings.Split(configFiles, configFileSeparator) {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// readConfigFiles reads all documents of the given config files, expands ${VAR} placeholders
+// with values of environment variables and merges all documents into a single one, documents
+// override the documents that come before them
+func readConfigFiles(paths []string) ([]byte, error) {
+	type document struct {
+		path string
+		data []byte
+	}
+	documents := []document{}
+	for _, path := range paths {
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			return nil, fmt.Errorf("config file %q is a directory, directories can only be used with --all", path)
+		}
+		data, err := eks.ReadConfig(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading config file %q", path)
+		}
+		for _, d := range documentSeparator.Split(string(expandEnvVars(data)), -1) {
+			if strings.TrimSpace(d) != "" {
+				documents = append(documents, document{path, []byte(d)})
+			}
+		}
+	}
+
+	// a single document is loaded as is, so that any errors refer to the lines of the file
+	if len(documents) == 1 {
+		return documents[0].data, nil
+	}
+
+	merged := map[string]interface{}{}
+	for _, d := range documents {
+		obj := map[string]interface{}{}
+		if err := yaml.Unmarshal(d.data, &obj); err != nil {
+			return nil, errors.Wrapf(err, "loading config file %q", d.path)
+		}
+		merged = mergeConfigObjects(merged, obj).(map[string]interface{})
+	}
+	return yaml.Marshal(merged)
+}
+
+// expandEnvVars replaces ${VAR} placeholders with values of environment variables; placeholders
+// of variables that are not set are left in place, as scripts in the config (e.g. preBootstrapCommands)
+// may use shell variables, and $${VAR} is always replaced with ${VAR}, so that scripts can use shell
+// variables that happen to be set in the environment eksctl runs in
+func expandEnvVars(data []byte) []byte {
+	return envVarPlaceholder.ReplaceAllFunc(data, func(placeholder []byte) []byte {
+		if strings.HasPrefix(string(placeholder), "$$") {
+			return placeholder[1:]
+		}
+		name := string(envVarPlaceholder.FindSubmatch(placeholder)[1])
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			logger.Debug("environment variable %q used in config file is not set, leaving %s in place", name, placeholder)
+			return placeholder
+		}
+		return []byte(value)
+	})
+}
+
+// mergeConfigObjects merges override into base; maps are merged recursively, lists of objects
+// that have a name (e.g. nodeGroups) are merged by name, all other values are replaced
+func mergeConfigObjects(base, override interface{}) interface{} {
+	switch override := override.(type) {
+	case map[string]interface{}:
+		baseMap, ok := base.(map[string]interface{})
+		if !ok {
+			return override
+		}
+		for k, v := range override {
+			if baseValue, ok := baseMap[k]; ok {
+				baseMap[k] = mergeConfigObjects(baseValue, v)
+			} else {
+				baseMap[k] = v
+			}
+		}
+		return baseMap
+	case []interface{}:
+		baseList, ok := base.([]interface{})
+		if !ok || !isNamedList(baseList) || !isNamedList(override) {
+			return override
+		}
+		for _, item := range override {
+			name := item.(map[string]interface{})["name"]
+			merged := false
+			for i, baseItem := range baseList {
+				if baseItem.(map[string]interface{})["name"] == name {
+					baseList[i] = mergeConfigObjects(baseItem, item)
+					merged = true
+					break
+				}
+			}
+			if !merged {
+				baseList = append(baseList, item)
+			}
+		}
+		return baseList
+	default:
+		return override
+	}
+}
+
+func isNamedList(list []interface{}) bool {
+	for _, item := range list {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if _, ok := obj["name"].(string); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -1,7 +1,10 @@
 package cmdutils_test
 
 import (
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -81,7 +84,7 @@ var _ = Describe("cmdutils configfile", func() {
 			examples, err := filepath.Glob(examplesDir + "*.yaml")
 			Expect(err).ToNot(HaveOccurred())
 
-			Expect(examples).To(HaveLen(14))
+			Expect(examples).To(HaveLen(22))
 			for _, example := range examples {
 				cmd := &Cmd{
 					CobraCommand:      newCmd(),
@@ -215,4 +218,104 @@ var _ = Describe("cmdutils configfile", func() {
 
 		})
 	})
+
+	Context("load multiple configfiles", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "eksctl-configfile-test")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		writeFile := func(name, content string) string {
+			path := filepath.Join(dir, name)
+			Expect(ioutil.WriteFile(path, []byte(content), 0644)).To(Succeed())
+			return path
+		}
+
+		load := func(configFiles ...string) (*api.ClusterConfig, error) {
+			cmd := &Cmd{
+				CobraCommand:      newCmd(),
+				ClusterConfigFile: strings.Join(configFiles, ","),
+				ClusterConfig:     api.NewClusterConfig(),
+				ProviderConfig:    &api.ProviderConfig{},
+			}
+			err := NewMetadataLoader(cmd).Load()
+			return cmd.ClusterConfig, err
+		}
+
+		base := `
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+
+metadata:
+  name: cluster-${EKSCTL_TEST_ENV}
+  region: us-west-2
+  tags:
+    env: ${EKSCTL_TEST_ENV}
+    team: platform
+
+nodeGroups:
+  - name: ng-1
+    instanceType: m5.large
+    desiredCapacity: 2
+    preBootstrapCommands:
+      - "echo ${EKSCTL_TEST_UNSET} $${EKSCTL_TEST_ENV}"
+`
+
+		BeforeEach(func() {
+			Expect(os.Setenv("EKSCTL_TEST_ENV", "prod")).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("EKSCTL_TEST_ENV")).To(Succeed())
+		})
+
+		It("should expand environment variables", func() {
+			cfg, err := load(writeFile("base.yaml", base))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cfg.Metadata.Name).To(Equal("cluster-prod"))
+			Expect(cfg.Metadata.Tags).To(HaveKeyWithValue("env", "prod"))
+			Expect(cfg.NodeGroups[0].PreBootstrapCommands).To(Equal([]string{"echo ${EKSCTL_TEST_UNSET} ${EKSCTL_TEST_ENV}"}))
+		})
+
+		It("should merge files and documents in order", func() {
+			overrides := writeFile("prod.yaml", `
+metadata:
+  tags:
+    team: data
+nodeGroups:
+  - name: ng-1
+    desiredCapacity: 5
+---
+nodeGroups:
+  - name: ng-2
+    instanceType: m5.xlarge
+`)
+			cfg, err := load(writeFile("base.yaml", base), overrides)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(cfg.Metadata.Name).To(Equal("cluster-prod"))
+			Expect(cfg.Metadata.Tags).To(Equal(map[string]string{"env": "prod", "team": "data"}))
+
+			Expect(cfg.NodeGroups).To(HaveLen(2))
+			Expect(cfg.NodeGroups[0].Name).To(Equal("ng-1"))
+			Expect(cfg.NodeGroups[0].InstanceType).To(Equal("m5.large"))
+			Expect(*cfg.NodeGroups[0].DesiredCapacity).To(Equal(5))
+			Expect(cfg.NodeGroups[1].Name).To(Equal("ng-2"))
+			Expect(cfg.NodeGroups[1].InstanceType).To(Equal("m5.xlarge"))
+		})
+
+		It("should reject unknown fields in merged documents", func() {
+			_, err := load(writeFile("base.yaml", base), writeFile("bad.yaml", "metadata:\n  nmae: foo\n"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`unknown field "nmae"`))
+		})
+	})
 })
@@ -3,10 +3,10 @@ package cmdutils
 import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
-	"github.com/kris-nova/logger"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // IAMServiceAccountFilter holds filter configuration
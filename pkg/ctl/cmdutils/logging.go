@@ -0,0 +1,25 @@
+package cmdutils
+
+import "github.com/weaveworks/eksctl/pkg/logger"
+
+// Log formats
+const (
+	LogFormatText = logger.FormatText
+	LogFormatJSON = logger.FormatJSON
+)
+
+// LoggerOptions holds the options of the logger that are set on the root command
+type LoggerOptions struct {
+	Color  string
+	Format string
+	Quiet  bool
+}
+
+// ConfigureLogger sets up the logger according to the options
+func ConfigureLogger(options LoggerOptions) error {
+	return logger.Configure(logger.Options{
+		Color:  options.Color,
+		Format: options.Format,
+		Quiet:  options.Quiet,
+	})
+}
@@ -1,11 +1,11 @@
 package cmdutils
 
 import (
-	"github.com/kris-nova/logger"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // NodeGroupFilter holds filter configuration
@@ -111,6 +111,17 @@ func (f *NodeGroupFilter) FilterMatching(nodeGroups []*api.NodeGroup) []*api.Nod
 	return match
 }
 
+// FilterMatchingManaged matches names against the filter and returns all included managed node groups
+func (f *NodeGroupFilter) FilterMatchingManaged(nodeGroups []*api.ManagedNodeGroup) []*api.ManagedNodeGroup {
+	var match []*api.ManagedNodeGroup
+	for _, ng := range nodeGroups {
+		if f.Match(ng.NameString()) {
+			match = append(match, ng)
+		}
+	}
+	return match
+}
+
 // ForEach iterates over each nodegroup that is included by the filter and calls iterFn
 func (f *NodeGroupFilter) ForEach(nodeGroups []*api.NodeGroup, iterFn func(i int, ng *api.NodeGroup) error) error {
 	for i, ng := range nodeGroups {
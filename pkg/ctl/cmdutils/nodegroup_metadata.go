@@ -0,0 +1,80 @@
+package cmdutils
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// reservedNodeGroupMetadataPrefix is used by eksctl for the labels it
+// sets on all nodes, these cannot be changed by `eksctl set/unset`
+const reservedNodeGroupMetadataPrefix = "alpha.eksctl.io/"
+
+// NodeGroupMetadataTarget holds what is needed to update labels and taints
+// of an existing nodegroup
+type NodeGroupMetadataTarget struct {
+	Ctl                  *eks.ClusterProvider
+	ClientSet            kubernetes.Interface
+	AutoScalingGroupName string
+}
+
+// NewNodeGroupMetadataTarget checks the cluster and nodegroup flags, and
+// looks up the auto scaling group of the nodegroup
+func NewNodeGroupMetadataTarget(cmd *Cmd, ng *api.NodeGroup) (*NodeGroupMetadataTarget, error) {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name == "" {
+		return nil, ErrMustBeSet(ClusterNameFlag(cmd))
+	}
+	if ng.Name == "" {
+		return nil, ErrMustBeSet("--nodegroup")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return nil, err
+	}
+	LogRegionAndVersionInfo(cfg.Metadata)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return nil, err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return nil, err
+	}
+
+	asgName, err := ctl.NewStackManager(cfg).GetNodeGroupAutoScalingGroupName(ng)
+	if err != nil {
+		return nil, err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NodeGroupMetadataTarget{
+		Ctl:                  ctl,
+		ClientSet:            clientSet,
+		AutoScalingGroupName: asgName,
+	}, nil
+}
+
+// ValidateNodeGroupMetadataKeys makes sure none of the label or taint keys
+// are reserved for use by eksctl
+func ValidateNodeGroupMetadataKeys(kind string, keys []string) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("at least one %s must be specified", kind)
+	}
+	for _, key := range keys {
+		if strings.HasPrefix(key, reservedNodeGroupMetadataPrefix) {
+			return fmt.Errorf("%s %q cannot be changed, the %s prefix is reserved for use by eksctl", kind, key, reservedNodeGroupMetadataPrefix)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package cmdutils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/file"
+)
+
+// UserConfigFile is the path of the user-level config file
+const UserConfigFile = "~/.eksctl/config.yaml"
+
+// UserConfig holds settings that apply to all commands run by the user
+type UserConfig struct {
+	// DefaultVersion is used for new clusters instead of the default Kubernetes
+	// version of eksctl, so that upgrading eksctl doesn't change it
+	DefaultVersion string `json:"defaultVersion,omitempty"`
+}
+
+// LoadUserConfig loads the user-level config file at path, a missing file
+// is the same as an empty one
+func LoadUserConfig(path string) (*UserConfig, error) {
+	cfg := &UserConfig{}
+
+	data, err := ioutil.ReadFile(file.ExpandPath(path))
+	if os.IsNotExist(err) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading %s", path)
+	}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, errors.Wrapf(err, "loading %s", path)
+	}
+
+	if cfg.DefaultVersion != "" && !api.IsSupportedVersion(cfg.DefaultVersion) {
+		return nil, fmt.Errorf("defaultVersion %q in %s is not supported, supported values: %s", cfg.DefaultVersion, path, strings.Join(api.SupportedVersions(), ", "))
+	}
+	return cfg, nil
+}
+
+// DefaultVersion returns the Kubernetes version to use for new clusters when no version
+// is given, which is the version pinned in the user-level config file, if any
+func DefaultVersion() (string, error) {
+	cfg, err := LoadUserConfig(UserConfigFile)
+	if err != nil {
+		return "", err
+	}
+	if cfg.DefaultVersion != "" {
+		return cfg.DefaultVersion, nil
+	}
+	return api.DefaultVersion, nil
+}
@@ -0,0 +1,63 @@
+package cmdutils_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("user-level config file", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "eksctl-userconfig")
+		Expect(err).ToNot(HaveOccurred())
+		path = filepath.Join(dir, "config.yaml")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeConfig := func(content string) {
+		Expect(ioutil.WriteFile(path, []byte(content), 0644)).To(Succeed())
+	}
+
+	It("should treat a missing file as an empty one", func() {
+		cfg, err := LoadUserConfig(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.DefaultVersion).To(BeEmpty())
+	})
+
+	It("should load the pinned default version", func() {
+		writeConfig("defaultVersion: \"" + api.Version1_13 + "\"\n")
+
+		cfg, err := LoadUserConfig(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.DefaultVersion).To(Equal(api.Version1_13))
+	})
+
+	It("should reject an unsupported default version", func() {
+		writeConfig("defaultVersion: \"1.99\"\n")
+
+		_, err := LoadUserConfig(path)
+		Expect(err).To(MatchError(ContainSubstring(`defaultVersion "1.99"`)))
+	})
+
+	It("should reject unknown fields", func() {
+		writeConfig("defaultVersions: \"1.13\"\n")
+
+		_, err := LoadUserConfig(path)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -3,10 +3,91 @@ package completion
 import (
 	"os"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
+// bashCompletionFunctions complete names of clusters and nodegroups by calling `eksctl completion names`,
+// passing on --region, --profile and --cluster from the command line; words, cur and last_command are
+// set by the functions cobra generates
+const bashCompletionFunctions = `
+__eksctl_get_names()
+{
+    local kind=$1
+    local args=()
+    local i
+    for ((i=0; i < ${#words[@]}; i++)); do
+        case "${words[i]}" in
+            --region=*|--profile=*|--cluster=*)
+                args+=("${words[i]}")
+                ;;
+            --region|-r|--profile|-p|--cluster)
+                args+=("${words[i]}" "${words[i+1]}")
+                ;;
+        esac
+    done
+    local names
+    if names=$(eksctl completion names "${kind}" "${args[@]}" 2>/dev/null); then
+        COMPREPLY=( $( compgen -W "${names}" -- "$cur" ) )
+    fi
+}
+
+__eksctl_get_clusters()
+{
+    __eksctl_get_names clusters
+}
+
+__eksctl_get_nodegroups()
+{
+    __eksctl_get_names nodegroups
+}
+
+__eksctl_custom_func()
+{
+    case ${last_command} in
+        eksctl_create_*)
+            return
+            ;;
+        eksctl_*_cluster|eksctl_utils_*)
+            __eksctl_get_clusters
+            return
+            ;;
+        eksctl_*_nodegroup)
+            __eksctl_get_nodegroups
+            return
+            ;;
+    esac
+}
+`
+
+// markFlagsForCompletion marks --cluster flags, as well as --name flags of commands that act
+// on existing clusters and nodegroups, to be completed with names fetched from the API
+func markFlagsForCompletion(cmd *cobra.Command) {
+	for _, c := range cmd.Commands() {
+		markFlagsForCompletion(c)
+	}
+
+	markFlag := func(name, function string) {
+		if err := cobra.MarkFlagCustom(cmd.Flags(), name, function); err != nil {
+			logger.Debug("ignoring error %q", err.Error())
+		}
+	}
+
+	if f := cmd.Flags().Lookup("cluster"); f != nil && f.Value.Type() == "string" {
+		markFlag("cluster", "__eksctl_get_clusters")
+	}
+
+	if f := cmd.Flags().Lookup("name"); f != nil && cmd.HasParent() && cmd.Parent().Name() != "create" {
+		switch cmd.Name() {
+		case "cluster":
+			markFlag("name", "__eksctl_get_clusters")
+		case "nodegroup":
+			markFlag("name", "__eksctl_get_nodegroups")
+		}
+	}
+}
+
 // Command will create the `completion` commands
 func Command(rootCmd *cobra.Command) *cobra.Command {
 	var bashCompletionCmd = &cobra.Command{
@@ -26,6 +107,8 @@ If you are stuck on Bash 3 (macOS) use
 source /dev/stdin <<<"$(eksctl completion bash)"
 `,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			rootCmd.BashCompletionFunction = bashCompletionFunctions
+			markFlagsForCompletion(rootCmd)
 			return rootCmd.GenBashCompletion(os.Stdout)
 		},
 	}
@@ -59,6 +142,7 @@ fpath=($fpath ~/.zsh/completion)
 
 	cmd.AddCommand(bashCompletionCmd)
 	cmd.AddCommand(zshCompletionCmd)
+	cmd.AddCommand(namesCmd())
 
 	return cmd
 }
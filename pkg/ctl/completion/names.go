@@ -0,0 +1,177 @@
+package completion
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	clustersKind   = "clusters"
+	nodeGroupsKind = "nodegroups"
+
+	// namesCacheTTL is how long names are reused, so that completing the same
+	// command several times doesn't make the same API calls over and over
+	namesCacheTTL = time.Minute
+	// namesLookupTimeout is the longest a completion waits for the API
+	namesLookupTimeout = 3 * time.Second
+)
+
+// namesLookup describes which names to look up
+type namesLookup struct {
+	Kind    string `json:"kind"`
+	Region  string `json:"region"`
+	Profile string `json:"profile"`
+	Cluster string `json:"cluster,omitempty"`
+}
+
+// cachedNames is the content of a cache file
+type cachedNames struct {
+	namesLookup
+	Names     []string  `json:"names"`
+	FetchedAt time.Time `json:"fetchedAt"`
+}
+
+// namesCmd creates a hidden command that prints the names of clusters or nodegroups,
+// it's called by the bash completion functions
+func namesCmd() *cobra.Command {
+	lookup := namesLookup{}
+
+	cmd := &cobra.Command{
+		Use:       "names [clusters|nodegroups]",
+		Short:     "Prints names of clusters or nodegroups for shell completion",
+		Hidden:    true,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{clustersKind, nodeGroupsKind},
+		RunE: func(_ *cobra.Command, args []string) error {
+			lookup.Kind = args[0]
+			names, err := lookupNames(lookup, fetchNames)
+			if err != nil {
+				// completion must never print errors to the terminal
+				logger.Debug("looking up names for completion: %s", err.Error())
+				return nil
+			}
+			for _, name := range names {
+				fmt.Println(name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&lookup.Region, "region", "r", "", "AWS region")
+	cmd.Flags().StringVarP(&lookup.Profile, "profile", "p", "", "AWS credentials profile to use")
+	cmd.Flags().StringVar(&lookup.Cluster, "cluster", "", "EKS cluster name, required for nodegroups")
+
+	return cmd
+}
+
+// lookupNames returns names from the cache if they were fetched recently, otherwise it fetches them,
+// giving up after namesLookupTimeout
+func lookupNames(lookup namesLookup, fetch func(namesLookup) ([]string, error)) ([]string, error) {
+	if lookup.Kind != clustersKind && lookup.Kind != nodeGroupsKind {
+		return nil, fmt.Errorf("unknown kind %q", lookup.Kind)
+	}
+	if lookup.Kind == nodeGroupsKind && lookup.Cluster == "" {
+		return nil, fmt.Errorf("cannot look up nodegroups without a cluster name")
+	}
+
+	cacheFile := namesCacheFile(lookup)
+	if cached, ok := readCachedNames(cacheFile); ok {
+		return cached, nil
+	}
+
+	type result struct {
+		names []string
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		names, err := fetch(lookup)
+		done <- result{names, err}
+	}()
+
+	select {
+	case r := <-done:
+		if r.err != nil {
+			return nil, r.err
+		}
+		sort.Strings(r.names)
+		writeCachedNames(cacheFile, lookup, r.names)
+		return r.names, nil
+	case <-time.After(namesLookupTimeout):
+		return nil, fmt.Errorf("timed out after %v", namesLookupTimeout)
+	}
+}
+
+func fetchNames(lookup namesLookup) ([]string, error) {
+	ctl := eks.New(&api.ProviderConfig{
+		Region:      lookup.Region,
+		Profile:     lookup.Profile,
+		WaitTimeout: namesLookupTimeout,
+	}, nil)
+
+	if lookup.Kind == clustersKind {
+		return ctl.ListClusterNames()
+	}
+
+	cfg := api.NewClusterConfig()
+	cfg.Metadata.Name = lookup.Cluster
+	return ctl.NewStackManager(cfg).ListNodeGroupStacks()
+}
+
+// namesCacheFile returns a path in the user's cache directory that is unique for the lookup
+func namesCacheFile(lookup namesLookup) string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	key, err := json.Marshal(lookup)
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(cacheDir, "eksctl", "completion", fmt.Sprintf("%x.json", sha1.Sum(key)))
+}
+
+func readCachedNames(cacheFile string) ([]string, bool) {
+	if cacheFile == "" {
+		return nil, false
+	}
+	data, err := ioutil.ReadFile(cacheFile)
+	if err != nil {
+		return nil, false
+	}
+	cached := cachedNames{}
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, false
+	}
+	if time.Since(cached.FetchedAt) > namesCacheTTL {
+		return nil, false
+	}
+	return cached.Names, true
+}
+
+func writeCachedNames(cacheFile string, lookup namesLookup, names []string) {
+	if cacheFile == "" {
+		return
+	}
+	data, err := json.Marshal(cachedNames{namesLookup: lookup, Names: names, FetchedAt: time.Now()})
+	if err == nil {
+		if err = os.MkdirAll(filepath.Dir(cacheFile), 0700); err == nil {
+			err = ioutil.WriteFile(cacheFile, data, 0600)
+		}
+	}
+	if err != nil {
+		logger.Debug("caching names for completion: %s", err.Error())
+	}
+}
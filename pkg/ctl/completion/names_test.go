@@ -0,0 +1,76 @@
+package completion
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("completion names", func() {
+	var (
+		cacheDir string
+		fetches  int
+	)
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "eksctl-completion-test")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.Setenv("XDG_CACHE_HOME", cacheDir)).To(Succeed())
+		fetches = 0
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv("XDG_CACHE_HOME")).To(Succeed())
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	fetch := func(lookup namesLookup) ([]string, error) {
+		fetches++
+		return []string{"ng-2", "ng-1-" + lookup.Cluster}, nil
+	}
+
+	It("should fetch names once and reuse them from the cache", func() {
+		lookup := namesLookup{Kind: nodeGroupsKind, Region: "us-west-2", Cluster: "cluster-1"}
+
+		names, err := lookupNames(lookup, fetch)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).To(Equal([]string{"ng-1-cluster-1", "ng-2"}))
+
+		names, err = lookupNames(lookup, fetch)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).To(Equal([]string{"ng-1-cluster-1", "ng-2"}))
+		Expect(fetches).To(Equal(1))
+
+		lookup.Cluster = "cluster-2"
+		names, err = lookupNames(lookup, fetch)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).To(Equal([]string{"ng-1-cluster-2", "ng-2"}))
+		Expect(fetches).To(Equal(2))
+	})
+
+	It("should not cache errors", func() {
+		lookup := namesLookup{Kind: clustersKind, Region: "us-west-2"}
+		failing := func(namesLookup) ([]string, error) {
+			fetches++
+			return nil, fmt.Errorf("no credentials")
+		}
+
+		_, err := lookupNames(lookup, failing)
+		Expect(err).To(MatchError("no credentials"))
+
+		names, err := lookupNames(lookup, fetch)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names).To(HaveLen(2))
+		Expect(fetches).To(Equal(2))
+	})
+
+	It("should require a cluster name to look up nodegroups", func() {
+		_, err := lookupNames(namesLookup{Kind: nodeGroupsKind}, fetch)
+		Expect(err).To(MatchError("cannot look up nodegroups without a cluster name"))
+		Expect(fetches).To(BeZero())
+	})
+})
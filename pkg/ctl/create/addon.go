@@ -0,0 +1,96 @@
+package create
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func createAddonCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	addon := &api.Addon{}
+	cfg.Addons = append(cfg.Addons, addon)
+
+	var force bool
+
+	cmd.SetDescription("addon", "Create EKS managed add-on(s)", "", "addons")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		cmdutils.SetAddonResolveConflicts(addon, force)
+		return doCreateAddon(cmd, addon)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to add the add-on to")
+
+		cmdutils.AddAddonFlags(fs, addon, &force)
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doCreateAddon(cmd *cmdutils.Cmd, addon *api.Addon) error {
+	if err := cmdutils.NewCreateAddonLoader(cmd, addon).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	printer := printers.NewJSONPrinter()
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	addons := []*api.Addon{}
+	for _, addon := range cfg.Addons {
+		_, err := ctl.DescribeAddon(cfg, addon.Name)
+		if err == nil {
+			logger.Info("add-on %q already exists, it will be excluded", addon.Name)
+			continue
+		}
+		if !eks.IsAddonNotFound(err) {
+			return errors.Wrap(err, "getting existing add-ons")
+		}
+		addons = append(addons, addon)
+	}
+
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+		return err
+	}
+
+	if len(addons) == 0 {
+		logger.Info("no add-ons to create in cluster %q", meta.Name)
+		return nil
+	}
+
+	if err := ctl.CreateAddons(cfg, addons); err != nil {
+		return err
+	}
+
+	logger.Success("created %d add-on(s) in cluster %q", len(addons), meta.Name)
+
+	return nil
+}
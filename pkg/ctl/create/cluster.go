@@ -4,15 +4,17 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"github.com/weaveworks/eksctl/pkg/eks"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/fargate"
 	"github.com/weaveworks/eksctl/pkg/kops"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/utils"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
@@ -23,13 +25,17 @@ type createClusterCmdParams struct {
 	writeKubeconfig             bool
 	kubeconfigPath              string
 	autoKubeconfigPath          bool
-	authenticatorRoleARN        string
+	kubeconfigOptions           kubeconfig.Options
 	setContext                  bool
 	availabilityZones           []string
 	installWindowsVPCController bool
 	kopsClusterNameForVPC       string
 	subnets                     map[api.SubnetTopology]*[]string
 	withoutNodeGroup            bool
+	fargate                     bool
+	installNvidiaPlugin         bool
+	installClusterAutoscaler    bool
+	installTerminationHandler   bool
 }
 
 func createClusterCmd(cmd *cmdutils.Cmd) {
@@ -41,7 +47,15 @@ func createClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("cluster", "Create a cluster", "")
 
+	var all cmdutils.AllClusterConfigs
+
 	cmd.SetRunFuncWithNameArg(func() error {
+		if all.Enabled {
+			return cmdutils.RunForAllClusterConfigs(cmd, all, "create", func(cmd *cmdutils.Cmd) error {
+				clusterParams := *params
+				return doCreateCluster(cmd, ng, &clusterParams)
+			})
+		}
 		return doCreateCluster(cmd, ng, params)
 	})
 
@@ -56,7 +70,12 @@ func createClusterCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddVersionFlag(fs, cfg.Metadata, "")
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddMaxConcurrencyFlag(fs, &cmd.MaxConcurrency, "create")
+		cmdutils.AddDryRunFlag(fs, cmd)
+		cmdutils.AddAllClusterConfigsFlags(fs, &all, "create")
+		cmdutils.AddStackTerminationProtectionFlag(fs, cmd.ProviderConfig)
 		fs.BoolVarP(&params.installWindowsVPCController, "install-vpc-controllers", "", false, "Install VPC controller that's required for Windows workloads")
+		fs.BoolVar(&params.fargate, "fargate", false, "Create a Fargate profile scheduling pods in the default and kube-system namespaces onto Fargate, instead of creating an initial nodegroup")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("Initial nodegroup", func(fs *pflag.FlagSet) {
@@ -67,6 +86,9 @@ func createClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.FlagSetGroup.InFlagSet("Cluster and nodegroup add-ons", func(fs *pflag.FlagSet) {
 		cmdutils.AddCommonCreateNodeGroupIAMAddonsFlags(fs, ng)
+		cmdutils.AddInstallNvidiaPluginFlag(fs, &params.installNvidiaPlugin)
+		cmdutils.AddInstallNodeTerminationHandlerFlag(fs, &params.installTerminationHandler)
+		fs.BoolVar(&params.installClusterAutoscaler, "install-cluster-autoscaler", false, "install cluster-autoscaler with an iamserviceaccount, and tag nodegroups for it to discover them (the manifest is committed to the gitops repository instead when git.repo is set)")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("VPC networking", func(fs *pflag.FlagSet) {
@@ -82,7 +104,7 @@ func createClusterCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
 
 	cmd.FlagSetGroup.InFlagSet("Output kubeconfig", func(fs *pflag.FlagSet) {
-		cmdutils.AddCommonFlagsForKubeconfig(fs, &params.kubeconfigPath, &params.authenticatorRoleARN, &params.setContext, &params.autoKubeconfigPath, exampleClusterName)
+		cmdutils.AddCommonFlagsForKubeconfig(fs, &params.kubeconfigPath, &params.kubeconfigOptions, &params.setContext, &params.autoKubeconfigPath, exampleClusterName)
 		fs.BoolVar(&params.writeKubeconfig, "write-kubeconfig", true, "toggle writing of kubeconfig")
 	})
 }
@@ -90,11 +112,28 @@ func createClusterCmd(cmd *cmdutils.Cmd) {
 func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createClusterCmdParams) error {
 	ngFilter := cmdutils.NewNodeGroupFilter()
 
+	if params.fargate {
+		params.withoutNodeGroup = true
+	}
+
 	if err := cmdutils.NewCreateClusterLoader(cmd, ngFilter, ng, params.withoutNodeGroup).Load(); err != nil {
 		return err
 	}
 
 	cfg := cmd.ClusterConfig
+
+	if params.fargate {
+		cfg.FargateProfiles = []*api.FargateProfile{api.NewDefaultFargateProfile()}
+	}
+	if params.installClusterAutoscaler {
+		if cfg.ClusterAutoscaler == nil {
+			cfg.ClusterAutoscaler = &api.ClusterAutoscaler{}
+		}
+		cfg.ClusterAutoscaler.Enabled = api.Enabled()
+	}
+	if params.installTerminationHandler {
+		cmdutils.EnableNodeTerminationHandler(cfg)
+	}
 	meta := cmd.ClusterConfig.Metadata
 
 	printer := printers.NewJSONPrinter()
@@ -106,11 +145,16 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 	cmdutils.LogRegionAndVersionInfo(meta)
 
 	if cfg.Metadata.Version == "" {
-		cfg.Metadata.Version = api.DefaultVersion
+		if cfg.Metadata.Version, err = cmdutils.DefaultVersion(); err != nil {
+			return err
+		}
+		if cfg.Metadata.Version != api.DefaultVersion {
+			logger.Info("using Kubernetes version %s, which is pinned as defaultVersion in %s", cfg.Metadata.Version, cmdutils.UserConfigFile)
+		}
 	}
 	if cfg.Metadata.Version != api.DefaultVersion {
-		if !isValidVersion(cfg.Metadata.Version) {
-			if isDeprecatedVersion(cfg.Metadata.Version) {
+		if !api.IsSupportedVersion(cfg.Metadata.Version) {
+			if api.IsDeprecatedVersion(cfg.Metadata.Version) {
 				return fmt.Errorf("invalid version, %s is now deprecated, supported values: %s\nsee also: https://docs.aws.amazon.com/eks/latest/userguide/kubernetes-versions.html", cfg.Metadata.Version, strings.Join(api.SupportedVersions(), ", "))
 			}
 			return fmt.Errorf("invalid version, supported values: %s", strings.Join(api.SupportedVersions(), ", "))
@@ -125,6 +169,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 		return err
 	}
 
+	if err := kubeconfig.ValidateAuthenticator(params.kubeconfigOptions.Authenticator); err != nil {
+		return err
+	}
+
 	if params.autoKubeconfigPath {
 		if params.kubeconfigPath != kubeconfig.DefaultPath {
 			return fmt.Errorf("--kubeconfig and --auto-kubeconfig %s", cmdutils.IncompatibleFlags)
@@ -145,10 +193,15 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 		}
 	}
 	filteredNodeGroups := ngFilter.FilterMatching(cfg.NodeGroups)
+	filteredManagedNodeGroups := ngFilter.FilterMatchingManaged(cfg.ManagedNodeGroups)
 
 	if err := eks.ValidateWindowsCompatibility(filteredNodeGroups, cfg.Metadata.Version); err != nil {
 		return err
 	}
+	if err := eks.ValidateARMCompatibility(filteredNodeGroups, cfg.Metadata.Version); err != nil {
+		return err
+	}
+	eks.LogARMCompatibility(filteredNodeGroups)
 	if params.installWindowsVPCController {
 		if !eks.SupportsWindowsWorkloads(filteredNodeGroups) {
 			return errors.New("running Windows workloads requires having both Windows and Linux (AmazonLinux2) node groups")
@@ -237,11 +290,25 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 			return err
 		}
 
+		if cfg.IsFullyPrivate() && !cfg.HasSufficientPrivateSubnets() {
+			return fmt.Errorf("a fully-private cluster requires at least %d private subnets", api.MinRequiredSubnets)
+		}
+
 		for _, ng := range filteredNodeGroups {
 			if err := canUseForPrivateNodeGroups(ng); err != nil {
 				return err
 			}
 		}
+		for _, ng := range filteredManagedNodeGroups {
+			if ng.PrivateNetworking && !cfg.HasSufficientPrivateSubnets() {
+				return fmt.Errorf("none or too few private subnets to use with managed nodegroup %q", ng.Name)
+			}
+		}
+
+		if err := vpc.ValidateExistingVPC(ctl.Provider, cfg); err != nil {
+			logger.Critical("unable to use given %s", subnetInfo())
+			return err
+		}
 
 		logger.Success("using existing %s", subnetInfo())
 		logger.Warning(customNetworkingNotice)
@@ -253,6 +320,12 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 	}
 
 	for _, ng := range filteredNodeGroups {
+		if err := ctl.ResolveInstanceSelector(ng); err != nil {
+			return err
+		}
+		if err := ctl.ValidateNodeGroupSubnets(cfg, ng); err != nil {
+			return err
+		}
 		// resolve AMI
 		if err := ctl.EnsureAMI(meta.Version, ng); err != nil {
 			return err
@@ -266,8 +339,28 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 		// load or use SSH key - name includes cluster name and the
 		// fingerprint, so if unique keys provided, each will get
 		// loaded and used as intended and there is no need to have
-		// nodegroup name in the key name
-		if err := loadSSHKey(ng, meta.Name, ctl.Provider); err != nil {
+		// nodegroup name in the key name; keys are imported into EC2,
+		// so this is skipped in dry-run mode
+		if cmd.DryRun {
+			continue
+		}
+		if err := loadSSHKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
+			return err
+		}
+	}
+
+	if cmd.DryRun {
+		stackManager := ctl.NewStackManager(cfg)
+		plans, err := stackManager.PlanCreateClusterWithNodeGroups(filteredNodeGroups, filteredManagedNodeGroups, cfg.FargateProfiles)
+		if err != nil {
+			return err
+		}
+		cmdutils.LogStackPlans(plans)
+		return nil
+	}
+
+	for _, ng := range filteredManagedNodeGroups {
+		if err := loadSSHKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
 			return err
 		}
 	}
@@ -291,9 +384,20 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 			ngFilter.LogInfo(cfg.NodeGroups)
 			logger.Info("will create a CloudFormation stack for cluster itself and %d nodegroup stack(s)", len(filteredNodeGroups))
 		}
+		if len(filteredManagedNodeGroups) > 0 {
+			logger.Info("will create %d managed nodegroup stack(s)", len(filteredManagedNodeGroups))
+		}
+		if len(cfg.FargateProfiles) > 0 {
+			logger.Info("will create %d Fargate profile stack(s)", len(cfg.FargateProfiles))
+		}
 		logger.Info("if you encounter any issues, check CloudFormation console or try 'eksctl utils describe-stacks --region=%s --cluster=%s'", meta.Region, meta.Name)
-		tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(filteredNodeGroups)
+		var postClusterCreationTasks []manager.Task
+		if cfg.HasCustomNetworking() {
+			postClusterCreationTasks = append(postClusterCreationTasks, ctl.NewTaskToConfigureCustomNetworking(cfg))
+		}
+		tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(filteredNodeGroups, filteredManagedNodeGroups, cfg.FargateProfiles, postClusterCreationTasks...)
 		ctl.AppendExtraClusterConfigTasks(cfg, params.installWindowsVPCController, tasks)
+		tasks.SetMaxConcurrency(cmd.MaxConcurrency)
 
 		logger.Info(tasks.Describe())
 		if errs := tasks.DoAllSync(); len(errs) > 0 {
@@ -314,7 +418,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 		var kubeconfigContextName string
 
 		if params.writeKubeconfig {
-			kubectlConfig := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), params.authenticatorRoleARN, ctl.Provider.Profile())
+			if params.kubeconfigOptions.Profile == "" {
+				params.kubeconfigOptions.Profile = ctl.Provider.Profile()
+			}
+			kubectlConfig := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), params.kubeconfigOptions)
 			kubeconfigContextName = kubectlConfig.CurrentContext
 
 			params.kubeconfigPath, err = kubeconfig.Write(params.kubeconfigPath, *kubectlConfig, params.setContext)
@@ -337,6 +444,13 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 			return err
 		}
 
+		// coredns can only run on Fargate when there are no nodes to schedule it onto
+		if len(filteredNodeGroups) == 0 && len(filteredManagedNodeGroups) == 0 && fargate.IsCoreDNSSchedulableOnFargate(cfg.FargateProfiles) {
+			if err := fargate.ScheduleCoreDNSOnFargate(clientSet); err != nil {
+				return err
+			}
+		}
+
 		for _, ng := range filteredNodeGroups {
 			// authorise nodes to join
 			if err = authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
@@ -344,16 +458,31 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 			}
 
 			// wait for nodes to join
-			if err = ctl.WaitForNodes(clientSet, ng); err != nil {
+			if err = ctl.WaitForNodes(clientSet, ng, cfg.NodeJoinTimeout(ctl.Provider.WaitTimeout())); err != nil {
 				return err
 			}
 
-			// if GPU instance type, give instructions
-			if utils.IsGPUInstanceType(ng.InstanceType) || (ng.InstancesDistribution != nil && utils.HasGPUInstanceType(ng.InstancesDistribution.InstanceTypes)) {
-				logger.Info("as you are using a GPU optimized instance type you will need to install NVIDIA Kubernetes device plugin.")
-				logger.Info("\t see the following page for instructions: https://github.com/NVIDIA/k8s-device-plugin")
-			}
+		}
+
+		if err := installNvidiaDevicePlugin(ctl, cfg, filteredNodeGroups, params.installNvidiaPlugin); err != nil {
+			return err
+		}
 
+		if err := installClusterAutoscaler(ctl, cfg); err != nil {
+			return err
+		}
+
+		if err := installNodeTerminationHandler(ctl, cfg, filteredNodeGroups); err != nil {
+			return err
+		}
+
+		if cfg.IsFullyPrivate() {
+			logger.Info("disabling public access to the Kubernetes API endpoint of fully-private cluster %q", meta.Name)
+			cfg.VPC.ClusterEndpoints.PublicAccess = api.Disabled()
+			if err := ctl.UpdateClusterConfigForEndpoints(cfg); err != nil {
+				return errors.Wrap(err, "disabling public endpoint access")
+			}
+			logger.Info("the Kubernetes API endpoint is now only accessible from within the VPC")
 		}
 
 		// check kubectl version, and offer install instructions if missing or old
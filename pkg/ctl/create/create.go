@@ -14,6 +14,8 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createNodeGroupCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createIAMServiceAccountCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createIAMIdentityMappingCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createFargateProfileCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createAddonCmd)
 
 	return verbCmd
 }
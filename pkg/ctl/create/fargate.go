@@ -0,0 +1,146 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func createFargateProfileCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	fp := &api.FargateProfile{
+		Selectors: []api.FargateProfileSelector{{}},
+	}
+	cfg.FargateProfiles = append(cfg.FargateProfiles, fp)
+
+	cmd.SetDescription("fargateprofile", "Create a Fargate profile", "", "fargateprofiles")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doCreateFargateProfile(cmd, fp)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to add the Fargate profile to")
+
+		fs.StringVar(&fp.Name, "name", "", "name of the Fargate profile to create")
+		fs.StringVar(&fp.Selectors[0].Namespace, "namespace", "", "namespace of the workload to schedule onto Fargate")
+		fs.StringToStringVar(&fp.Selectors[0].Labels, "labels", nil, `labels of the workload to schedule onto Fargate, e.g. "app=frontend,tier=web"`)
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doCreateFargateProfile(cmd *cmdutils.Cmd, fp *api.FargateProfile) error {
+	if err := cmdutils.NewCreateFargateProfileLoader(cmd, fp).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	printer := printers.NewJSONPrinter()
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	existing, err := stackManager.ListFargateProfileStacks()
+	if err != nil {
+		return errors.Wrap(err, "getting existing Fargate profiles")
+	}
+	existingNames := sets.NewString(existing...)
+
+	fargateProfiles := []*api.FargateProfile{}
+	for _, fp := range cfg.FargateProfiles {
+		if existingNames.Has(fp.Name) {
+			logger.Info("Fargate profile %q already exists, it will be excluded", fp.Name)
+			continue
+		}
+		fargateProfiles = append(fargateProfiles, fp)
+	}
+
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+		return err
+	}
+
+	if len(fargateProfiles) == 0 {
+		logger.Info("no Fargate profiles to create in cluster %q", meta.Name)
+		return nil
+	}
+
+	tasks := stackManager.NewTasksToCreateFargateProfiles(fargateProfiles)
+	tasks.PlanMode = cmd.Plan
+
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred and Fargate profiles haven't been created properly, you may wish to check CloudFormation console", len(errs))
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to create Fargate profile(s)")
+	}
+
+	if cmd.Plan {
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+
+	if err := scheduleCoreDNSOnFargateIfRelevant(ctl, cfg, stackManager, fargateProfiles); err != nil {
+		return err
+	}
+
+	logger.Success("created %d Fargate profile(s) in cluster %q", len(fargateProfiles), meta.Name)
+
+	return nil
+}
+
+// scheduleCoreDNSOnFargateIfRelevant makes coredns schedulable onto Fargate,
+// when the cluster has no nodegroups and one of the profiles selects coredns
+func scheduleCoreDNSOnFargateIfRelevant(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, stackManager *manager.StackCollection, fargateProfiles []*api.FargateProfile) error {
+	if !fargate.IsCoreDNSSchedulableOnFargate(fargateProfiles) {
+		return nil
+	}
+
+	nodeGroups, err := stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return errors.Wrap(err, "getting existing nodegroups")
+	}
+	if len(nodeGroups) > 0 {
+		logger.Debug("cluster %q has %d nodegroup(s), coredns will not be patched", cfg.Metadata.Name, len(nodeGroups))
+		return nil
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	return fargate.ScheduleCoreDNSOnFargate(clientSet)
+}
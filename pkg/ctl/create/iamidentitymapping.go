@@ -1,7 +1,8 @@
 package create
 
 import (
-	"github.com/kris-nova/logger"
+	"reflect"
+
 	"github.com/lithammer/dedent"
 	"github.com/spf13/pflag"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
@@ -78,29 +80,28 @@ func doCreateIAMIdentityMapping(cmd *cmdutils.Cmd, arn string, username string,
 	if err != nil {
 		return err
 	}
-	acm, err := authconfigmap.NewFromClientSet(clientSet)
-	if err != nil {
-		return err
-	}
-
-	// Check whether role already exists.
-	identities, err := acm.Identities()
-	if err != nil {
-		return err
-	}
-
 	createdArn := id.ARN() // The call to Valid above makes sure this cannot error
-	for _, identity := range identities {
-		arn := identity.ARN()
 
-		if createdArn == arn {
+	_, err = authconfigmap.Update(clientSet, func(acm *authconfigmap.AuthConfigMap) error {
+		// Check whether role already exists.
+		identities, err := acm.Identities()
+		if err != nil {
+			return err
+		}
+
+		for _, identity := range identities {
+			if identity.ARN() != createdArn {
+				continue
+			}
+			if identity.Username() == id.Username() && reflect.DeepEqual(identity.Groups(), id.Groups()) {
+				logger.Info("mapping for arn %q with the same username and groups already exists", createdArn)
+				return nil
+			}
 			logger.Warning("found existing mappings with same arn %q (which will be shadowed by your new mapping)", createdArn)
 			break
 		}
-	}
 
-	if err := acm.AddIdentity(id); err != nil {
-		return err
-	}
-	return acm.Save()
+		return acm.AddIdentity(id)
+	})
+	return err
 }
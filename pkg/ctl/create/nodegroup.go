@@ -3,15 +3,15 @@ package create
 import (
 	"fmt"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
-	"github.com/weaveworks/eksctl/pkg/utils"
 )
 
 func createNodeGroupCmd(cmd *cmdutils.Cmd) {
@@ -19,14 +19,18 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 	ng := cfg.NewNodeGroup()
 	cmd.ClusterConfig = cfg
 
-	var updateAuthConfigMap bool
+	var (
+		updateAuthConfigMap       bool
+		installNvidiaPlugin       bool
+		installTerminationHandler bool
+	)
 
 	cfg.Metadata.Version = "auto"
 
 	cmd.SetDescription("nodegroup", "Create a nodegroup", "", "ng")
 
 	cmd.SetRunFuncWithNameArg(func() error {
-		return doCreateNodeGroups(cmd, updateAuthConfigMap)
+		return doCreateNodeGroups(cmd, updateAuthConfigMap, installNvidiaPlugin, installTerminationHandler)
 	})
 
 	exampleNodeGroupName := cmdutils.NodeGroupName("", "")
@@ -39,11 +43,16 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude)
 		cmdutils.AddUpdateAuthConfigMap(fs, &updateAuthConfigMap, "Remove nodegroup IAM role from aws-auth configmap")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddMaxConcurrencyFlag(fs, &cmd.MaxConcurrency, "create")
+		cmdutils.AddDryRunFlag(fs, cmd)
+		cmdutils.AddStackTerminationProtectionFlag(fs, cmd.ProviderConfig)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("New nodegroup", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&ng.Name, "name", "n", "", fmt.Sprintf("name of the new nodegroup (generated if unspecified, e.g. %q)", exampleNodeGroupName))
 		cmdutils.AddCommonCreateNodeGroupFlags(fs, cmd, ng)
+		cmdutils.AddInstallNvidiaPluginFlag(fs, &installNvidiaPlugin)
+		cmdutils.AddInstallNodeTerminationHandlerFlag(fs, &installTerminationHandler)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("IAM addons", func(fs *pflag.FlagSet) {
@@ -53,7 +62,7 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
 }
 
-func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
+func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap, installNvidiaPlugin, installTerminationHandler bool) error {
 	ngFilter := cmdutils.NewNodeGroupFilter()
 
 	if err := cmdutils.NewCreateNodeGroupLoader(cmd, ngFilter).Load(); err != nil {
@@ -63,6 +72,10 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
+	if installTerminationHandler {
+		cmdutils.EnableNodeTerminationHandler(cfg)
+	}
+
 	printer := printers.NewJSONPrinter()
 
 	ctl, err := cmd.NewCtl()
@@ -87,6 +100,10 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 		return errors.Wrapf(err, "getting VPC configuration for cluster %q", cfg.Metadata.Name)
 	}
 
+	if err := ctl.LoadClusterKubernetesNetworkConfig(cfg); err != nil {
+		return errors.Wrapf(err, "getting Kubernetes network configuration for cluster %q", cfg.Metadata.Name)
+	}
+
 	stackManager := ctl.NewStackManager(cfg)
 
 	if err := ngFilter.SetExcludeExistingFilter(stackManager); err != nil {
@@ -94,8 +111,26 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 	}
 
 	filteredNodeGroups := ngFilter.FilterMatching(cfg.NodeGroups)
+	filteredManagedNodeGroups := ngFilter.FilterMatchingManaged(cfg.ManagedNodeGroups)
+
+	if len(filteredNodeGroups) == 0 && len(filteredManagedNodeGroups) == 0 {
+		ngFilter.LogInfo(cfg.NodeGroups)
+		logger.Info("no nodegroups to create in cluster %q", cfg.Metadata.Name)
+		return nil
+	}
+
+	if err := eks.ValidateARMCompatibility(filteredNodeGroups, meta.Version); err != nil {
+		return err
+	}
+	eks.LogARMCompatibility(filteredNodeGroups)
 
 	for _, ng := range filteredNodeGroups {
+		if err := ctl.ResolveInstanceSelector(ng); err != nil {
+			return err
+		}
+		if err := ctl.ValidateNodeGroupSubnets(cfg, ng); err != nil {
+			return err
+		}
 		// resolve AMI
 		if err := ctl.EnsureAMI(meta.Version, ng); err != nil {
 			return err
@@ -109,8 +144,21 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 		// load or use SSH key - name includes cluster name and the
 		// fingerprint, so if unique keys provided, each will get
 		// loaded and used as intended and there is no need to have
-		// nodegroup name in the key name
-		if err := loadSSHKey(ng, meta.Name, ctl.Provider); err != nil {
+		// nodegroup name in the key name; keys are imported into EC2,
+		// so this is skipped in dry-run mode
+		if cmd.DryRun {
+			continue
+		}
+		if err := loadSSHKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
+			return err
+		}
+	}
+
+	for _, ng := range filteredManagedNodeGroups {
+		if cmd.DryRun {
+			continue
+		}
+		if err := loadSSHKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
 			return err
 		}
 	}
@@ -123,13 +171,26 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 		return errors.Wrap(err, "cluster compatibility check failed")
 	}
 
+	if cmd.DryRun {
+		plans, err := stackManager.PlanCreateAllNodeGroups(filteredNodeGroups, filteredManagedNodeGroups)
+		if err != nil {
+			return err
+		}
+		cmdutils.LogStackPlans(plans)
+		return nil
+	}
+
 	{
 		ngFilter.LogInfo(cfg.NodeGroups)
 		if len(filteredNodeGroups) > 0 {
 			logger.Info("will create a CloudFormation stack for each of %d nodegroups in cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
 		}
+		if len(filteredManagedNodeGroups) > 0 {
+			logger.Info("will create a CloudFormation stack for each of %d managed nodegroups in cluster %q", len(filteredManagedNodeGroups), cfg.Metadata.Name)
+		}
 
-		tasks := stackManager.NewTasksToCreateNodeGroups(filteredNodeGroups)
+		tasks := stackManager.NewTasksToCreateAllNodeGroups(filteredNodeGroups, filteredManagedNodeGroups)
+		tasks.SetMaxConcurrency(cmd.MaxConcurrency)
 		logger.Info(tasks.Describe())
 		errs := tasks.DoAllSync()
 		if len(errs) > 0 {
@@ -158,23 +219,30 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 				}
 
 				// wait for nodes to join
-				if err = ctl.WaitForNodes(clientSet, ng); err != nil {
+				if err = ctl.WaitForNodes(clientSet, ng, cfg.NodeJoinTimeout(ctl.Provider.WaitTimeout())); err != nil {
 					return err
 				}
 			}
+		}
 
-			// if GPU instance type, give instructions
-			if utils.IsGPUInstanceType(ng.InstanceType) || (ng.InstancesDistribution != nil && utils.HasGPUInstanceType(ng.InstancesDistribution.InstanceTypes)) {
-				logger.Info("as you are using a GPU optimized instance type you will need to install NVIDIA Kubernetes device plugin.")
-				logger.Info("\t see the following page for instructions: https://github.com/NVIDIA/k8s-device-plugin")
-			}
+		if err := installNvidiaDevicePlugin(ctl, cfg, filteredNodeGroups, installNvidiaPlugin); err != nil {
+			return err
+		}
+
+		if err := installNodeTerminationHandler(ctl, cfg, filteredNodeGroups); err != nil {
+			return err
 		}
 
-		logger.Success("created %d nodegroup(s) in cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
+		if len(filteredNodeGroups) > 0 {
+			logger.Success("created %d nodegroup(s) in cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
+		}
+		if len(filteredManagedNodeGroups) > 0 {
+			logger.Success("created %d managed nodegroup(s) in cluster %q", len(filteredManagedNodeGroups), cfg.Metadata.Name)
+		}
 	}
 
 	if err := ctl.ValidateExistingNodeGroupsForCompatibility(cfg, stackManager); err != nil {
-		logger.Critical("failed checking nodegroups", err.Error())
+		logger.Critical("failed checking nodegroups: %s", err.Error())
 	}
 
 	return nil
@@ -4,12 +4,17 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 
+	"github.com/weaveworks/eksctl/pkg/addons"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/git"
+	"github.com/weaveworks/eksctl/pkg/gitops"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/ssh"
+	"github.com/weaveworks/eksctl/pkg/utils"
 	"github.com/weaveworks/eksctl/pkg/utils/file"
 )
 
@@ -24,14 +29,18 @@ func checkVersion(cmd *cmdutils.Cmd, ctl *eks.ClusterProvider, meta *api.Cluster
 	case "":
 		meta.Version = "auto"
 	case "default":
-		meta.Version = api.DefaultVersion
+		defaultVersion, err := cmdutils.DefaultVersion()
+		if err != nil {
+			return err
+		}
+		meta.Version = defaultVersion
 		logger.Info("will use default version (%s) for new nodegroup(s)", meta.Version)
 	case "latest":
 		meta.Version = api.LatestVersion
 		logger.Info("will use latest version (%s) for new nodegroup(s)", meta.Version)
 	default:
-		if !isValidVersion(meta.Version) {
-			if isDeprecatedVersion(meta.Version) {
+		if !api.IsSupportedVersion(meta.Version) {
+			if api.IsDeprecatedVersion(meta.Version) {
 				return fmt.Errorf("invalid version, %s is now deprecated, supported values: auto, default, latest, %s\nsee also: https://docs.aws.amazon.com/eks/latest/userguide/kubernetes-versions.html", meta.Version, strings.Join(api.SupportedVersions(), ", "))
 			}
 			return fmt.Errorf("invalid version %s, supported values: auto, default, latest, %s", meta.Version, strings.Join(api.SupportedVersions(), ", "))
@@ -54,30 +63,11 @@ func checkVersion(cmd *cmdutils.Cmd, ctl *eks.ClusterProvider, meta *api.Cluster
 	return nil
 }
 
-func isValidVersion(version string) bool {
-	for _, v := range api.SupportedVersions() {
-		if version == v {
-			return true
-		}
-	}
-	return false
-}
-
-func isDeprecatedVersion(version string) bool {
-	for _, v := range api.DeprecatedVersions() {
-		if version == v {
-			return true
-		}
-	}
-	return false
-}
-
-// loadSSHKey loads the ssh public key specified in the NodeGroup. The key should be specified
+// loadSSHKey loads the ssh public key specified for a nodegroup. The key should be specified
 // in only one way: by name (for a key existing in EC2), by path (for a key in a local file)
 // or by its contents (in the config-file). It also assumes that if ssh is enabled (SSH.Allow
 // == true) then one key was specified
-func loadSSHKey(ng *api.NodeGroup, clusterName string, provider api.ClusterProvider) error {
-	sshConfig := ng.SSH
+func loadSSHKey(sshConfig *api.NodeGroupSSH, clusterName, nodeGroupName string, provider api.ClusterProvider) error {
 	if sshConfig.Allow == nil || *sshConfig.Allow == false {
 		return nil
 	}
@@ -86,7 +76,7 @@ func loadSSHKey(ng *api.NodeGroup, clusterName string, provider api.ClusterProvi
 
 	// Load Key by content
 	case sshConfig.PublicKey != nil:
-		keyName, err := ssh.LoadKeyByContent(sshConfig.PublicKey, clusterName, ng.Name, provider)
+		keyName, err := ssh.LoadKeyByContent(sshConfig.PublicKey, clusterName, nodeGroupName, provider)
 		if err != nil {
 			return err
 		}
@@ -101,7 +91,7 @@ func loadSSHKey(ng *api.NodeGroup, clusterName string, provider api.ClusterProvi
 
 	// Local ssh key file
 	case file.Exists(*sshConfig.PublicKeyPath):
-		keyName, err := ssh.LoadKeyFromFile(*sshConfig.PublicKeyPath, clusterName, ng.Name, provider)
+		keyName, err := ssh.LoadKeyFromFile(*sshConfig.PublicKeyPath, clusterName, nodeGroupName, provider)
 		if err != nil {
 			return err
 		}
@@ -115,8 +105,115 @@ func loadSSHKey(ng *api.NodeGroup, clusterName string, provider api.ClusterProvi
 		}
 		sshConfig.PublicKeyName = sshConfig.PublicKeyPath
 		sshConfig.PublicKeyPath = nil
-		logger.Info("using EC2 key pair %q", *ng.SSH.PublicKeyName)
+		logger.Info("using EC2 key pair %q", *sshConfig.PublicKeyName)
+	}
+
+	return nil
+}
+
+func hasGPUInstanceType(ng *api.NodeGroup) bool {
+	return utils.IsGPUInstanceType(ng.InstanceType) || (ng.InstancesDistribution != nil && utils.HasGPUInstanceType(ng.InstancesDistribution.InstanceTypes))
+}
+
+// installNvidiaDevicePlugin installs the NVIDIA Kubernetes device plugin when any of the
+// nodegroups uses a GPU instance type; when a gitops repository is configured, the manifest
+// is committed to it for Flux to apply, and when installation is disabled only instructions
+// are given
+func installNvidiaDevicePlugin(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, nodeGroups []*api.NodeGroup, install bool) error {
+	gpuNodeGroups := []string{}
+	for _, ng := range nodeGroups {
+		if hasGPUInstanceType(ng) {
+			gpuNodeGroups = append(gpuNodeGroups, ng.Name)
+		}
+	}
+	if len(gpuNodeGroups) == 0 {
+		return nil
+	}
+
+	if !install {
+		logger.Info("as you are using a GPU optimized instance type you will need to install NVIDIA Kubernetes device plugin.")
+		logger.Info("\t see the following page for instructions: https://github.com/NVIDIA/k8s-device-plugin")
+		return nil
+	}
+
+	logger.Info("nodegroup(s) %s use a GPU optimized instance type, installing NVIDIA Kubernetes device plugin", strings.Join(gpuNodeGroups, ", "))
+
+	if cfg.HasGitRepo() {
+		return commitAddonManifest(cfg, addons.NvidiaDevicePlugin, addons.NvidiaDevicePluginManifestFileName, addons.NvidiaDevicePluginManifest(), "Add NVIDIA Kubernetes device plugin")
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	return addons.InstallNvidiaDevicePlugin(rawClient, false)
+}
+
+// installClusterAutoscaler installs cluster-autoscaler when it is enabled; when a gitops
+// repository is configured, the manifest is committed to it for Flux to apply, while its
+// iamserviceaccount has been created along with the cluster either way
+func installClusterAutoscaler(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) error {
+	if !cfg.HasClusterAutoscaler() {
+		return nil
 	}
 
+	logger.Info("installing cluster-autoscaler, which will scale nodegroups of cluster %q", cfg.Metadata.Name)
+
+	if cfg.HasGitRepo() {
+		manifest, err := addons.ClusterAutoscalerManifest(cfg)
+		if err != nil {
+			return err
+		}
+		return commitAddonManifest(cfg, addons.ClusterAutoscaler, addons.ClusterAutoscalerManifestFileName, manifest, "Add cluster-autoscaler")
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	return addons.InstallClusterAutoscaler(rawClient, cfg, false)
+}
+
+// installNodeTerminationHandler installs the AWS Node Termination Handler when it is
+// enabled and any of the nodegroups uses mixed instances, which may be spot instances;
+// when a gitops repository is configured, the manifest is committed to it for Flux to apply
+func installNodeTerminationHandler(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, nodeGroups []*api.NodeGroup) error {
+	if !cfg.HasNodeTerminationHandler() {
+		return nil
+	}
+	spotNodeGroups := []string{}
+	for _, ng := range nodeGroups {
+		if api.HasMixedInstances(ng) {
+			spotNodeGroups = append(spotNodeGroups, ng.Name)
+		}
+	}
+	if len(spotNodeGroups) == 0 {
+		return nil
+	}
+
+	logger.Info("nodegroup(s) %s use spot instances, installing AWS Node Termination Handler", strings.Join(spotNodeGroups, ", "))
+
+	manifest, err := addons.NodeTerminationHandlerManifest(cfg)
+	if err != nil {
+		return err
+	}
+	if cfg.HasGitRepo() {
+		return commitAddonManifest(cfg, addons.NodeTerminationHandler, addons.NodeTerminationHandlerManifestFileName, manifest, "Add AWS Node Termination Handler")
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	return addons.InstallNodeTerminationHandler(rawClient, cfg, false)
+}
+
+// commitAddonManifest commits the manifest of an addon to the gitops repository of the cluster
+func commitAddonManifest(cfg *api.ClusterConfig, name, fileName string, manifest []byte, message string) error {
+	repo := cfg.Git.Repo
+	gitClient := git.NewGitClient(git.ClientParams{PrivateSSHKeyPath: repo.PrivateSSHKeyPath})
+	if err := gitops.CommitManifests(gitClient, repo, map[string][]byte{fileName: manifest}, message); err != nil {
+		return errors.Wrapf(err, "committing %q to %s", name, repo.URL)
+	}
 	return nil
 }
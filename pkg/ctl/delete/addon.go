@@ -0,0 +1,95 @@
+package delete
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func deleteAddonCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	addon := &api.Addon{}
+
+	cmd.SetDescription("addon", "Delete EKS managed add-on(s)", "", "addons")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doDeleteAddon(cmd, addon)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to delete the add-on from")
+
+		fs.StringVar(&addon.Name, "name", "", "name of the add-on to delete")
+
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doDeleteAddon(cmd *cmdutils.Cmd, addon *api.Addon) error {
+	if err := cmdutils.NewDeleteAddonLoader(cmd, addon).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	names := []string{}
+	if cmd.ClusterConfigFile != "" {
+		for _, addon := range cfg.Addons {
+			names = append(names, addon.Name)
+		}
+	} else {
+		names = append(names, addon.Name)
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	for _, name := range names {
+		if cmd.Plan {
+			logger.Info("(plan) would delete add-on %q and its IAM role stack, if any", name)
+			continue
+		}
+		if err := ctl.DeleteAddon(cfg, name); err != nil {
+			if !eks.IsAddonNotFound(err) {
+				return err
+			}
+			logger.Info("add-on %q does not exist", name)
+		}
+		if err := stackManager.DeleteAddonIAMRole(name); err != nil {
+			return err
+		}
+	}
+
+	if cmd.Plan {
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+
+	logger.Success("deleted %d add-on(s) from cluster %q", len(names), meta.Name)
+
+	return nil
+}
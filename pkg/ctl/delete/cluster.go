@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"github.com/weaveworks/eksctl/pkg/eks"
@@ -13,9 +12,11 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/drain"
 	"github.com/weaveworks/eksctl/pkg/elb"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/ssh"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
@@ -28,8 +29,19 @@ func deleteClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("cluster", "Delete a cluster", "")
 
+	var (
+		drainNodeGroups               bool
+		detachSecurityGroupReferences bool
+		all                           cmdutils.AllClusterConfigs
+	)
+
 	cmd.SetRunFuncWithNameArg(func() error {
-		return doDeleteCluster(cmd)
+		if all.Enabled {
+			return cmdutils.RunForAllClusterConfigs(cmd, all, "delete", func(cmd *cmdutils.Cmd) error {
+				return doDeleteCluster(cmd, drainNodeGroups, detachSecurityGroupReferences)
+			})
+		}
+		return doDeleteCluster(cmd, drainNodeGroups, detachSecurityGroupReferences)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -41,6 +53,11 @@ func deleteClusterCmd(cmd *cmdutils.Cmd) {
 
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddMaxConcurrencyFlag(fs, &cmd.MaxConcurrency, "delete")
+		cmdutils.AddDryRunFlag(fs, cmd)
+		cmdutils.AddAllClusterConfigsFlags(fs, &all, "delete")
+		fs.BoolVar(&drainNodeGroups, "drain", false, "drain all nodegroups before deleting them")
+		fs.BoolVar(&detachSecurityGroupReferences, "detach-security-group-references", false, "revoke rules of security groups not created by eksctl that refer to security groups of the cluster, instead of only logging them")
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
@@ -64,13 +81,13 @@ func deleteDeprecatedStacks(stackManager *manager.StackCollection) (bool, error)
 		if errs := tasks.DoAllSync(); len(errs) > 0 {
 			return true, handleErrors(errs, "deprecated stacks")
 		}
-		logger.Success("deleted all %s deperecated stacks", count)
+		logger.Success("deleted all %d deperecated stacks", count)
 		return true, nil
 	}
 	return false, nil
 }
 
-func doDeleteCluster(cmd *cmdutils.Cmd) error {
+func doDeleteCluster(cmd *cmdutils.Cmd, drainNodeGroups, detachSecurityGroupReferences bool) error {
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -123,6 +140,24 @@ func doDeleteCluster(cmd *cmdutils.Cmd) error {
 
 	stackManager := ctl.NewStackManager(cfg)
 
+	// checked before anything is deleted, so that a protected cluster is left intact
+	if err := stackManager.CheckTerminationProtection(); err != nil {
+		return err
+	}
+
+	if cmd.DryRun {
+		stacks, err := stackManager.DescribeStacks()
+		if err != nil {
+			return err
+		}
+		plans, err := stackManager.PlanDeleteStacks(stacks)
+		if err != nil {
+			return err
+		}
+		cmdutils.LogStackPlans(plans)
+		return nil
+	}
+
 	ssh.DeleteKeys(meta.Name, ctl.Provider)
 
 	kubeconfig.MaybeDeleteConfig(meta)
@@ -135,6 +170,16 @@ func doDeleteCluster(cmd *cmdutils.Cmd) error {
 	}
 
 	{
+		if drainNodeGroups {
+			if clusterOperable {
+				if err := drainAllNodeGroups(stackManager, clientSet, ctl.Provider.WaitTimeout()); err != nil {
+					return err
+				}
+			} else {
+				logger.Warning("cluster %q is not operable, nodegroups will be deleted without draining them", meta.Name)
+			}
+		}
+
 		// only need to cleanup ELBs if the cluster has already been created.
 		if clusterOperable {
 			ctx, cleanup := context.WithTimeout(context.Background(), 10*time.Minute)
@@ -158,7 +203,7 @@ func doDeleteCluster(cmd *cmdutils.Cmd) error {
 				close(errs)
 			}()
 			return nil
-		})
+		}, ctl.NewTaskToCleanupVPC(cfg, detachSecurityGroupReferences))
 
 		if err != nil {
 			return err
@@ -168,14 +213,35 @@ func doDeleteCluster(cmd *cmdutils.Cmd) error {
 			logger.Warning("no cluster resources were found for %q", meta.Name)
 			return nil
 		}
+		tasks.SetMaxConcurrency(cmd.MaxConcurrency)
 
 		logger.Info(tasks.Describe())
 		if errs := tasks.DoAllSync(); len(errs) > 0 {
 			return handleErrors(errs, "cluster with nodegroup(s)")
 		}
 
+		if !cmd.Wait {
+			cmdutils.LogNoWaitHint(cmd.Wait, cmd.Plan, meta, stackManager.DeletionRequestedStackIDs())
+			return nil
+		}
 		logger.Success("all cluster resources were deleted")
 	}
 
 	return nil
 }
+
+// drainAllNodeGroups drains nodegroups that are not managed by EKS, as EKS drains
+// managed nodegroups itself when they get deleted
+func drainAllNodeGroups(stackManager *manager.StackCollection, clientSet kubernetes.Interface, waitTimeout time.Duration) error {
+	names, err := stackManager.ListUnmanagedNodeGroupStacks()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		logger.Info("draining nodegroup %q", name)
+		if err := drain.NodeGroup(clientSet, &api.NodeGroup{Name: name}, waitTimeout, false, drain.DefaultOptions()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
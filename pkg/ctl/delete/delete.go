@@ -14,6 +14,8 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteNodeGroupCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteIAMServiceAccountCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteIAMIdentityMappingCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteFargateProfileCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteAddonCmd)
 
 	return verbCmd
 }
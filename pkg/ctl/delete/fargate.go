@@ -0,0 +1,100 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func deleteFargateProfileCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	fp := &api.FargateProfile{}
+
+	cmd.SetDescription("fargateprofile", "Delete Fargate profile(s)", "", "fargateprofiles")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doDeleteFargateProfile(cmd, fp)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to delete the Fargate profile from")
+
+		fs.StringVar(&fp.Name, "name", "", "name of the Fargate profile to delete")
+
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+
+		cmd.Wait = false
+		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doDeleteFargateProfile(cmd *cmdutils.Cmd, fp *api.FargateProfile) error {
+	if err := cmdutils.NewDeleteFargateProfileLoader(cmd, fp).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	names := sets.NewString()
+	if cmd.ClusterConfigFile != "" {
+		for _, fp := range cfg.FargateProfiles {
+			names.Insert(fp.Name)
+		}
+	} else {
+		names.Insert(fp.Name)
+	}
+
+	tasks, err := stackManager.NewTasksToDeleteFargateProfiles(names.Has, cmd.Wait)
+	if err != nil {
+		return err
+	}
+	tasks.PlanMode = cmd.Plan
+
+	if tasks.Len() == 0 {
+		logger.Info("no Fargate profiles to delete in cluster %q", meta.Name)
+		return nil
+	}
+
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred and Fargate profile stacks haven't been deleted properly, you may wish to check CloudFormation console", len(errs))
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to delete Fargate profile(s)")
+	}
+
+	cmdutils.LogNoWaitHint(cmd.Wait, cmd.Plan, meta, stackManager.DeletionRequestedStackIDs())
+	cmdutils.LogPlanModeWarning(cmd.Plan && tasks.Len() > 0)
+
+	return nil
+}
@@ -1,12 +1,12 @@
 package delete
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func deleteIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
@@ -67,18 +67,13 @@ func doDeleteIAMIdentityMapping(cmd *cmdutils.Cmd, arn string, all bool) error {
 	if err != nil {
 		return err
 	}
-	acm, err := authconfigmap.NewFromClientSet(clientSet)
+	acm, err := authconfigmap.Update(clientSet, func(acm *authconfigmap.AuthConfigMap) error {
+		return acm.RemoveIdentity(arn, all)
+	})
 	if err != nil {
 		return err
 	}
 
-	if err := acm.RemoveIdentity(arn, all); err != nil {
-		return err
-	}
-	if err := acm.Save(); err != nil {
-		return err
-	}
-
 	// Check whether we have more roles that match
 	identities, err := acm.Identities()
 	if err != nil {
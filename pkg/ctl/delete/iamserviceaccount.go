@@ -3,12 +3,12 @@ package delete
 import (
 	"fmt"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
@@ -126,6 +126,7 @@ func doDeleteIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAM
 		return fmt.Errorf("failed to delete iamserviceaccount(s)")
 	}
 
+	cmdutils.LogNoWaitHint(cmd.Wait, cmd.Plan, meta, stackManager.DeletionRequestedStackIDs())
 	cmdutils.LogPlanModeWarning(cmd.Plan && saSubset.Len() > 0)
 
 	return nil
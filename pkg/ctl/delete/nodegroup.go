@@ -1,13 +1,14 @@
 package delete
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func deleteNodeGroupCmd(cmd *cmdutils.Cmd) {
@@ -37,6 +38,8 @@ func deleteNodeGroupCmd(cmd *cmdutils.Cmd) {
 		cmd.Wait = false
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddMaxConcurrencyFlag(fs, &cmd.MaxConcurrency, "delete")
+		cmdutils.AddDryRunFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
@@ -83,6 +86,10 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 
 	ngFilter.LogInfo(cfg.NodeGroups)
 
+	if cmd.DryRun {
+		return planDeleteNodeGroups(stackManager, ngFilter, cfg)
+	}
+
 	if updateAuthConfigMap {
 		cmdutils.LogIntendedAction(cmd.Plan, "delete %d nodegroups from auth ConfigMap in cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
 		if !cmd.Plan {
@@ -104,7 +111,7 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 		cmdutils.LogIntendedAction(cmd.Plan, "drain %d nodegroups in cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
 		if !cmd.Plan {
 			for _, ng := range filteredNodeGroups {
-				if err := drain.NodeGroup(clientSet, ng, ctl.Provider.WaitTimeout(), false); err != nil {
+				if err := drain.NodeGroup(clientSet, ng, ctl.Provider.WaitTimeout(), false, drain.DefaultOptions()); err != nil {
 					return err
 				}
 			}
@@ -120,14 +127,36 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 			return err
 		}
 		tasks.PlanMode = cmd.Plan
+		tasks.SetMaxConcurrency(cmd.MaxConcurrency)
 		logger.Info(tasks.Describe())
 		if errs := tasks.DoAllSync(); len(errs) > 0 {
 			return handleErrors(errs, "nodegroup(s)")
 		}
 		cmdutils.LogCompletedAction(cmd.Plan, "deleted %d nodegroups from cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
+		cmdutils.LogNoWaitHint(cmd.Wait, cmd.Plan, cfg.Metadata, stackManager.DeletionRequestedStackIDs())
 	}
 
 	cmdutils.LogPlanModeWarning(cmd.Plan && len(filteredNodeGroups) > 0)
 
 	return nil
 }
+
+func planDeleteNodeGroups(stackManager *manager.StackCollection, ngFilter *cmdutils.NodeGroupFilter, cfg *api.ClusterConfig) error {
+	ngSubset, _ := ngFilter.MatchAll(cfg.NodeGroups)
+	nodeGroupStacks, err := stackManager.DescribeNodeGroupStacks()
+	if err != nil {
+		return err
+	}
+	stacks := []*manager.Stack{}
+	for _, s := range nodeGroupStacks {
+		if ngSubset.Has(stackManager.GetNodeGroupName(s)) {
+			stacks = append(stacks, s)
+		}
+	}
+	plans, err := stackManager.PlanDeleteStacks(stacks)
+	if err != nil {
+		return err
+	}
+	cmdutils.LogStackPlans(plans)
+	return nil
+}
@@ -1,13 +1,13 @@
 package drain
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 
 	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func drainNodeGroupCmd(cmd *cmdutils.Cmd) {
@@ -17,16 +17,18 @@ func drainNodeGroupCmd(cmd *cmdutils.Cmd) {
 
 	var undo, onlyMissing bool
 
+	var options drain.Options
+
 	cmd.SetDescription("nodegroup", "Cordon and drain a nodegroup", "", "ng")
 
 	cmd.SetRunFuncWithNameArg(func() error {
-		return doDrainNodeGroup(cmd, ng, undo, onlyMissing)
+		return doDrainNodeGroup(cmd, ng, undo, onlyMissing, options)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
-		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup to delete")
+		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup to drain")
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddApproveFlag(fs, cmd)
 		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude)
@@ -35,10 +37,23 @@ func drainNodeGroupCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
+	cmd.FlagSetGroup.InFlagSet("Drain", func(fs *pflag.FlagSet) {
+		addDrainOptionsFlags(fs, &options)
+	})
+
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
 }
 
-func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool) error {
+// addDrainOptionsFlags adds the flags that control which pods can be evicted,
+// like with `kubectl drain` they all default to false, while nodegroups used
+// to be drained as if they were all set
+func addDrainOptionsFlags(fs *pflag.FlagSet, options *drain.Options) {
+	fs.BoolVar(&options.Force, "force", false, "Evict pods that are not managed by a ReplicationController, ReplicaSet, Job, DaemonSet or StatefulSet, otherwise draining fails when there are any")
+	fs.BoolVar(&options.IgnoreDaemonSets, "ignore-daemonsets", false, "Ignore DaemonSet-managed pods, otherwise draining fails when there are any (pods of aws-node, kube-proxy and a few other well-known DaemonSets are always ignored)")
+	fs.BoolVar(&options.DeleteLocalData, "delete-local-data", false, "Evict pods using emptyDir volumes, the data in them is deleted, otherwise draining fails when there are any")
+}
+
+func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, options drain.Options) error {
 	ngFilter := cmdutils.NewNodeGroupFilter()
 
 	if err := cmdutils.NewDeleteNodeGroupLoader(cmd, ng, ngFilter).Load(); err != nil {
@@ -90,7 +105,7 @@ func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bo
 		return nil
 	}
 	for _, ng := range filteredNodeGroups {
-		if err := drain.NodeGroup(clientSet, ng, ctl.Provider.WaitTimeout(), undo); err != nil {
+		if err := drain.NodeGroup(clientSet, ng, ctl.Provider.WaitTimeout(), undo, options); err != nil {
 			return err
 		}
 	}
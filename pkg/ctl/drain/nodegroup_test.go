@@ -0,0 +1,47 @@
+package drain
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/drain"
+)
+
+var _ = Describe("drain nodegroup", func() {
+	var (
+		fs      *pflag.FlagSet
+		options drain.Options
+	)
+
+	BeforeEach(func() {
+		fs = pflag.NewFlagSet("drain", pflag.ContinueOnError)
+		options = drain.Options{}
+		addDrainOptionsFlags(fs, &options)
+	})
+
+	It("doesn't evict any pods that kubectl drain wouldn't by default", func() {
+		Expect(fs.Parse(nil)).To(Succeed())
+		Expect(options).To(Equal(drain.Options{}))
+
+		for _, name := range []string{"force", "ignore-daemonsets", "delete-local-data"} {
+			Expect(fs.Lookup(name).DefValue).To(Equal("false"))
+		}
+	})
+
+	It("sets the options from flags", func() {
+		Expect(fs.Parse([]string{"--force", "--ignore-daemonsets", "--delete-local-data"})).To(Succeed())
+		Expect(options).To(Equal(drain.Options{
+			Force:            true,
+			IgnoreDaemonSets: true,
+			DeleteLocalData:  true,
+		}))
+	})
+
+	It("sets each option independently", func() {
+		Expect(fs.Parse([]string{"--ignore-daemonsets"})).To(Succeed())
+		Expect(options).To(Equal(drain.Options{
+			IgnoreDaemonSets: true,
+		}))
+	})
+})
@@ -4,12 +4,12 @@ import (
 	"context"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/gitops/flux"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils/file"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
@@ -24,16 +24,6 @@ func enableRepo(cmd *cmdutils.Cmd) {
 	)
 	var opts flux.InstallOpts
 	cmd.SetRunFuncWithNameArg(func() error {
-		if err := opts.GitOptions.ValidateURL(); err != nil {
-			return errors.Wrap(err, "please supply a valid --git-url argument")
-		}
-		if opts.GitOptions.Email == "" {
-			return errors.New("please supply a valid --git-email argument")
-		}
-		if opts.GitPrivateSSHKeyPath != "" && !file.Exists(opts.GitPrivateSSHKeyPath) {
-			return errors.New("please supply a valid --git-private-ssh-key-path argument")
-		}
-
 		if err := cmdutils.NewInstallFluxLoader(cmd).Load(); err != nil {
 			return err
 		}
@@ -43,6 +33,18 @@ func enableRepo(cmd *cmdutils.Cmd) {
 			return err
 		}
 
+		if cfg.HasGitRepo() {
+			setGitOptionsFromConfig(&opts, cfg.Git.Repo)
+		}
+		if len(opts.GitPaths) == 0 {
+			// only sync the directory eksctl writes to, so that Flux doesn't
+			// try to apply everything when the repository is a monorepo
+			opts.GitPaths = []string{opts.GitFluxPath}
+		}
+		if err := validateInstallOpts(opts); err != nil {
+			return err
+		}
+
 		if err := ctl.CheckAuth(); err != nil {
 			return err
 		}
@@ -73,17 +75,17 @@ func enableRepo(cmd *cmdutils.Cmd) {
 	cmd.FlagSetGroup.InFlagSet("Flux installation", func(fs *pflag.FlagSet) {
 		fs.StringVar(&opts.GitOptions.URL, "git-url", "",
 			"SSH URL of the Git repository to be used by Flux, e.g. git@github.com:<github_org>/<repo_name>")
-		fs.StringVar(&opts.GitOptions.Branch, "git-branch", "master",
+		fs.StringVar(&opts.GitOptions.Branch, "git-branch", api.DefaultGitBranch,
 			"Git branch to be used by Flux")
 		fs.StringSliceVar(&opts.GitPaths, "git-paths", []string{},
-			"Relative paths within the Git repo for Flux to locate Kubernetes manifests")
+			"Relative paths within the Git repo for Flux to locate Kubernetes manifests (defaults to the value of --git-flux-subdir)")
 		fs.StringVar(&opts.GitLabel, "git-label", "flux",
 			"Git label to keep track of Flux's sync progress; overrides both --git-sync-tag and --git-notes-ref")
-		fs.StringVar(&opts.GitOptions.User, "git-user", "Flux",
+		fs.StringVar(&opts.GitOptions.User, "git-user", api.DefaultGitUser,
 			"Username to use as Git committer")
 		fs.StringVar(&opts.GitOptions.Email, "git-email", "",
 			"Email to use as Git committer")
-		fs.StringVar(&opts.GitFluxPath, "git-flux-subdir", "flux/",
+		fs.StringVar(&opts.GitFluxPath, "git-flux-subdir", api.DefaultGitFluxPath,
 			"Directory within the Git repository where to commit the Flux manifests")
 		fs.StringVar(&opts.GitPrivateSSHKeyPath, "git-private-ssh-key-path", "",
 			"Optional path to the private SSH key to use with Git, e.g. ~/.ssh/id_rsa")
@@ -103,3 +105,29 @@ func enableRepo(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
 	cmd.ProviderConfig.WaitTimeout = opts.Timeout
 }
+
+func setGitOptionsFromConfig(opts *flux.InstallOpts, repo *api.Repo) {
+	opts.GitOptions.URL = repo.URL
+	opts.GitOptions.Branch = repo.Branch
+	opts.GitOptions.User = repo.User
+	opts.GitOptions.Email = repo.Email
+	opts.GitPaths = repo.Paths
+	opts.GitFluxPath = repo.FluxPath
+	opts.GitPrivateSSHKeyPath = repo.PrivateSSHKeyPath
+}
+
+func validateInstallOpts(opts flux.InstallOpts) error {
+	if err := opts.GitOptions.ValidateURL(); err != nil {
+		return errors.Wrap(err, "please supply a valid --git-url argument")
+	}
+	if opts.GitOptions.Email == "" {
+		return errors.New("please supply a valid --git-email argument")
+	}
+	if opts.GitPrivateSSHKeyPath != "" && !file.Exists(opts.GitPrivateSSHKeyPath) {
+		return errors.New("please supply a valid --git-private-ssh-key-path argument")
+	}
+	if err := api.ValidateGitPaths(opts.GitPaths); err != nil {
+		return errors.Wrap(err, "please supply valid --git-paths arguments")
+	}
+	return nil
+}
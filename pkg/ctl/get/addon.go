@@ -0,0 +1,144 @@
+package get
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+// addonSummary holds the details of an EKS managed add-on that are worth showing
+type addonSummary struct {
+	Name                  string   `json:"name"`
+	Version               string   `json:"version"`
+	Status                string   `json:"status"`
+	ServiceAccountRoleARN string   `json:"serviceAccountRoleARN,omitempty"`
+	Issues                []string `json:"issues,omitempty"`
+}
+
+func getAddonCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	addon := &api.Addon{}
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("addon", "Get EKS managed add-on(s)", "", "addons")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doGetAddon(cmd, addon, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+
+		fs.StringVar(&addon.Name, "name", "", "name of the add-on")
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doGetAddon(cmd *cmdutils.Cmd, addon *api.Addon, params *getCmdParams) error {
+	printer, err := cmdutils.NewOutputPrinter(params.output, addAddonSummaryTableColumns)
+	if err != nil {
+		return err
+	}
+
+	if err := cmdutils.NewGetAddonLoader(cmd, addon).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	remoteAddons, err := ctl.ListAddons(cfg)
+	if err != nil {
+		return err
+	}
+
+	// only return the add-on that user asked for,
+	// or the ones defined in the given config file
+	names := map[string]bool{}
+	if cmd.ClusterConfigFile != "" {
+		for _, addon := range cfg.Addons {
+			names[addon.Name] = true
+		}
+	} else if addon.Name != "" {
+		names[addon.Name] = true
+	}
+
+	summaries := []*addonSummary{}
+	for _, remoteAddon := range remoteAddons {
+		if len(names) == 0 || names[aws.StringValue(remoteAddon.AddonName)] {
+			summaries = append(summaries, makeAddonSummary(remoteAddon))
+		}
+	}
+
+	if addon.Name != "" && len(summaries) == 0 {
+		return fmt.Errorf("no add-on %q found", addon.Name)
+	}
+
+	return printer.PrintObjWithKind("addons", summaries, os.Stdout)
+}
+
+func makeAddonSummary(addon *awseks.Addon) *addonSummary {
+	summary := &addonSummary{
+		Name:                  aws.StringValue(addon.AddonName),
+		Version:               aws.StringValue(addon.AddonVersion),
+		Status:                aws.StringValue(addon.Status),
+		ServiceAccountRoleARN: aws.StringValue(addon.ServiceAccountRoleArn),
+	}
+	if addon.Health != nil {
+		for _, issue := range addon.Health.Issues {
+			summary.Issues = append(summary.Issues, fmt.Sprintf("%s: %s", aws.StringValue(issue.Code), aws.StringValue(issue.Message)))
+		}
+	}
+	return summary
+}
+
+func addAddonSummaryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(s *addonSummary) string {
+		return s.Name
+	})
+	printer.AddColumn("VERSION", func(s *addonSummary) string {
+		return s.Version
+	})
+	printer.AddColumn("STATUS", func(s *addonSummary) string {
+		return s.Status
+	})
+	printer.AddColumn("IAM ROLE", func(s *addonSummary) string {
+		return s.ServiceAccountRoleARN
+	})
+	printer.AddColumn("ISSUES", func(s *addonSummary) string {
+		if len(s.Issues) == 0 {
+			return "<none>"
+		}
+		return strings.Join(s.Issues, "; ")
+	})
+}
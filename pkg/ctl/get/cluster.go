@@ -3,10 +3,11 @@ package get
 import (
 	"fmt"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 func getClusterCmd(cmd *cmdutils.Cmd) {
@@ -35,6 +36,12 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 }
 
 func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool) error {
+	// the printer is created by ListClusters, but an invalid format
+	// should still be reported before making any API calls
+	if _, err := printers.NewPrinter(params.output); err != nil {
+		return err
+	}
+
 	cfg := cmd.ClusterConfig
 	regionGiven := cfg.Metadata.Region != "" // eks.New resets this field, so we need to check if it was set in the fist place
 
@@ -0,0 +1,76 @@
+package get
+
+import (
+	"os"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+// eksVersionSummary holds the details of a Kubernetes version of EKS that eksctl supports
+type eksVersionSummary struct {
+	Version string `json:"version"`
+	// EksctlDefault is set for the version eksctl uses when none is given
+	EksctlDefault bool `json:"eksctlDefault"`
+	// Pinned is set when the eksctl default is pinned in the user-level config file
+	Pinned bool `json:"pinned,omitempty"`
+}
+
+func getEKSVersionsCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("eks-versions", "Get the Kubernetes versions of EKS that eksctl supports", "", "eks-version")
+
+	cmd.SetRunFunc(func() error {
+		return doGetEKSVersions(params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+	})
+}
+
+func doGetEKSVersions(params *getCmdParams) error {
+	printer, err := cmdutils.NewOutputPrinter(params.output, addEKSVersionSummaryTableColumns)
+	if err != nil {
+		return err
+	}
+
+	defaultVersion, err := cmdutils.DefaultVersion()
+	if err != nil {
+		return err
+	}
+
+	// aws-sdk-go has no API to list the versions EKS supports, so the versions
+	// eksctl supports are listed
+	summaries := []*eksVersionSummary{}
+	for _, v := range api.SupportedVersions() {
+		summaries = append(summaries, &eksVersionSummary{
+			Version:       v,
+			EksctlDefault: v == defaultVersion,
+			Pinned:        v == defaultVersion && defaultVersion != api.DefaultVersion,
+		})
+	}
+	return printer.PrintObjWithKind("eks-versions", summaries, os.Stdout)
+}
+
+func addEKSVersionSummaryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("VERSION", func(s *eksVersionSummary) string {
+		return s.Version
+	})
+	printer.AddColumn("DEFAULT", func(s *eksVersionSummary) string {
+		switch {
+		case s.Pinned:
+			return "eksctl (pinned)"
+		case s.EksctlDefault:
+			return "eksctl"
+		default:
+			return "-"
+		}
+	})
+}
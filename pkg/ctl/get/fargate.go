@@ -0,0 +1,158 @@
+package get
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getFargateProfileCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	fp := &api.FargateProfile{}
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("fargateprofile", "Get Fargate profile(s)", "", "fargateprofiles")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doGetFargateProfile(cmd, fp, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+
+		fs.StringVar(&fp.Name, "name", "", "name of the Fargate profile")
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doGetFargateProfile(cmd *cmdutils.Cmd, fp *api.FargateProfile, params *getCmdParams) error {
+	printer, err := cmdutils.NewOutputPrinter(params.output, addFargateProfileSummaryTableColumns)
+	if err != nil {
+		return err
+	}
+
+	if err := cmdutils.NewGetFargateProfileLoader(cmd, fp).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	remoteFargateProfiles, err := stackManager.GetFargateProfiles()
+	if err != nil {
+		return errors.Wrap(err, "getting Fargate profiles")
+	}
+
+	// only return the Fargate profile that user asked for,
+	// or the ones defined in the given config file
+	names := map[string]bool{}
+	if cmd.ClusterConfigFile != "" {
+		for _, fp := range cfg.FargateProfiles {
+			names[fp.Name] = true
+		}
+	} else {
+		// reset defaulted fields to avoid output being a complete lie
+		cfg.VPC = nil
+		cfg.CloudWatch = nil
+		if fp.Name != "" {
+			names[fp.Name] = true
+		}
+	}
+
+	cfg.FargateProfiles = []*api.FargateProfile{}
+	for _, remoteFargateProfile := range remoteFargateProfiles {
+		if len(names) == 0 || names[remoteFargateProfile.Name] {
+			cfg.FargateProfiles = append(cfg.FargateProfiles, remoteFargateProfile)
+		}
+	}
+
+	if fp.Name != "" && len(cfg.FargateProfiles) == 0 {
+		return fmt.Errorf("no Fargate profile %q found", fp.Name)
+	}
+
+	var obj interface{}
+	if params.output == printers.TableType {
+		obj = makeFargateProfileSummaries(cfg.FargateProfiles)
+	} else {
+		obj = cfg
+	}
+	return printer.PrintObjWithKind("fargateprofiles", obj, os.Stdout)
+}
+
+// fargateProfileSummary holds a single selector of a Fargate profile,
+// so that each selector is shown on its own row
+type fargateProfileSummary struct {
+	fp       *api.FargateProfile
+	selector api.FargateProfileSelector
+}
+
+func makeFargateProfileSummaries(fps []*api.FargateProfile) []*fargateProfileSummary {
+	summaries := []*fargateProfileSummary{}
+	for _, fp := range fps {
+		for _, selector := range fp.Selectors {
+			summaries = append(summaries, &fargateProfileSummary{fp: fp, selector: selector})
+		}
+	}
+	return summaries
+}
+
+func addFargateProfileSummaryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(s *fargateProfileSummary) string {
+		return s.fp.Name
+	})
+	printer.AddColumn("SELECTOR NAMESPACE", func(s *fargateProfileSummary) string {
+		return s.selector.Namespace
+	})
+	printer.AddColumn("SELECTOR LABELS", func(s *fargateProfileSummary) string {
+		if len(s.selector.Labels) == 0 {
+			return "<none>"
+		}
+		labels := []string{}
+		for k, v := range s.selector.Labels {
+			labels = append(labels, k+"="+v)
+		}
+		sort.Strings(labels)
+		return strings.Join(labels, ",")
+	})
+	printer.AddColumn("POD EXECUTION ROLE ARN", func(s *fargateProfileSummary) string {
+		return s.fp.PodExecutionRoleARN
+	})
+	printer.AddColumn("SUBNETS", func(s *fargateProfileSummary) string {
+		if len(s.fp.Subnets) == 0 {
+			return "<cluster private subnets>"
+		}
+		return strings.Join(s.fp.Subnets, ",")
+	})
+}
@@ -19,6 +19,9 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getNodeGroupCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getIAMServiceAccountCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getIAMIdentityMappingCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getFargateProfileCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getAddonCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, getEKSVersionsCmd)
 
 	return verbCmd
 }
@@ -41,6 +41,11 @@ func getIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 }
 
 func doGetIAMIdentityMapping(cmd *cmdutils.Cmd, params *getCmdParams, arn string) error {
+	printer, err := cmdutils.NewOutputPrinter(params.output, addIAMIdentityMappingTableColumns)
+	if err != nil {
+		return err
+	}
+
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -92,14 +97,6 @@ func doGetIAMIdentityMapping(cmd *cmdutils.Cmd, params *getCmdParams, arn string
 		}
 	}
 
-	printer, err := printers.NewPrinter(params.output)
-	if err != nil {
-		return err
-	}
-	if params.output == "table" {
-		addIAMIdentityMappingTableColumns(printer.(*printers.TablePrinter))
-	}
-
 	if err := printer.PrintObjWithKind("iamidentitymappings", identities, os.Stdout); err != nil {
 		return err
 	}
@@ -47,6 +47,11 @@ func getIAMServiceAccountCmd(cmd *cmdutils.Cmd) {
 }
 
 func doGetIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount, params *getCmdParams) error {
+	printer, err := cmdutils.NewOutputPrinter(params.output, addIAMServiceAccountSummaryTableColumns)
+	if err != nil {
+		return err
+	}
+
 	if err := cmdutils.NewGetIAMServiceAccountLoader(cmd, serviceAccount).Load(); err != nil {
 		return err
 	}
@@ -106,14 +111,8 @@ func doGetIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMSer
 		return err
 	}
 
-	printer, err := printers.NewPrinter(params.output)
-	if err != nil {
-		return err
-	}
-
 	var obj interface{}
-	if params.output == "table" {
-		addIAMServiceAccountSummaryTableColumns(printer.(*printers.TablePrinter))
+	if params.output == printers.TableType {
 		obj = cfg.IAM.ServiceAccounts
 	} else {
 		obj = cfg
@@ -41,6 +41,11 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams) error {
 	cfg := cmd.ClusterConfig
 
+	printer, err := cmdutils.NewOutputPrinter(params.output, addSummaryTableColumns)
+	if err != nil {
+		return err
+	}
+
 	// TODO: move this into a loader when --config-file gets added to this command
 	if cfg.Metadata.Name == "" {
 		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
@@ -74,15 +79,6 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 		return errors.Wrap(err, "getting nodegroup stack summaries")
 	}
 
-	printer, err := printers.NewPrinter(params.output)
-	if err != nil {
-		return err
-	}
-
-	if params.output == "table" {
-		addSummaryTableColumns(printer.(*printers.TablePrinter))
-	}
-
 	if err := printer.PrintObjWithKind("nodegroups", summaries, os.Stdout); err != nil {
 		return err
 	}
@@ -0,0 +1,130 @@
+package refresh
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func refreshNodeGroupCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	var onlyMissing bool
+	var minHealthyPercentage, instanceWarmup *int
+
+	cmd.SetDescription("nodegroup", "Replace the instances of a nodegroup with an instance refresh",
+		"Replace the instances of a nodegroup in a rolling fashion with an instance refresh of its auto scaling group, "+
+			"so that they use the latest launch template without recreating the nodegroup stack. Only applies to unmanaged nodegroups.", "ng")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doRefreshNodeGroup(cmd, ng, onlyMissing, minHealthyPercentage, instanceWarmup)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup to refresh")
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude)
+		fs.BoolVar(&onlyMissing, "only-missing", false, "Only refresh nodegroups that are not defined in the given config file")
+
+		minHealthy := fs.Int("min-healthy-percentage", api.DefaultInstanceRefreshMinHealthyPercentage, "percentage of the nodes that must remain in service while instances are replaced (overrides instanceRefresh.minHealthyPercentage)")
+		warmup := fs.Int("instance-warmup", 0, "number of seconds until a new node is considered to be in service, defaults to the health check grace period of the nodegroup (overrides instanceRefresh.instanceWarmup)")
+		cmdutils.AddPreRun(cmd.CobraCommand, func(cobraCmd *cobra.Command, args []string) {
+			if f := cobraCmd.Flag("min-healthy-percentage"); f.Changed {
+				minHealthyPercentage = minHealthy
+			}
+			if f := cobraCmd.Flag("instance-warmup"); f.Changed {
+				instanceWarmup = warmup
+			}
+		})
+
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doRefreshNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, onlyMissing bool, minHealthyPercentage, instanceWarmup *int) error {
+	ngFilter := cmdutils.NewNodeGroupFilter()
+
+	if err := cmdutils.NewDeleteNodeGroupLoader(cmd, ng, ngFilter).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	if minHealthyPercentage != nil && (*minHealthyPercentage < 0 || *minHealthyPercentage > 100) {
+		return fmt.Errorf("--min-healthy-percentage must be between 0 and 100")
+	}
+	if instanceWarmup != nil && *instanceWarmup < 0 {
+		return fmt.Errorf("--instance-warmup must be 0 or greater")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	if cmd.ClusterConfigFile != "" {
+		logger.Info("comparing %d nodegroups defined in the given config (%q) against remote state", len(cfg.NodeGroups), cmd.ClusterConfigFile)
+		if err := ngFilter.SetIncludeOrExcludeMissingFilter(stackManager, onlyMissing, &cfg.NodeGroups); err != nil {
+			return err
+		}
+	}
+
+	filteredNodeGroups := ngFilter.FilterMatching(cfg.NodeGroups)
+
+	ngFilter.LogInfo(cfg.NodeGroups)
+	cmdutils.LogIntendedAction(cmd.Plan, "refresh instances of %d nodegroups in cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
+
+	cmdutils.LogPlanModeWarning(cmd.Plan && len(filteredNodeGroups) > 0)
+
+	if cmd.Plan {
+		return nil
+	}
+
+	for _, ng := range filteredNodeGroups {
+		if minHealthyPercentage != nil || instanceWarmup != nil {
+			if ng.InstanceRefresh == nil {
+				ng.InstanceRefresh = &api.InstanceRefresh{}
+			}
+			if minHealthyPercentage != nil {
+				ng.InstanceRefresh.MinHealthyPercentage = minHealthyPercentage
+			}
+			if instanceWarmup != nil {
+				ng.InstanceRefresh.InstanceWarmup = instanceWarmup
+			}
+		}
+
+		asgName, err := stackManager.GetNodeGroupAutoScalingGroupName(ng)
+		if err != nil {
+			return err
+		}
+
+		if err := ctl.RefreshNodeGroupInstances(ng, asgName, cfg.UpdateTimeout(ctl.Provider.WaitTimeout())); err != nil {
+			return err
+		}
+		logger.Success("instances of nodegroup %q have been refreshed", ng.Name)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package refresh
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// Command will create the `refresh` commands
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	verbCmd := cmdutils.NewVerbCmd("refresh", "Refresh resource(s)", "")
+
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, refreshNodeGroupCmd)
+
+	return verbCmd
+}
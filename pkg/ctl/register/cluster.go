@@ -0,0 +1,87 @@
+package register
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func registerClusterCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	// the version is determined based on what's currently deployed
+	cfg.Metadata.Version = ""
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("cluster", "Register a cluster that was not created by eksctl",
+		"Discover the VPC, subnets, security group and service role of a cluster that was created with the console or other tools, "+
+			"and create a cluster stack for it, so that eksctl can manage its nodegroups, IAM service accounts and GitOps configuration. "+
+			"The control plane itself is not changed.")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doRegisterCluster(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", "EKS cluster name")
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doRegisterCluster(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	hasClusterStack, err := stackManager.HasClusterStack()
+	if err != nil {
+		return err
+	}
+	if hasClusterStack {
+		return fmt.Errorf("cluster %q is already managed by eksctl", meta.Name)
+	}
+
+	if err := ctl.LoadClusterForRegistration(cfg); err != nil {
+		return errors.Wrapf(err, "loading configuration of cluster %q", meta.Name)
+	}
+
+	logger.Info("using VPC %q and control plane security group %q of cluster %q", cfg.VPC.ID, cfg.VPC.SecurityGroup, meta.Name)
+	logger.Info("private subnets: %v", cfg.PrivateSubnetIDs())
+	logger.Info("public subnets: %v", cfg.PublicSubnetIDs())
+
+	tasks := stackManager.NewTasksToRegisterCluster()
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred while registering cluster %q", len(errs), meta.Name)
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to register cluster %q", meta.Name)
+	}
+
+	logger.Success("cluster %q has been registered, nodegroups can be added with 'eksctl create nodegroup --cluster=%s'", meta.Name, meta.Name)
+	logger.Info("run 'eksctl utils export-config --cluster=%s' to get a config file for it", meta.Name)
+	return nil
+}
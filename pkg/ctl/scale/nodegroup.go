@@ -15,7 +15,7 @@ func scaleNodeGroupCmd(cmd *cmdutils.Cmd) {
 	ng := cfg.NewNodeGroup()
 	cmd.ClusterConfig = cfg
 
-	cmd.SetDescription("nodegroup", "Scale a nodegroup", "", "ng")
+	cmd.SetDescription("nodegroup", "Scale a nodegroup", "Scale a nodegroup by changing its desired capacity, min size or max size, this works for both unmanaged and managed nodegroups", "ng")
 
 	cmd.SetRunFuncWithNameArg(func() error {
 		return doScaleNodeGroup(cmd, ng)
@@ -26,10 +26,18 @@ func scaleNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup to scale")
 
 		desiredCapacity := fs.IntP("nodes", "N", -1, "total number of nodes (scale to this number)")
+		minSize := fs.IntP("nodes-min", "m", -1, "minimum nodes in ASG")
+		maxSize := fs.IntP("nodes-max", "M", -1, "maximum nodes in ASG")
 		cmdutils.AddPreRun(cmd.CobraCommand, func(cobraCmd *cobra.Command, args []string) {
 			if f := cobraCmd.Flag("nodes"); f.Changed {
 				ng.DesiredCapacity = desiredCapacity
 			}
+			if f := cobraCmd.Flag("nodes-min"); f.Changed {
+				ng.MinSize = minSize
+			}
+			if f := cobraCmd.Flag("nodes-max"); f.Changed {
+				ng.MaxSize = maxSize
+			}
 		})
 
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
@@ -68,8 +76,17 @@ func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup) error {
 		return err
 	}
 
-	if ng.DesiredCapacity == nil || *ng.DesiredCapacity < 0 {
-		return fmt.Errorf("number of nodes must be 0 or greater. Use the --nodes/-N flag")
+	if ng.DesiredCapacity == nil && ng.MinSize == nil && ng.MaxSize == nil {
+		return fmt.Errorf("at least one of --nodes/-N, --nodes-min/-m and --nodes-max/-M must be set")
+	}
+
+	for _, f := range []struct {
+		name  string
+		value *int
+	}{{"--nodes", ng.DesiredCapacity}, {"--nodes-min", ng.MinSize}, {"--nodes-max", ng.MaxSize}} {
+		if f.value != nil && *f.value < 0 {
+			return fmt.Errorf("%s must be 0 or greater", f.name)
+		}
 	}
 
 	stackManager := ctl.NewStackManager(cfg)
@@ -0,0 +1,59 @@
+package set
+
+import (
+	"sort"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func setLabelsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("labels", "Set labels on the nodes of a nodegroup", "")
+
+	cmd.SetRunFunc(func() error {
+		return doSetLabels(cmd, ng)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVarP(&ng.Name, "nodegroup", "n", "", "Name of the nodegroup")
+		fs.StringToStringVarP(&ng.Labels, "labels", "l", nil, `Labels to set, e.g. "partition=backend,nodeclass=hugememory"`)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doSetLabels(cmd *cmdutils.Cmd, ng *api.NodeGroup) error {
+	keys := []string{}
+	for key := range ng.Labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if err := cmdutils.ValidateNodeGroupMetadataKeys("label", keys); err != nil {
+		return err
+	}
+	if err := api.ValidateNodeGroupLabels(ng); err != nil {
+		return err
+	}
+
+	target, err := cmdutils.NewNodeGroupMetadataTarget(cmd, ng)
+	if err != nil {
+		return err
+	}
+
+	if err := target.Ctl.UpdateNodeGroupLabels(target.ClientSet, ng, target.AutoScalingGroupName, ng.Labels, nil); err != nil {
+		return err
+	}
+
+	logger.Success("set labels %v on nodegroup %q", keys, ng.Name)
+	return nil
+}
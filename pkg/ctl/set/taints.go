@@ -0,0 +1,59 @@
+package set
+
+import (
+	"sort"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func setTaintsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("taints", "Set taints on the nodes of a nodegroup", "")
+
+	cmd.SetRunFunc(func() error {
+		return doSetTaints(cmd, ng)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVarP(&ng.Name, "nodegroup", "n", "", "Name of the nodegroup")
+		fs.StringToStringVarP(&ng.Taints, "taints", "t", nil, `Taints to set, in the form key=value:Effect, e.g. "nvidia.com/gpu=true:NoSchedule"`)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doSetTaints(cmd *cmdutils.Cmd, ng *api.NodeGroup) error {
+	keys := []string{}
+	for key := range ng.Taints {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	if err := cmdutils.ValidateNodeGroupMetadataKeys("taint", keys); err != nil {
+		return err
+	}
+	if err := api.ValidateNodeGroupTaints(ng); err != nil {
+		return err
+	}
+
+	target, err := cmdutils.NewNodeGroupMetadataTarget(cmd, ng)
+	if err != nil {
+		return err
+	}
+
+	if err := target.Ctl.UpdateNodeGroupTaints(target.ClientSet, ng, target.AutoScalingGroupName, ng.Taints, nil); err != nil {
+		return err
+	}
+
+	logger.Success("set taints %v on nodegroup %q", keys, ng.Name)
+	return nil
+}
@@ -0,0 +1,50 @@
+package unset
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func unsetLabelsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	var keys []string
+
+	cmd.SetDescription("labels", "Remove labels from the nodes of a nodegroup", "")
+
+	cmd.SetRunFunc(func() error {
+		return doUnsetLabels(cmd, ng, keys)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVarP(&ng.Name, "nodegroup", "n", "", "Name of the nodegroup")
+		fs.StringSliceVarP(&keys, "labels", "l", nil, `Keys of the labels to remove, e.g. "partition,nodeclass"`)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doUnsetLabels(cmd *cmdutils.Cmd, ng *api.NodeGroup, keys []string) error {
+	if err := cmdutils.ValidateNodeGroupMetadataKeys("label", keys); err != nil {
+		return err
+	}
+
+	target, err := cmdutils.NewNodeGroupMetadataTarget(cmd, ng)
+	if err != nil {
+		return err
+	}
+
+	if err := target.Ctl.UpdateNodeGroupLabels(target.ClientSet, ng, target.AutoScalingGroupName, nil, keys); err != nil {
+		return err
+	}
+
+	logger.Success("removed labels %v from nodegroup %q", keys, ng.Name)
+	return nil
+}
@@ -0,0 +1,50 @@
+package unset
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func unsetTaintsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	var keys []string
+
+	cmd.SetDescription("taints", "Remove taints from the nodes of a nodegroup", "")
+
+	cmd.SetRunFunc(func() error {
+		return doUnsetTaints(cmd, ng, keys)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVarP(&ng.Name, "nodegroup", "n", "", "Name of the nodegroup")
+		fs.StringSliceVarP(&keys, "taints", "t", nil, `Keys of the taints to remove, all effects of a key are removed, e.g. "nvidia.com/gpu"`)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doUnsetTaints(cmd *cmdutils.Cmd, ng *api.NodeGroup, keys []string) error {
+	if err := cmdutils.ValidateNodeGroupMetadataKeys("taint", keys); err != nil {
+		return err
+	}
+
+	target, err := cmdutils.NewNodeGroupMetadataTarget(cmd, ng)
+	if err != nil {
+		return err
+	}
+
+	if err := target.Ctl.UpdateNodeGroupTaints(target.ClientSet, ng, target.AutoScalingGroupName, nil, keys); err != nil {
+		return err
+	}
+
+	logger.Success("removed taints %v from nodegroup %q", keys, ng.Name)
+	return nil
+}
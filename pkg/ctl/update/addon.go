@@ -0,0 +1,69 @@
+package update
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func updateAddonCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	addon := &api.Addon{}
+	cfg.Addons = append(cfg.Addons, addon)
+
+	var force bool
+
+	cmd.SetDescription("addon", "Update EKS managed add-on(s)", "", "addons")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		cmdutils.SetAddonResolveConflicts(addon, force)
+		return doUpdateAddon(cmd, addon)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster the add-on belongs to")
+
+		cmdutils.AddAddonFlags(fs, addon, &force)
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doUpdateAddon(cmd *cmdutils.Cmd, addon *api.Addon) error {
+	if err := cmdutils.NewCreateAddonLoader(cmd, addon).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	if err := ctl.UpdateAddons(cfg, cfg.Addons); err != nil {
+		return err
+	}
+
+	logger.Success("updated %d add-on(s) in cluster %q", len(cfg.Addons), meta.Name)
+
+	return nil
+}
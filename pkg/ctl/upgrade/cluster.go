@@ -0,0 +1,272 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+const nextVersion = "next"
+
+// ClusterCmd sets up the command that upgrades the control plane to the next
+// Kubernetes version, or to the version given via --version or the config file
+func ClusterCmd(cmd *cmdutils.Cmd) {
+	clusterCmd(cmd, false)
+}
+
+// DeprecatedUpdateClusterCmd sets up the deprecated `eksctl update cluster`, which always
+// upgrades the control plane to the next version, the version in the config file is ignored
+func DeprecatedUpdateClusterCmd(cmd *cmdutils.Cmd) {
+	clusterCmd(cmd, true)
+}
+
+func clusterCmd(cmd *cmdutils.Cmd, alwaysNextVersion bool) {
+	cfg := api.NewClusterConfig()
+	// the version is determined based on what's currently deployed
+	cfg.Metadata.Version = ""
+	cmd.ClusterConfig = cfg
+
+	var updateAddons bool
+
+	cmd.SetDescription("cluster", "Upgrade control plane to the next version",
+		"Upgrade control plane to the next Kubernetes version, and update the cluster stack to match it. "+
+			"Default add-ons can also be updated once the control plane has been upgraded.")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doUpgradeCluster(cmd, updateAddons, alwaysNextVersion)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", "EKS cluster name")
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+
+		if !alwaysNextVersion {
+			cmdutils.AddVersionFlag(fs, cfg.Metadata, `"next" can be used to upgrade to the next version, which is the default`)
+		}
+
+		cmdutils.AddApproveFlag(fs, cmd)
+		fs.BoolVar(&cmd.Plan, "dry-run", cmd.Plan, "")
+		_ = fs.MarkDeprecated("dry-run", "see --approve")
+
+		cmd.Wait = true
+		cmdutils.AddWaitFlag(fs, &cmd.Wait, "all update operations to complete")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+
+		fs.BoolVar(&updateAddons, "update-addons", false, "update default add-ons (kube-proxy, aws-node and coredns) once the control plane has been upgraded, requires --wait")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doUpgradeCluster(cmd *cmdutils.Cmd, updateAddons, alwaysNextVersion bool) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	if updateAddons && !cmd.Wait {
+		return errors.New("--update-addons cannot be used without --wait")
+	}
+
+	printer := printers.NewJSONPrinter()
+
+	// the requested version is only used for resolving the target version
+	requestedVersion := getRequestedVersion(meta.Version, alwaysNextVersion)
+	meta.Version = ""
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	if cmd.ClusterConfigFile != "" {
+		if alwaysNextVersion {
+			logger.Warning("NOTE: config file is used for finding cluster name and region, the control plane is always upgraded to the next version")
+		} else {
+			logger.Warning("NOTE: config file is used for finding cluster name, region and version")
+		}
+		logger.Warning("NOTE: cluster VPC (subnets, routing & NAT Gateway) configuration changes are not yet implemented")
+	}
+
+	currentVersion := ctl.ControlPlaneVersion()
+	if meta.Version, err = getTargetVersion(currentVersion, requestedVersion); err != nil {
+		return err
+	}
+	versionUpdateRequired := meta.Version != currentVersion
+
+	if err := ctl.LoadClusterVPC(cfg); err != nil {
+		return errors.Wrapf(err, "getting VPC configuration for cluster %q", meta.Name)
+	}
+
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	stackUpdateRequired, err := stackManager.AppendNewClusterStackResource(cmd.Plan)
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.ValidateExistingNodeGroupsForCompatibility(cfg, stackManager); err != nil {
+		logger.Critical("failed checking nodegroups: %s", err.Error())
+	}
+
+	if !versionUpdateRequired {
+		logger.Info("cluster %q control plane is already at version %q", meta.Name, currentVersion)
+	} else {
+		cmdutils.LogIntendedAction(cmd.Plan, "upgrade cluster %q control plane from current version %q to %q", meta.Name, currentVersion, meta.Version)
+		if !cmd.Plan {
+			if !cmd.Wait {
+				if _, err := ctl.UpdateClusterVersion(cfg); err != nil {
+					return err
+				}
+				logger.Success("a version update operation has been requested for cluster %q", meta.Name)
+				logNextSteps(meta)
+				return nil
+			}
+
+			if err := ctl.UpdateClusterVersionBlocking(cfg); err != nil {
+				return err
+			}
+			logger.Success("cluster %q control plane has been upgraded to version %q", meta.Name, meta.Version)
+
+			if !updateAddons {
+				logNextSteps(meta)
+				return nil
+			}
+		}
+	}
+
+	if updateAddons {
+		addonsUpdateRequired, err := updateDefaultAddons(ctl, cfg, cmd.Plan && versionUpdateRequired, cmd.Plan)
+		if err != nil {
+			return err
+		}
+		stackUpdateRequired = stackUpdateRequired || addonsUpdateRequired
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan && (stackUpdateRequired || versionUpdateRequired))
+
+	return nil
+}
+
+// getRequestedVersion returns the version that was asked for, the deprecated `eksctl update cluster`
+// kept ignoring the version of the config file, and always upgrades to the next version
+func getRequestedVersion(configVersion string, alwaysNextVersion bool) string {
+	if alwaysNextVersion {
+		return nextVersion
+	}
+	return configVersion
+}
+
+// getTargetVersion determines the version to upgrade to, the control plane
+// can only be upgraded by one minor version at a time
+func getTargetVersion(currentVersion, requestedVersion string) (string, error) {
+	if currentVersion == "" {
+		return "", errors.New("unable to get control plane version")
+	}
+
+	next, err := getNextVersion(currentVersion)
+	if err != nil {
+		return "", err
+	}
+
+	switch requestedVersion {
+	case "", nextVersion, next:
+		return next, nil
+	case currentVersion:
+		return currentVersion, nil
+	default:
+		return "", fmt.Errorf("cannot upgrade control plane from version %q to %q, only one minor version upgrade is possible at a time, the next version is %q", currentVersion, requestedVersion, next)
+	}
+}
+
+// getNextVersion returns the version that follows currentVersion, the latest
+// supported version is returned as is
+func getNextVersion(currentVersion string) (string, error) {
+	supportedVersions := api.SupportedVersions()
+	for i, version := range supportedVersions {
+		if version != currentVersion {
+			continue
+		}
+		if i == len(supportedVersions)-1 {
+			return version, nil
+		}
+		return supportedVersions[i+1], nil
+	}
+	// version of control plane is not known to us, maybe we are just too old...
+	return "", fmt.Errorf("control plane version %q is not known to this version of eksctl, try to upgrade eksctl first", currentVersion)
+}
+
+// updateDefaultAddons updates the default add-ons to match the version of the control plane; when
+// the control plane is yet to be upgraded, they are planned for the target version instead
+func updateDefaultAddons(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, versionUpdatePending, plan bool) (bool, error) {
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return false, err
+	}
+
+	serverVersion, err := rawClient.ServerVersion()
+	if err != nil {
+		return false, err
+	}
+	kubernetesVersion := getAddonsVersion(serverVersion, cfg.Metadata.Version, versionUpdatePending)
+
+	kubeProxyUpdateRequired, err := defaultaddons.UpdateKubeProxyImageTag(rawClient.ClientSet(), kubernetesVersion, plan)
+	if err != nil {
+		return false, errors.Wrapf(err, "updating %q", defaultaddons.KubeProxy)
+	}
+
+	awsNodeUpdateRequired, err := defaultaddons.UpdateAWSNode(rawClient, cfg.Metadata.Region, plan)
+	if err != nil {
+		return false, errors.Wrapf(err, "updating %q", defaultaddons.AWSNode)
+	}
+
+	coreDNSUpdateRequired, err := defaultaddons.UpdateCoreDNS(rawClient, cfg.Metadata.Region, kubernetesVersion, plan)
+	if err != nil {
+		return false, errors.Wrapf(err, "updating %q", defaultaddons.CoreDNS)
+	}
+
+	return kubeProxyUpdateRequired || awsNodeUpdateRequired || coreDNSUpdateRequired, nil
+}
+
+// getAddonsVersion returns the Kubernetes version to update the default add-ons for, which is the
+// server version, unless the control plane is yet to be upgraded to targetVersion; as the patch
+// version the control plane gets is only known once it has been upgraded, it's left as "x"
+func getAddonsVersion(serverVersion, targetVersion string, versionUpdatePending bool) string {
+	if versionUpdatePending {
+		return targetVersion + ".x"
+	}
+	return serverVersion
+}
+
+func logNextSteps(meta *api.ClusterMeta) {
+	logger.Info("the default add-ons and nodegroups need to be updated to match the new control plane version")
+	args := fmt.Sprintf("--cluster=%s --region=%s --approve", meta.Name, meta.Region)
+	for _, command := range []string{"update-kube-proxy", "update-aws-node", "update-coredns"} {
+		logger.Info("to update add-ons, run: eksctl utils %s %s", command, args)
+	}
+	logger.Info("to update nodegroups, run: eksctl upgrade nodegroup --cluster=%s --region=%s --name=<nodeGroupName> --approve", meta.Name, meta.Region)
+}
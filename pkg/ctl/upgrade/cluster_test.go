@@ -0,0 +1,82 @@
+package upgrade
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("upgrade cluster", func() {
+	type versionCase struct {
+		currentVersion   string
+		requestedVersion string
+		expectedVersion  string
+		expectedErr      string
+	}
+
+	DescribeTable("target version",
+		func(c versionCase) {
+			version, err := getTargetVersion(c.currentVersion, c.requestedVersion)
+			if c.expectedErr != "" {
+				Expect(err).To(MatchError(c.expectedErr))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal(c.expectedVersion))
+		},
+		Entry("defaults to the next version", versionCase{
+			currentVersion:  api.Version1_12,
+			expectedVersion: api.Version1_13,
+		}),
+		Entry("accepts next", versionCase{
+			currentVersion:   api.Version1_13,
+			requestedVersion: "next",
+			expectedVersion:  api.Version1_14,
+		}),
+		Entry("accepts the next version explicitly", versionCase{
+			currentVersion:   api.Version1_11,
+			requestedVersion: api.Version1_12,
+			expectedVersion:  api.Version1_12,
+		}),
+		Entry("accepts the current version", versionCase{
+			currentVersion:   api.Version1_13,
+			requestedVersion: api.Version1_13,
+			expectedVersion:  api.Version1_13,
+		}),
+		Entry("stays at the latest version", versionCase{
+			currentVersion:  api.LatestVersion,
+			expectedVersion: api.LatestVersion,
+		}),
+		Entry("rejects skipping a minor version", versionCase{
+			currentVersion:   api.Version1_12,
+			requestedVersion: api.Version1_14,
+			expectedErr:      `cannot upgrade control plane from version "1.12" to "1.14", only one minor version upgrade is possible at a time, the next version is "1.13"`,
+		}),
+		Entry("rejects an unknown control plane version", versionCase{
+			currentVersion: "1.99",
+			expectedErr:    `control plane version "1.99" is not known to this version of eksctl, try to upgrade eksctl first`,
+		}),
+		Entry("fails without a control plane version", versionCase{
+			expectedErr: "unable to get control plane version",
+		}),
+	)
+
+	DescribeTable("requested version",
+		func(configVersion string, alwaysNextVersion bool, expectedVersion string) {
+			Expect(getRequestedVersion(configVersion, alwaysNextVersion)).To(Equal(expectedVersion))
+		},
+		Entry("uses the version of the config file", api.Version1_14, false, api.Version1_14),
+		Entry("leaves the default to getTargetVersion", "", false, ""),
+		Entry("ignores the version of the config file with update cluster", api.Version1_14, true, nextVersion),
+	)
+
+	DescribeTable("add-ons version",
+		func(versionUpdatePending bool, expectedVersion string) {
+			Expect(getAddonsVersion("1.13.8", api.Version1_14, versionUpdatePending)).To(Equal(expectedVersion))
+		},
+		Entry("uses the server version once the control plane has been upgraded", false, "1.13.8"),
+		Entry("uses the target version when the control plane is yet to be upgraded", true, "1.14.x"),
+	)
+})
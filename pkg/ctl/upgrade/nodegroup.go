@@ -0,0 +1,197 @@
+package upgrade
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+const (
+	defaultMaxSurge     = 1
+	defaultDrainTimeout = 15 * time.Minute
+)
+
+func nodeGroupCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	var kubernetesVersion string
+	opts := eks.InstanceReplacementOptions{}
+
+	cmd.SetDescription("nodegroup", "Upgrade a nodegroup by replacing its nodes",
+		"Upgrade a nodegroup to the AMI matching the given Kubernetes version, and replace its nodes in batches. "+
+			"For each batch, new nodes are added, then old nodes are cordoned and drained (respecting PodDisruptionBudgets) and terminated.", "ng")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doUpgradeNodeGroup(cmd, ng, kubernetesVersion, opts)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup to upgrade")
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+
+		fs.StringVar(&kubernetesVersion, "kubernetes-version", "", "Kubernetes version of the nodes (defaults to the control plane version)")
+		fs.StringVar(&ng.AMI, "node-ami", api.NodeImageResolverAuto, "Advanced use cases only. If 'auto' is supplied (default) then eksctl will automatically set the AMI based on version/region/instance type; if 'static' is supplied then eksctl will use static AMIs; if any other value is supplied it will override the AMI to use for the nodes. Use with extreme care.")
+		fs.StringVar(&ng.AMIFamily, "node-ami-family", "", "AMI family of the nodegroup (defaults to the AMI family it was created with)")
+
+		fs.IntVar(&opts.MaxSurge, "max-surge", defaultMaxSurge, "number of nodes that are added before the same number of old nodes is drained and removed")
+		fs.DurationVar(&opts.DrainTimeout, "drain-timeout", defaultDrainTimeout, "maximum time to wait for each batch of nodes to be drained")
+
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doUpgradeNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, kubernetesVersion string, opts eks.InstanceReplacementOptions) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+
+	if ng.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrFlagAndArg("--name", ng.Name, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		ng.Name = cmd.NameArg
+	}
+
+	if ng.Name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	if opts.MaxSurge < 1 {
+		return fmt.Errorf("--max-surge must be at least 1")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	if kubernetesVersion, err = getNodeGroupVersion(ctl.ControlPlaneVersion(), kubernetesVersion); err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	summaries, err := stackManager.GetNodeGroupSummaries(ng.Name)
+	if err != nil {
+		return errors.Wrap(err, "getting nodegroup stack summaries")
+	}
+	if len(summaries) == 0 {
+		return fmt.Errorf("nodegroup %q not found in cluster %q", ng.Name, cfg.Metadata.Name)
+	}
+	if summaries[0].InstanceType != "" {
+		ng.InstanceType = summaries[0].InstanceType
+	}
+
+	stackAMIFamily, err := stackManager.GetNodeGroupAMIFamily(ng)
+	if err != nil {
+		return err
+	}
+	if ng.AMIFamily, err = getNodeGroupAMIFamily(stackAMIFamily, ng.AMIFamily); err != nil {
+		return err
+	}
+
+	if err := ctl.EnsureAMI(kubernetesVersion, ng); err != nil {
+		return err
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "upgrade nodegroup %q in cluster %q to image %q (Kubernetes version %s), replacing %d node(s) at a time",
+		ng.Name, cfg.Metadata.Name, ng.AMI, kubernetesVersion, opts.MaxSurge)
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	if cmd.Plan {
+		return nil
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	updatePolicy, err := stackManager.UpgradeNodeGroupImage(ng, ng.AMI)
+	if err != nil {
+		return errors.Wrapf(err, "upgrading nodegroup %q", ng.Name)
+	}
+
+	asgName, err := stackManager.GetNodeGroupAutoScalingGroupName(ng)
+	if err != nil {
+		return err
+	}
+
+	opts.NodeJoinTimeout = cfg.NodeJoinTimeout(ctl.Provider.WaitTimeout())
+	replaceErr := ctl.ReplaceNodeGroupInstances(clientSet, ng, asgName, opts)
+
+	// the policy is restored even when not all instances could be replaced,
+	// as restoring it doesn't replace any instances
+	if err := stackManager.RestoreNodeGroupUpdatePolicy(ng, updatePolicy); err != nil {
+		return errors.Wrapf(err, "restoring the update policy of nodegroup %q", ng.Name)
+	}
+	if replaceErr != nil {
+		return replaceErr
+	}
+
+	logger.Success("nodegroup %q has been upgraded", ng.Name)
+	return nil
+}
+
+// getNodeGroupVersion determines the Kubernetes version of the nodes,
+// which defaults to the control plane version and cannot be newer than it
+func getNodeGroupVersion(controlPlaneVersion, requestedVersion string) (string, error) {
+	if controlPlaneVersion == "" {
+		return "", errors.New("unable to get control plane version")
+	}
+	if requestedVersion == "" || requestedVersion == controlPlaneVersion {
+		return controlPlaneVersion, nil
+	}
+	if !api.IsSupportedVersion(requestedVersion) {
+		return "", fmt.Errorf("invalid version %s, supported values: %s", requestedVersion, strings.Join(api.SupportedVersions(), ", "))
+	}
+	ok, err := utils.IsMinVersion(requestedVersion, controlPlaneVersion)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("nodes cannot use Kubernetes version %q, as it's newer than the control plane version %q", requestedVersion, controlPlaneVersion)
+	}
+	return requestedVersion, nil
+}
+
+// getNodeGroupAMIFamily returns the AMI family to upgrade the nodegroup with, which must be
+// the one it was created with, as the bootstrap user data of its stack is specific to it
+func getNodeGroupAMIFamily(stackAMIFamily, flagAMIFamily string) (string, error) {
+	switch {
+	case stackAMIFamily == "" && flagAMIFamily == "":
+		return "", fmt.Errorf("unable to determine the AMI family of the nodegroup from its stack, --node-ami-family must be set")
+	case stackAMIFamily == "":
+		return flagAMIFamily, nil
+	case flagAMIFamily != "" && flagAMIFamily != stackAMIFamily:
+		return "", fmt.Errorf("--node-ami-family %q doesn't match the AMI family of the nodegroup (%s), the AMI family of a nodegroup cannot be changed", flagAMIFamily, stackAMIFamily)
+	default:
+		return stackAMIFamily, nil
+	}
+}
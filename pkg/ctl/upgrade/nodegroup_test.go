@@ -0,0 +1,94 @@
+package upgrade
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("upgrade nodegroup", func() {
+	type versionCase struct {
+		controlPlaneVersion string
+		requestedVersion    string
+		expectedVersion     string
+		expectedErr         string
+	}
+
+	DescribeTable("nodegroup version",
+		func(c versionCase) {
+			version, err := getNodeGroupVersion(c.controlPlaneVersion, c.requestedVersion)
+			if c.expectedErr != "" {
+				Expect(err).To(MatchError(c.expectedErr))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(version).To(Equal(c.expectedVersion))
+		},
+		Entry("defaults to the control plane version", versionCase{
+			controlPlaneVersion: api.Version1_14,
+			expectedVersion:     api.Version1_14,
+		}),
+		Entry("accepts an older version", versionCase{
+			controlPlaneVersion: api.Version1_14,
+			requestedVersion:    api.Version1_13,
+			expectedVersion:     api.Version1_13,
+		}),
+		Entry("rejects a version newer than the control plane", versionCase{
+			controlPlaneVersion: api.Version1_13,
+			requestedVersion:    api.Version1_14,
+			expectedErr:         `nodes cannot use Kubernetes version "1.14", as it's newer than the control plane version "1.13"`,
+		}),
+		Entry("rejects an unsupported version", versionCase{
+			controlPlaneVersion: api.Version1_14,
+			requestedVersion:    "1.9",
+			expectedErr:         "invalid version 1.9, supported values: " + strings.Join(api.SupportedVersions(), ", "),
+		}),
+		Entry("fails without a control plane version", versionCase{
+			expectedErr: "unable to get control plane version",
+		}),
+	)
+
+	type amiFamilyCase struct {
+		stackAMIFamily    string
+		flagAMIFamily     string
+		expectedAMIFamily string
+		expectedErr       string
+	}
+
+	DescribeTable("nodegroup AMI family",
+		func(c amiFamilyCase) {
+			amiFamily, err := getNodeGroupAMIFamily(c.stackAMIFamily, c.flagAMIFamily)
+			if c.expectedErr != "" {
+				Expect(err).To(MatchError(c.expectedErr))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(amiFamily).To(Equal(c.expectedAMIFamily))
+		},
+		Entry("defaults to the AMI family of the stack", amiFamilyCase{
+			stackAMIFamily:    api.NodeImageFamilyUbuntu1804,
+			expectedAMIFamily: api.NodeImageFamilyUbuntu1804,
+		}),
+		Entry("accepts the AMI family of the stack", amiFamilyCase{
+			stackAMIFamily:    api.NodeImageFamilyBottlerocket,
+			flagAMIFamily:     api.NodeImageFamilyBottlerocket,
+			expectedAMIFamily: api.NodeImageFamilyBottlerocket,
+		}),
+		Entry("rejects a different AMI family", amiFamilyCase{
+			stackAMIFamily: api.NodeImageFamilyUbuntu1804,
+			flagAMIFamily:  api.NodeImageFamilyAmazonLinux2,
+			expectedErr:    `--node-ami-family "AmazonLinux2" doesn't match the AMI family of the nodegroup (Ubuntu1804), the AMI family of a nodegroup cannot be changed`,
+		}),
+		Entry("uses the flag when the stack doesn't record the AMI family", amiFamilyCase{
+			flagAMIFamily:     api.NodeImageFamilyAmazonLinux2,
+			expectedAMIFamily: api.NodeImageFamilyAmazonLinux2,
+		}),
+		Entry("fails when the AMI family is unknown", amiFamilyCase{
+			expectedErr: "unable to determine the AMI family of the nodegroup from its stack, --node-ami-family must be set",
+		}),
+	)
+})
@@ -0,0 +1,17 @@
+package upgrade
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// Command will create the `upgrade` commands
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	verbCmd := cmdutils.NewVerbCmd("upgrade", "Upgrade resource(s)", "")
+
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, ClusterCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, nodeGroupCmd)
+
+	return verbCmd
+}
@@ -1,12 +1,16 @@
 package utils
 
 import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func describeStacksCmd(cmd *cmdutils.Cmd) {
@@ -25,7 +29,7 @@ func describeStacksCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddClusterFlagWithDeprecated(fs, cfg.Metadata)
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
 		fs.BoolVar(&all, "all", false, "include deleted stacks")
-		fs.BoolVar(&events, "events", false, "include stack events")
+		fs.BoolVar(&events, "events", false, "include stack events, along with resources that failed during the most recent stack operation")
 		fs.BoolVar(&trail, "trail", false, "lookup CloudTrail events for the cluster")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
@@ -79,8 +83,11 @@ func doDescribeStacksCmd(cmd *cmdutils.Cmd, all, events, trail bool) error {
 			if err != nil {
 				logger.Critical(err.Error())
 			}
-			for i, e := range events {
-				logger.Info("CloudFormation.events/%s[%d] = %#v", *s.StackName, i, e)
+			for _, e := range events {
+				logger.Info("CloudFormation.events/%s %s %s", *s.StackName, aws.TimeValue(e.Timestamp).Format(time.RFC3339), manager.FormatStackEvent(e))
+			}
+			for _, e := range manager.FailedStackEvents(*s.StackName, events) {
+				logger.Critical("CloudFormation.failed/%s %s", *s.StackName, manager.FormatStackEvent(e))
 			}
 		}
 		if trail {
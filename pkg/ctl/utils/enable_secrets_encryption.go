@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func enableSecretsEncryptionCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cfg.SecretsEncryption = &api.SecretsEncryption{
+		KeyARN: new(string),
+	}
+
+	var encryptExistingSecrets bool
+
+	cmd.SetDescription("enable-secrets-encryption", "Enable envelope encryption of Kubernetes secrets using a KMS key", "")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doEnableSecretsEncryption(cmd, encryptExistingSecrets)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlagWithDeprecated(fs, cfg.Metadata)
+		fs.StringVar(cfg.SecretsEncryption.KeyARN, "key-arn", "", "ARN of the KMS key used to encrypt Kubernetes secrets")
+		fs.BoolVar(&encryptExistingSecrets, "encrypt-existing-secrets", true, "rewrite existing secrets, so that they get encrypted with the KMS key")
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doEnableSecretsEncryption(cmd *cmdutils.Cmd, encryptExistingSecrets bool) error {
+	if err := cmdutils.NewUtilsEnableSecretsEncryptionLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	keyARN := *cfg.SecretsEncryption.KeyARN
+
+	currentKeyARN, err := ctl.GetCurrentClusterSecretsEncryptionKey(cfg)
+	if err != nil {
+		return err
+	}
+
+	if currentKeyARN == keyARN {
+		logger.Success("secrets encryption for cluster %q in %q is already enabled with KMS key %q", meta.Name, meta.Region, keyARN)
+		return nil
+	}
+	if currentKeyARN != "" {
+		// EKS doesn't allow to change the key once envelope encryption has been enabled
+		return fmt.Errorf("secrets encryption for cluster %q is already enabled with a different KMS key %q", meta.Name, currentKeyARN)
+	}
+
+	cmdutils.LogIntendedAction(cmd.Plan, "enable secrets encryption for cluster %q in %q using KMS key %q", meta.Name, meta.Region, keyARN)
+	if encryptExistingSecrets {
+		cmdutils.LogIntendedAction(cmd.Plan, "encrypt all existing secrets in cluster %q", meta.Name)
+	}
+
+	if !cmd.Plan {
+		if err := ctl.EnableSecretsEncryption(cfg); err != nil {
+			return err
+		}
+		cmdutils.LogCompletedAction(false, "enabled secrets encryption for cluster %q in %q", meta.Name, meta.Region)
+
+		if encryptExistingSecrets {
+			clientSet, err := ctl.NewStdClientSet(cfg)
+			if err != nil {
+				return err
+			}
+			n, err := kubernetes.RewriteAllSecrets(clientSet)
+			if err != nil {
+				return err
+			}
+			cmdutils.LogCompletedAction(false, "encrypted %d existing secret(s) in cluster %q", n, meta.Name)
+		}
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func exportConfigCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var output string
+
+	cmd.SetDescription("export-config", "Export an existing cluster as a ClusterConfig file",
+		"Reconstructs the ClusterConfig of a cluster created by eksctl from the cluster, its CloudFormation stacks and its VPC, and prints it")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doExportConfig(cmd, output)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		fs.StringVarP(&output, "output", "o", printers.YAMLType, fmt.Sprintf("specifies the output format (valid option: %s, %s)", printers.YAMLType, printers.JSONType))
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doExportConfig(cmd *cmdutils.Cmd, output string) error {
+	if output != printers.YAMLType && output != printers.JSONType {
+		return fmt.Errorf("unsupported output format %q, valid options: %s, %s", output, printers.YAMLType, printers.JSONType)
+	}
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	// TODO: move this into a loader when --config-file gets added to this command
+	if cfg.Metadata.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrClusterFlagAndArg(cmd, cfg.Metadata.Name, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		cfg.Metadata.Name = cmd.NameArg
+	}
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	exported, err := ctl.ExportClusterConfig(cfg.Metadata.Name)
+	if err != nil {
+		return err
+	}
+
+	return printer.PrintObj(exported, os.Stdout)
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func setStackProtectionCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("set-stack-protection", "Enable or disable termination protection for the CloudFormation stacks of a cluster", "")
+
+	var enable, disable bool
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doSetStackProtection(cmd, enable, disable)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlagWithDeprecated(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.BoolVar(&enable, "enable", false, "Enable termination protection for all stacks of the cluster")
+		fs.BoolVar(&disable, "disable", false, "Disable termination protection for all stacks of the cluster")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doSetStackProtection(cmd *cmdutils.Cmd, enable, disable bool) error {
+	if enable == disable {
+		return fmt.Errorf("exactly one of --enable and --disable must be set")
+	}
+
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cmd.ClusterConfig)
+	stacks, err := stackManager.DescribeStacks()
+	if err != nil {
+		return err
+	}
+
+	action, state := "enable", "enabled"
+	if disable {
+		action, state = "disable", "disabled"
+	}
+
+	updated := 0
+	for _, s := range stacks {
+		if aws.BoolValue(s.EnableTerminationProtection) == enable {
+			continue
+		}
+		cmdutils.LogIntendedAction(cmd.Plan, "%s termination protection for stack %q", action, *s.StackName)
+		if !cmd.Plan {
+			if err := stackManager.UpdateTerminationProtection(s, enable); err != nil {
+				return err
+			}
+		}
+		updated++
+	}
+
+	if updated == 0 {
+		logger.Success("termination protection is already %s for all stacks of cluster %q", state, meta.Name)
+	} else if !cmd.Plan {
+		logger.Success("termination protection is %s for all stacks of cluster %q", state, meta.Name)
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan && updated > 0)
+
+	return nil
+}
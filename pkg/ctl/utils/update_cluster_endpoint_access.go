@@ -1,16 +1,19 @@
 package utils
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 var (
-	private bool
-	public  bool
+	private           bool
+	public            bool
+	publicAccessCIDRs []string
 )
 
 func updateClusterEndpointsCmd(cmd *cmdutils.Cmd) {
@@ -35,11 +38,12 @@ func updateClusterEndpointsCmd(cmd *cmdutils.Cmd) {
 		func(fs *pflag.FlagSet) {
 			fs.BoolVar(&private, "private-access", false, "access for private (VPC) clients")
 			fs.BoolVar(&public, "public-access", false, "access for public clients")
+			fs.StringSliceVar(&publicAccessCIDRs, "public-access-cidrs", nil, "CIDRs that can access the public endpoint (e.g. 1.2.3.4/32,10.0.0.0/16)")
 		})
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
 }
 
-func accessFlagsSet(cmd *cmdutils.Cmd) (privateSet, publicSet bool) {
+func accessFlagsSet(cmd *cmdutils.Cmd) (privateSet, publicSet, cidrsSet bool) {
 	cmd.FlagSetGroup.InFlagSet("Update private/public Kubernetes API endpoint access configuration",
 		func(fs *pflag.FlagSet) {
 			if priv := fs.Lookup("private-access"); priv != nil {
@@ -48,6 +52,9 @@ func accessFlagsSet(cmd *cmdutils.Cmd) (privateSet, publicSet bool) {
 			if pub := fs.Lookup("public-access"); pub != nil {
 				publicSet = pub.Changed
 			}
+			if cidrs := fs.Lookup("public-access-cidrs"); cidrs != nil {
+				cidrsSet = cidrs.Changed
+			}
 		})
 	return
 }
@@ -79,30 +86,74 @@ func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate bool, newPublic bool
 		return err
 	}
 
-	logger.Info("current Kubernetes API endpoint access: privateAccess=%v, publicAccess=%v",
-		curPrivate, curPublic)
-
-	privateSet, publicSet := accessFlagsSet(cmd)
-	if !privateSet {
-		newPrivate = curPrivate
+	curCIDRs, err := ctl.GetCurrentClusterPublicAccessCIDRs(cfg)
+	if err != nil {
+		return err
 	}
-	if !publicSet {
-		newPublic = curPublic
+
+	logger.Info("current Kubernetes API endpoint access: privateAccess=%v, publicAccess=%v, publicAccessCIDRs=%v",
+		curPrivate, curPublic, curCIDRs)
+
+	newCIDRs := publicAccessCIDRs
+	if cmd.ClusterConfigFile != "" {
+		// with a config file, the fields that are not set are left as they are
+		privateSet, publicSet, cidrsSet := false, false, false
+		if cfg.VPC != nil {
+			if endpoints := cfg.VPC.ClusterEndpoints; endpoints != nil {
+				if privateSet = endpoints.PrivateAccess != nil; privateSet {
+					newPrivate = *endpoints.PrivateAccess
+				}
+				if publicSet = endpoints.PublicAccess != nil; publicSet {
+					newPublic = *endpoints.PublicAccess
+				}
+			}
+			cidrsSet = len(cfg.VPC.PublicAccessCIDRs) > 0
+			newCIDRs = cfg.VPC.PublicAccessCIDRs
+		}
+		if !privateSet {
+			newPrivate = curPrivate
+		}
+		if !publicSet {
+			newPublic = curPublic
+		}
+		if !cidrsSet {
+			newCIDRs = curCIDRs
+		}
+	} else {
+		privateSet, publicSet, cidrsSet := accessFlagsSet(cmd)
+		if !privateSet {
+			newPrivate = curPrivate
+		}
+		if !publicSet {
+			newPublic = curPublic
+		}
+		if !cidrsSet {
+			newCIDRs = curCIDRs
+		}
 	}
 
 	// Nothing changed?
-	if newPrivate == curPrivate && newPublic == curPublic {
+	if newPrivate == curPrivate && newPublic == curPublic && sets.NewString(newCIDRs...).Equal(sets.NewString(curCIDRs...)) {
 		logger.Success("Kubernetes API endpoint access for cluster %q in %q is already up to date",
 			meta.Name, meta.Region)
 		return nil
 	}
 
-	cfg.VPC.ClusterEndpoints.PrivateAccess = &newPrivate
-	cfg.VPC.ClusterEndpoints.PublicAccess = &newPublic
+	if cfg.VPC == nil {
+		cfg.VPC = api.NewClusterVPC()
+	}
+	cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+		PrivateAccess: &newPrivate,
+		PublicAccess:  &newPublic,
+	}
+	cfg.VPC.PublicAccessCIDRs = nil
+	if newPublic {
+		cfg.VPC.PublicAccessCIDRs = newCIDRs
+	}
 
 	cmdutils.LogIntendedAction(
-		cmd.Plan, "update Kubernetes API endpoint access for cluster %q in %q to: privateAccess=%v, publicAccess=%v",
-		meta.Name, meta.Region, newPrivate, newPublic)
+		cmd.Plan, "update Kubernetes API endpoint access for cluster %q in %q to: privateAccess=%v, publicAccess=%v, publicAccessCIDRs=%v",
+		meta.Name, meta.Region, newPrivate, newPublic, cfg.VPC.PublicAccessCIDRs)
 
 	if err := cfg.ValidateClusterEndpointConfig(); err != nil {
 		// Error for everything except private-only (which leaves the cluster accessible)
@@ -111,16 +162,35 @@ func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate bool, newPublic bool
 		}
 		logger.Warning(err.Error())
 	}
+	if err := api.ValidatePublicAccessCIDRs(cfg); err != nil {
+		return err
+	}
 
 	if !cmd.Plan {
+		// nodes that are ready now should still be ready once the update is done,
+		// otherwise they have lost access to the Kubernetes API
+		clientSet, err := ctl.NewStdClientSet(cfg)
+		if err != nil {
+			return err
+		}
+		readyNodes, err := eks.ReadyNodes(clientSet)
+		if err != nil {
+			logger.Warning("unable to list nodes, their connectivity will not be checked after the update: %s", err.Error())
+			readyNodes = sets.NewString()
+		}
+
 		if err := ctl.UpdateClusterConfigForEndpoints(cfg); err != nil {
 			return err
 		}
 		cmdutils.LogCompletedAction(
 			false,
 			"the Kubernetes API endpoint access for cluster %q in %q has been updated to: "+
-				"privateAccess=%v, publicAccess=%v",
-			meta.Name, meta.Region, newPrivate, newPublic)
+				"privateAccess=%v, publicAccess=%v, publicAccessCIDRs=%v",
+			meta.Name, meta.Region, newPrivate, newPublic, cfg.VPC.PublicAccessCIDRs)
+
+		if err := eks.CheckNodeConnectivity(clientSet, readyNodes, eks.NodeConnectivityGracePeriod); err != nil {
+			return err
+		}
 	}
 	cmdutils.LogPlanModeWarning(cmd.Plan)
 
@@ -20,6 +20,9 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, associateIAMOIDCProviderCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, installWindowsVPCController)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateClusterEndpointsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableSecretsEncryptionCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, exportConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, setStackProtectionCmd)
 
 	return verbCmd
 }
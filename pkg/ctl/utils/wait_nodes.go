@@ -54,7 +54,7 @@ func doWaitNodes(cmd *cmdutils.Cmd, ng *api.NodeGroup, kubeconfigPath string) er
 		return err
 	}
 
-	if err := ctl.WaitForNodes(clientSet, ng); err != nil {
+	if err := ctl.WaitForNodes(clientSet, ng, ctl.Provider.WaitTimeout()); err != nil {
 		return err
 	}
 
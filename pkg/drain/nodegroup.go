@@ -4,23 +4,58 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // this is our custom addition, it's not part of the package
 // we copied from Kubernetes
 
+// Options controls which pods can be evicted from the nodes being drained,
+// the names of the fields match the kubectl flags that errors refer to
+type Options struct {
+	// Force evicts pods that are not managed by a controller
+	Force bool
+	// IgnoreDaemonSets ignores pods managed by DaemonSets
+	IgnoreDaemonSets bool
+	// DeleteLocalData evicts pods with emptyDir volumes
+	DeleteLocalData bool
+}
+
+// DefaultOptions returns the options used when nodes are drained
+// before a nodegroup is deleted or replaced
+func DefaultOptions() Options {
+	return Options{
+		Force:            true,
+		IgnoreDaemonSets: true,
+		DeleteLocalData:  true,
+	}
+}
+
+// podFilterError is returned when pods on a node cannot be evicted
+// with the given options, which retrying won't fix
+type podFilterError struct {
+	errs []error
+}
+
+func (e *podFilterError) Error() string {
+	return fmt.Sprintf("errs: %v", e.errs) // TODO: improve formatting
+}
+
 func evictPods(drainer *Helper, node *corev1.Node) (int, error) {
 	list, errs := drainer.GetPodsForDeletion(node.Name)
 	if len(errs) > 0 {
+		if list != nil {
+			return 0, &podFilterError{errs: errs}
+		}
 		return 0, fmt.Errorf("errs: %v", errs) // TODO: improve formatting
 	}
 	if w := list.Warnings(); w != "" {
@@ -31,25 +66,22 @@ func evictPods(drainer *Helper, node *corev1.Node) (int, error) {
 	for _, pod := range pods {
 		// TODO: handle API rate limiter error
 		if err := drainer.EvictOrDeletePod(pod); err != nil {
+			if apierrors.IsTooManyRequests(err) {
+				return pending, errors.Wrapf(err, "evicting pod %s/%s is blocked by a PodDisruptionBudget", pod.Namespace, pod.Name)
+			}
 			return pending, err
 		}
 	}
 	return pending, nil
 }
 
-// NodeGroup drains a nodegroup
-func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout time.Duration, undo bool) error {
-	drainer := &Helper{
+func newDrainer(clientSet kubernetes.Interface, options Options) *Helper {
+	return &Helper{
 		Client: clientSet,
 
-		// TODO: Force, DeleteLocalData & IgnoreAllDaemonSets shouldn't
-		// be enabled by default, we need flags to control these, but that
-		// requires more improvements in the underlying drain package,
-		// as it currently produces errors and warnings with references
-		// to kubectl flags
-		Force:               true,
-		DeleteLocalData:     true,
-		IgnoreAllDaemonSets: true,
+		Force:               options.Force,
+		DeleteLocalData:     options.DeleteLocalData,
+		IgnoreAllDaemonSets: options.IgnoreDaemonSets,
 
 		// TODO: ideally only the list of well-known DaemonSets should
 		// be set by default
@@ -79,6 +111,13 @@ func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout ti
 			},
 		},
 	}
+}
+
+// NodeGroup drains a nodegroup, pods are evicted so that PodDisruptionBudgets
+// are respected, and evictions are retried until all pods are gone from the
+// nodes or waitTimeout expires
+func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout time.Duration, undo bool, options Options) error {
+	drainer := newDrainer(clientSet, options)
 
 	if err := drainer.CanUseEvictions(); err != nil {
 		return errors.Wrap(err, "checking if cluster implements policy API")
@@ -145,6 +184,9 @@ func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout ti
 				if newPendingNodes.Has(node.Name) {
 					pending, err := evictPods(drainer, &node)
 					if err != nil {
+						if _, ok := err.(*podFilterError); ok {
+							return errors.Wrapf(err, "draining node %q", node.Name)
+						}
 						logger.Warning("pod eviction error (%q) on node %s – will retry after delay of %s", err, node.Name, retryDelay)
 						retryTimer := time.NewTimer(retryDelay)
 						select {
@@ -167,6 +209,71 @@ func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout ti
 	return nil
 }
 
+// Nodes cordons and drains the given nodes, pods are evicted so that
+// PodDisruptionBudgets are respected, and evictions are retried until
+// all pods are gone from the nodes or waitTimeout expires
+func Nodes(clientSet kubernetes.Interface, nodes []corev1.Node, waitTimeout time.Duration) error {
+	drainer := newDrainer(clientSet, DefaultOptions())
+
+	if err := drainer.CanUseEvictions(); err != nil {
+		return errors.Wrap(err, "checking if cluster implements policy API")
+	}
+
+	pendingNodes := sets.NewString()
+	for i := range nodes {
+		node := &nodes[i]
+		pendingNodes.Insert(node.Name)
+		c := NewCordonHelper(node, true)
+		if !c.IsUpdateRequired() {
+			logger.Debug("no need to cordon node %q", node.Name)
+			continue
+		}
+		err, patchErr := c.PatchOrReplace(clientSet)
+		if patchErr != nil {
+			logger.Warning(patchErr.Error())
+		}
+		if err != nil {
+			return errors.Wrapf(err, "cordoning node %q", node.Name)
+		}
+		logger.Info("cordon node %q", node.Name)
+	}
+	drainedNodes := pendingNodes.List()
+
+	timer := time.NewTimer(waitTimeout)
+	defer timer.Stop()
+
+	for {
+		for i := range nodes {
+			node := &nodes[i]
+			if !pendingNodes.Has(node.Name) {
+				continue
+			}
+			pending, err := evictPods(drainer, node)
+			if err != nil {
+				logger.Warning("pod eviction error (%q) on node %s – will retry after delay of %s", err, node.Name, retryDelay)
+				continue
+			}
+			logger.Debug("%d pods to be evicted from %s", pending, node.Name)
+			if pending == 0 {
+				pendingNodes.Delete(node.Name)
+			}
+		}
+
+		if pendingNodes.Len() == 0 {
+			logger.Success("drained nodes: %v", drainedNodes)
+			return nil
+		}
+
+		retryTimer := time.NewTimer(retryDelay)
+		select {
+		case <-retryTimer.C:
+		case <-timer.C:
+			retryTimer.Stop()
+			return fmt.Errorf("timed out (after %s) waiting for nodes %v to be drained", waitTimeout, pendingNodes.List())
+		}
+	}
+}
+
 func cordonStatus(desired bool) string {
 	if desired {
 		return "cordon"
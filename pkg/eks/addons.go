@@ -0,0 +1,251 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils/waiters"
+)
+
+const iamPolicyAmazonEKSCNIPolicyARN = "arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"
+
+// CreateAddon creates an EKS managed add-on and waits for it to become active,
+// serviceAccountRoleARN is the IAM role for the service account of the add-on,
+// when it's empty the add-on uses the permissions of the node IAM role
+func (c *ClusterProvider) CreateAddon(spec *api.ClusterConfig, addon *api.Addon, serviceAccountRoleARN string) error {
+	input := &awseks.CreateAddonInput{
+		AddonName:        &addon.Name,
+		ClusterName:      &spec.Metadata.Name,
+		ResolveConflicts: resolveConflicts(addon),
+		Tags:             aws.StringMap(addon.Tags),
+	}
+	if addon.Version != "" {
+		input.AddonVersion = &addon.Version
+	}
+	if serviceAccountRoleARN != "" {
+		input.ServiceAccountRoleArn = &serviceAccountRoleARN
+	}
+
+	if _, err := c.Provider.EKS().CreateAddon(input); err != nil {
+		return errors.Wrapf(err, "creating add-on %q", addon.Name)
+	}
+
+	logger.Info("creating add-on %q in cluster %q", addon.Name, spec.Metadata.Name)
+	return c.waitForAddonToBeActive(spec.Metadata.Name, addon.Name, c.Provider.WaitTimeout())
+}
+
+// DescribeAddon returns the EKS managed add-on with the given name
+func (c *ClusterProvider) DescribeAddon(spec *api.ClusterConfig, name string) (*awseks.Addon, error) {
+	output, err := c.Provider.EKS().DescribeAddon(describeAddonInput(spec.Metadata.Name, name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing add-on %q", name)
+	}
+	return output.Addon, nil
+}
+
+// ListAddons returns all of the EKS managed add-ons of the cluster
+func (c *ClusterProvider) ListAddons(spec *api.ClusterConfig) ([]*awseks.Addon, error) {
+	names := []string{}
+	input := &awseks.ListAddonsInput{ClusterName: &spec.Metadata.Name}
+	err := c.Provider.EKS().ListAddonsPages(input, func(output *awseks.ListAddonsOutput, _ bool) bool {
+		names = append(names, aws.StringValueSlice(output.Addons)...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing add-ons of cluster %q", spec.Metadata.Name)
+	}
+
+	addons := []*awseks.Addon{}
+	for _, name := range names {
+		addon, err := c.DescribeAddon(spec, name)
+		if err != nil {
+			return nil, err
+		}
+		addons = append(addons, addon)
+	}
+	return addons, nil
+}
+
+// UpdateAddon updates the version, the IAM role or both of an EKS managed add-on,
+// and waits for it to become active again
+func (c *ClusterProvider) UpdateAddon(spec *api.ClusterConfig, addon *api.Addon, serviceAccountRoleARN string) error {
+	input := &awseks.UpdateAddonInput{
+		AddonName:        &addon.Name,
+		ClusterName:      &spec.Metadata.Name,
+		ResolveConflicts: resolveConflicts(addon),
+	}
+	if addon.Version != "" {
+		input.AddonVersion = &addon.Version
+	}
+	if serviceAccountRoleARN != "" {
+		input.ServiceAccountRoleArn = &serviceAccountRoleARN
+	}
+
+	output, err := c.Provider.EKS().UpdateAddon(input)
+	if err != nil {
+		return errors.Wrapf(err, "updating add-on %q", addon.Name)
+	}
+	if output.Update == nil {
+		return fmt.Errorf("unexpected response from EKS API - no update returned")
+	}
+
+	logger.Info("initiated update of add-on %q in cluster %q", addon.Name, spec.Metadata.Name)
+	return c.waitForAddonToBeActive(spec.Metadata.Name, addon.Name, spec.UpdateTimeout(c.Provider.WaitTimeout()))
+}
+
+// DeleteAddon deletes an EKS managed add-on and waits for it to be gone,
+// the Kubernetes resources of the add-on are removed from the cluster
+func (c *ClusterProvider) DeleteAddon(spec *api.ClusterConfig, name string) error {
+	input := &awseks.DeleteAddonInput{
+		AddonName:   &name,
+		ClusterName: &spec.Metadata.Name,
+	}
+	if _, err := c.Provider.EKS().DeleteAddon(input); err != nil {
+		return errors.Wrapf(err, "deleting add-on %q", name)
+	}
+
+	logger.Info("deleting add-on %q from cluster %q", name, spec.Metadata.Name)
+
+	newRequest := func() *request.Request {
+		req, _ := c.Provider.EKS().DescribeAddonRequest(describeAddonInput(spec.Metadata.Name, name))
+		return req
+	}
+	acceptors := []request.WaiterAcceptor{
+		{
+			State:    request.SuccessWaiterState,
+			Matcher:  request.ErrorWaiterMatch,
+			Expected: awseks.ErrCodeResourceNotFoundException,
+		},
+		{
+			State:    request.FailureWaiterState,
+			Matcher:  request.PathWaiterMatch,
+			Argument: "Addon.Status",
+			Expected: awseks.AddonStatusDeleteFailed,
+		},
+	}
+	msg := fmt.Sprintf("waiting for add-on %q to get deleted", name)
+	return waiters.Wait(name, msg, acceptors, newRequest, spec.DeletionTimeout(c.Provider.WaitTimeout()), nil)
+}
+
+// CreateAddons creates the given EKS managed add-ons one after another, along with
+// IAM roles for the service accounts of the ones that make calls to AWS APIs
+func (c *ClusterProvider) CreateAddons(spec *api.ClusterConfig, addons []*api.Addon) error {
+	for _, addon := range addons {
+		roleARN, err := c.ensureAddonIAMRole(spec, addon)
+		if err != nil {
+			return err
+		}
+		if err := c.CreateAddon(spec, addon, roleARN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateAddons updates the given EKS managed add-ons one after another, creating
+// IAM roles for the service accounts of the add-ons when needed
+func (c *ClusterProvider) UpdateAddons(spec *api.ClusterConfig, addons []*api.Addon) error {
+	for _, addon := range addons {
+		roleARN, err := c.ensureAddonIAMRole(spec, addon)
+		if err != nil {
+			return err
+		}
+		if err := c.UpdateAddon(spec, addon, roleARN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureAddonIAMRole returns the ARN of the IAM role to use for the service account of
+// the add-on, which is either given explicitly, or created by eksctl with the policies
+// to attach; the VPC CNI gets a role with the policy it needs when iam.withOIDC is enabled
+func (c *ClusterProvider) ensureAddonIAMRole(spec *api.ClusterConfig, addon *api.Addon) (string, error) {
+	if addon.ServiceAccountRoleARN != "" {
+		return addon.ServiceAccountRoleARN, nil
+	}
+
+	policyARNs := addon.AttachPolicyARNs
+	if len(policyARNs) == 0 && addon.Name == api.VPCCNIAddon && api.IsEnabled(spec.IAM.WithOIDC) {
+		policyARNs = []string{iamPolicyAmazonEKSCNIPolicyARN}
+	}
+	if len(policyARNs) == 0 {
+		return "", nil
+	}
+
+	namespace, name, ok := api.AddonServiceAccount(addon.Name)
+	if !ok {
+		return "", fmt.Errorf("cannot create an IAM role for add-on %q, its service account is not known", addon.Name)
+	}
+
+	if err := c.RefreshClusterStatus(spec); err != nil {
+		return "", err
+	}
+	oidc, err := c.NewOpenIDConnectManager(spec)
+	if err != nil {
+		return "", err
+	}
+	providerExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return "", err
+	}
+	if !providerExists {
+		logger.Warning("no IAM OIDC provider associated with cluster, try 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s'", spec.Metadata.Region, spec.Metadata.Name)
+		return "", fmt.Errorf("unable to create an IAM role for add-on %q without IAM OIDC provider enabled", addon.Name)
+	}
+
+	serviceAccount := &api.ClusterIAMServiceAccount{
+		AttachPolicyARNs: policyARNs,
+	}
+	serviceAccount.Namespace = namespace
+	serviceAccount.Name = name
+
+	return c.NewStackManager(spec).CreateAddonIAMRole(addon.Name, serviceAccount, oidc)
+}
+
+// IsAddonNotFound checks whether the error returned by one of the add-on
+// operations is caused by the add-on not existing
+func IsAddonNotFound(err error) bool {
+	awsErr, ok := errors.Cause(err).(awserr.Error)
+	return ok && awsErr.Code() == awseks.ErrCodeResourceNotFoundException
+}
+
+func describeAddonInput(clusterName, name string) *awseks.DescribeAddonInput {
+	return &awseks.DescribeAddonInput{
+		AddonName:   &name,
+		ClusterName: &clusterName,
+	}
+}
+
+func (c *ClusterProvider) waitForAddonToBeActive(clusterName, name string, timeout time.Duration) error {
+	newRequest := func() *request.Request {
+		req, _ := c.Provider.EKS().DescribeAddonRequest(describeAddonInput(clusterName, name))
+		return req
+	}
+	acceptors := waiters.MakeAcceptors(
+		"Addon.Status",
+		awseks.AddonStatusActive,
+		[]string{
+			awseks.AddonStatusCreateFailed,
+			awseks.AddonStatusDegraded,
+		},
+	)
+	msg := fmt.Sprintf("waiting for add-on %q in cluster %q", name, clusterName)
+	return waiters.Wait(name, msg, acceptors, newRequest, timeout, nil)
+}
+
+func resolveConflicts(addon *api.Addon) *string {
+	if addon.ResolveConflicts == "" {
+		return nil
+	}
+	return aws.String(strings.ToUpper(addon.ResolveConflicts))
+}
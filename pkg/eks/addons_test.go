@@ -0,0 +1,152 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("EKS API wrapper", func() {
+	Describe("managed add-ons", func() {
+		const roleARN = "arn:aws:iam::123456789012:role/kube-proxy"
+
+		var (
+			p   *mockprovider.MockProvider
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+
+			addon *awseks.Addon
+		)
+
+		notFoundErr := awserr.New(awseks.ErrCodeResourceNotFoundException, "No addon found", nil)
+
+		isKubeProxy := func(input *awseks.DescribeAddonInput) bool {
+			return *input.ClusterName == "test-cluster" && *input.AddonName == "kube-proxy"
+		}
+
+		mockDescribeAddonRequest := func(err error) {
+			output := &awseks.DescribeAddonOutput{Addon: addon}
+			req := p.Client.MockRequestForGivenOutput(&awseks.DescribeAddonInput{}, output)
+			if err != nil {
+				req.Handlers.Send.PushBack(func(r *request.Request) { r.Error = err })
+			}
+			p.MockEKS().On("DescribeAddonRequest", mock.MatchedBy(isKubeProxy)).Return(req, output)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				Provider: p,
+				Status:   &ProviderStatus{},
+			}
+
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			addon = &awseks.Addon{
+				AddonName:             aws.String("kube-proxy"),
+				AddonVersion:          aws.String("v1.18.8-eksbuild.1"),
+				ClusterName:           aws.String("test-cluster"),
+				Status:                aws.String(awseks.AddonStatusActive),
+				ServiceAccountRoleArn: aws.String(roleARN),
+			}
+		})
+
+		It("should create an add-on with the given role and wait for it to become active", func() {
+			var createInput *awseks.CreateAddonInput
+			p.MockEKS().On("CreateAddon", mock.MatchedBy(func(input *awseks.CreateAddonInput) bool {
+				createInput = input
+				return true
+			})).Return(&awseks.CreateAddonOutput{}, nil)
+			mockDescribeAddonRequest(nil)
+
+			Expect(ctl.CreateAddons(cfg, []*api.Addon{
+				{
+					Name:                  api.KubeProxyAddon,
+					Version:               "v1.18.8-eksbuild.1",
+					ServiceAccountRoleARN: roleARN,
+					ResolveConflicts:      api.AddonResolveConflictsOverwrite,
+					Tags:                  map[string]string{"team": "platform"},
+				},
+			})).To(Succeed())
+
+			Expect(*createInput.ClusterName).To(Equal("test-cluster"))
+			Expect(*createInput.AddonName).To(Equal("kube-proxy"))
+			Expect(*createInput.AddonVersion).To(Equal("v1.18.8-eksbuild.1"))
+			Expect(*createInput.ServiceAccountRoleArn).To(Equal(roleARN))
+			Expect(*createInput.ResolveConflicts).To(Equal(awseks.ResolveConflictsOverwrite))
+			Expect(createInput.Tags).To(Equal(aws.StringMap(map[string]string{"team": "platform"})))
+			Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeAddonRequest", 1)).To(BeTrue())
+		})
+
+		It("should update an add-on and wait for it to become active again", func() {
+			var updateInput *awseks.UpdateAddonInput
+			p.MockEKS().On("UpdateAddon", mock.MatchedBy(func(input *awseks.UpdateAddonInput) bool {
+				updateInput = input
+				return true
+			})).Return(&awseks.UpdateAddonOutput{
+				Update: &awseks.Update{Id: aws.String("u123")},
+			}, nil)
+			mockDescribeAddonRequest(nil)
+
+			Expect(ctl.UpdateAddons(cfg, []*api.Addon{
+				{
+					Name:    api.KubeProxyAddon,
+					Version: "v1.19.6-eksbuild.2",
+				},
+			})).To(Succeed())
+
+			Expect(*updateInput.AddonVersion).To(Equal("v1.19.6-eksbuild.2"))
+			Expect(updateInput.ServiceAccountRoleArn).To(BeNil())
+			Expect(updateInput.ResolveConflicts).To(BeNil())
+		})
+
+		It("should list and describe the add-ons of the cluster", func() {
+			p.MockEKS().On("ListAddonsPages", mock.MatchedBy(func(input *awseks.ListAddonsInput) bool {
+				return *input.ClusterName == "test-cluster"
+			}), mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListAddonsOutput, bool) bool)
+				consume(&awseks.ListAddonsOutput{Addons: aws.StringSlice([]string{"kube-proxy"})}, true)
+			}).Return(nil)
+			p.MockEKS().On("DescribeAddon", mock.MatchedBy(isKubeProxy)).Return(&awseks.DescribeAddonOutput{Addon: addon}, nil)
+
+			addons, err := ctl.ListAddons(cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(addons).To(Equal([]*awseks.Addon{addon}))
+		})
+
+		It("should delete an add-on and wait for it to be gone", func() {
+			p.MockEKS().On("DeleteAddon", mock.MatchedBy(func(input *awseks.DeleteAddonInput) bool {
+				return *input.ClusterName == "test-cluster" && *input.AddonName == "kube-proxy"
+			})).Return(&awseks.DeleteAddonOutput{}, nil)
+			mockDescribeAddonRequest(notFoundErr)
+
+			Expect(ctl.DeleteAddon(cfg, api.KubeProxyAddon)).To(Succeed())
+		})
+
+		It("should fail to delete an add-on that could not be deleted", func() {
+			p.MockEKS().On("DeleteAddon", mock.Anything).Return(&awseks.DeleteAddonOutput{}, nil)
+			addon.Status = aws.String(awseks.AddonStatusDeleteFailed)
+			mockDescribeAddonRequest(nil)
+
+			Expect(ctl.DeleteAddon(cfg, api.KubeProxyAddon)).ToNot(Succeed())
+		})
+
+		It("should report add-ons that don't exist", func() {
+			p.MockEKS().On("DescribeAddon", mock.Anything).Return(nil, notFoundErr)
+
+			_, err := ctl.DescribeAddon(cfg, api.VPCCNIAddon)
+			Expect(err).To(HaveOccurred())
+			Expect(IsAddonNotFound(err)).To(BeTrue())
+		})
+	})
+})
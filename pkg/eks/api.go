@@ -7,11 +7,14 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
@@ -30,7 +33,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -40,7 +42,9 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/az"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils"
+	"github.com/weaveworks/eksctl/pkg/utils/cache"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
@@ -50,6 +54,10 @@ type ClusterProvider struct {
 	Provider api.ClusterProvider
 	// informative fields, i.e. used as outputs
 	Status *ProviderStatus
+	// on-disk cache of lookups, nil when caching is disabled
+	cache *cache.Cache
+	// whether stacks are created with termination protection
+	stackTerminationProtection bool
 }
 
 // ProviderServices stores the used APIs
@@ -65,6 +73,7 @@ type ProviderServices struct {
 	iam   iamiface.IAMAPI
 
 	cloudtrail cloudtrailiface.CloudTrailAPI
+	asg        autoscalingiface.AutoScalingAPI
 }
 
 // CloudFormation returns a representation of the CloudFormation API
@@ -97,6 +106,9 @@ func (p ProviderServices) IAM() iamiface.IAMAPI { return p.iam }
 // CloudTrail returns a representation of the CloudTrail API
 func (p ProviderServices) CloudTrail() cloudtrailiface.CloudTrailAPI { return p.cloudtrail }
 
+// ASG returns a representation of the AutoScaling API
+func (p ProviderServices) ASG() autoscalingiface.AutoScalingAPI { return p.asg }
+
 // Region returns provider-level region setting
 func (p ProviderServices) Region() string { return p.spec.Region }
 
@@ -119,7 +131,11 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) *ClusterProvi
 		spec: spec,
 	}
 	c := &ClusterProvider{
-		Provider: provider,
+		Provider:                   provider,
+		stackTerminationProtection: spec.StackTerminationProtection,
+	}
+	if !spec.NoCache {
+		c.cache = cache.New(cache.DefaultDir)
 	}
 	// Create a new session and save credentials for possible
 	// later re-use if overriding sessions due to custom URL
@@ -142,6 +158,7 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) *ClusterProvi
 	provider.ssm = ssm.New(s)
 	provider.iam = iam.New(s)
 	provider.cloudtrail = cloudtrail.New(s)
+	provider.asg = autoscaling.New(s)
 
 	c.Status = &ProviderStatus{
 		sessionCreds: s.Config.Credentials,
@@ -183,6 +200,10 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) *ClusterProvi
 		logger.Debug("Setting CloudTrail endpoint to %s", endpoint)
 		provider.cloudtrail = cloudtrail.New(s, s.Config.Copy().WithEndpoint(endpoint))
 	}
+	if endpoint, ok := os.LookupEnv("AWS_AUTOSCALING_ENDPOINT"); ok {
+		logger.Debug("Setting AutoScaling endpoint to %s", endpoint)
+		provider.asg = autoscaling.New(s, s.Config.Copy().WithEndpoint(endpoint))
+	}
 
 	if clusterSpec != nil {
 		clusterSpec.Metadata.Region = c.Provider.Region()
@@ -193,11 +214,15 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) *ClusterProvi
 
 // LoadConfigFromFile loads ClusterConfig from configFile
 func LoadConfigFromFile(configFile string) (*api.ClusterConfig, error) {
-	data, err := readConfig(configFile)
+	data, err := ReadConfig(configFile)
 	if err != nil {
 		return nil, errors.Wrapf(err, "reading config file %q", configFile)
 	}
+	return LoadConfig(data, configFile)
+}
 
+// LoadConfig loads ClusterConfig from data read from configFile
+func LoadConfig(data []byte, configFile string) (*api.ClusterConfig, error) {
 	// strict mode is not available in runtime.Decode, so we use the parser
 	// directly; we don't store the resulting object, this is just the means
 	// of detecting any unknown keys
@@ -219,7 +244,8 @@ func LoadConfigFromFile(configFile string) (*api.ClusterConfig, error) {
 	return cfg, nil
 }
 
-func readConfig(configFile string) ([]byte, error) {
+// ReadConfig reads the contents of configFile, or stdin if configFile is "-"
+func ReadConfig(configFile string) ([]byte, error) {
 	if configFile == "-" {
 		return ioutil.ReadAll(os.Stdin)
 	}
@@ -265,6 +291,19 @@ func (c *ClusterProvider) CheckAuth() error {
 	return nil
 }
 
+// accountID returns the ID of the account of the current session, it is
+// only known after CheckAuth has been called
+func (c *ClusterProvider) accountID() string {
+	if c.Status == nil {
+		return ""
+	}
+	parsed, err := arn.Parse(c.Status.iamRoleARN)
+	if err != nil {
+		return ""
+	}
+	return parsed.AccountID
+}
+
 // EnsureAMI ensures that the node AMI is set and is available
 func (c *ClusterProvider) EnsureAMI(version string, ng *api.NodeGroup) error {
 	if api.IsAMI(ng.AMI) {
@@ -274,9 +313,9 @@ func (c *ClusterProvider) EnsureAMI(version string, ng *api.NodeGroup) error {
 	var resolver ami.Resolver
 	switch ng.AMI {
 	case api.NodeImageResolverAuto:
-		resolver = ami.NewAutoResolver(c.Provider.EC2())
+		resolver = ami.NewCachingResolver(api.NodeImageResolverAuto, ami.NewAutoResolver(c.Provider.EC2()), c.cache)
 	case api.NodeImageResolverAutoSSM:
-		resolver = ami.NewSSMResolver(c.Provider.SSM())
+		resolver = ami.NewCachingResolver(api.NodeImageResolverAutoSSM, ami.NewSSMResolver(c.Provider.SSM()), c.cache)
 	default:
 		resolver = ami.NewDefaultResolver()
 	}
@@ -349,7 +388,7 @@ func (c *ClusterProvider) SetAvailabilityZones(spec *api.ClusterConfig, given []
 	if c.Provider.Region() == api.RegionUSEast1 {
 		azSelector = az.NewSelectorWithMinRequired(c.Provider.EC2())
 	}
-	zones, err := azSelector.SelectZones(c.Provider.Region())
+	zones, err := azSelector.WithCache(c.cache, c.accountID()).SelectZones(c.Provider.Region())
 	if err != nil {
 		return errors.Wrap(err, "getting availability zones")
 	}
@@ -371,7 +410,7 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 	}
 
 	config = config.WithCredentialsChainVerboseErrors(true)
-	config = request.WithRetryer(config, newLoggingRetryer())
+	config = request.WithRetryer(config, NewThrottlingRetryer())
 	if logger.Level >= api.AWSDebugLevel {
 		config = config.WithLogLevel(aws.LogDebug |
 			aws.LogDebugWithHTTPBody |
@@ -418,5 +457,9 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 
 // NewStackManager returns a new stack manager
 func (c *ClusterProvider) NewStackManager(spec *api.ClusterConfig) *manager.StackCollection {
-	return manager.NewStackCollection(c.Provider, spec)
+	stackManager := manager.NewStackCollection(c.Provider, spec)
+	if c.stackTerminationProtection {
+		stackManager.EnableTerminationProtection()
+	}
+	return stackManager
 }
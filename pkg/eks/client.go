@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -119,3 +120,18 @@ func (c *ClusterProvider) NewRawClient(spec *api.ClusterConfig) (*kubewrapper.Ra
 
 	return kubewrapper.NewRawClient(clientSet, client.rawConfig)
 }
+
+// NewDynamicClient creates a new dynamic client with an embedded STS token,
+// it's used for custom resources that client-go doesn't have types for
+func (c *ClusterProvider) NewDynamicClient(spec *api.ClusterConfig) (dynamic.Interface, error) {
+	client, _, err := c.newClientSetWithEmbeddedToken(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(client.rawConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating dynamic Kubernetes client")
+	}
+	return dynamicClient, nil
+}
@@ -43,7 +43,7 @@ var _ = Describe("eks auth helpers", func() {
 				}
 
 				testAuthenticatorConfig := func(roleARN string) {
-					clientConfig := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), roleARN, ctl.Provider.Profile())
+					clientConfig := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), kubeconfig.Options{RoleARN: roleARN, Profile: ctl.Provider.Profile()})
 					Expect(clientConfig).To(Not(BeNil()))
 					ctx := clientConfig.CurrentContext
 					cluster := strings.Split(ctx, "@")[1]
@@ -4,12 +4,12 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // ValidateClusterForCompatibility looks at the cluster stack and check if it's
@@ -33,7 +33,7 @@ func (c *ClusterProvider) ValidateClusterForCompatibility(cfg *api.ClusterConfig
 	if err != nil {
 		logger.Debug("err = %s", err.Error())
 		return fmt.Errorf(
-			"shared node security group missing, to fix this run 'eksctl update cluster --name=%s --region=%s'",
+			"shared node security group missing, to fix this run 'eksctl upgrade cluster --name=%s --region=%s'",
 			cfg.Metadata.Name,
 			cfg.Metadata.Region,
 		)
@@ -7,7 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	awseks "github.com/aws/aws-sdk-go/service/eks"
@@ -17,6 +16,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
@@ -173,13 +173,13 @@ func (c *ClusterProvider) ListClusters(clusterName string, chunkSize int, output
 	}
 
 	if clusterName != "" {
-		if output == "table" {
+		if output == printers.TableType {
 			addSummaryTableColumns(printer.(*printers.TablePrinter))
 		}
 		return c.doGetCluster(clusterName, printer)
 	}
 
-	if output == "table" {
+	if output == printers.TableType {
 		addListTableColumns(printer.(*printers.TablePrinter))
 	}
 	allClusters := []*api.ClusterMeta{}
@@ -241,6 +241,29 @@ func (c *ClusterProvider) doListClusters(chunkSize int64, printer printers.Outpu
 	return nil
 }
 
+// ListClusterNames returns the names of all clusters in the region
+func (c *ClusterProvider) ListClusterNames() ([]string, error) {
+	names := []string{}
+	token := ""
+	for {
+		clusters, nextToken, err := c.getClustersRequest(100, token)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, clusterName := range clusters {
+			names = append(names, *clusterName)
+		}
+
+		if api.IsSetAndNonEmptyString(nextToken) {
+			token = *nextToken
+		} else {
+			break
+		}
+	}
+	return names, nil
+}
+
 func (c *ClusterProvider) doGetCluster(clusterName string, printer printers.OutputPrinter) error {
 	input := &awseks.DescribeClusterInput{
 		Name: &clusterName,
@@ -0,0 +1,62 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const encryptionConfigResourceSecrets = "secrets"
+
+// GetCurrentClusterSecretsEncryptionKey returns the ARN of the KMS key used to encrypt secrets,
+// or an empty string when envelope encryption is not enabled
+func (c *ClusterProvider) GetCurrentClusterSecretsEncryptionKey(spec *api.ClusterConfig) (string, error) {
+	cluster, err := c.DescribeControlPlane(spec.Metadata)
+	if err != nil {
+		return "", err
+	}
+
+	for _, config := range cluster.EncryptionConfig {
+		for _, resource := range config.Resources {
+			if aws.StringValue(resource) == encryptionConfigResourceSecrets && config.Provider != nil && config.Provider.KeyArn != nil {
+				return *config.Provider.KeyArn, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// EnableSecretsEncryption calls AssociateEncryptionConfig to enable envelope encryption
+// of Kubernetes secrets, and waits for the update to complete
+func (c *ClusterProvider) EnableSecretsEncryption(spec *api.ClusterConfig) error {
+	if !spec.HasSecretsEncryption() {
+		return errors.New("secretsEncryption.keyARN must be set")
+	}
+
+	input := &awseks.AssociateEncryptionConfigInput{
+		ClusterName: &spec.Metadata.Name,
+		EncryptionConfig: []*awseks.EncryptionConfig{
+			{
+				Provider: &awseks.Provider{
+					KeyArn: spec.SecretsEncryption.KeyARN,
+				},
+				Resources: aws.StringSlice([]string{encryptionConfigResourceSecrets}),
+			},
+		},
+	}
+	output, err := c.Provider.EKS().AssociateEncryptionConfig(input)
+	if err != nil {
+		return errors.Wrapf(err, "enabling secrets encryption for cluster %q", spec.Metadata.Name)
+	}
+	if output.Update == nil {
+		return fmt.Errorf("unexpected response from EKS API - no update returned")
+	}
+
+	logger.Info("initiated enabling of secrets encryption for cluster %q", spec.Metadata.Name)
+	return c.waitForUpdateToSucceed(spec, output.Update)
+}
@@ -0,0 +1,123 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("EKS API wrapper", func() {
+	Describe("secrets encryption", func() {
+		const keyARN = "arn:aws:kms:us-west-2:000000000000:key/12345678-1234-1234-1234-123456789012"
+
+		var (
+			p   *mockprovider.MockProvider
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				Provider: p,
+				Status:   &ProviderStatus{},
+			}
+
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+		})
+
+		mockDescribeCluster := func(encryptionConfig []*awseks.EncryptionConfig) {
+			cluster := testutils.NewFakeCluster("test-cluster", awseks.ClusterStatusActive)
+			cluster.EncryptionConfig = encryptionConfig
+			p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+				return *input.Name == "test-cluster"
+			})).Return(&awseks.DescribeClusterOutput{Cluster: cluster}, nil)
+		}
+
+		It("should return no key when secrets encryption is disabled", func() {
+			mockDescribeCluster(nil)
+
+			key, err := ctl.GetCurrentClusterSecretsEncryptionKey(cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key).To(BeEmpty())
+		})
+
+		It("should return the key when secrets encryption is enabled", func() {
+			mockDescribeCluster([]*awseks.EncryptionConfig{
+				{
+					Resources: aws.StringSlice([]string{"secrets"}),
+					Provider:  &awseks.Provider{KeyArn: aws.String(keyARN)},
+				},
+			})
+
+			key, err := ctl.GetCurrentClusterSecretsEncryptionKey(cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key).To(Equal(keyARN))
+		})
+
+		It("should associate the key and wait for the update", func() {
+			cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: aws.String(keyARN)}
+
+			var associateInput *awseks.AssociateEncryptionConfigInput
+			p.MockEKS().On("AssociateEncryptionConfig", mock.MatchedBy(func(input *awseks.AssociateEncryptionConfigInput) bool {
+				associateInput = input
+				return true
+			})).Return(&awseks.AssociateEncryptionConfigOutput{
+				Update: &awseks.Update{
+					Id:   aws.String("u123"),
+					Type: aws.String(awseks.UpdateTypeAssociateEncryptionConfig),
+				},
+			}, nil)
+
+			describeUpdateInput := &awseks.DescribeUpdateInput{}
+			describeUpdateOutput := &awseks.DescribeUpdateOutput{
+				Update: &awseks.Update{
+					Id:     aws.String("u123"),
+					Type:   aws.String(awseks.UpdateTypeAssociateEncryptionConfig),
+					Status: aws.String(awseks.UpdateStatusSuccessful),
+				},
+			}
+			p.MockEKS().On("DescribeUpdateRequest", mock.MatchedBy(func(input *awseks.DescribeUpdateInput) bool {
+				*describeUpdateInput = *input
+				return true
+			})).Return(p.Client.MockRequestForGivenOutput(describeUpdateInput, describeUpdateOutput), describeUpdateOutput)
+
+			Expect(ctl.EnableSecretsEncryption(cfg)).To(Succeed())
+
+			Expect(*associateInput.ClusterName).To(Equal("test-cluster"))
+			Expect(associateInput.EncryptionConfig).To(Equal([]*awseks.EncryptionConfig{
+				{
+					Resources: aws.StringSlice([]string{"secrets"}),
+					Provider:  &awseks.Provider{KeyArn: aws.String(keyARN)},
+				},
+			}))
+			Expect(*describeUpdateInput.Name).To(Equal("test-cluster"))
+			Expect(*describeUpdateInput.UpdateId).To(Equal("u123"))
+		})
+
+		It("should fail when the association fails", func() {
+			cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: aws.String(keyARN)}
+
+			p.MockEKS().On("AssociateEncryptionConfig", mock.Anything).Return(nil, errors.New("access denied"))
+
+			err := ctl.EnableSecretsEncryption(cfg)
+			Expect(err).To(MatchError(`enabling secrets encryption for cluster "test-cluster": access denied`))
+			p.MockEKS().AssertNotCalled(GinkgoT(), "DescribeUpdateRequest", mock.Anything)
+		})
+
+		It("should fail when the key is not set", func() {
+			Expect(ctl.EnableSecretsEncryption(cfg)).ToNot(Succeed())
+			p.MockEKS().AssertNotCalled(GinkgoT(), "AssociateEncryptionConfig", mock.Anything)
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package eks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// NodeConnectivityGracePeriod is how long nodes are given to report their status after
+// the endpoint access has changed, it is longer than the time after which the control
+// plane marks nodes as not ready when they stop reporting
+const NodeConnectivityGracePeriod = 90 * time.Second
+
+// GetCurrentClusterPublicAccessCIDRs returns the CIDRs that can access the public
+// Kubernetes API endpoint of the cluster
+func (c *ClusterProvider) GetCurrentClusterPublicAccessCIDRs(spec *api.ClusterConfig) ([]string, error) {
+	cluster, err := c.DescribeControlPlane(spec.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.ResourcesVpcConfig == nil {
+		return nil, nil
+	}
+	return aws.StringValueSlice(cluster.ResourcesVpcConfig.PublicAccessCidrs), nil
+}
+
+// ReadyNodes returns the names of the nodes of the cluster that are ready
+func ReadyNodes(clientSet kubernetes.Interface) (sets.String, error) {
+	nodes, err := clientSet.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+	ready := sets.NewString()
+	for i := range nodes.Items {
+		if isNodeReady(&nodes.Items[i]) {
+			ready.Insert(nodes.Items[i].Name)
+		}
+	}
+	return ready, nil
+}
+
+// CheckNodeConnectivity waits for the grace period, and then checks that the nodes
+// that were ready before the endpoint access was changed are still ready, i.e. they
+// can still reach the Kubernetes API
+func CheckNodeConnectivity(clientSet kubernetes.Interface, readyBefore sets.String, gracePeriod time.Duration) error {
+	if readyBefore.Len() == 0 {
+		return nil
+	}
+	logger.Info("waiting %s to check that %d node(s) can still reach the Kubernetes API", gracePeriod, readyBefore.Len())
+	time.Sleep(gracePeriod)
+
+	readyAfter, err := ReadyNodes(clientSet)
+	if err != nil {
+		// the endpoint that eksctl uses may be the one that has just been disabled
+		logger.Warning("unable to check that nodes can still reach the Kubernetes API, it may not be accessible from here anymore: %s", err.Error())
+		return nil
+	}
+	lost := readyBefore.Difference(readyAfter).List()
+	if len(lost) == 0 {
+		logger.Success("all %d node(s) are still ready", readyBefore.Len())
+		return nil
+	}
+	sort.Strings(lost)
+	return fmt.Errorf("%d node(s) are no longer ready since the Kubernetes API endpoint access was updated: %s; "+
+		"nodes need private access to be enabled, or the addresses they reach the internet from (e.g. of NAT gateways) to be in the public access CIDRs",
+		len(lost), strings.Join(lost, ", "))
+}
@@ -0,0 +1,126 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Kubernetes API endpoint access", func() {
+	Describe("public access CIDRs", func() {
+		var (
+			p   *mockprovider.MockProvider
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				Provider: p,
+				Status:   &ProviderStatus{},
+			}
+
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+		})
+
+		It("should return the current public access CIDRs", func() {
+			cluster := testutils.NewFakeCluster("test-cluster", awseks.ClusterStatusActive)
+			cluster.ResourcesVpcConfig.PublicAccessCidrs = aws.StringSlice([]string{"0.0.0.0/0"})
+			p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+				return *input.Name == "test-cluster"
+			})).Return(&awseks.DescribeClusterOutput{Cluster: cluster}, nil)
+
+			cidrs, err := ctl.GetCurrentClusterPublicAccessCIDRs(cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cidrs).To(Equal([]string{"0.0.0.0/0"}))
+		})
+
+		It("should update the endpoint access with the CIDRs and wait for the update", func() {
+			cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{PrivateAccess: api.Enabled(), PublicAccess: api.Enabled()}
+			cfg.VPC.PublicAccessCIDRs = []string{"1.2.3.4/32"}
+
+			var updateInput *awseks.UpdateClusterConfigInput
+			p.MockEKS().On("UpdateClusterConfig", mock.MatchedBy(func(input *awseks.UpdateClusterConfigInput) bool {
+				updateInput = input
+				return true
+			})).Return(&awseks.UpdateClusterConfigOutput{
+				Update: &awseks.Update{
+					Id:   aws.String("u123"),
+					Type: aws.String(awseks.UpdateTypeEndpointAccessUpdate),
+				},
+			}, nil)
+
+			describeUpdateOutput := &awseks.DescribeUpdateOutput{
+				Update: &awseks.Update{
+					Id:     aws.String("u123"),
+					Type:   aws.String(awseks.UpdateTypeEndpointAccessUpdate),
+					Status: aws.String(awseks.UpdateStatusSuccessful),
+				},
+			}
+			p.MockEKS().On("DescribeUpdateRequest", mock.MatchedBy(func(input *awseks.DescribeUpdateInput) bool {
+				return *input.Name == "test-cluster" && *input.UpdateId == "u123"
+			})).Return(p.Client.MockRequestForGivenOutput(&awseks.DescribeUpdateInput{}, describeUpdateOutput), describeUpdateOutput)
+
+			Expect(ctl.UpdateClusterConfigForEndpoints(cfg)).To(Succeed())
+
+			Expect(*updateInput.Name).To(Equal("test-cluster"))
+			Expect(updateInput.ResourcesVpcConfig).To(Equal(&awseks.VpcConfigRequest{
+				EndpointPrivateAccess: aws.Bool(true),
+				EndpointPublicAccess:  aws.Bool(true),
+				PublicAccessCidrs:     aws.StringSlice([]string{"1.2.3.4/32"}),
+			}))
+			Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeUpdateRequest", 1)).To(BeTrue())
+		})
+	})
+
+	Describe("node connectivity", func() {
+		newNode := func(name string, ready corev1.ConditionStatus) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+				},
+			}
+		}
+
+		It("should list the nodes that are ready", func() {
+			clientSet := fake.NewSimpleClientset(
+				newNode("node-1", corev1.ConditionTrue),
+				newNode("node-2", corev1.ConditionFalse),
+			)
+			nodes, err := ReadyNodes(clientSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nodes.List()).To(Equal([]string{"node-1"}))
+		})
+
+		It("should succeed when the nodes are still ready", func() {
+			clientSet := fake.NewSimpleClientset(newNode("node-1", corev1.ConditionTrue))
+			Expect(CheckNodeConnectivity(clientSet, sets.NewString("node-1"), 0)).To(Succeed())
+		})
+
+		It("should fail when nodes are no longer ready", func() {
+			clientSet := fake.NewSimpleClientset(
+				newNode("node-1", corev1.ConditionTrue),
+				newNode("node-2", corev1.ConditionUnknown),
+			)
+			err := CheckNodeConnectivity(clientSet, sets.NewString("node-1", "node-2"), 0)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("1 node(s) are no longer ready"))
+			Expect(err.Error()).To(ContainSubstring("node-2"))
+		})
+	})
+})
@@ -0,0 +1,175 @@
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// ExportClusterConfig reconstructs the ClusterConfig of an existing cluster from the EKS API,
+// the CloudFormation stacks eksctl created for it and its VPC, so that it can be kept in git
+// and passed back to eksctl; settings that cannot be read back (e.g. preBootstrapCommands of
+// nodegroups) are left unset, and it only works for clusters that were created by eksctl
+func (c *ClusterProvider) ExportClusterConfig(clusterName string) (*api.ClusterConfig, error) {
+	cfg := &api.ClusterConfig{
+		TypeMeta: api.ClusterConfigTypeMeta(),
+		Metadata: &api.ClusterMeta{
+			Name:   clusterName,
+			Region: c.Provider.Region(),
+		},
+		IAM: &api.ClusterIAM{},
+	}
+
+	if ok, err := c.CanOperate(cfg); !ok {
+		return nil, err
+	}
+	cluster := c.Status.clusterInfo.cluster
+	cfg.Metadata.Version = aws.StringValue(cluster.Version)
+
+	stackManager := c.NewStackManager(cfg)
+	clusterStack, err := stackManager.DescribeClusterStack()
+	if err != nil {
+		return nil, err
+	}
+	// tags have to be known before the nodegroups are exported, so that
+	// the tags nodegroups inherit from the cluster can be left out
+	cfg.Metadata.Tags = manager.GetUserDefinedStackTags(clusterStack)
+
+	if err := vpc.UseFromCluster(c.Provider, clusterStack, cfg); err != nil {
+		return nil, errors.Wrapf(err, "loading VPC configuration of cluster %q", clusterName)
+	}
+
+	enabledLogTypes, _, err := c.GetCurrentClusterConfigForLogging(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if enabledLogTypes.Len() > 0 {
+		cfg.CloudWatch = &api.ClusterCloudWatch{
+			ClusterLogging: &api.ClusterCloudWatchLogging{
+				EnableTypes: enabledLogTypes.List(),
+			},
+		}
+	}
+
+	keyARN, err := c.GetCurrentClusterSecretsEncryptionKey(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if keyARN != "" {
+		cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: &keyARN}
+	}
+
+	if err := c.LoadClusterKubernetesNetworkConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := c.exportIAM(cfg, stackManager); err != nil {
+		return nil, err
+	}
+
+	if cfg.NodeGroups, cfg.ManagedNodeGroups, err = stackManager.GetNodeGroups(); err != nil {
+		return nil, err
+	}
+
+	fargateProfiles, err := stackManager.GetFargateProfiles()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting Fargate profiles")
+	}
+	if len(fargateProfiles) > 0 {
+		cfg.FargateProfiles = fargateProfiles
+	}
+
+	if err := c.exportAddons(cfg, stackManager); err != nil {
+		return nil, err
+	}
+
+	if err := c.exportIdentityProviders(cfg); err != nil {
+		return nil, err
+	}
+
+	cfg.Status = nil
+	return cfg, nil
+}
+
+func (c *ClusterProvider) exportIAM(cfg *api.ClusterConfig, stackManager *manager.StackCollection) error {
+	cluster := c.Status.clusterInfo.cluster
+	if cluster.Identity == nil || cluster.Identity.Oidc == nil || cluster.Identity.Oidc.Issuer == nil {
+		return nil
+	}
+	oidc, err := c.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return err
+	}
+	exists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	cfg.IAM.WithOIDC = api.Enabled()
+
+	serviceAccounts, err := stackManager.ExportIAMServiceAccounts()
+	if err != nil {
+		return errors.Wrap(err, "getting iamserviceaccounts")
+	}
+	if len(serviceAccounts) > 0 {
+		cfg.IAM.ServiceAccounts = serviceAccounts
+	}
+	return nil
+}
+
+func (c *ClusterProvider) exportAddons(cfg *api.ClusterConfig, stackManager *manager.StackCollection) error {
+	remoteAddons, err := c.ListAddons(cfg)
+	if err != nil {
+		// EKS managed add-ons are not available for older versions of Kubernetes
+		logger.Warning("add-ons of cluster %q cannot be exported: %s", cfg.Metadata.Name, err.Error())
+		return nil
+	}
+
+	for _, remoteAddon := range remoteAddons {
+		addon := &api.Addon{
+			Name:    aws.StringValue(remoteAddon.AddonName),
+			Version: aws.StringValue(remoteAddon.AddonVersion),
+		}
+		if len(remoteAddon.Tags) > 0 {
+			addon.Tags = aws.StringValueMap(remoteAddon.Tags)
+		}
+		policyARNs, err := stackManager.GetAddonIAMRolePolicyARNs(addon.Name)
+		if err != nil {
+			return err
+		}
+		// a role created by eksctl is exported as the policies attached to it,
+		// so that eksctl creates a new role when the config is used for another cluster
+		if len(policyARNs) > 0 {
+			addon.AttachPolicyARNs = policyARNs
+		} else {
+			addon.ServiceAccountRoleARN = aws.StringValue(remoteAddon.ServiceAccountRoleArn)
+		}
+		cfg.Addons = append(cfg.Addons, addon)
+	}
+	return nil
+}
+
+func (c *ClusterProvider) exportIdentityProviders(cfg *api.ClusterConfig) error {
+	configs, err := c.ListIdentityProviderConfigs(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, config := range configs {
+		if aws.StringValue(config.Type) != api.OIDCIdentityProviderType {
+			continue
+		}
+		idp, err := c.DescribeIdentityProvider(cfg, aws.StringValue(config.Name))
+		if err != nil {
+			return err
+		}
+		cfg.IdentityProviders = append(cfg.IdentityProviders, idp)
+	}
+	return nil
+}
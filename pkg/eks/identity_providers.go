@@ -0,0 +1,133 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// ListIdentityProviderConfigs returns the identity providers associated with the cluster
+func (c *ClusterProvider) ListIdentityProviderConfigs(spec *api.ClusterConfig) ([]*awseks.IdentityProviderConfig, error) {
+	configs := []*awseks.IdentityProviderConfig{}
+	input := &awseks.ListIdentityProviderConfigsInput{ClusterName: &spec.Metadata.Name}
+	err := c.Provider.EKS().ListIdentityProviderConfigsPages(input, func(output *awseks.ListIdentityProviderConfigsOutput, _ bool) bool {
+		configs = append(configs, output.IdentityProviderConfigs...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing identity providers of cluster %q", spec.Metadata.Name)
+	}
+	return configs, nil
+}
+
+// DescribeIdentityProvider returns the configuration of an OIDC identity provider
+// associated with the cluster
+func (c *ClusterProvider) DescribeIdentityProvider(spec *api.ClusterConfig, name string) (*api.IdentityProvider, error) {
+	input := &awseks.DescribeIdentityProviderConfigInput{
+		ClusterName: &spec.Metadata.Name,
+		IdentityProviderConfig: &awseks.IdentityProviderConfig{
+			Name: &name,
+			Type: aws.String(api.OIDCIdentityProviderType),
+		},
+	}
+
+	output, err := c.Provider.EKS().DescribeIdentityProviderConfig(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing identity provider %q of cluster %q", name, spec.Metadata.Name)
+	}
+	if output.IdentityProviderConfig == nil || output.IdentityProviderConfig.Oidc == nil {
+		return nil, fmt.Errorf("unexpected response from EKS API - no OIDC identity provider config returned")
+	}
+
+	config := output.IdentityProviderConfig.Oidc
+	idp := &api.IdentityProvider{
+		Name:           name,
+		Type:           api.OIDCIdentityProviderType,
+		IssuerURL:      aws.StringValue(config.IssuerUrl),
+		ClientID:       aws.StringValue(config.ClientId),
+		UsernameClaim:  aws.StringValue(config.UsernameClaim),
+		UsernamePrefix: aws.StringValue(config.UsernamePrefix),
+		GroupsClaim:    aws.StringValue(config.GroupsClaim),
+		GroupsPrefix:   aws.StringValue(config.GroupsPrefix),
+	}
+	if len(config.RequiredClaims) > 0 {
+		idp.RequiredClaims = aws.StringValueMap(config.RequiredClaims)
+	}
+	if len(config.Tags) > 0 {
+		idp.Tags = aws.StringValueMap(config.Tags)
+	}
+	return idp, nil
+}
+
+// AssociateIdentityProvider associates an OIDC identity provider with the cluster,
+// and waits for the update to complete, which can take a long time
+func (c *ClusterProvider) AssociateIdentityProvider(spec *api.ClusterConfig, idp *api.IdentityProvider) error {
+	input := &awseks.AssociateIdentityProviderConfigInput{
+		ClusterName: &spec.Metadata.Name,
+		Oidc: &awseks.OidcIdentityProviderConfigRequest{
+			ClientId:                   &idp.ClientID,
+			IdentityProviderConfigName: &idp.Name,
+			IssuerUrl:                  &idp.IssuerURL,
+			GroupsClaim:                optionalString(idp.GroupsClaim),
+			GroupsPrefix:               optionalString(idp.GroupsPrefix),
+			UsernameClaim:              optionalString(idp.UsernameClaim),
+			UsernamePrefix:             optionalString(idp.UsernamePrefix),
+		},
+	}
+	if len(idp.RequiredClaims) > 0 {
+		input.Oidc.RequiredClaims = aws.StringMap(idp.RequiredClaims)
+	}
+	if len(idp.Tags) > 0 {
+		input.Tags = aws.StringMap(idp.Tags)
+	}
+
+	output, err := c.Provider.EKS().AssociateIdentityProviderConfig(input)
+	if err != nil {
+		return errors.Wrapf(err, "associating identity provider %q with cluster %q", idp.Name, spec.Metadata.Name)
+	}
+	if output.Update == nil {
+		return fmt.Errorf("unexpected response from EKS API - no update returned")
+	}
+
+	logger.Info("initiated association of identity provider %q with cluster %q", idp.Name, spec.Metadata.Name)
+	return c.waitForUpdateToSucceed(spec, output.Update)
+}
+
+// AssociateIdentityProviders associates the given identity providers with the cluster one
+// after another, as EKS doesn't allow concurrent updates, skipping the ones that are
+// already associated
+func (c *ClusterProvider) AssociateIdentityProviders(spec *api.ClusterConfig, idps []*api.IdentityProvider) error {
+	existing, err := c.ListIdentityProviderConfigs(spec)
+	if err != nil {
+		return err
+	}
+	existingNames := sets.NewString()
+	for _, config := range existing {
+		existingNames.Insert(aws.StringValue(config.Name))
+	}
+
+	for _, idp := range idps {
+		if existingNames.Has(idp.Name) {
+			logger.Info("identity provider %q is already associated with cluster %q", idp.Name, spec.Metadata.Name)
+			continue
+		}
+		if err := c.AssociateIdentityProvider(spec, idp); err != nil {
+			return err
+		}
+		logger.Success("associated identity provider %q with cluster %q", idp.Name, spec.Metadata.Name)
+	}
+	return nil
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
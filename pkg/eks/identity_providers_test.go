@@ -0,0 +1,141 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("EKS API wrapper", func() {
+	Describe("identity providers", func() {
+		var (
+			p   *mockprovider.MockProvider
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+
+			associateInput *awseks.AssociateIdentityProviderConfigInput
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				Provider: p,
+				Status:   &ProviderStatus{},
+			}
+
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+			cfg.IdentityProviders = []*api.IdentityProvider{
+				{
+					Name:           "okta",
+					Type:           api.OIDCIdentityProviderType,
+					IssuerURL:      "https://example.okta.com",
+					ClientID:       "kubernetes",
+					UsernameClaim:  "email",
+					GroupsClaim:    "groups",
+					GroupsPrefix:   "okta:",
+					RequiredClaims: map[string]string{"hd": "example.com"},
+				},
+			}
+
+			associateInput = nil
+			p.MockEKS().On("AssociateIdentityProviderConfig", mock.MatchedBy(func(input *awseks.AssociateIdentityProviderConfigInput) bool {
+				associateInput = input
+				return true
+			})).Return(&awseks.AssociateIdentityProviderConfigOutput{
+				Update: &awseks.Update{
+					Id:   aws.String("u123"),
+					Type: aws.String(awseks.UpdateTypeAssociateIdentityProviderConfig),
+				},
+			}, nil)
+
+			describeUpdateOutput := &awseks.DescribeUpdateOutput{
+				Update: &awseks.Update{
+					Id:     aws.String("u123"),
+					Type:   aws.String(awseks.UpdateTypeAssociateIdentityProviderConfig),
+					Status: aws.String(awseks.UpdateStatusSuccessful),
+				},
+			}
+			p.MockEKS().On("DescribeUpdateRequest", mock.MatchedBy(func(input *awseks.DescribeUpdateInput) bool {
+				return *input.Name == "test-cluster" && *input.UpdateId == "u123"
+			})).Return(p.Client.MockRequestForGivenOutput(&awseks.DescribeUpdateInput{}, describeUpdateOutput), describeUpdateOutput)
+		})
+
+		mockListIdentityProviderConfigs := func(configs ...*awseks.IdentityProviderConfig) {
+			p.MockEKS().On("ListIdentityProviderConfigsPages", mock.MatchedBy(func(input *awseks.ListIdentityProviderConfigsInput) bool {
+				return *input.ClusterName == "test-cluster"
+			}), mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(*awseks.ListIdentityProviderConfigsOutput, bool) bool)
+				consume(&awseks.ListIdentityProviderConfigsOutput{IdentityProviderConfigs: configs}, true)
+			}).Return(nil)
+		}
+
+		It("should associate the identity provider and wait for the update", func() {
+			mockListIdentityProviderConfigs()
+
+			Expect(ctl.AssociateIdentityProviders(cfg, cfg.IdentityProviders)).To(Succeed())
+
+			Expect(*associateInput.ClusterName).To(Equal("test-cluster"))
+			Expect(associateInput.Tags).To(BeNil())
+			Expect(associateInput.Oidc).To(Equal(&awseks.OidcIdentityProviderConfigRequest{
+				IdentityProviderConfigName: aws.String("okta"),
+				IssuerUrl:                  aws.String("https://example.okta.com"),
+				ClientId:                   aws.String("kubernetes"),
+				UsernameClaim:              aws.String("email"),
+				GroupsClaim:                aws.String("groups"),
+				GroupsPrefix:               aws.String("okta:"),
+				RequiredClaims:             aws.StringMap(map[string]string{"hd": "example.com"}),
+			}))
+			Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeUpdateRequest", 1)).To(BeTrue())
+		})
+
+		It("should skip identity providers that are already associated", func() {
+			mockListIdentityProviderConfigs(&awseks.IdentityProviderConfig{
+				Name: aws.String("okta"),
+				Type: aws.String("oidc"),
+			})
+
+			Expect(ctl.AssociateIdentityProviders(cfg, cfg.IdentityProviders)).To(Succeed())
+
+			p.MockEKS().AssertNotCalled(GinkgoT(), "AssociateIdentityProviderConfig", mock.Anything)
+		})
+
+		It("should describe an identity provider", func() {
+			var describeInput *awseks.DescribeIdentityProviderConfigInput
+			p.MockEKS().On("DescribeIdentityProviderConfig", mock.MatchedBy(func(input *awseks.DescribeIdentityProviderConfigInput) bool {
+				describeInput = input
+				return true
+			})).Return(&awseks.DescribeIdentityProviderConfigOutput{
+				IdentityProviderConfig: &awseks.IdentityProviderConfigResponse{
+					Oidc: &awseks.OidcIdentityProviderConfig{
+						IdentityProviderConfigName: aws.String("okta"),
+						IssuerUrl:                  aws.String("https://example.okta.com"),
+						ClientId:                   aws.String("kubernetes"),
+						UsernameClaim:              aws.String("email"),
+						GroupsClaim:                aws.String("groups"),
+						GroupsPrefix:               aws.String("okta:"),
+						RequiredClaims:             aws.StringMap(map[string]string{"hd": "example.com"}),
+						Status:                     aws.String(awseks.ConfigStatusActive),
+					},
+				},
+			}, nil)
+
+			idp, err := ctl.DescribeIdentityProvider(cfg, "okta")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(*describeInput.ClusterName).To(Equal("test-cluster"))
+			Expect(describeInput.IdentityProviderConfig).To(Equal(&awseks.IdentityProviderConfig{
+				Name: aws.String("okta"),
+				Type: aws.String("oidc"),
+			}))
+			Expect(idp).To(Equal(cfg.IdentityProviders[0]))
+		})
+	})
+})
@@ -0,0 +1,178 @@
+package eks
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	// maxSelectedInstanceTypes is the maximum number of instance types of a mixed nodegroup
+	maxSelectedInstanceTypes = 20
+
+	// instanceTypesCacheTTL is how long the instance types offered in a region are cached for
+	instanceTypesCacheTTL = 24 * time.Hour
+)
+
+// instanceGeneration matches the generation of an instance type, e.g. 5 in m5.large or m5a.large
+var instanceGeneration = regexp.MustCompile(`^[a-z]+(\d+)`)
+
+// ResolveInstanceSelector sets the instance type of the nodegroup, or the instance types
+// of its instancesDistribution, to the instance types that are offered in the region and
+// match the resources of the instance selector
+func (c *ClusterProvider) ResolveInstanceSelector(ng *api.NodeGroup) error {
+	if ng.InstanceSelector == nil {
+		return nil
+	}
+
+	memoryMiB := int64(0)
+	if ng.InstanceSelector.Memory != "" {
+		var err error
+		if memoryMiB, err = api.ParseInstanceSelectorMemory(ng.InstanceSelector.Memory); err != nil {
+			return err
+		}
+	}
+
+	filters := []*ec2.Filter{
+		{Name: aws.String("current-generation"), Values: aws.StringSlice([]string{"true"})},
+		// ARM instance types are only used when they are set explicitly
+		{Name: aws.String("processor-info.supported-architecture"), Values: aws.StringSlice([]string{"x86_64"})},
+	}
+	if ng.InstanceSelector.VCPUs > 0 {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("vcpu-info.default-vcpus"),
+			Values: aws.StringSlice([]string{strconv.Itoa(ng.InstanceSelector.VCPUs)}),
+		})
+	}
+	if memoryMiB > 0 {
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("memory-info.size-in-mib"),
+			Values: aws.StringSlice([]string{strconv.FormatInt(memoryMiB, 10)}),
+		})
+	}
+
+	instanceTypes, err := c.describeInstanceTypes(filters)
+	if err != nil {
+		return errors.Wrapf(err, "looking up instance types for nodegroup %q", ng.Name)
+	}
+
+	selected := selectInstanceTypes(ng.InstanceSelector, memoryMiB, instanceTypes)
+	if len(selected) == 0 {
+		return fmt.Errorf("no instance types in region %q match the instanceSelector of nodegroup %q", c.Provider.Region(), ng.Name)
+	}
+
+	if ng.InstancesDistribution == nil {
+		ng.InstanceType = selected[0]
+		logger.Info("nodegroup %q will use instance type %q", ng.Name, ng.InstanceType)
+		return nil
+	}
+
+	if len(selected) > maxSelectedInstanceTypes {
+		selected = selected[:maxSelectedInstanceTypes]
+	}
+	if len(selected) < 2 {
+		return fmt.Errorf("only instance type %q matches the instanceSelector of nodegroup %q, mixed nodegroups need at least two instance types", selected[0], ng.Name)
+	}
+	ng.InstanceType = "mixed"
+	ng.InstancesDistribution.InstanceTypes = selected
+	logger.Info("nodegroup %q will use instance types %s", ng.Name, strings.Join(selected, ", "))
+	return nil
+}
+
+func (c *ClusterProvider) describeInstanceTypes(filters []*ec2.Filter) ([]*ec2.InstanceTypeInfo, error) {
+	cacheKey := "instance-types/" + c.Provider.Region()
+	for _, filter := range filters {
+		cacheKey += fmt.Sprintf("/%s=%s", aws.StringValue(filter.Name), strings.Join(aws.StringValueSlice(filter.Values), ","))
+	}
+	instanceTypes := []*ec2.InstanceTypeInfo{}
+	if c.cache.Get(cacheKey, &instanceTypes) {
+		return instanceTypes, nil
+	}
+
+	input := &ec2.DescribeInstanceTypesInput{
+		Filters:    filters,
+		MaxResults: aws.Int64(100),
+	}
+	err := c.Provider.EC2().DescribeInstanceTypesPages(input, func(output *ec2.DescribeInstanceTypesOutput, _ bool) bool {
+		instanceTypes = append(instanceTypes, output.InstanceTypes...)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Set(cacheKey, instanceTypes, instanceTypesCacheTTL)
+	return instanceTypes, nil
+}
+
+// selectInstanceTypes returns the names of the instance types that match the selector, the
+// best match comes first: instance types with a fixed performance are preferred over
+// burstable ones, and newer generations over older ones
+func selectInstanceTypes(selector *api.InstanceSelector, memoryMiB int64, instanceTypes []*ec2.InstanceTypeInfo) []string {
+	matching := []*ec2.InstanceTypeInfo{}
+	for _, it := range instanceTypes {
+		if aws.StringValue(it.InstanceType) == "" || aws.BoolValue(it.BareMetal) {
+			continue
+		}
+		if selector.VCPUs > 0 && (it.VCpuInfo == nil || aws.Int64Value(it.VCpuInfo.DefaultVCpus) != int64(selector.VCPUs)) {
+			continue
+		}
+		if memoryMiB > 0 && (it.MemoryInfo == nil || aws.Int64Value(it.MemoryInfo.SizeInMiB) != memoryMiB) {
+			continue
+		}
+		if countGPUs(it) != int64(selector.GPUs) {
+			continue
+		}
+		matching = append(matching, it)
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		a, b := matching[i], matching[j]
+		if burstableA, burstableB := aws.BoolValue(a.BurstablePerformanceSupported), aws.BoolValue(b.BurstablePerformanceSupported); burstableA != burstableB {
+			return burstableB
+		}
+		nameA, nameB := aws.StringValue(a.InstanceType), aws.StringValue(b.InstanceType)
+		if generationA, generationB := generationOf(nameA), generationOf(nameB); generationA != generationB {
+			return generationA > generationB
+		}
+		if len(nameA) != len(nameB) {
+			return len(nameA) < len(nameB)
+		}
+		return nameA < nameB
+	})
+
+	names := make([]string, len(matching))
+	for i, it := range matching {
+		names[i] = aws.StringValue(it.InstanceType)
+	}
+	return names
+}
+
+func countGPUs(it *ec2.InstanceTypeInfo) int64 {
+	if it.GpuInfo == nil {
+		return 0
+	}
+	count := int64(0)
+	for _, gpu := range it.GpuInfo.Gpus {
+		count += aws.Int64Value(gpu.Count)
+	}
+	return count
+}
+
+func generationOf(instanceType string) int {
+	m := instanceGeneration.FindStringSubmatch(instanceType)
+	if m == nil {
+		return 0
+	}
+	generation, _ := strconv.Atoi(m[1])
+	return generation
+}
@@ -0,0 +1,117 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+func instanceTypeInfo(name string, vCPUs, memoryMiB, gpus int64, burstable bool) *ec2.InstanceTypeInfo {
+	it := &ec2.InstanceTypeInfo{
+		InstanceType:                  aws.String(name),
+		BareMetal:                     aws.Bool(false),
+		BurstablePerformanceSupported: aws.Bool(burstable),
+		VCpuInfo:                      &ec2.VCpuInfo{DefaultVCpus: aws.Int64(vCPUs)},
+		MemoryInfo:                    &ec2.MemoryInfo{SizeInMiB: aws.Int64(memoryMiB)},
+	}
+	if gpus > 0 {
+		it.GpuInfo = &ec2.GpuInfo{
+			Gpus: []*ec2.GpuDeviceInfo{{Count: aws.Int64(gpus), Name: aws.String("V100")}},
+		}
+	}
+	return it
+}
+
+var _ = Describe("Instance selector", func() {
+	var (
+		p      *mockprovider.MockProvider
+		ctl    *ClusterProvider
+		inputs []*ec2.DescribeInstanceTypesInput
+	)
+
+	BeforeEach(func() {
+		inputs = nil
+		pages := [][]*ec2.InstanceTypeInfo{{
+			instanceTypeInfo("m4.xlarge", 4, 16384, 0, false),
+			instanceTypeInfo("t3.xlarge", 4, 16384, 0, true),
+			instanceTypeInfo("m5a.xlarge", 4, 16384, 0, false),
+		}, {
+			instanceTypeInfo("m5.xlarge", 4, 16384, 0, false),
+			instanceTypeInfo("g4dn.xlarge", 4, 16384, 1, false),
+		}}
+
+		p = mockprovider.NewMockProvider()
+		ctl = &ClusterProvider{
+			Provider: p,
+			Status:   &ProviderStatus{},
+		}
+
+		p.MockEC2().On("DescribeInstanceTypesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			inputs = append(inputs, args[0].(*ec2.DescribeInstanceTypesInput))
+			consume := args[1].(func(*ec2.DescribeInstanceTypesOutput, bool) bool)
+			for i, page := range pages {
+				if !consume(&ec2.DescribeInstanceTypesOutput{InstanceTypes: page}, i == len(pages)-1) {
+					return
+				}
+			}
+		}).Return(nil)
+	})
+
+	It("should do nothing without an instance selector", func() {
+		ng := api.NewNodeGroup()
+		ng.InstanceType = "m5.large"
+		Expect(ctl.ResolveInstanceSelector(ng)).To(Succeed())
+		Expect(ng.InstanceType).To(Equal("m5.large"))
+		Expect(inputs).To(BeEmpty())
+	})
+
+	It("should select the best matching instance type", func() {
+		ng := api.NewNodeGroup()
+		ng.InstanceSelector = &api.InstanceSelector{VCPUs: 4, Memory: "16Gi"}
+
+		Expect(ctl.ResolveInstanceSelector(ng)).To(Succeed())
+		Expect(ng.InstanceType).To(Equal("m5.xlarge"))
+
+		Expect(inputs).To(HaveLen(1))
+		Expect(inputs[0].Filters).To(Equal([]*ec2.Filter{
+			{Name: aws.String("current-generation"), Values: aws.StringSlice([]string{"true"})},
+			{Name: aws.String("processor-info.supported-architecture"), Values: aws.StringSlice([]string{"x86_64"})},
+			{Name: aws.String("vcpu-info.default-vcpus"), Values: aws.StringSlice([]string{"4"})},
+			{Name: aws.String("memory-info.size-in-mib"), Values: aws.StringSlice([]string{"16384"})},
+		}))
+	})
+
+	It("should select instance types for mixed nodegroups", func() {
+		ng := api.NewNodeGroup()
+		ng.InstanceSelector = &api.InstanceSelector{VCPUs: 4, Memory: "16Gi"}
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{}
+
+		Expect(ctl.ResolveInstanceSelector(ng)).To(Succeed())
+		Expect(ng.InstanceType).To(Equal("mixed"))
+		Expect(ng.InstancesDistribution.InstanceTypes).To(Equal([]string{"m5.xlarge", "m5a.xlarge", "m4.xlarge", "t3.xlarge"}))
+	})
+
+	It("should only select instance types with GPUs when gpus is set", func() {
+		ng := api.NewNodeGroup()
+		ng.InstanceSelector = &api.InstanceSelector{VCPUs: 4, GPUs: 1}
+
+		Expect(ctl.ResolveInstanceSelector(ng)).To(Succeed())
+		Expect(ng.InstanceType).To(Equal("g4dn.xlarge"))
+	})
+
+	It("should fail when no instance types match", func() {
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceSelector = &api.InstanceSelector{VCPUs: 4, GPUs: 8}
+
+		err := ctl.ResolveInstanceSelector(ng)
+		Expect(err).To(MatchError(`no instance types in region "us-west-2" match the instanceSelector of nodegroup "ng-1"`))
+	})
+})
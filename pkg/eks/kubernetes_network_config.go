@@ -0,0 +1,32 @@
+package eks
+
+import (
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
+)
+
+// LoadClusterKubernetesNetworkConfig sets the service CIDR of an existing cluster
+// in the config, unless it was set already, so that new nodes use the right address
+// of the cluster DNS service
+func (c *ClusterProvider) LoadClusterKubernetesNetworkConfig(spec *api.ClusterConfig) error {
+	if spec.HasServiceIPv4CIDR() {
+		return nil
+	}
+
+	cluster, err := c.DescribeControlPlane(spec.Metadata)
+	if err != nil {
+		return err
+	}
+
+	if cluster.KubernetesNetworkConfig == nil || cluster.KubernetesNetworkConfig.ServiceIpv4Cidr == nil {
+		return nil
+	}
+	cidr, err := ipnet.ParseCIDR(*cluster.KubernetesNetworkConfig.ServiceIpv4Cidr)
+	if err != nil {
+		return errors.Wrapf(err, "parsing service CIDR of cluster %q", spec.Metadata.Name)
+	}
+	spec.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{ServiceIPv4CIDR: cidr}
+	return nil
+}
@@ -0,0 +1,71 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
+)
+
+var _ = Describe("Kubernetes network config", func() {
+	var (
+		p   *mockprovider.MockProvider
+		ctl *ClusterProvider
+		cfg *api.ClusterConfig
+
+		serviceIPv4CIDR string
+	)
+
+	BeforeEach(func() {
+		serviceIPv4CIDR = "192.168.0.0/16"
+
+		p = mockprovider.NewMockProvider()
+		ctl = &ClusterProvider{
+			Provider: p,
+			Status:   &ProviderStatus{},
+		}
+
+		p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+			return *input.Name == "test-cluster"
+		})).Return(func(*awseks.DescribeClusterInput) *awseks.DescribeClusterOutput {
+			cluster := testutils.NewFakeCluster("test-cluster", awseks.ClusterStatusActive)
+			if serviceIPv4CIDR != "" {
+				cluster.KubernetesNetworkConfig = &awseks.KubernetesNetworkConfigResponse{ServiceIpv4Cidr: aws.String(serviceIPv4CIDR)}
+			}
+			return &awseks.DescribeClusterOutput{Cluster: cluster}
+		}, nil)
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+	})
+
+	It("should load the service CIDR of the cluster", func() {
+		Expect(ctl.LoadClusterKubernetesNetworkConfig(cfg)).To(Succeed())
+		p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeCluster", 1)
+		Expect(cfg.KubernetesNetworkConfig.ServiceIPv4CIDR.String()).To(Equal("192.168.0.0/16"))
+		Expect(cfg.ClusterDNSIP()).To(Equal("192.168.0.10"))
+	})
+
+	It("should keep the service CIDR when it's set in the config", func() {
+		cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{ServiceIPv4CIDR: ipnet.MustParseCIDR("10.200.0.0/16")}
+
+		Expect(ctl.LoadClusterKubernetesNetworkConfig(cfg)).To(Succeed())
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DescribeCluster", mock.Anything)
+		Expect(cfg.KubernetesNetworkConfig.ServiceIPv4CIDR.String()).To(Equal("10.200.0.0/16"))
+	})
+
+	It("should leave the config unchanged when the cluster has no network config", func() {
+		serviceIPv4CIDR = ""
+
+		Expect(ctl.LoadClusterKubernetesNetworkConfig(cfg)).To(Succeed())
+		Expect(cfg.HasServiceIPv4CIDR()).To(BeFalse())
+	})
+})
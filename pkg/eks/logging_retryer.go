@@ -20,10 +20,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/kris-nova/logger"
-
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/request"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const maxRetries = 13
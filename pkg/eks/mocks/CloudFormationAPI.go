@@ -793,6 +793,84 @@ func (_m *CloudFormationAPI) DeleteStackWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
+// DeregisterType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeregisterType(_a0 *cloudformation.DeregisterTypeInput) (*cloudformation.DeregisterTypeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DeregisterTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeregisterTypeInput) *cloudformation.DeregisterTypeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DeregisterTypeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeregisterTypeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeregisterTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeregisterTypeRequest(_a0 *cloudformation.DeregisterTypeInput) (*request.Request, *cloudformation.DeregisterTypeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeregisterTypeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.DeregisterTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeregisterTypeInput) *cloudformation.DeregisterTypeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.DeregisterTypeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeregisterTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DeregisterTypeWithContext(_a0 context.Context, _a1 *cloudformation.DeregisterTypeInput, _a2 ...request.Option) (*cloudformation.DeregisterTypeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.DeregisterTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeregisterTypeInput, ...request.Option) *cloudformation.DeregisterTypeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DeregisterTypeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeregisterTypeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeAccountLimits provides a mock function with given fields: _a0
 func (_m *CloudFormationAPI) DescribeAccountLimits(_a0 *cloudformation.DescribeAccountLimitsInput) (*cloudformation.DescribeAccountLimitsOutput, error) {
 	ret := _m.Called(_a0)
@@ -816,6 +894,41 @@ func (_m *CloudFormationAPI) DescribeAccountLimits(_a0 *cloudformation.DescribeA
 	return r0, r1
 }
 
+// DescribeAccountLimitsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) DescribeAccountLimitsPages(_a0 *cloudformation.DescribeAccountLimitsInput, _a1 func(*cloudformation.DescribeAccountLimitsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeAccountLimitsInput, func(*cloudformation.DescribeAccountLimitsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeAccountLimitsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) DescribeAccountLimitsPagesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeAccountLimitsInput, _a2 func(*cloudformation.DescribeAccountLimitsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeAccountLimitsInput, func(*cloudformation.DescribeAccountLimitsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DescribeAccountLimitsRequest provides a mock function with given fields: _a0
 func (_m *CloudFormationAPI) DescribeAccountLimitsRequest(_a0 *cloudformation.DescribeAccountLimitsInput) (*request.Request, *cloudformation.DescribeAccountLimitsOutput) {
 	ret := _m.Called(_a0)
@@ -1756,21 +1869,21 @@ func (_m *CloudFormationAPI) DescribeStacksWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DetectStackDrift provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DetectStackDrift(_a0 *cloudformation.DetectStackDriftInput) (*cloudformation.DetectStackDriftOutput, error) {
+// DescribeType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeType(_a0 *cloudformation.DescribeTypeInput) (*cloudformation.DescribeTypeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DetectStackDriftOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackDriftInput) *cloudformation.DetectStackDriftOutput); ok {
+	var r0 *cloudformation.DescribeTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeInput) *cloudformation.DescribeTypeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DetectStackDriftOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackDriftInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeTypeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1779,12 +1892,35 @@ func (_m *CloudFormationAPI) DetectStackDrift(_a0 *cloudformation.DetectStackDri
 	return r0, r1
 }
 
-// DetectStackDriftRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DetectStackDriftRequest(_a0 *cloudformation.DetectStackDriftInput) (*request.Request, *cloudformation.DetectStackDriftOutput) {
+// DescribeTypeRegistration provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeTypeRegistration(_a0 *cloudformation.DescribeTypeRegistrationInput) (*cloudformation.DescribeTypeRegistrationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DescribeTypeRegistrationOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeRegistrationInput) *cloudformation.DescribeTypeRegistrationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DescribeTypeRegistrationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeTypeRegistrationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTypeRegistrationRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeTypeRegistrationRequest(_a0 *cloudformation.DescribeTypeRegistrationInput) (*request.Request, *cloudformation.DescribeTypeRegistrationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackDriftInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeRegistrationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1792,20 +1928,20 @@ func (_m *CloudFormationAPI) DetectStackDriftRequest(_a0 *cloudformation.DetectS
 		}
 	}
 
-	var r1 *cloudformation.DetectStackDriftOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackDriftInput) *cloudformation.DetectStackDriftOutput); ok {
+	var r1 *cloudformation.DescribeTypeRegistrationOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeTypeRegistrationInput) *cloudformation.DescribeTypeRegistrationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DetectStackDriftOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeTypeRegistrationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DetectStackDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DetectStackDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackDriftOutput, error) {
+// DescribeTypeRegistrationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeTypeRegistrationWithContext(_a0 context.Context, _a1 *cloudformation.DescribeTypeRegistrationInput, _a2 ...request.Option) (*cloudformation.DescribeTypeRegistrationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1815,17 +1951,17 @@ func (_m *CloudFormationAPI) DetectStackDriftWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DetectStackDriftOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackDriftInput, ...request.Option) *cloudformation.DetectStackDriftOutput); ok {
+	var r0 *cloudformation.DescribeTypeRegistrationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeTypeRegistrationInput, ...request.Option) *cloudformation.DescribeTypeRegistrationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DetectStackDriftOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeTypeRegistrationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackDriftInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeTypeRegistrationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1834,35 +1970,12 @@ func (_m *CloudFormationAPI) DetectStackDriftWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// DetectStackResourceDrift provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DetectStackResourceDrift(_a0 *cloudformation.DetectStackResourceDriftInput) (*cloudformation.DetectStackResourceDriftOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *cloudformation.DetectStackResourceDriftOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackResourceDriftInput) *cloudformation.DetectStackResourceDriftOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DetectStackResourceDriftOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackResourceDriftInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DetectStackResourceDriftRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DetectStackResourceDriftRequest(_a0 *cloudformation.DetectStackResourceDriftInput) (*request.Request, *cloudformation.DetectStackResourceDriftOutput) {
+// DescribeTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeTypeRequest(_a0 *cloudformation.DescribeTypeInput) (*request.Request, *cloudformation.DescribeTypeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackResourceDriftInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1870,20 +1983,20 @@ func (_m *CloudFormationAPI) DetectStackResourceDriftRequest(_a0 *cloudformation
 		}
 	}
 
-	var r1 *cloudformation.DetectStackResourceDriftOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackResourceDriftInput) *cloudformation.DetectStackResourceDriftOutput); ok {
+	var r1 *cloudformation.DescribeTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeTypeInput) *cloudformation.DescribeTypeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DetectStackResourceDriftOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeTypeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DetectStackResourceDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DetectStackResourceDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackResourceDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackResourceDriftOutput, error) {
+// DescribeTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeTypeWithContext(_a0 context.Context, _a1 *cloudformation.DescribeTypeInput, _a2 ...request.Option) (*cloudformation.DescribeTypeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1893,17 +2006,17 @@ func (_m *CloudFormationAPI) DetectStackResourceDriftWithContext(_a0 context.Con
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DetectStackResourceDriftOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...request.Option) *cloudformation.DetectStackResourceDriftOutput); ok {
+	var r0 *cloudformation.DescribeTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeTypeInput, ...request.Option) *cloudformation.DescribeTypeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DetectStackResourceDriftOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeTypeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1912,21 +2025,21 @@ func (_m *CloudFormationAPI) DetectStackResourceDriftWithContext(_a0 context.Con
 	return r0, r1
 }
 
-// EstimateTemplateCost provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) EstimateTemplateCost(_a0 *cloudformation.EstimateTemplateCostInput) (*cloudformation.EstimateTemplateCostOutput, error) {
+// DetectStackDrift provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackDrift(_a0 *cloudformation.DetectStackDriftInput) (*cloudformation.DetectStackDriftOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.EstimateTemplateCostOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.EstimateTemplateCostInput) *cloudformation.EstimateTemplateCostOutput); ok {
+	var r0 *cloudformation.DetectStackDriftOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackDriftInput) *cloudformation.DetectStackDriftOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.EstimateTemplateCostOutput)
+			r0 = ret.Get(0).(*cloudformation.DetectStackDriftOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.EstimateTemplateCostInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackDriftInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1935,12 +2048,12 @@ func (_m *CloudFormationAPI) EstimateTemplateCost(_a0 *cloudformation.EstimateTe
 	return r0, r1
 }
 
-// EstimateTemplateCostRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) EstimateTemplateCostRequest(_a0 *cloudformation.EstimateTemplateCostInput) (*request.Request, *cloudformation.EstimateTemplateCostOutput) {
+// DetectStackDriftRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackDriftRequest(_a0 *cloudformation.DetectStackDriftInput) (*request.Request, *cloudformation.DetectStackDriftOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.EstimateTemplateCostInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackDriftInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1948,20 +2061,20 @@ func (_m *CloudFormationAPI) EstimateTemplateCostRequest(_a0 *cloudformation.Est
 		}
 	}
 
-	var r1 *cloudformation.EstimateTemplateCostOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.EstimateTemplateCostInput) *cloudformation.EstimateTemplateCostOutput); ok {
+	var r1 *cloudformation.DetectStackDriftOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackDriftInput) *cloudformation.DetectStackDriftOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.EstimateTemplateCostOutput)
+			r1 = ret.Get(1).(*cloudformation.DetectStackDriftOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// EstimateTemplateCostWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) EstimateTemplateCostWithContext(_a0 context.Context, _a1 *cloudformation.EstimateTemplateCostInput, _a2 ...request.Option) (*cloudformation.EstimateTemplateCostOutput, error) {
+// DetectStackDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DetectStackDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackDriftOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1971,17 +2084,17 @@ func (_m *CloudFormationAPI) EstimateTemplateCostWithContext(_a0 context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.EstimateTemplateCostOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.EstimateTemplateCostInput, ...request.Option) *cloudformation.EstimateTemplateCostOutput); ok {
+	var r0 *cloudformation.DetectStackDriftOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackDriftInput, ...request.Option) *cloudformation.DetectStackDriftOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.EstimateTemplateCostOutput)
+			r0 = ret.Get(0).(*cloudformation.DetectStackDriftOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.EstimateTemplateCostInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackDriftInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1990,21 +2103,21 @@ func (_m *CloudFormationAPI) EstimateTemplateCostWithContext(_a0 context.Context
 	return r0, r1
 }
 
-// ExecuteChangeSet provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ExecuteChangeSet(_a0 *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+// DetectStackResourceDrift provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackResourceDrift(_a0 *cloudformation.DetectStackResourceDriftInput) (*cloudformation.DetectStackResourceDriftOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ExecuteChangeSetOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ExecuteChangeSetInput) *cloudformation.ExecuteChangeSetOutput); ok {
+	var r0 *cloudformation.DetectStackResourceDriftOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackResourceDriftInput) *cloudformation.DetectStackResourceDriftOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ExecuteChangeSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DetectStackResourceDriftOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ExecuteChangeSetInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackResourceDriftInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2013,12 +2126,12 @@ func (_m *CloudFormationAPI) ExecuteChangeSet(_a0 *cloudformation.ExecuteChangeS
 	return r0, r1
 }
 
-// ExecuteChangeSetRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ExecuteChangeSetRequest(_a0 *cloudformation.ExecuteChangeSetInput) (*request.Request, *cloudformation.ExecuteChangeSetOutput) {
+// DetectStackResourceDriftRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackResourceDriftRequest(_a0 *cloudformation.DetectStackResourceDriftInput) (*request.Request, *cloudformation.DetectStackResourceDriftOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ExecuteChangeSetInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackResourceDriftInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2026,20 +2139,20 @@ func (_m *CloudFormationAPI) ExecuteChangeSetRequest(_a0 *cloudformation.Execute
 		}
 	}
 
-	var r1 *cloudformation.ExecuteChangeSetOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ExecuteChangeSetInput) *cloudformation.ExecuteChangeSetOutput); ok {
+	var r1 *cloudformation.DetectStackResourceDriftOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackResourceDriftInput) *cloudformation.DetectStackResourceDriftOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ExecuteChangeSetOutput)
+			r1 = ret.Get(1).(*cloudformation.DetectStackResourceDriftOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ExecuteChangeSetWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ExecuteChangeSetWithContext(_a0 context.Context, _a1 *cloudformation.ExecuteChangeSetInput, _a2 ...request.Option) (*cloudformation.ExecuteChangeSetOutput, error) {
+// DetectStackResourceDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DetectStackResourceDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackResourceDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackResourceDriftOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2049,17 +2162,17 @@ func (_m *CloudFormationAPI) ExecuteChangeSetWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ExecuteChangeSetOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ExecuteChangeSetInput, ...request.Option) *cloudformation.ExecuteChangeSetOutput); ok {
+	var r0 *cloudformation.DetectStackResourceDriftOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...request.Option) *cloudformation.DetectStackResourceDriftOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ExecuteChangeSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DetectStackResourceDriftOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ExecuteChangeSetInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2068,21 +2181,21 @@ func (_m *CloudFormationAPI) ExecuteChangeSetWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// GetStackPolicy provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) GetStackPolicy(_a0 *cloudformation.GetStackPolicyInput) (*cloudformation.GetStackPolicyOutput, error) {
+// DetectStackSetDrift provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackSetDrift(_a0 *cloudformation.DetectStackSetDriftInput) (*cloudformation.DetectStackSetDriftOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.GetStackPolicyOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.GetStackPolicyInput) *cloudformation.GetStackPolicyOutput); ok {
+	var r0 *cloudformation.DetectStackSetDriftOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackSetDriftInput) *cloudformation.DetectStackSetDriftOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.GetStackPolicyOutput)
+			r0 = ret.Get(0).(*cloudformation.DetectStackSetDriftOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.GetStackPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackSetDriftInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2091,12 +2204,12 @@ func (_m *CloudFormationAPI) GetStackPolicy(_a0 *cloudformation.GetStackPolicyIn
 	return r0, r1
 }
 
-// GetStackPolicyRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) GetStackPolicyRequest(_a0 *cloudformation.GetStackPolicyInput) (*request.Request, *cloudformation.GetStackPolicyOutput) {
+// DetectStackSetDriftRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackSetDriftRequest(_a0 *cloudformation.DetectStackSetDriftInput) (*request.Request, *cloudformation.DetectStackSetDriftOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.GetStackPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackSetDriftInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2104,20 +2217,20 @@ func (_m *CloudFormationAPI) GetStackPolicyRequest(_a0 *cloudformation.GetStackP
 		}
 	}
 
-	var r1 *cloudformation.GetStackPolicyOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.GetStackPolicyInput) *cloudformation.GetStackPolicyOutput); ok {
+	var r1 *cloudformation.DetectStackSetDriftOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackSetDriftInput) *cloudformation.DetectStackSetDriftOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.GetStackPolicyOutput)
+			r1 = ret.Get(1).(*cloudformation.DetectStackSetDriftOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetStackPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) GetStackPolicyWithContext(_a0 context.Context, _a1 *cloudformation.GetStackPolicyInput, _a2 ...request.Option) (*cloudformation.GetStackPolicyOutput, error) {
+// DetectStackSetDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DetectStackSetDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackSetDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackSetDriftOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2127,17 +2240,17 @@ func (_m *CloudFormationAPI) GetStackPolicyWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.GetStackPolicyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.GetStackPolicyInput, ...request.Option) *cloudformation.GetStackPolicyOutput); ok {
+	var r0 *cloudformation.DetectStackSetDriftOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackSetDriftInput, ...request.Option) *cloudformation.DetectStackSetDriftOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.GetStackPolicyOutput)
+			r0 = ret.Get(0).(*cloudformation.DetectStackSetDriftOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetStackPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackSetDriftInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2146,13 +2259,247 @@ func (_m *CloudFormationAPI) GetStackPolicyWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// GetTemplate provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) GetTemplate(_a0 *cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error) {
+// EstimateTemplateCost provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) EstimateTemplateCost(_a0 *cloudformation.EstimateTemplateCostInput) (*cloudformation.EstimateTemplateCostOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.GetTemplateOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateInput) *cloudformation.GetTemplateOutput); ok {
-		r0 = rf(_a0)
+	var r0 *cloudformation.EstimateTemplateCostOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.EstimateTemplateCostInput) *cloudformation.EstimateTemplateCostOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.EstimateTemplateCostOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.EstimateTemplateCostInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EstimateTemplateCostRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) EstimateTemplateCostRequest(_a0 *cloudformation.EstimateTemplateCostInput) (*request.Request, *cloudformation.EstimateTemplateCostOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.EstimateTemplateCostInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.EstimateTemplateCostOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.EstimateTemplateCostInput) *cloudformation.EstimateTemplateCostOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.EstimateTemplateCostOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// EstimateTemplateCostWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) EstimateTemplateCostWithContext(_a0 context.Context, _a1 *cloudformation.EstimateTemplateCostInput, _a2 ...request.Option) (*cloudformation.EstimateTemplateCostOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.EstimateTemplateCostOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.EstimateTemplateCostInput, ...request.Option) *cloudformation.EstimateTemplateCostOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.EstimateTemplateCostOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.EstimateTemplateCostInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteChangeSet provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ExecuteChangeSet(_a0 *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ExecuteChangeSetOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ExecuteChangeSetInput) *cloudformation.ExecuteChangeSetOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ExecuteChangeSetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ExecuteChangeSetInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteChangeSetRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ExecuteChangeSetRequest(_a0 *cloudformation.ExecuteChangeSetInput) (*request.Request, *cloudformation.ExecuteChangeSetOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ExecuteChangeSetInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ExecuteChangeSetOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ExecuteChangeSetInput) *cloudformation.ExecuteChangeSetOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ExecuteChangeSetOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecuteChangeSetWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ExecuteChangeSetWithContext(_a0 context.Context, _a1 *cloudformation.ExecuteChangeSetInput, _a2 ...request.Option) (*cloudformation.ExecuteChangeSetOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ExecuteChangeSetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ExecuteChangeSetInput, ...request.Option) *cloudformation.ExecuteChangeSetOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ExecuteChangeSetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ExecuteChangeSetInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStackPolicy provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) GetStackPolicy(_a0 *cloudformation.GetStackPolicyInput) (*cloudformation.GetStackPolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.GetStackPolicyOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.GetStackPolicyInput) *cloudformation.GetStackPolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.GetStackPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.GetStackPolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStackPolicyRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) GetStackPolicyRequest(_a0 *cloudformation.GetStackPolicyInput) (*request.Request, *cloudformation.GetStackPolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.GetStackPolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.GetStackPolicyOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.GetStackPolicyInput) *cloudformation.GetStackPolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.GetStackPolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetStackPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) GetStackPolicyWithContext(_a0 context.Context, _a1 *cloudformation.GetStackPolicyInput, _a2 ...request.Option) (*cloudformation.GetStackPolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.GetStackPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.GetStackPolicyInput, ...request.Option) *cloudformation.GetStackPolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.GetStackPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetStackPolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplate provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) GetTemplate(_a0 *cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.GetTemplateOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateInput) *cloudformation.GetTemplateOutput); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*cloudformation.GetTemplateOutput)
@@ -2288,12 +2635,690 @@ func (_m *CloudFormationAPI) GetTemplateWithContext(_a0 context.Context, _a1 *cl
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.GetTemplateOutput)
+			r0 = ret.Get(0).(*cloudformation.GetTemplateOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetTemplateInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChangeSets provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListChangeSets(_a0 *cloudformation.ListChangeSetsInput) (*cloudformation.ListChangeSetsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListChangeSetsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput) *cloudformation.ListChangeSetsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListChangeSetsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListChangeSetsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChangeSetsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListChangeSetsPages(_a0 *cloudformation.ListChangeSetsInput, _a1 func(*cloudformation.ListChangeSetsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput, func(*cloudformation.ListChangeSetsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListChangeSetsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListChangeSetsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListChangeSetsInput, _a2 func(*cloudformation.ListChangeSetsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListChangeSetsInput, func(*cloudformation.ListChangeSetsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListChangeSetsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListChangeSetsRequest(_a0 *cloudformation.ListChangeSetsInput) (*request.Request, *cloudformation.ListChangeSetsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListChangeSetsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListChangeSetsInput) *cloudformation.ListChangeSetsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListChangeSetsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListChangeSetsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListChangeSetsWithContext(_a0 context.Context, _a1 *cloudformation.ListChangeSetsInput, _a2 ...request.Option) (*cloudformation.ListChangeSetsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListChangeSetsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListChangeSetsInput, ...request.Option) *cloudformation.ListChangeSetsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListChangeSetsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListChangeSetsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExports provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListExports(_a0 *cloudformation.ListExportsInput) (*cloudformation.ListExportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput) *cloudformation.ListExportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListExportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExportsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListExportsPages(_a0 *cloudformation.ListExportsInput, _a1 func(*cloudformation.ListExportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput, func(*cloudformation.ListExportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListExportsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListExportsInput, _a2 func(*cloudformation.ListExportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListExportsInput, func(*cloudformation.ListExportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListExportsRequest(_a0 *cloudformation.ListExportsInput) (*request.Request, *cloudformation.ListExportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListExportsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListExportsInput) *cloudformation.ListExportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListExportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListExportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListExportsWithContext(_a0 context.Context, _a1 *cloudformation.ListExportsInput, _a2 ...request.Option) (*cloudformation.ListExportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListExportsInput, ...request.Option) *cloudformation.ListExportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListExportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImports provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListImports(_a0 *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput) *cloudformation.ListImportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListImportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImportsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListImportsPages(_a0 *cloudformation.ListImportsInput, _a1 func(*cloudformation.ListImportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput, func(*cloudformation.ListImportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListImportsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListImportsInput, _a2 func(*cloudformation.ListImportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListImportsInput, func(*cloudformation.ListImportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListImportsRequest(_a0 *cloudformation.ListImportsInput) (*request.Request, *cloudformation.ListImportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListImportsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListImportsInput) *cloudformation.ListImportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListImportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListImportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListImportsWithContext(_a0 context.Context, _a1 *cloudformation.ListImportsInput, _a2 ...request.Option) (*cloudformation.ListImportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListImportsInput, ...request.Option) *cloudformation.ListImportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListImportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackInstances provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackInstances(_a0 *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStackInstancesOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput) *cloudformation.ListStackInstancesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstancesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackInstancesPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackInstancesPages(_a0 *cloudformation.ListStackInstancesInput, _a1 func(*cloudformation.ListStackInstancesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput, func(*cloudformation.ListStackInstancesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackInstancesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackInstancesPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackInstancesInput, _a2 func(*cloudformation.ListStackInstancesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackInstancesInput, func(*cloudformation.ListStackInstancesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackInstancesRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackInstancesRequest(_a0 *cloudformation.ListStackInstancesInput) (*request.Request, *cloudformation.ListStackInstancesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListStackInstancesOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstancesInput) *cloudformation.ListStackInstancesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStackInstancesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStackInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackInstancesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackInstancesInput, _a2 ...request.Option) (*cloudformation.ListStackInstancesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListStackInstancesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackInstancesInput, ...request.Option) *cloudformation.ListStackInstancesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackInstancesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackResources provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackResources(_a0 *cloudformation.ListStackResourcesInput) (*cloudformation.ListStackResourcesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStackResourcesOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput) *cloudformation.ListStackResourcesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackResourcesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackResourcesPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackResourcesPages(_a0 *cloudformation.ListStackResourcesInput, _a1 func(*cloudformation.ListStackResourcesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackResourcesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackResourcesPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackResourcesInput, _a2 func(*cloudformation.ListStackResourcesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackResourcesRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackResourcesRequest(_a0 *cloudformation.ListStackResourcesInput) (*request.Request, *cloudformation.ListStackResourcesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListStackResourcesOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackResourcesInput) *cloudformation.ListStackResourcesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStackResourcesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStackResourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackResourcesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackResourcesInput, _a2 ...request.Option) (*cloudformation.ListStackResourcesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListStackResourcesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackResourcesInput, ...request.Option) *cloudformation.ListStackResourcesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackResourcesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackSetOperationResults provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetOperationResults(_a0 *cloudformation.ListStackSetOperationResultsInput) (*cloudformation.ListStackSetOperationResultsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStackSetOperationResultsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput) *cloudformation.ListStackSetOperationResultsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationResultsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationResultsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackSetOperationResultsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackSetOperationResultsPages(_a0 *cloudformation.ListStackSetOperationResultsInput, _a1 func(*cloudformation.ListStackSetOperationResultsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput, func(*cloudformation.ListStackSetOperationResultsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetOperationResultsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackSetOperationResultsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationResultsInput, _a2 func(*cloudformation.ListStackSetOperationResultsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, func(*cloudformation.ListStackSetOperationResultsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetOperationResultsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetOperationResultsRequest(_a0 *cloudformation.ListStackSetOperationResultsInput) (*request.Request, *cloudformation.ListStackSetOperationResultsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListStackSetOperationResultsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationResultsInput) *cloudformation.ListStackSetOperationResultsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStackSetOperationResultsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStackSetOperationResultsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackSetOperationResultsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationResultsInput, _a2 ...request.Option) (*cloudformation.ListStackSetOperationResultsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListStackSetOperationResultsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, ...request.Option) *cloudformation.ListStackSetOperationResultsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationResultsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetTemplateInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2302,21 +3327,21 @@ func (_m *CloudFormationAPI) GetTemplateWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
-// ListChangeSets provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListChangeSets(_a0 *cloudformation.ListChangeSetsInput) (*cloudformation.ListChangeSetsOutput, error) {
+// ListStackSetOperations provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetOperations(_a0 *cloudformation.ListStackSetOperationsInput) (*cloudformation.ListStackSetOperationsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListChangeSetsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput) *cloudformation.ListChangeSetsOutput); ok {
+	var r0 *cloudformation.ListStackSetOperationsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput) *cloudformation.ListStackSetOperationsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListChangeSetsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListChangeSetsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2325,12 +3350,47 @@ func (_m *CloudFormationAPI) ListChangeSets(_a0 *cloudformation.ListChangeSetsIn
 	return r0, r1
 }
 
-// ListChangeSetsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListChangeSetsRequest(_a0 *cloudformation.ListChangeSetsInput) (*request.Request, *cloudformation.ListChangeSetsOutput) {
+// ListStackSetOperationsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackSetOperationsPages(_a0 *cloudformation.ListStackSetOperationsInput, _a1 func(*cloudformation.ListStackSetOperationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput, func(*cloudformation.ListStackSetOperationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetOperationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackSetOperationsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationsInput, _a2 func(*cloudformation.ListStackSetOperationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationsInput, func(*cloudformation.ListStackSetOperationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetOperationsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetOperationsRequest(_a0 *cloudformation.ListStackSetOperationsInput) (*request.Request, *cloudformation.ListStackSetOperationsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2338,20 +3398,20 @@ func (_m *CloudFormationAPI) ListChangeSetsRequest(_a0 *cloudformation.ListChang
 		}
 	}
 
-	var r1 *cloudformation.ListChangeSetsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListChangeSetsInput) *cloudformation.ListChangeSetsOutput); ok {
+	var r1 *cloudformation.ListStackSetOperationsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationsInput) *cloudformation.ListStackSetOperationsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListChangeSetsOutput)
+			r1 = ret.Get(1).(*cloudformation.ListStackSetOperationsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListChangeSetsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListChangeSetsWithContext(_a0 context.Context, _a1 *cloudformation.ListChangeSetsInput, _a2 ...request.Option) (*cloudformation.ListChangeSetsOutput, error) {
+// ListStackSetOperationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackSetOperationsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationsInput, _a2 ...request.Option) (*cloudformation.ListStackSetOperationsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2361,17 +3421,17 @@ func (_m *CloudFormationAPI) ListChangeSetsWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListChangeSetsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListChangeSetsInput, ...request.Option) *cloudformation.ListChangeSetsOutput); ok {
+	var r0 *cloudformation.ListStackSetOperationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationsInput, ...request.Option) *cloudformation.ListStackSetOperationsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListChangeSetsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListChangeSetsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetOperationsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2380,21 +3440,21 @@ func (_m *CloudFormationAPI) ListChangeSetsWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// ListExports provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListExports(_a0 *cloudformation.ListExportsInput) (*cloudformation.ListExportsOutput, error) {
+// ListStackSets provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSets(_a0 *cloudformation.ListStackSetsInput) (*cloudformation.ListStackSetsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListExportsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput) *cloudformation.ListExportsOutput); ok {
+	var r0 *cloudformation.ListStackSetsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput) *cloudformation.ListStackSetsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListExportsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListStackSetsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListExportsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2403,12 +3463,12 @@ func (_m *CloudFormationAPI) ListExports(_a0 *cloudformation.ListExportsInput) (
 	return r0, r1
 }
 
-// ListExportsPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) ListExportsPages(_a0 *cloudformation.ListExportsInput, _a1 func(*cloudformation.ListExportsOutput, bool) bool) error {
+// ListStackSetsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackSetsPages(_a0 *cloudformation.ListStackSetsInput, _a1 func(*cloudformation.ListStackSetsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput, func(*cloudformation.ListExportsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput, func(*cloudformation.ListStackSetsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -2417,8 +3477,8 @@ func (_m *CloudFormationAPI) ListExportsPages(_a0 *cloudformation.ListExportsInp
 	return r0
 }
 
-// ListExportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) ListExportsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListExportsInput, _a2 func(*cloudformation.ListExportsOutput, bool) bool, _a3 ...request.Option) error {
+// ListStackSetsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackSetsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetsInput, _a2 func(*cloudformation.ListStackSetsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -2429,7 +3489,7 @@ func (_m *CloudFormationAPI) ListExportsPagesWithContext(_a0 context.Context, _a
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListExportsInput, func(*cloudformation.ListExportsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetsInput, func(*cloudformation.ListStackSetsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -2438,12 +3498,12 @@ func (_m *CloudFormationAPI) ListExportsPagesWithContext(_a0 context.Context, _a
 	return r0
 }
 
-// ListExportsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListExportsRequest(_a0 *cloudformation.ListExportsInput) (*request.Request, *cloudformation.ListExportsOutput) {
+// ListStackSetsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetsRequest(_a0 *cloudformation.ListStackSetsInput) (*request.Request, *cloudformation.ListStackSetsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2451,20 +3511,20 @@ func (_m *CloudFormationAPI) ListExportsRequest(_a0 *cloudformation.ListExportsI
 		}
 	}
 
-	var r1 *cloudformation.ListExportsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListExportsInput) *cloudformation.ListExportsOutput); ok {
+	var r1 *cloudformation.ListStackSetsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetsInput) *cloudformation.ListStackSetsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListExportsOutput)
+			r1 = ret.Get(1).(*cloudformation.ListStackSetsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListExportsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListExportsWithContext(_a0 context.Context, _a1 *cloudformation.ListExportsInput, _a2 ...request.Option) (*cloudformation.ListExportsOutput, error) {
+// ListStackSetsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackSetsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetsInput, _a2 ...request.Option) (*cloudformation.ListStackSetsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2474,17 +3534,17 @@ func (_m *CloudFormationAPI) ListExportsWithContext(_a0 context.Context, _a1 *cl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListExportsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListExportsInput, ...request.Option) *cloudformation.ListExportsOutput); ok {
+	var r0 *cloudformation.ListStackSetsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetsInput, ...request.Option) *cloudformation.ListStackSetsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListExportsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListStackSetsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListExportsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2493,21 +3553,21 @@ func (_m *CloudFormationAPI) ListExportsWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
-// ListImports provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListImports(_a0 *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error) {
+// ListStacks provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStacks(_a0 *cloudformation.ListStacksInput) (*cloudformation.ListStacksOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListImportsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput) *cloudformation.ListImportsOutput); ok {
+	var r0 *cloudformation.ListStacksOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput) *cloudformation.ListStacksOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListImportsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListStacksOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListImportsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStacksInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2516,12 +3576,12 @@ func (_m *CloudFormationAPI) ListImports(_a0 *cloudformation.ListImportsInput) (
 	return r0, r1
 }
 
-// ListImportsPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) ListImportsPages(_a0 *cloudformation.ListImportsInput, _a1 func(*cloudformation.ListImportsOutput, bool) bool) error {
+// ListStacksPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStacksPages(_a0 *cloudformation.ListStacksInput, _a1 func(*cloudformation.ListStacksOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput, func(*cloudformation.ListImportsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -2530,8 +3590,8 @@ func (_m *CloudFormationAPI) ListImportsPages(_a0 *cloudformation.ListImportsInp
 	return r0
 }
 
-// ListImportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) ListImportsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListImportsInput, _a2 func(*cloudformation.ListImportsOutput, bool) bool, _a3 ...request.Option) error {
+// ListStacksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStacksPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStacksInput, _a2 func(*cloudformation.ListStacksOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -2542,7 +3602,7 @@ func (_m *CloudFormationAPI) ListImportsPagesWithContext(_a0 context.Context, _a
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListImportsInput, func(*cloudformation.ListImportsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -2551,12 +3611,12 @@ func (_m *CloudFormationAPI) ListImportsPagesWithContext(_a0 context.Context, _a
 	return r0
 }
 
-// ListImportsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListImportsRequest(_a0 *cloudformation.ListImportsInput) (*request.Request, *cloudformation.ListImportsOutput) {
+// ListStacksRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStacksRequest(_a0 *cloudformation.ListStacksInput) (*request.Request, *cloudformation.ListStacksOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2564,20 +3624,20 @@ func (_m *CloudFormationAPI) ListImportsRequest(_a0 *cloudformation.ListImportsI
 		}
 	}
 
-	var r1 *cloudformation.ListImportsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListImportsInput) *cloudformation.ListImportsOutput); ok {
+	var r1 *cloudformation.ListStacksOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStacksInput) *cloudformation.ListStacksOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListImportsOutput)
+			r1 = ret.Get(1).(*cloudformation.ListStacksOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListImportsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListImportsWithContext(_a0 context.Context, _a1 *cloudformation.ListImportsInput, _a2 ...request.Option) (*cloudformation.ListImportsOutput, error) {
+// ListStacksWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStacksWithContext(_a0 context.Context, _a1 *cloudformation.ListStacksInput, _a2 ...request.Option) (*cloudformation.ListStacksOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2587,17 +3647,17 @@ func (_m *CloudFormationAPI) ListImportsWithContext(_a0 context.Context, _a1 *cl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListImportsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListImportsInput, ...request.Option) *cloudformation.ListImportsOutput); ok {
+	var r0 *cloudformation.ListStacksOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStacksInput, ...request.Option) *cloudformation.ListStacksOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListImportsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListStacksOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListImportsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStacksInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2606,21 +3666,21 @@ func (_m *CloudFormationAPI) ListImportsWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
-// ListStackInstances provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackInstances(_a0 *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+// ListTypeRegistrations provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypeRegistrations(_a0 *cloudformation.ListTypeRegistrationsInput) (*cloudformation.ListTypeRegistrationsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStackInstancesOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput) *cloudformation.ListStackInstancesOutput); ok {
+	var r0 *cloudformation.ListTypeRegistrationsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeRegistrationsInput) *cloudformation.ListTypeRegistrationsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackInstancesOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypeRegistrationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstancesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypeRegistrationsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2629,12 +3689,47 @@ func (_m *CloudFormationAPI) ListStackInstances(_a0 *cloudformation.ListStackIns
 	return r0, r1
 }
 
-// ListStackInstancesRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackInstancesRequest(_a0 *cloudformation.ListStackInstancesInput) (*request.Request, *cloudformation.ListStackInstancesOutput) {
+// ListTypeRegistrationsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListTypeRegistrationsPages(_a0 *cloudformation.ListTypeRegistrationsInput, _a1 func(*cloudformation.ListTypeRegistrationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeRegistrationsInput, func(*cloudformation.ListTypeRegistrationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTypeRegistrationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListTypeRegistrationsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListTypeRegistrationsInput, _a2 func(*cloudformation.ListTypeRegistrationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypeRegistrationsInput, func(*cloudformation.ListTypeRegistrationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTypeRegistrationsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypeRegistrationsRequest(_a0 *cloudformation.ListTypeRegistrationsInput) (*request.Request, *cloudformation.ListTypeRegistrationsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeRegistrationsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2642,20 +3737,20 @@ func (_m *CloudFormationAPI) ListStackInstancesRequest(_a0 *cloudformation.ListS
 		}
 	}
 
-	var r1 *cloudformation.ListStackInstancesOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstancesInput) *cloudformation.ListStackInstancesOutput); ok {
+	var r1 *cloudformation.ListTypeRegistrationsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypeRegistrationsInput) *cloudformation.ListTypeRegistrationsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackInstancesOutput)
+			r1 = ret.Get(1).(*cloudformation.ListTypeRegistrationsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackInstancesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackInstancesInput, _a2 ...request.Option) (*cloudformation.ListStackInstancesOutput, error) {
+// ListTypeRegistrationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListTypeRegistrationsWithContext(_a0 context.Context, _a1 *cloudformation.ListTypeRegistrationsInput, _a2 ...request.Option) (*cloudformation.ListTypeRegistrationsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2665,17 +3760,17 @@ func (_m *CloudFormationAPI) ListStackInstancesWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackInstancesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackInstancesInput, ...request.Option) *cloudformation.ListStackInstancesOutput); ok {
+	var r0 *cloudformation.ListTypeRegistrationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypeRegistrationsInput, ...request.Option) *cloudformation.ListTypeRegistrationsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackInstancesOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypeRegistrationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackInstancesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListTypeRegistrationsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2684,21 +3779,21 @@ func (_m *CloudFormationAPI) ListStackInstancesWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// ListStackResources provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackResources(_a0 *cloudformation.ListStackResourcesInput) (*cloudformation.ListStackResourcesOutput, error) {
+// ListTypeVersions provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypeVersions(_a0 *cloudformation.ListTypeVersionsInput) (*cloudformation.ListTypeVersionsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStackResourcesOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput) *cloudformation.ListStackResourcesOutput); ok {
+	var r0 *cloudformation.ListTypeVersionsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeVersionsInput) *cloudformation.ListTypeVersionsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackResourcesOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypeVersionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackResourcesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypeVersionsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2707,12 +3802,12 @@ func (_m *CloudFormationAPI) ListStackResources(_a0 *cloudformation.ListStackRes
 	return r0, r1
 }
 
-// ListStackResourcesPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) ListStackResourcesPages(_a0 *cloudformation.ListStackResourcesInput, _a1 func(*cloudformation.ListStackResourcesOutput, bool) bool) error {
+// ListTypeVersionsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListTypeVersionsPages(_a0 *cloudformation.ListTypeVersionsInput, _a1 func(*cloudformation.ListTypeVersionsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeVersionsInput, func(*cloudformation.ListTypeVersionsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -2721,8 +3816,8 @@ func (_m *CloudFormationAPI) ListStackResourcesPages(_a0 *cloudformation.ListSta
 	return r0
 }
 
-// ListStackResourcesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) ListStackResourcesPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackResourcesInput, _a2 func(*cloudformation.ListStackResourcesOutput, bool) bool, _a3 ...request.Option) error {
+// ListTypeVersionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListTypeVersionsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListTypeVersionsInput, _a2 func(*cloudformation.ListTypeVersionsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -2733,7 +3828,7 @@ func (_m *CloudFormationAPI) ListStackResourcesPagesWithContext(_a0 context.Cont
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypeVersionsInput, func(*cloudformation.ListTypeVersionsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -2742,12 +3837,12 @@ func (_m *CloudFormationAPI) ListStackResourcesPagesWithContext(_a0 context.Cont
 	return r0
 }
 
-// ListStackResourcesRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackResourcesRequest(_a0 *cloudformation.ListStackResourcesInput) (*request.Request, *cloudformation.ListStackResourcesOutput) {
+// ListTypeVersionsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypeVersionsRequest(_a0 *cloudformation.ListTypeVersionsInput) (*request.Request, *cloudformation.ListTypeVersionsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeVersionsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2755,20 +3850,20 @@ func (_m *CloudFormationAPI) ListStackResourcesRequest(_a0 *cloudformation.ListS
 		}
 	}
 
-	var r1 *cloudformation.ListStackResourcesOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackResourcesInput) *cloudformation.ListStackResourcesOutput); ok {
+	var r1 *cloudformation.ListTypeVersionsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypeVersionsInput) *cloudformation.ListTypeVersionsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackResourcesOutput)
+			r1 = ret.Get(1).(*cloudformation.ListTypeVersionsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackResourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackResourcesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackResourcesInput, _a2 ...request.Option) (*cloudformation.ListStackResourcesOutput, error) {
+// ListTypeVersionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListTypeVersionsWithContext(_a0 context.Context, _a1 *cloudformation.ListTypeVersionsInput, _a2 ...request.Option) (*cloudformation.ListTypeVersionsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2778,17 +3873,17 @@ func (_m *CloudFormationAPI) ListStackResourcesWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackResourcesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackResourcesInput, ...request.Option) *cloudformation.ListStackResourcesOutput); ok {
+	var r0 *cloudformation.ListTypeVersionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypeVersionsInput, ...request.Option) *cloudformation.ListTypeVersionsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackResourcesOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypeVersionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackResourcesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListTypeVersionsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2797,35 +3892,70 @@ func (_m *CloudFormationAPI) ListStackResourcesWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// ListStackSetOperationResults provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetOperationResults(_a0 *cloudformation.ListStackSetOperationResultsInput) (*cloudformation.ListStackSetOperationResultsOutput, error) {
-	ret := _m.Called(_a0)
+// ListTypes provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypes(_a0 *cloudformation.ListTypesInput) (*cloudformation.ListTypesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListTypesOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypesInput) *cloudformation.ListTypesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListTypesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTypesPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListTypesPages(_a0 *cloudformation.ListTypesInput, _a1 func(*cloudformation.ListTypesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypesInput, func(*cloudformation.ListTypesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
 
-	var r0 *cloudformation.ListStackSetOperationResultsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput) *cloudformation.ListStackSetOperationResultsOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationResultsOutput)
-		}
+// ListTypesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListTypesPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListTypesInput, _a2 func(*cloudformation.ListTypesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
 	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationResultsInput) error); ok {
-		r1 = rf(_a0)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypesInput, func(*cloudformation.ListTypesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// ListStackSetOperationResultsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetOperationResultsRequest(_a0 *cloudformation.ListStackSetOperationResultsInput) (*request.Request, *cloudformation.ListStackSetOperationResultsOutput) {
+// ListTypesRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypesRequest(_a0 *cloudformation.ListTypesInput) (*request.Request, *cloudformation.ListTypesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2833,20 +3963,20 @@ func (_m *CloudFormationAPI) ListStackSetOperationResultsRequest(_a0 *cloudforma
 		}
 	}
 
-	var r1 *cloudformation.ListStackSetOperationResultsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationResultsInput) *cloudformation.ListStackSetOperationResultsOutput); ok {
+	var r1 *cloudformation.ListTypesOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypesInput) *cloudformation.ListTypesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackSetOperationResultsOutput)
+			r1 = ret.Get(1).(*cloudformation.ListTypesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackSetOperationResultsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackSetOperationResultsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationResultsInput, _a2 ...request.Option) (*cloudformation.ListStackSetOperationResultsOutput, error) {
+// ListTypesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListTypesWithContext(_a0 context.Context, _a1 *cloudformation.ListTypesInput, _a2 ...request.Option) (*cloudformation.ListTypesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2856,17 +3986,17 @@ func (_m *CloudFormationAPI) ListStackSetOperationResultsWithContext(_a0 context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackSetOperationResultsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, ...request.Option) *cloudformation.ListStackSetOperationResultsOutput); ok {
+	var r0 *cloudformation.ListTypesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypesInput, ...request.Option) *cloudformation.ListTypesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationResultsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListTypesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2875,21 +4005,21 @@ func (_m *CloudFormationAPI) ListStackSetOperationResultsWithContext(_a0 context
 	return r0, r1
 }
 
-// ListStackSetOperations provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetOperations(_a0 *cloudformation.ListStackSetOperationsInput) (*cloudformation.ListStackSetOperationsOutput, error) {
+// RecordHandlerProgress provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RecordHandlerProgress(_a0 *cloudformation.RecordHandlerProgressInput) (*cloudformation.RecordHandlerProgressOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStackSetOperationsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput) *cloudformation.ListStackSetOperationsOutput); ok {
+	var r0 *cloudformation.RecordHandlerProgressOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.RecordHandlerProgressInput) *cloudformation.RecordHandlerProgressOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationsOutput)
+			r0 = ret.Get(0).(*cloudformation.RecordHandlerProgressOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.RecordHandlerProgressInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2898,12 +4028,12 @@ func (_m *CloudFormationAPI) ListStackSetOperations(_a0 *cloudformation.ListStac
 	return r0, r1
 }
 
-// ListStackSetOperationsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetOperationsRequest(_a0 *cloudformation.ListStackSetOperationsInput) (*request.Request, *cloudformation.ListStackSetOperationsOutput) {
+// RecordHandlerProgressRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RecordHandlerProgressRequest(_a0 *cloudformation.RecordHandlerProgressInput) (*request.Request, *cloudformation.RecordHandlerProgressOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.RecordHandlerProgressInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2911,20 +4041,20 @@ func (_m *CloudFormationAPI) ListStackSetOperationsRequest(_a0 *cloudformation.L
 		}
 	}
 
-	var r1 *cloudformation.ListStackSetOperationsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationsInput) *cloudformation.ListStackSetOperationsOutput); ok {
+	var r1 *cloudformation.RecordHandlerProgressOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.RecordHandlerProgressInput) *cloudformation.RecordHandlerProgressOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackSetOperationsOutput)
+			r1 = ret.Get(1).(*cloudformation.RecordHandlerProgressOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackSetOperationsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackSetOperationsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationsInput, _a2 ...request.Option) (*cloudformation.ListStackSetOperationsOutput, error) {
+// RecordHandlerProgressWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) RecordHandlerProgressWithContext(_a0 context.Context, _a1 *cloudformation.RecordHandlerProgressInput, _a2 ...request.Option) (*cloudformation.RecordHandlerProgressOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2934,17 +4064,17 @@ func (_m *CloudFormationAPI) ListStackSetOperationsWithContext(_a0 context.Conte
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackSetOperationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationsInput, ...request.Option) *cloudformation.ListStackSetOperationsOutput); ok {
+	var r0 *cloudformation.RecordHandlerProgressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.RecordHandlerProgressInput, ...request.Option) *cloudformation.RecordHandlerProgressOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationsOutput)
+			r0 = ret.Get(0).(*cloudformation.RecordHandlerProgressOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetOperationsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.RecordHandlerProgressInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2953,21 +4083,21 @@ func (_m *CloudFormationAPI) ListStackSetOperationsWithContext(_a0 context.Conte
 	return r0, r1
 }
 
-// ListStackSets provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSets(_a0 *cloudformation.ListStackSetsInput) (*cloudformation.ListStackSetsOutput, error) {
+// RegisterType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RegisterType(_a0 *cloudformation.RegisterTypeInput) (*cloudformation.RegisterTypeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStackSetsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput) *cloudformation.ListStackSetsOutput); ok {
+	var r0 *cloudformation.RegisterTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.RegisterTypeInput) *cloudformation.RegisterTypeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetsOutput)
+			r0 = ret.Get(0).(*cloudformation.RegisterTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.RegisterTypeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2976,12 +4106,12 @@ func (_m *CloudFormationAPI) ListStackSets(_a0 *cloudformation.ListStackSetsInpu
 	return r0, r1
 }
 
-// ListStackSetsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetsRequest(_a0 *cloudformation.ListStackSetsInput) (*request.Request, *cloudformation.ListStackSetsOutput) {
+// RegisterTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RegisterTypeRequest(_a0 *cloudformation.RegisterTypeInput) (*request.Request, *cloudformation.RegisterTypeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.RegisterTypeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2989,20 +4119,20 @@ func (_m *CloudFormationAPI) ListStackSetsRequest(_a0 *cloudformation.ListStackS
 		}
 	}
 
-	var r1 *cloudformation.ListStackSetsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetsInput) *cloudformation.ListStackSetsOutput); ok {
+	var r1 *cloudformation.RegisterTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.RegisterTypeInput) *cloudformation.RegisterTypeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackSetsOutput)
+			r1 = ret.Get(1).(*cloudformation.RegisterTypeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackSetsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackSetsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetsInput, _a2 ...request.Option) (*cloudformation.ListStackSetsOutput, error) {
+// RegisterTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) RegisterTypeWithContext(_a0 context.Context, _a1 *cloudformation.RegisterTypeInput, _a2 ...request.Option) (*cloudformation.RegisterTypeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3012,17 +4142,17 @@ func (_m *CloudFormationAPI) ListStackSetsWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackSetsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetsInput, ...request.Option) *cloudformation.ListStackSetsOutput); ok {
+	var r0 *cloudformation.RegisterTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.RegisterTypeInput, ...request.Option) *cloudformation.RegisterTypeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetsOutput)
+			r0 = ret.Get(0).(*cloudformation.RegisterTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.RegisterTypeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3031,21 +4161,21 @@ func (_m *CloudFormationAPI) ListStackSetsWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// ListStacks provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStacks(_a0 *cloudformation.ListStacksInput) (*cloudformation.ListStacksOutput, error) {
+// SetStackPolicy provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetStackPolicy(_a0 *cloudformation.SetStackPolicyInput) (*cloudformation.SetStackPolicyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStacksOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput) *cloudformation.ListStacksOutput); ok {
+	var r0 *cloudformation.SetStackPolicyOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetStackPolicyInput) *cloudformation.SetStackPolicyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStacksOutput)
+			r0 = ret.Get(0).(*cloudformation.SetStackPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStacksInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetStackPolicyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3054,47 +4184,12 @@ func (_m *CloudFormationAPI) ListStacks(_a0 *cloudformation.ListStacksInput) (*c
 	return r0, r1
 }
 
-// ListStacksPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) ListStacksPages(_a0 *cloudformation.ListStacksInput, _a1 func(*cloudformation.ListStacksOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListStacksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) ListStacksPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStacksInput, _a2 func(*cloudformation.ListStacksOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListStacksRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStacksRequest(_a0 *cloudformation.ListStacksInput) (*request.Request, *cloudformation.ListStacksOutput) {
+// SetStackPolicyRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetStackPolicyRequest(_a0 *cloudformation.SetStackPolicyInput) (*request.Request, *cloudformation.SetStackPolicyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetStackPolicyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3102,20 +4197,20 @@ func (_m *CloudFormationAPI) ListStacksRequest(_a0 *cloudformation.ListStacksInp
 		}
 	}
 
-	var r1 *cloudformation.ListStacksOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStacksInput) *cloudformation.ListStacksOutput); ok {
+	var r1 *cloudformation.SetStackPolicyOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetStackPolicyInput) *cloudformation.SetStackPolicyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStacksOutput)
+			r1 = ret.Get(1).(*cloudformation.SetStackPolicyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStacksWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStacksWithContext(_a0 context.Context, _a1 *cloudformation.ListStacksInput, _a2 ...request.Option) (*cloudformation.ListStacksOutput, error) {
+// SetStackPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) SetStackPolicyWithContext(_a0 context.Context, _a1 *cloudformation.SetStackPolicyInput, _a2 ...request.Option) (*cloudformation.SetStackPolicyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3125,17 +4220,17 @@ func (_m *CloudFormationAPI) ListStacksWithContext(_a0 context.Context, _a1 *clo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStacksOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStacksInput, ...request.Option) *cloudformation.ListStacksOutput); ok {
+	var r0 *cloudformation.SetStackPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.SetStackPolicyInput, ...request.Option) *cloudformation.SetStackPolicyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStacksOutput)
+			r0 = ret.Get(0).(*cloudformation.SetStackPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStacksInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.SetStackPolicyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3144,21 +4239,21 @@ func (_m *CloudFormationAPI) ListStacksWithContext(_a0 context.Context, _a1 *clo
 	return r0, r1
 }
 
-// SetStackPolicy provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) SetStackPolicy(_a0 *cloudformation.SetStackPolicyInput) (*cloudformation.SetStackPolicyOutput, error) {
+// SetTypeDefaultVersion provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetTypeDefaultVersion(_a0 *cloudformation.SetTypeDefaultVersionInput) (*cloudformation.SetTypeDefaultVersionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.SetStackPolicyOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.SetStackPolicyInput) *cloudformation.SetStackPolicyOutput); ok {
+	var r0 *cloudformation.SetTypeDefaultVersionOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetTypeDefaultVersionInput) *cloudformation.SetTypeDefaultVersionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.SetStackPolicyOutput)
+			r0 = ret.Get(0).(*cloudformation.SetTypeDefaultVersionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.SetStackPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetTypeDefaultVersionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3167,12 +4262,12 @@ func (_m *CloudFormationAPI) SetStackPolicy(_a0 *cloudformation.SetStackPolicyIn
 	return r0, r1
 }
 
-// SetStackPolicyRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) SetStackPolicyRequest(_a0 *cloudformation.SetStackPolicyInput) (*request.Request, *cloudformation.SetStackPolicyOutput) {
+// SetTypeDefaultVersionRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetTypeDefaultVersionRequest(_a0 *cloudformation.SetTypeDefaultVersionInput) (*request.Request, *cloudformation.SetTypeDefaultVersionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.SetStackPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetTypeDefaultVersionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3180,20 +4275,20 @@ func (_m *CloudFormationAPI) SetStackPolicyRequest(_a0 *cloudformation.SetStackP
 		}
 	}
 
-	var r1 *cloudformation.SetStackPolicyOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.SetStackPolicyInput) *cloudformation.SetStackPolicyOutput); ok {
+	var r1 *cloudformation.SetTypeDefaultVersionOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetTypeDefaultVersionInput) *cloudformation.SetTypeDefaultVersionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.SetStackPolicyOutput)
+			r1 = ret.Get(1).(*cloudformation.SetTypeDefaultVersionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// SetStackPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) SetStackPolicyWithContext(_a0 context.Context, _a1 *cloudformation.SetStackPolicyInput, _a2 ...request.Option) (*cloudformation.SetStackPolicyOutput, error) {
+// SetTypeDefaultVersionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) SetTypeDefaultVersionWithContext(_a0 context.Context, _a1 *cloudformation.SetTypeDefaultVersionInput, _a2 ...request.Option) (*cloudformation.SetTypeDefaultVersionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3203,17 +4298,17 @@ func (_m *CloudFormationAPI) SetStackPolicyWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.SetStackPolicyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.SetStackPolicyInput, ...request.Option) *cloudformation.SetStackPolicyOutput); ok {
+	var r0 *cloudformation.SetTypeDefaultVersionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.SetTypeDefaultVersionInput, ...request.Option) *cloudformation.SetTypeDefaultVersionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.SetStackPolicyOutput)
+			r0 = ret.Get(0).(*cloudformation.SetTypeDefaultVersionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.SetStackPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.SetTypeDefaultVersionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3908,6 +5003,76 @@ func (_m *CloudFormationAPI) WaitUntilStackExistsWithContext(_a0 context.Context
 	return r0
 }
 
+// WaitUntilStackImportComplete provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) WaitUntilStackImportComplete(_a0 *cloudformation.DescribeStacksInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilStackImportCompleteWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) WaitUntilStackImportCompleteWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStacksInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStacksInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilStackRollbackComplete provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) WaitUntilStackRollbackComplete(_a0 *cloudformation.DescribeStacksInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilStackRollbackCompleteWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) WaitUntilStackRollbackCompleteWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStacksInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStacksInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WaitUntilStackUpdateComplete provides a mock function with given fields: _a0
 func (_m *CloudFormationAPI) WaitUntilStackUpdateComplete(_a0 *cloudformation.DescribeStacksInput) error {
 	ret := _m.Called(_a0)
@@ -3942,3 +5107,38 @@ func (_m *CloudFormationAPI) WaitUntilStackUpdateCompleteWithContext(_a0 context
 
 	return r0
 }
+
+// WaitUntilTypeRegistrationComplete provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) WaitUntilTypeRegistrationComplete(_a0 *cloudformation.DescribeTypeRegistrationInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeRegistrationInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilTypeRegistrationCompleteWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) WaitUntilTypeRegistrationCompleteWithContext(_a0 context.Context, _a1 *cloudformation.DescribeTypeRegistrationInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeTypeRegistrationInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
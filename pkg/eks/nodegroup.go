@@ -2,9 +2,9 @@ package eks
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
@@ -12,6 +12,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/utils"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -100,13 +101,51 @@ func LogWindowsCompatibility(nodeGroups []*api.NodeGroup, clusterMeta *api.Clust
 	}
 }
 
-// WaitForNodes waits till the nodes are ready
-func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
+// ValidateARMCompatibility checks that the version of the control plane supports
+// nodegroups with ARM instance types
+func ValidateARMCompatibility(nodeGroups []*api.NodeGroup, controlPlaneVersion string) error {
+	if len(armNodeGroupNames(nodeGroups)) == 0 {
+		return nil
+	}
+
+	supportsARM, err := utils.IsMinVersion(api.Version1_14, controlPlaneVersion)
+	if err != nil {
+		return err
+	}
+	if !supportsARM {
+		return errors.New("ARM nodes are only supported on Kubernetes 1.14 and above")
+	}
+	return nil
+}
+
+// LogARMCompatibility logs ARM compatibility messages
+func LogARMCompatibility(nodeGroups []*api.NodeGroup) {
+	armNodeGroups := armNodeGroupNames(nodeGroups)
+	if len(armNodeGroups) == 0 {
+		return
+	}
+	logger.Warning("nodegroup(s) %s use ARM instance types, the default add-ons (aws-node, kube-proxy and coredns) only run on amd64 nodes", strings.Join(armNodeGroups, ", "))
+	logger.Warning("update the add-ons to images that support arm64, and keep an amd64 nodegroup for coredns until then, see https://docs.aws.amazon.com/eks/latest/userguide/arm-support.html")
+}
+
+// armNodeGroupNames returns the names of the nodegroups that use ARM instance types
+func armNodeGroupNames(nodeGroups []*api.NodeGroup) []string {
+	names := []string{}
+	for _, ng := range nodeGroups {
+		if api.HasARMInstances(ng) {
+			names = append(names, ng.Name)
+		}
+	}
+	return names
+}
+
+// WaitForNodes waits till the nodes are ready, for at most the given timeout
+func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng *api.NodeGroup, timeout time.Duration) error {
 	if ng.MinSize == nil || *ng.MinSize == 0 {
 		return nil
 	}
-	timer := time.After(c.Provider.WaitTimeout())
-	timeout := false
+	timer := time.After(timeout)
+	timedOut := false
 	readyNodes := sets.NewString()
 	watcher, err := clientSet.CoreV1().Nodes().Watch(ng.ListOptions())
 	if err != nil {
@@ -119,7 +158,7 @@ func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng *api.N
 	}
 
 	logger.Info("waiting for at least %d node(s) to become ready in %q", *ng.MinSize, ng.Name)
-	for !timeout && counter < *ng.MinSize {
+	for !timedOut && counter < *ng.MinSize {
 		select {
 		case event := <-watcher.ResultChan():
 			logger.Debug("event = %#v", event)
@@ -136,12 +175,12 @@ func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng *api.N
 				}
 			}
 		case <-timer:
-			timeout = true
+			timedOut = true
 		}
 	}
 	watcher.Stop()
-	if timeout {
-		return fmt.Errorf("timed out (after %s) waiting for at least %d nodes to join the cluster and become ready in %q", c.Provider.WaitTimeout(), *ng.MinSize, ng.Name)
+	if timedOut {
+		return fmt.Errorf("timed out (after %s) waiting for at least %d nodes to join the cluster and become ready in %q", timeout, *ng.MinSize, ng.Name)
 	}
 
 	if _, err = getNodes(clientSet, ng); err != nil {
@@ -0,0 +1,90 @@
+package eks
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+// UpdateNodeGroupLabels sets and removes labels on the live nodes of the nodegroup,
+// and updates the cluster-autoscaler node template tags of its auto scaling group
+// so that scaling from zero takes the labels into account
+func (c *ClusterProvider) UpdateNodeGroupLabels(clientSet kubernetes.Interface, ng *api.NodeGroup, asgName string, set map[string]string, remove []string) error {
+	if err := c.updateNodeTemplateTags(asgName, api.NodeTemplateLabelTagPrefix, set, remove); err != nil {
+		return err
+	}
+	if err := kubewrapper.UpdateNodeLabels(clientSet, ng.ListOptions(), set, remove); err != nil {
+		return errors.Wrapf(err, "updating labels of nodegroup %q", ng.Name)
+	}
+	return nil
+}
+
+// UpdateNodeGroupTaints sets and removes taints on the live nodes of the nodegroup,
+// and updates the cluster-autoscaler node template tags of its auto scaling group;
+// taints are given in the same `key: value:Effect` format as in the config file
+func (c *ClusterProvider) UpdateNodeGroupTaints(clientSet kubernetes.Interface, ng *api.NodeGroup, asgName string, set map[string]string, remove []string) error {
+	taints := []corev1.Taint{}
+	for key, taint := range set {
+		value, effect, err := api.ParseTaintValue(taint)
+		if err != nil {
+			return errors.Wrapf(err, "taint %q", key)
+		}
+		taints = append(taints, corev1.Taint{Key: key, Value: value, Effect: corev1.TaintEffect(effect)})
+	}
+	sort.Slice(taints, func(i, j int) bool { return taints[i].Key < taints[j].Key })
+
+	if err := c.updateNodeTemplateTags(asgName, api.NodeTemplateTaintTagPrefix, set, remove); err != nil {
+		return err
+	}
+	if err := kubewrapper.UpdateNodeTaints(clientSet, ng.ListOptions(), taints, remove); err != nil {
+		return errors.Wrapf(err, "updating taints of nodegroup %q", ng.Name)
+	}
+	return nil
+}
+
+func (c *ClusterProvider) updateNodeTemplateTags(asgName, prefix string, set map[string]string, remove []string) error {
+	newTag := func(key string) *autoscaling.Tag {
+		return &autoscaling.Tag{
+			ResourceId:        aws.String(asgName),
+			ResourceType:      aws.String("auto-scaling-group"),
+			Key:               aws.String(prefix + key),
+			PropagateAtLaunch: aws.Bool(false),
+		}
+	}
+
+	if len(set) > 0 {
+		keys := []string{}
+		for key := range set {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		tags := []*autoscaling.Tag{}
+		for _, key := range keys {
+			tag := newTag(key)
+			tag.Value = aws.String(set[key])
+			tags = append(tags, tag)
+		}
+		if _, err := c.Provider.ASG().CreateOrUpdateTags(&autoscaling.CreateOrUpdateTagsInput{Tags: tags}); err != nil {
+			return errors.Wrapf(err, "tagging auto scaling group %q", asgName)
+		}
+	}
+
+	if len(remove) > 0 {
+		tags := []*autoscaling.Tag{}
+		for _, key := range remove {
+			tags = append(tags, newTag(key))
+		}
+		if _, err := c.Provider.ASG().DeleteTags(&autoscaling.DeleteTagsInput{Tags: tags}); err != nil {
+			return errors.Wrapf(err, "removing tags from auto scaling group %q", asgName)
+		}
+	}
+	return nil
+}
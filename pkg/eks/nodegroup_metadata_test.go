@@ -0,0 +1,100 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("nodegroup labels and taints", func() {
+	const asgName = "eksctl-test-cluster-nodegroup-ng-1-NodeGroup-ABCDEF"
+
+	var (
+		p         *mockprovider.MockProvider
+		ctl       *ClusterProvider
+		ng        *api.NodeGroup
+		clientSet *fake.Clientset
+
+		createdTags []*autoscaling.Tag
+		deletedTags []*autoscaling.Tag
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		ctl = &ClusterProvider{Provider: p}
+
+		cfg := api.NewClusterConfig()
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		createdTags = nil
+		deletedTags = nil
+
+		clientSet = fake.NewSimpleClientset(&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "node-1",
+				Labels: map[string]string{
+					"alpha.eksctl.io/nodegroup-name": "ng-1",
+					"old":                            "label",
+				},
+			},
+		})
+
+		p.MockASG().On("CreateOrUpdateTags", mock.Anything).Run(func(args mock.Arguments) {
+			createdTags = append(createdTags, args.Get(0).(*autoscaling.CreateOrUpdateTagsInput).Tags...)
+		}).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+
+		p.MockASG().On("DeleteTags", mock.Anything).Run(func(args mock.Arguments) {
+			deletedTags = append(deletedTags, args.Get(0).(*autoscaling.DeleteTagsInput).Tags...)
+		}).Return(&autoscaling.DeleteTagsOutput{}, nil)
+	})
+
+	It("updates the node template tags and the nodes labels", func() {
+		err := ctl.UpdateNodeGroupLabels(clientSet, ng, asgName, map[string]string{"role": "gpu"}, []string{"old"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(createdTags).To(HaveLen(1))
+		Expect(*createdTags[0].ResourceId).To(Equal(asgName))
+		Expect(*createdTags[0].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/label/role"))
+		Expect(*createdTags[0].Value).To(Equal("gpu"))
+		Expect(*createdTags[0].PropagateAtLaunch).To(BeFalse())
+
+		Expect(deletedTags).To(HaveLen(1))
+		Expect(*deletedTags[0].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/label/old"))
+
+		node, err := clientSet.CoreV1().Nodes().Get("node-1", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Labels).To(HaveKeyWithValue("role", "gpu"))
+		Expect(node.Labels).ToNot(HaveKey("old"))
+	})
+
+	It("updates the node template tags and the nodes taints", func() {
+		err := ctl.UpdateNodeGroupTaints(clientSet, ng, asgName, map[string]string{"nvidia.com/gpu": "true:NoSchedule"}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(createdTags).To(HaveLen(1))
+		Expect(*createdTags[0].Key).To(Equal("k8s.io/cluster-autoscaler/node-template/taint/nvidia.com/gpu"))
+		Expect(*createdTags[0].Value).To(Equal("true:NoSchedule"))
+		Expect(deletedTags).To(BeEmpty())
+
+		node, err := clientSet.CoreV1().Nodes().Get("node-1", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(node.Spec.Taints).To(Equal([]corev1.Taint{
+			{Key: "nvidia.com/gpu", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+		}))
+	})
+
+	It("rejects invalid taints before making any changes", func() {
+		err := ctl.UpdateNodeGroupTaints(clientSet, ng, asgName, map[string]string{"dedicated": "true"}, nil)
+		Expect(err).To(MatchError(`taint "dedicated": "true" is not of the form value:Effect`))
+		Expect(createdTags).To(BeEmpty())
+	})
+})
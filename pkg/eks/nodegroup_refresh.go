@@ -0,0 +1,60 @@
+package eks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils/waiters"
+)
+
+// RefreshNodeGroupInstances starts an instance refresh of the auto scaling group
+// of the nodegroup, which replaces its instances in a rolling fashion, and waits
+// up to timeout for it to complete
+func (c *ClusterProvider) RefreshNodeGroupInstances(ng *api.NodeGroup, asgName string, timeout time.Duration) error {
+	preferences := &autoscaling.RefreshPreferences{}
+	if ng.InstanceRefresh != nil {
+		if ng.InstanceRefresh.MinHealthyPercentage != nil {
+			preferences.MinHealthyPercentage = aws.Int64(int64(*ng.InstanceRefresh.MinHealthyPercentage))
+		}
+		if ng.InstanceRefresh.InstanceWarmup != nil {
+			preferences.InstanceWarmup = aws.Int64(int64(*ng.InstanceRefresh.InstanceWarmup))
+		}
+	}
+
+	output, err := c.Provider.ASG().StartInstanceRefresh(&autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: &asgName,
+		Strategy:             aws.String(autoscaling.RefreshStrategyRolling),
+		Preferences:          preferences,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "starting instance refresh of nodegroup %q", ng.Name)
+	}
+
+	refreshID := aws.StringValue(output.InstanceRefreshId)
+	logger.Info("started instance refresh %q of nodegroup %q", refreshID, ng.Name)
+
+	newRequest := func() *request.Request {
+		req, _ := c.Provider.ASG().DescribeInstanceRefreshesRequest(&autoscaling.DescribeInstanceRefreshesInput{
+			AutoScalingGroupName: &asgName,
+			InstanceRefreshIds:   []*string{&refreshID},
+		})
+		return req
+	}
+	acceptors := waiters.MakeAcceptors(
+		"InstanceRefreshes[0].Status",
+		autoscaling.InstanceRefreshStatusSuccessful,
+		[]string{
+			autoscaling.InstanceRefreshStatusFailed,
+			autoscaling.InstanceRefreshStatusCancelled,
+		},
+	)
+	msg := fmt.Sprintf("waiting for instance refresh %q of nodegroup %q", refreshID, ng.Name)
+	return waiters.Wait(ng.Name, msg, acceptors, newRequest, timeout, nil)
+}
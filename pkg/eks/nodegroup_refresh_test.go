@@ -0,0 +1,92 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("nodegroup instance refresh", func() {
+	const asgName = "eksctl-test-cluster-nodegroup-ng-1-NodeGroup-ABCDEF"
+
+	var (
+		p   *mockprovider.MockProvider
+		ctl *ClusterProvider
+
+		startInput    *autoscaling.StartInstanceRefreshInput
+		describeInput *autoscaling.DescribeInstanceRefreshesInput
+		status        string
+	)
+
+	BeforeEach(func() {
+		startInput, describeInput = nil, &autoscaling.DescribeInstanceRefreshesInput{}
+		status = autoscaling.InstanceRefreshStatusSuccessful
+
+		p = mockprovider.NewMockProvider()
+		ctl = &ClusterProvider{
+			Provider: p,
+			Status:   &ProviderStatus{},
+		}
+
+		p.MockASG().On("StartInstanceRefresh", mock.MatchedBy(func(input *autoscaling.StartInstanceRefreshInput) bool {
+			startInput = input
+			return true
+		})).Return(&autoscaling.StartInstanceRefreshOutput{InstanceRefreshId: aws.String("refresh-1")}, nil)
+
+		describeOutput := &autoscaling.DescribeInstanceRefreshesOutput{}
+		p.MockASG().On("DescribeInstanceRefreshesRequest", mock.MatchedBy(func(input *autoscaling.DescribeInstanceRefreshesInput) bool {
+			*describeInput = *input
+			describeOutput.InstanceRefreshes = []*autoscaling.InstanceRefresh{
+				{
+					InstanceRefreshId:  aws.String("refresh-1"),
+					Status:             aws.String(status),
+					PercentageComplete: aws.Int64(100),
+				},
+			}
+			return true
+		})).Return(p.Client.MockRequestForGivenOutput(describeInput, describeOutput), describeOutput)
+	})
+
+	It("should start an instance refresh with the preferences of the nodegroup and wait for it", func() {
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceRefresh = &api.InstanceRefresh{
+			MinHealthyPercentage: aws.Int(75),
+			InstanceWarmup:       aws.Int(120),
+		}
+
+		Expect(ctl.RefreshNodeGroupInstances(ng, asgName, ctl.Provider.WaitTimeout())).To(Succeed())
+
+		Expect(*startInput.AutoScalingGroupName).To(Equal(asgName))
+		Expect(*startInput.Strategy).To(Equal("Rolling"))
+		Expect(startInput.Preferences).To(Equal(&autoscaling.RefreshPreferences{
+			MinHealthyPercentage: aws.Int64(75),
+			InstanceWarmup:       aws.Int64(120),
+		}))
+		Expect(*describeInput.AutoScalingGroupName).To(Equal(asgName))
+		Expect(aws.StringValueSlice(describeInput.InstanceRefreshIds)).To(Equal([]string{"refresh-1"}))
+	})
+
+	It("should leave the preferences to the auto scaling group when they are not set", func() {
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		Expect(ctl.RefreshNodeGroupInstances(ng, asgName, ctl.Provider.WaitTimeout())).To(Succeed())
+		Expect(startInput.Preferences).To(Equal(&autoscaling.RefreshPreferences{}))
+	})
+
+	It("should fail when the instance refresh fails", func() {
+		status = autoscaling.InstanceRefreshStatusFailed
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		Expect(ctl.RefreshNodeGroupInstances(ng, asgName, ctl.Provider.WaitTimeout())).ToNot(Succeed())
+	})
+})
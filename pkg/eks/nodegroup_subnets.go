@@ -0,0 +1,120 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// ValidateNodeGroupSubnets checks the subnets of a nodegroup that are set with subnets,
+// they must be in the VPC of the cluster, in zones that are enabled in the account and
+// that offer the instance types of the nodegroup; the Outpost ARN of the nodegroup is
+// set from the subnets when they are on an Outpost
+func (c *ClusterProvider) ValidateNodeGroupSubnets(spec *api.ClusterConfig, ng *api.NodeGroup) error {
+	if len(ng.Subnets) == 0 {
+		return nil
+	}
+
+	// subnets created later cannot be in a VPC that eksctl is yet to create
+	if spec.VPC.ID == "" {
+		return fmt.Errorf("subnets of nodegroup %q can only be used with an existing VPC", ng.Name)
+	}
+
+	subnetsOutput, err := c.Provider.EC2().DescribeSubnets(&ec2.DescribeSubnetsInput{SubnetIds: aws.StringSlice(ng.Subnets)})
+	if err != nil {
+		return errors.Wrapf(err, "describing subnets of nodegroup %q", ng.Name)
+	}
+
+	zones := []string{}
+	for _, subnet := range subnetsOutput.Subnets {
+		subnetID := aws.StringValue(subnet.SubnetId)
+		if aws.StringValue(subnet.VpcId) != spec.VPC.ID {
+			return fmt.Errorf("subnet %s of nodegroup %q is not in the VPC of the cluster (%s)", subnetID, ng.Name, spec.VPC.ID)
+		}
+
+		outpostARN := aws.StringValue(subnet.OutpostArn)
+		if outpostARN != "" && !ng.HasOutpost() {
+			logger.Info("nodegroup %q will be launched on Outpost %s", ng.Name, outpostARN)
+			ng.OutpostARN = outpostARN
+		}
+		if outpostARN != ng.OutpostARN {
+			if outpostARN == "" {
+				return fmt.Errorf("subnet %s of nodegroup %q is not on Outpost %s", subnetID, ng.Name, ng.OutpostARN)
+			}
+			return fmt.Errorf("subnet %s of nodegroup %q is on Outpost %s, not on %s", subnetID, ng.Name, outpostARN, ng.OutpostARN)
+		}
+
+		zones = append(zones, aws.StringValue(subnet.AvailabilityZone))
+	}
+
+	zonesOutput, err := c.Provider.EC2().DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+		AllAvailabilityZones: aws.Bool(true),
+		ZoneNames:            aws.StringSlice(zones),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing zones of nodegroup %q", ng.Name)
+	}
+	for _, zone := range zonesOutput.AvailabilityZones {
+		zoneName := aws.StringValue(zone.ZoneName)
+		if aws.StringValue(zone.OptInStatus) == ec2.AvailabilityZoneOptInStatusNotOptedIn {
+			return fmt.Errorf("zone %s of nodegroup %q is not enabled in the account, opt in to it first", zoneName, ng.Name)
+		}
+		// instances in Wavelength Zones cannot have public IP addresses, they use
+		// carrier IP addresses instead
+		if aws.StringValue(zone.ZoneType) == api.ZoneTypeWavelengthZone && !ng.PrivateNetworking {
+			return fmt.Errorf("nodegroup %q must use privateNetworking to launch nodes in Wavelength Zone %s", ng.Name, zoneName)
+		}
+	}
+
+	// the instance types of an Outpost depend on its capacity, which EC2 doesn't describe
+	if ng.HasOutpost() {
+		return nil
+	}
+	return c.checkInstanceTypeOfferings(ng, zones)
+}
+
+// checkInstanceTypeOfferings checks that the instance types of the nodegroup are offered
+// in all the given zones, Local Zones and Wavelength Zones offer only a few instance types
+func (c *ClusterProvider) checkInstanceTypeOfferings(ng *api.NodeGroup, zones []string) error {
+	instanceTypes := []string{ng.InstanceType}
+	if api.HasMixedInstances(ng) {
+		instanceTypes = ng.InstancesDistribution.InstanceTypes
+	}
+
+	input := &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		Filters: []*ec2.Filter{
+			{Name: aws.String("location"), Values: aws.StringSlice(zones)},
+			{Name: aws.String("instance-type"), Values: aws.StringSlice(instanceTypes)},
+		},
+	}
+	offered := map[string]bool{}
+	err := c.Provider.EC2().DescribeInstanceTypeOfferingsPages(input, func(output *ec2.DescribeInstanceTypeOfferingsOutput, _ bool) bool {
+		for _, offering := range output.InstanceTypeOfferings {
+			offered[aws.StringValue(offering.Location)+"/"+aws.StringValue(offering.InstanceType)] = true
+		}
+		return true
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing instance types offered in the zones of nodegroup %q", ng.Name)
+	}
+
+	for _, zone := range zones {
+		missing := []string{}
+		for _, instanceType := range instanceTypes {
+			if !offered[zone+"/"+instanceType] {
+				missing = append(missing, instanceType)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("instance type(s) %s of nodegroup %q are not offered in zone %s", strings.Join(missing, ", "), ng.Name, zone)
+		}
+	}
+	return nil
+}
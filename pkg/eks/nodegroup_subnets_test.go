@@ -0,0 +1,141 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("nodegroup subnets", func() {
+	const outpostARN = "arn:aws:outposts:us-west-2:000000000000:outpost/op-0123456789abcdef0"
+
+	var (
+		p   *mockprovider.MockProvider
+		ctl *ClusterProvider
+		cfg *api.ClusterConfig
+		ng  *api.NodeGroup
+
+		vpcID, outpost, zoneType, optInStatus, offeredType string
+	)
+
+	BeforeEach(func() {
+		vpcID, outpost, zoneType, optInStatus, offeredType = "vpc-1", "", api.ZoneTypeLocalZone, ec2.AvailabilityZoneOptInStatusOptedIn, "t3.medium"
+
+		p = mockprovider.NewMockProvider()
+		ctl = &ClusterProvider{
+			Provider: p,
+			Status:   &ProviderStatus{},
+		}
+
+		p.MockEC2().On("DescribeSubnets", mock.MatchedBy(func(input *ec2.DescribeSubnetsInput) bool {
+			return Expect(aws.StringValueSlice(input.SubnetIds)).To(Equal([]string{"subnet-lax"}))
+		})).Return(func(*ec2.DescribeSubnetsInput) *ec2.DescribeSubnetsOutput {
+			subnet := &ec2.Subnet{
+				SubnetId:         aws.String("subnet-lax"),
+				VpcId:            aws.String(vpcID),
+				AvailabilityZone: aws.String("us-west-2-lax-1a"),
+			}
+			if outpost != "" {
+				subnet.OutpostArn = aws.String(outpost)
+			}
+			return &ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{subnet}}
+		}, nil)
+
+		p.MockEC2().On("DescribeAvailabilityZones", mock.MatchedBy(func(input *ec2.DescribeAvailabilityZonesInput) bool {
+			return Expect(*input.AllAvailabilityZones).To(BeTrue()) &&
+				Expect(aws.StringValueSlice(input.ZoneNames)).To(Equal([]string{"us-west-2-lax-1a"}))
+		})).Return(func(*ec2.DescribeAvailabilityZonesInput) *ec2.DescribeAvailabilityZonesOutput {
+			return &ec2.DescribeAvailabilityZonesOutput{
+				AvailabilityZones: []*ec2.AvailabilityZone{
+					{
+						ZoneName:    aws.String("us-west-2-lax-1a"),
+						ZoneType:    aws.String(zoneType),
+						OptInStatus: aws.String(optInStatus),
+					},
+				},
+			}
+		}, nil)
+
+		p.MockEC2().On("DescribeInstanceTypeOfferingsPages", mock.MatchedBy(func(input *ec2.DescribeInstanceTypeOfferingsInput) bool {
+			return Expect(*input.LocationType).To(Equal(ec2.LocationTypeAvailabilityZone))
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeInstanceTypeOfferingsOutput, bool) bool)
+			consume(&ec2.DescribeInstanceTypeOfferingsOutput{
+				InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+					{
+						InstanceType: aws.String(offeredType),
+						LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+						Location:     aws.String("us-west-2-lax-1a"),
+					},
+				},
+			}, true)
+		}).Return(nil)
+
+		cfg = api.NewClusterConfig()
+		cfg.VPC.ID = "vpc-1"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "edge"
+		ng.InstanceType = "t3.medium"
+		ng.Subnets = []string{"subnet-lax"}
+	})
+
+	It("should do nothing for nodegroups without subnets", func() {
+		ng.Subnets = nil
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(Succeed())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeSubnets", mock.Anything)
+	})
+
+	It("should accept subnets of a Local Zone that offers the instance type", func() {
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(Succeed())
+		p.MockEC2().AssertNumberOfCalls(GinkgoT(), "DescribeInstanceTypeOfferingsPages", 1)
+	})
+
+	It("should reject instance types that are not offered in the zone", func() {
+		offeredType = "t3.xlarge"
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(MatchError(`instance type(s) t3.medium of nodegroup "edge" are not offered in zone us-west-2-lax-1a`))
+	})
+
+	It("should reject subnets of other VPCs", func() {
+		vpcID = "vpc-2"
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(MatchError(ContainSubstring("is not in the VPC of the cluster")))
+	})
+
+	It("should reject subnets when the VPC is yet to be created", func() {
+		cfg.VPC.ID = ""
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(MatchError(`subnets of nodegroup "edge" can only be used with an existing VPC`))
+	})
+
+	It("should reject zones that are not enabled", func() {
+		optInStatus = ec2.AvailabilityZoneOptInStatusNotOptedIn
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(MatchError(ContainSubstring("is not enabled in the account")))
+	})
+
+	It("should require private networking in Wavelength Zones", func() {
+		zoneType = api.ZoneTypeWavelengthZone
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(MatchError(ContainSubstring("must use privateNetworking")))
+
+		ng.PrivateNetworking = true
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(Succeed())
+	})
+
+	It("should set the Outpost ARN from the subnets", func() {
+		outpost = outpostARN
+		zoneType = api.ZoneTypeAvailabilityZone
+
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(Succeed())
+		Expect(ng.OutpostARN).To(Equal(outpostARN))
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeInstanceTypeOfferingsPages", mock.Anything, mock.Anything)
+	})
+
+	It("should reject subnets that are not on the Outpost of the nodegroup", func() {
+		ng.OutpostARN = outpostARN
+		Expect(ctl.ValidateNodeGroupSubnets(cfg, ng)).To(MatchError(ContainSubstring("is not on Outpost")))
+	})
+})
@@ -0,0 +1,223 @@
+package eks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// nodeReadyPollInterval is how often nodes are listed while waiting
+// for replacement instances to join the cluster
+var nodeReadyPollInterval = 10 * time.Second
+
+// InstanceReplacementOptions controls how instances of a nodegroup are replaced
+type InstanceReplacementOptions struct {
+	// MaxSurge is the number of instances that are added before the same
+	// number of old instances is drained and terminated
+	MaxSurge int
+	// DrainTimeout is how long to wait for a batch of nodes to be drained
+	DrainTimeout time.Duration
+	// NodeJoinTimeout is how long to wait for the nodes of a batch of new
+	// instances to become ready, the provider timeout is used when it's not set
+	NodeJoinTimeout time.Duration
+}
+
+// ReplaceNodeGroupInstances replaces all instances of the auto scaling group
+// of the nodegroup in batches of opts.MaxSurge; for each batch the group is
+// scaled up, the new nodes are waited for, and the old nodes are cordoned and
+// drained (respecting PodDisruptionBudgets) before they are terminated
+func (c *ClusterProvider) ReplaceNodeGroupInstances(clientSet kubernetes.Interface, ng *api.NodeGroup, asgName string, opts InstanceReplacementOptions) error {
+	if opts.MaxSurge < 1 {
+		return fmt.Errorf("max surge must be at least 1, got %d", opts.MaxSurge)
+	}
+
+	group, err := c.describeAutoScalingGroup(asgName)
+	if err != nil {
+		return err
+	}
+
+	oldInstances := []string{}
+	for _, instance := range group.Instances {
+		oldInstances = append(oldInstances, *instance.InstanceId)
+	}
+	sort.Strings(oldInstances)
+
+	if len(oldInstances) == 0 {
+		logger.Info("nodegroup %q has no instances to replace", ng.Name)
+		return nil
+	}
+	logger.Info("replacing %d instance(s) of nodegroup %q, %d at a time", len(oldInstances), ng.Name, opts.MaxSurge)
+
+	originalMaxSize := *group.MaxSize
+
+	if opts.NodeJoinTimeout == 0 {
+		opts.NodeJoinTimeout = c.Provider.WaitTimeout()
+	}
+
+	for start := 0; start < len(oldInstances); start += opts.MaxSurge {
+		end := start + opts.MaxSurge
+		if end > len(oldInstances) {
+			end = len(oldInstances)
+		}
+		batch := oldInstances[start:end]
+
+		if err := c.replaceInstances(clientSet, ng, asgName, originalMaxSize, batch, opts); err != nil {
+			return errors.Wrapf(err, "replacing instances %v of nodegroup %q", batch, ng.Name)
+		}
+	}
+
+	if _, err := c.Provider.ASG().UpdateAutoScalingGroup(&autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: &asgName,
+		MaxSize:              &originalMaxSize,
+	}); err != nil {
+		return errors.Wrapf(err, "restoring max size of auto scaling group %q", asgName)
+	}
+
+	logger.Success("replaced all %d instance(s) of nodegroup %q", len(oldInstances), ng.Name)
+	return nil
+}
+
+func (c *ClusterProvider) replaceInstances(clientSet kubernetes.Interface, ng *api.NodeGroup, asgName string, originalMaxSize int64, instanceIDs []string, opts InstanceReplacementOptions) error {
+	knownNodes, err := listNodeNames(clientSet, ng)
+	if err != nil {
+		return err
+	}
+
+	group, err := c.describeAutoScalingGroup(asgName)
+	if err != nil {
+		return err
+	}
+
+	desiredCapacity := *group.DesiredCapacity + int64(len(instanceIDs))
+	maxSize := originalMaxSize
+	if desiredCapacity > maxSize {
+		maxSize = desiredCapacity
+	}
+
+	logger.Info("scaling auto scaling group %q to %d instance(s) to add replacements for %v", asgName, desiredCapacity, instanceIDs)
+	if _, err := c.Provider.ASG().UpdateAutoScalingGroup(&autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: &asgName,
+		DesiredCapacity:      &desiredCapacity,
+		MaxSize:              &maxSize,
+	}); err != nil {
+		return errors.Wrapf(err, "scaling up auto scaling group %q", asgName)
+	}
+
+	if err := c.waitForNewNodes(clientSet, ng, knownNodes, len(instanceIDs), opts.NodeJoinTimeout); err != nil {
+		return err
+	}
+
+	oldNodes, err := findNodesForInstances(clientSet, ng, instanceIDs)
+	if err != nil {
+		return err
+	}
+
+	if err := drain.Nodes(clientSet, oldNodes, opts.DrainTimeout); err != nil {
+		return err
+	}
+
+	for _, id := range instanceIDs {
+		logger.Info("terminating instance %q", id)
+		if _, err := c.Provider.ASG().TerminateInstanceInAutoScalingGroup(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+			InstanceId:                     aws.String(id),
+			ShouldDecrementDesiredCapacity: aws.Bool(true),
+		}); err != nil {
+			return errors.Wrapf(err, "terminating instance %q", id)
+		}
+	}
+	return nil
+}
+
+func (c *ClusterProvider) describeAutoScalingGroup(asgName string) (*autoscaling.Group, error) {
+	groups := []*autoscaling.Group{}
+	if err := c.Provider.ASG().DescribeAutoScalingGroupsPages(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []*string{&asgName},
+	}, func(p *autoscaling.DescribeAutoScalingGroupsOutput, _ bool) bool {
+		groups = append(groups, p.AutoScalingGroups...)
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "describing auto scaling group %q", asgName)
+	}
+	if len(groups) != 1 {
+		return nil, fmt.Errorf("auto scaling group %q not found", asgName)
+	}
+	return groups[0], nil
+}
+
+// waitForNewNodes waits until count ready nodes that are not in knownNodes have joined the nodegroup,
+// for at most the given timeout
+func (c *ClusterProvider) waitForNewNodes(clientSet kubernetes.Interface, ng *api.NodeGroup, knownNodes sets.String, count int, timeout time.Duration) error {
+	logger.Info("waiting for %d new node(s) to become ready in %q", count, ng.Name)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		nodes, err := clientSet.CoreV1().Nodes().List(ng.ListOptions())
+		if err != nil {
+			return errors.Wrap(err, "listing nodes")
+		}
+		newNodes := []string{}
+		for i := range nodes.Items {
+			node := &nodes.Items[i]
+			if !knownNodes.Has(node.Name) && isNodeReady(node) {
+				newNodes = append(newNodes, node.Name)
+			}
+		}
+		if len(newNodes) >= count {
+			logger.Info("new node(s) %v are ready in %q", newNodes, ng.Name)
+			return nil
+		}
+
+		select {
+		case <-time.After(nodeReadyPollInterval):
+		case <-timer.C:
+			return fmt.Errorf("timed out (after %s) waiting for %d new node(s) to join the cluster and become ready in %q", timeout, count, ng.Name)
+		}
+	}
+}
+
+func listNodeNames(clientSet kubernetes.Interface, ng *api.NodeGroup) (sets.String, error) {
+	nodes, err := clientSet.CoreV1().Nodes().List(ng.ListOptions())
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+	names := sets.NewString()
+	for _, node := range nodes.Items {
+		names.Insert(node.Name)
+	}
+	return names, nil
+}
+
+// findNodesForInstances returns nodes of the nodegroup backed by the given instances,
+// based on the instance ID in the provider ID (aws:///<az>/<instance-id>)
+func findNodesForInstances(clientSet kubernetes.Interface, ng *api.NodeGroup, instanceIDs []string) ([]corev1.Node, error) {
+	nodes, err := clientSet.CoreV1().Nodes().List(ng.ListOptions())
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+	ids := sets.NewString(instanceIDs...)
+	found := []corev1.Node{}
+	for _, node := range nodes.Items {
+		providerID := node.Spec.ProviderID
+		if ids.Has(providerID[strings.LastIndex(providerID, "/")+1:]) {
+			found = append(found, node)
+		}
+	}
+	if len(found) != len(instanceIDs) {
+		logger.Warning("found %d node(s) for %d instance(s) %v, instances without a node will be terminated without draining", len(found), len(instanceIDs), instanceIDs)
+	}
+	return found, nil
+}
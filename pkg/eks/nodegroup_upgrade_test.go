@@ -0,0 +1,172 @@
+package eks_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("nodegroup instance replacement", func() {
+	const asgName = "eksctl-test-cluster-nodegroup-ng-1-NodeGroup-ABCDEF"
+
+	var (
+		p         *mockprovider.MockProvider
+		ctl       *ClusterProvider
+		ng        *api.NodeGroup
+		clientSet *fake.Clientset
+
+		desiredCapacity int64
+		instances       []string
+		newInstances    int
+
+		updates    []autoscaling.UpdateAutoScalingGroupInput
+		terminated []string
+	)
+
+	newNode := func(instanceID string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("ip-%s.us-west-2.compute.internal", instanceID),
+				Labels: map[string]string{
+					"alpha.eksctl.io/nodegroup-name": ng.Name,
+				},
+			},
+			Spec: corev1.NodeSpec{
+				ProviderID: "aws:///us-west-2a/" + instanceID,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		ctl = &ClusterProvider{Provider: p}
+
+		cfg := api.NewClusterConfig()
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		instances = []string{"i-old-1", "i-old-2", "i-old-3"}
+		desiredCapacity = int64(len(instances))
+		newInstances = 0
+		updates = nil
+		terminated = nil
+
+		clientSet = fake.NewSimpleClientset()
+		for _, id := range instances {
+			_, err := clientSet.CoreV1().Nodes().Create(newNode(id))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		p.MockASG().On("DescribeAutoScalingGroupsPages", mock.MatchedBy(func(input *autoscaling.DescribeAutoScalingGroupsInput) bool {
+			return len(input.AutoScalingGroupNames) == 1 && *input.AutoScalingGroupNames[0] == asgName
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			group := &autoscaling.Group{
+				AutoScalingGroupName: aws.String(asgName),
+				DesiredCapacity:      aws.Int64(desiredCapacity),
+				MaxSize:              aws.Int64(3),
+			}
+			for _, id := range instances {
+				group.Instances = append(group.Instances, &autoscaling.Instance{InstanceId: aws.String(id)})
+			}
+			consume := args[1].(func(*autoscaling.DescribeAutoScalingGroupsOutput, bool) bool)
+			consume(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{group}}, true)
+		}).Return(nil)
+
+		p.MockASG().On("UpdateAutoScalingGroup", mock.Anything).Run(func(args mock.Arguments) {
+			input := args.Get(0).(*autoscaling.UpdateAutoScalingGroupInput)
+			updates = append(updates, *input)
+			if input.DesiredCapacity == nil {
+				return
+			}
+			// simulate new instances joining the cluster
+			for desiredCapacity < *input.DesiredCapacity {
+				newInstances++
+				id := fmt.Sprintf("i-new-%d", newInstances)
+				instances = append(instances, id)
+				_, err := clientSet.CoreV1().Nodes().Create(newNode(id))
+				Expect(err).ToNot(HaveOccurred())
+				desiredCapacity++
+			}
+		}).Return(&autoscaling.UpdateAutoScalingGroupOutput{}, nil)
+
+		p.MockASG().On("TerminateInstanceInAutoScalingGroup", mock.Anything).Run(func(args mock.Arguments) {
+			input := args.Get(0).(*autoscaling.TerminateInstanceInAutoScalingGroupInput)
+			Expect(*input.ShouldDecrementDesiredCapacity).To(BeTrue())
+			terminated = append(terminated, *input.InstanceId)
+
+			remaining := []string{}
+			for _, id := range instances {
+				if id != *input.InstanceId {
+					remaining = append(remaining, id)
+				}
+			}
+			instances = remaining
+			desiredCapacity--
+		}).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+	})
+
+	It("replaces instances one at a time by default", func() {
+		err := ctl.ReplaceNodeGroupInstances(clientSet, ng, asgName, InstanceReplacementOptions{MaxSurge: 1})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(terminated).To(Equal([]string{"i-old-1", "i-old-2", "i-old-3"}))
+		Expect(instances).To(Equal([]string{"i-new-1", "i-new-2", "i-new-3"}))
+
+		Expect(updates).To(HaveLen(4))
+		for _, update := range updates[:3] {
+			Expect(*update.DesiredCapacity).To(Equal(int64(4)))
+			Expect(*update.MaxSize).To(Equal(int64(4)))
+		}
+		Expect(updates[3].DesiredCapacity).To(BeNil())
+		Expect(*updates[3].MaxSize).To(Equal(int64(3)))
+	})
+
+	It("cordons the old nodes before they are terminated", func() {
+		err := ctl.ReplaceNodeGroupInstances(clientSet, ng, asgName, InstanceReplacementOptions{MaxSurge: 1})
+		Expect(err).ToNot(HaveOccurred())
+
+		nodes, err := clientSet.CoreV1().Nodes().List(metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for _, node := range nodes.Items {
+			if strings.HasPrefix(node.Name, "ip-i-old") {
+				Expect(node.Spec.Unschedulable).To(BeTrue())
+			} else {
+				Expect(node.Spec.Unschedulable).To(BeFalse())
+			}
+		}
+	})
+
+	It("replaces instances in batches of max surge", func() {
+		err := ctl.ReplaceNodeGroupInstances(clientSet, ng, asgName, InstanceReplacementOptions{MaxSurge: 2})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(terminated).To(Equal([]string{"i-old-1", "i-old-2", "i-old-3"}))
+		Expect(updates).To(HaveLen(3))
+		Expect(*updates[0].DesiredCapacity).To(Equal(int64(5)))
+		Expect(*updates[1].DesiredCapacity).To(Equal(int64(4)))
+		Expect(*updates[2].MaxSize).To(Equal(int64(3)))
+	})
+
+	It("rejects max surge lower than 1", func() {
+		err := ctl.ReplaceNodeGroupInstances(clientSet, ng, asgName, InstanceReplacementOptions{MaxSurge: 0})
+		Expect(err).To(MatchError("max surge must be at least 1, got 0"))
+		Expect(updates).To(BeEmpty())
+	})
+})
@@ -0,0 +1,34 @@
+package eks
+
+import (
+	"fmt"
+
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// LoadClusterForRegistration discovers the version, service role, VPC, subnets and
+// security group of a cluster that was not created by eksctl and sets them in spec,
+// so that its cluster stack can be created
+func (c *ClusterProvider) LoadClusterForRegistration(spec *api.ClusterConfig) error {
+	if err := c.RefreshClusterStatus(spec); err != nil {
+		return err
+	}
+
+	cluster := c.Status.clusterInfo.cluster
+	if status := *cluster.Status; status != awseks.ClusterStatusActive {
+		return fmt.Errorf("cannot register cluster %q in %q region due to status %q", spec.Metadata.Name, spec.Metadata.Region, status)
+	}
+
+	spec.Metadata.Version = *cluster.Version
+	spec.IAM.ServiceRoleARN = cluster.RoleArn
+
+	if err := vpc.ImportFromControlPlane(c.Provider, spec, cluster.ResourcesVpcConfig); err != nil {
+		return errors.Wrapf(err, "importing VPC configuration of cluster %q", spec.Metadata.Name)
+	}
+
+	return nil
+}
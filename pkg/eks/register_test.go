@@ -0,0 +1,87 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Registering a cluster", func() {
+	var (
+		ctl *ClusterProvider
+		cfg *api.ClusterConfig
+
+		securityGroupIDs []string
+	)
+
+	BeforeEach(func() {
+		securityGroupIDs = []string{}
+
+		p := mockprovider.NewMockProvider()
+		p.MockEKS().On("DescribeCluster", mock.MatchedBy(func(input *awseks.DescribeClusterInput) bool {
+			return *input.Name == "test-cluster"
+		})).Return(func(*awseks.DescribeClusterInput) *awseks.DescribeClusterOutput {
+			cluster := testutils.NewFakeCluster("test-cluster", awseks.ClusterStatusActive)
+			cluster.Version = aws.String(api.DefaultVersion)
+			cluster.RoleArn = aws.String("arn:aws:iam::123456789012:role/test-cluster-role")
+			cluster.ResourcesVpcConfig = &awseks.VpcConfigResponse{
+				VpcId:                  aws.String("vpc-1"),
+				SubnetIds:              aws.StringSlice([]string{"subnet-a"}),
+				SecurityGroupIds:       aws.StringSlice(securityGroupIDs),
+				ClusterSecurityGroupId: aws.String("sg-cluster"),
+			}
+			return &awseks.DescribeClusterOutput{Cluster: cluster}
+		}, nil)
+		p.MockEC2().On("DescribeVpcsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeVpcsOutput, bool) bool)
+			consume(&ec2.DescribeVpcsOutput{
+				Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("10.0.0.0/16")}},
+			}, true)
+		}).Return(nil)
+		p.MockEC2().On("DescribeSubnetsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeSubnetsOutput, bool) bool)
+			consume(&ec2.DescribeSubnetsOutput{Subnets: []*ec2.Subnet{{
+				SubnetId:         aws.String("subnet-a"),
+				AvailabilityZone: aws.String("us-west-2a"),
+				CidrBlock:        aws.String("10.0.0.0/19"),
+				VpcId:            aws.String("vpc-1"),
+			}}}, true)
+		}).Return(nil)
+		p.MockEC2().On("DescribeRouteTablesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeRouteTablesOutput, bool) bool)
+			consume(&ec2.DescribeRouteTablesOutput{}, true)
+		}).Return(nil)
+
+		ctl = &ClusterProvider{
+			Provider: p,
+			Status:   &ProviderStatus{},
+		}
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+	})
+
+	It("should use the additional security group of the control plane", func() {
+		securityGroupIDs = []string{"sg-control-plane"}
+
+		Expect(ctl.LoadClusterForRegistration(cfg)).To(Succeed())
+		Expect(cfg.VPC.ID).To(Equal("vpc-1"))
+		Expect(cfg.VPC.SecurityGroup).To(Equal("sg-control-plane"))
+		Expect(cfg.PrivateSubnetIDs()).To(ConsistOf("subnet-a"))
+	})
+
+	It("should fall back to the cluster security group when the control plane has no additional security groups", func() {
+		Expect(ctl.LoadClusterForRegistration(cfg)).To(Succeed())
+		Expect(cfg.VPC.SecurityGroup).To(Equal("sg-cluster"))
+	})
+})
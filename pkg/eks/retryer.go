@@ -0,0 +1,118 @@
+package eks
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	minThrottleDelay = 500 * time.Millisecond
+	maxThrottleDelay = 30 * time.Second
+
+	// throttleWindow is the period over which throttling errors from a service
+	// are counted, the more of them there are, the longer the backoff
+	throttleWindow = 30 * time.Second
+	// maxThrottleScale limits how much recent throttling can increase the backoff
+	maxThrottleScale = 8
+)
+
+// ThrottlingRetryer extends LoggingRetryer with a backoff for throttling errors (e.g.
+// RequestLimitExceeded) that adapts to how often a service has recently throttled
+// requests; it's shared by all AWS clients created from a session, so that one busy
+// operation slows down the other calls to the same service as well; throttling errors
+// are expected in large accounts and are only logged at debug level
+type ThrottlingRetryer struct {
+	LoggingRetryer
+	throttles *throttleTracker
+}
+
+var _ request.Retryer = &ThrottlingRetryer{}
+
+// NewThrottlingRetryer creates a ThrottlingRetryer
+func NewThrottlingRetryer() *ThrottlingRetryer {
+	return &ThrottlingRetryer{
+		LoggingRetryer: *newLoggingRetryer(),
+		throttles:      newThrottleTracker(),
+	}
+}
+
+// RetryRules extends on LoggingRetryer.RetryRules
+func (t ThrottlingRetryer) RetryRules(r *request.Request) time.Duration {
+	if !r.IsErrorThrottle() {
+		return t.LoggingRetryer.RetryRules(r)
+	}
+
+	service := r.ClientInfo.ServiceName
+	operation := "?"
+	if r.Operation != nil {
+		operation = r.Operation.Name
+	}
+
+	recent, total := t.throttles.record(service+"/"+operation, service, time.Now())
+	duration := throttleDelay(r.RetryCount, recent)
+
+	logger.Debug("throttled by %s/%s (%d time(s) in total, %d time(s) in the last %v for %s), will retry after delay of %v (attempt %d of %d)",
+		service, operation, total, recent, throttleWindow, service, duration, r.RetryCount+1, t.MaxRetries())
+
+	return duration
+}
+
+// throttleDelay returns an exponential backoff that is scaled by the number of recent
+// throttling errors, with jitter, so that concurrent requests don't retry all at once
+func throttleDelay(retryCount, recentThrottles int) time.Duration {
+	if retryCount > 6 {
+		retryCount = 6
+	}
+	scale := recentThrottles
+	if scale < 1 {
+		scale = 1
+	}
+	if scale > maxThrottleScale {
+		scale = maxThrottleScale
+	}
+
+	ceiling := minThrottleDelay * time.Duration(int64(1)<<uint(retryCount)) * time.Duration(scale)
+	if ceiling > maxThrottleDelay {
+		ceiling = maxThrottleDelay
+	}
+	return ceiling/2 + time.Duration(rand.Int63n(int64(ceiling/2)+1))
+}
+
+// throttleTracker keeps count of throttling errors per operation, and of recent
+// throttling errors per service
+type throttleTracker struct {
+	mutex      sync.Mutex
+	operations map[string]int
+	recent     map[string][]time.Time
+}
+
+func newThrottleTracker() *throttleTracker {
+	return &throttleTracker{
+		operations: map[string]int{},
+		recent:     map[string][]time.Time{},
+	}
+}
+
+// record a throttling error, it returns the number of throttling errors of the service
+// within throttleWindow and the total number of throttling errors of the operation
+func (t *throttleTracker) record(operation, service string, now time.Time) (int, int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.operations[operation]++
+
+	recent := []time.Time{now}
+	for _, at := range t.recent[service] {
+		if now.Sub(at) < throttleWindow {
+			recent = append(recent, at)
+		}
+	}
+	t.recent[service] = recent
+
+	return len(recent), t.operations[operation]
+}
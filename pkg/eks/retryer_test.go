@@ -0,0 +1,67 @@
+package eks_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("ThrottlingRetryer", func() {
+	newRequest := func(service, code string, retryCount int) *request.Request {
+		return &request.Request{
+			ClientInfo:   metadata.ClientInfo{ServiceName: service},
+			Operation:    &request.Operation{Name: "DescribeSomething"},
+			Error:        awserr.New(code, "", nil),
+			HTTPResponse: &http.Response{StatusCode: 400},
+			RetryCount:   retryCount,
+		}
+	}
+
+	It("should back off more as a service keeps throttling requests", func() {
+		retryer := NewThrottlingRetryer()
+
+		Expect(retryer.ShouldRetry(newRequest("ec2", "RequestLimitExceeded", 0))).To(BeTrue())
+
+		first := retryer.RetryRules(newRequest("ec2", "RequestLimitExceeded", 0))
+		Expect(first).To(BeNumerically(">=", 250*time.Millisecond))
+		Expect(first).To(BeNumerically("<=", 500*time.Millisecond))
+
+		for i := 0; i < 8; i++ {
+			retryer.RetryRules(newRequest("ec2", "RequestLimitExceeded", i))
+		}
+
+		last := retryer.RetryRules(newRequest("ec2", "RequestLimitExceeded", 6))
+		Expect(last).To(BeNumerically(">=", 15*time.Second))
+		Expect(last).To(BeNumerically("<=", 30*time.Second))
+	})
+
+	It("should track throttling of each service separately", func() {
+		retryer := NewThrottlingRetryer()
+
+		for i := 0; i < 8; i++ {
+			retryer.RetryRules(newRequest("cloudformation", "Throttling", 0))
+		}
+
+		delay := retryer.RetryRules(newRequest("autoscaling", "Throttling", 0))
+		Expect(delay).To(BeNumerically("<=", 500*time.Millisecond))
+	})
+
+	It("should share throttling state between copies", func() {
+		retryer := NewThrottlingRetryer()
+		copied := *retryer
+
+		for i := 0; i < 8; i++ {
+			copied.RetryRules(newRequest("ec2", "RequestLimitExceeded", 0))
+		}
+
+		delay := retryer.RetryRules(newRequest("ec2", "RequestLimitExceeded", 0))
+		Expect(delay).To(BeNumerically(">=", 2*time.Second))
+	})
+})
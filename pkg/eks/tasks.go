@@ -1,7 +1,6 @@
 package eks
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/weaveworks/eksctl/pkg/addons"
 
@@ -9,6 +8,8 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
 type clusterConfigTask struct {
@@ -46,6 +47,70 @@ func (v *vpcControllerTask) Do(errCh chan error) error {
 	return nil
 }
 
+// NewTaskToConfigureCustomNetworking returns a task that creates ENIConfigs for pod subnets and configures aws-node,
+// it needs to run right after the cluster is created, so that nodes use custom networking from the start
+func (c *ClusterProvider) NewTaskToConfigureCustomNetworking(cfg *api.ClusterConfig) manager.Task {
+	return &clusterConfigTask{
+		info: "configure VPC CNI custom networking",
+		spec: cfg,
+		call: func(cfg *api.ClusterConfig) error {
+			clientSet, err := c.NewStdClientSet(cfg)
+			if err != nil {
+				return err
+			}
+			dynamicClient, err := c.NewDynamicClient(cfg)
+			if err != nil {
+				return err
+			}
+			return addons.ConfigureCustomNetworking(clientSet, dynamicClient, cfg)
+		},
+	}
+}
+
+// NewTaskToCleanupVPC returns a task that finds rules of other security groups that refer to security
+// groups of the cluster, and deletes dangling network interfaces, as those would block deletion of the
+// cluster stack; the rules are only revoked when detachSecurityGroupReferences is set, as the security
+// groups they belong to were not created by eksctl, otherwise they are logged; failures are only logged,
+// as CloudFormation reports anything that still blocks deletion
+func (c *ClusterProvider) NewTaskToCleanupVPC(cfg *api.ClusterConfig, detachSecurityGroupReferences bool) manager.Task {
+	return &clusterConfigTask{
+		info: "clean up security group references and network interfaces in the VPC",
+		spec: cfg,
+		call: func(cfg *api.ClusterConfig) error {
+			if err := c.LoadClusterVPC(cfg); err != nil {
+				logger.Warning("unable to get VPC configuration for cluster %q: %s", cfg.Metadata.Name, err.Error())
+				return nil
+			}
+			c.cleanupSecurityGroupReferences(cfg, detachSecurityGroupReferences)
+			if err := vpc.CleanupNetworkInterfaces(c.Provider.EC2(), cfg); err != nil {
+				logger.Warning(err.Error())
+			}
+			return nil
+		},
+	}
+}
+
+func (c *ClusterProvider) cleanupSecurityGroupReferences(cfg *api.ClusterConfig, detach bool) {
+	references, err := vpc.FindSecurityGroupReferences(c.Provider.EC2(), cfg)
+	if err != nil {
+		logger.Warning(err.Error())
+		return
+	}
+	if detach {
+		if err := vpc.RevokeSecurityGroupReferences(c.Provider.EC2(), references); err != nil {
+			logger.Warning(err.Error())
+		}
+		return
+	}
+	for _, ref := range references {
+		logger.Warning("security group %q (%s) has %d ingress and %d egress rule(s) that refer to security groups of cluster %q, which may block deletion of the cluster stack",
+			ref.GroupName, ref.GroupID, len(ref.Ingress), len(ref.Egress), cfg.Metadata.Name)
+	}
+	if len(references) > 0 {
+		logger.Info("remove these rules, or use --detach-security-group-references to have eksctl revoke them")
+	}
+}
+
 // AppendExtraClusterConfigTasks returns all tasks for updating cluster configuration or nil if there are no tasks
 func (c *ClusterProvider) AppendExtraClusterConfigTasks(cfg *api.ClusterConfig, installVPCController bool, tasks *manager.TaskTree) {
 	newTasks := &manager.TaskTree{
@@ -66,6 +131,24 @@ func (c *ClusterProvider) AppendExtraClusterConfigTasks(cfg *api.ClusterConfig,
 	if api.IsEnabled(cfg.IAM.WithOIDC) {
 		c.appendCreateTasksForIAMServiceAccounts(cfg, newTasks)
 	}
+	if len(cfg.Addons) > 0 {
+		newTasks.Append(&clusterConfigTask{
+			info: "create EKS managed add-ons",
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				return c.CreateAddons(cfg, cfg.Addons)
+			},
+		})
+	}
+	if len(cfg.IdentityProviders) > 0 {
+		newTasks.Append(&clusterConfigTask{
+			info: "associate identity providers",
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				return c.AssociateIdentityProviders(cfg, cfg.IdentityProviders)
+			},
+		})
+	}
 	c.maybeAppendTasksForEndpointAccessUpdates(cfg, newTasks)
 	if installVPCController {
 		newTasks.Append(&vpcControllerTask{
@@ -125,7 +208,8 @@ func (c *ClusterProvider) maybeAppendTasksForEndpointAccessUpdates(cfg *api.Clus
 	// non-CloudFormation context, so we create a task to send it through the EKS API.
 	// A caveat is that sending the default endpoint parameters for a cluster as an update will
 	// return an error from the EKS API, so we must check for this before sending the request.
-	if cfg.HasClusterEndpointAccess() && api.EndpointsEqual(*cfg.VPC.ClusterEndpoints, *api.ClusterEndpointAccessDefaults()) {
+	if cfg.HasClusterEndpointAccess() && api.EndpointsEqual(*cfg.VPC.ClusterEndpoints, *api.ClusterEndpointAccessDefaults()) &&
+		len(cfg.VPC.PublicAccessCIDRs) == 0 {
 		// No tasks to append here as there's no updates to make.
 		logger.Info(cfg.DefaultEndpointsMsg())
 	} else {
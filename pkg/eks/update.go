@@ -7,11 +7,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
@@ -71,7 +71,7 @@ func (c *ClusterProvider) UpdateClusterConfigForLogging(cfg *api.ClusterConfig)
 	if err != nil {
 		return err
 	}
-	if err := c.waitForUpdateToSucceed(cfg.Metadata.Name, output.Update); err != nil {
+	if err := c.waitForUpdateToSucceed(cfg, output.Update); err != nil {
 		return err
 	}
 
@@ -105,7 +105,6 @@ func (c *ClusterProvider) GetCurrentClusterConfigForEndpoints(spec *api.ClusterC
 
 // UpdateClusterConfigForEndpoints calls eks.UpdateClusterConfig and updates access to API endpoints
 func (c *ClusterProvider) UpdateClusterConfigForEndpoints(cfg *api.ClusterConfig) error {
-
 	input := &awseks.UpdateClusterConfigInput{
 		Name: &cfg.Metadata.Name,
 		ResourcesVpcConfig: &awseks.VpcConfigRequest{
@@ -113,13 +112,16 @@ func (c *ClusterProvider) UpdateClusterConfigForEndpoints(cfg *api.ClusterConfig
 			EndpointPublicAccess:  cfg.VPC.ClusterEndpoints.PublicAccess,
 		},
 	}
+	if len(cfg.VPC.PublicAccessCIDRs) > 0 {
+		input.ResourcesVpcConfig.PublicAccessCidrs = aws.StringSlice(cfg.VPC.PublicAccessCIDRs)
+	}
 
 	output, err := c.Provider.EKS().UpdateClusterConfig(input)
 	if err != nil {
 		return err
 	}
 
-	return c.waitForUpdateToSucceed(cfg.Metadata.Name, output.Update)
+	return c.waitForUpdateToSucceed(cfg, output.Update)
 }
 
 // UpdateClusterVersion calls eks.UpdateClusterVersion and updates to cfg.Metadata.Version,
@@ -144,10 +146,12 @@ func (c *ClusterProvider) UpdateClusterVersionBlocking(cfg *api.ClusterConfig) e
 		return err
 	}
 
-	return c.waitForUpdateToSucceed(cfg.Metadata.Name, id)
+	return c.waitForUpdateToSucceed(cfg, id)
 }
 
-func (c *ClusterProvider) waitForUpdateToSucceed(clusterName string, update *awseks.Update) error {
+func (c *ClusterProvider) waitForUpdateToSucceed(cfg *api.ClusterConfig, update *awseks.Update) error {
+	clusterName := cfg.Metadata.Name
+
 	newRequest := func() *request.Request {
 		input := &awseks.DescribeUpdateInput{
 			Name:     &clusterName,
@@ -168,5 +172,5 @@ func (c *ClusterProvider) waitForUpdateToSucceed(clusterName string, update *aws
 
 	msg := fmt.Sprintf("waiting for requested %q in cluster %q to succeed", *update.Type, clusterName)
 
-	return waiters.Wait(clusterName, msg, acceptors, newRequest, c.Provider.WaitTimeout(), nil)
+	return waiters.Wait(clusterName, msg, acceptors, newRequest, cfg.UpdateTimeout(c.Provider.WaitTimeout()), nil)
 }
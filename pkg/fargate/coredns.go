@@ -0,0 +1,61 @@
+package fargate
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	// CoreDNS is the name of the coredns deployment
+	CoreDNS = "coredns"
+
+	// ComputeTypeAnnotationKey is the annotation EKS sets on the coredns pod
+	// template, which prevents coredns from being scheduled on Fargate
+	ComputeTypeAnnotationKey = "eks.amazonaws.com/compute-type"
+)
+
+var coreDNSLabels = map[string]string{
+	"k8s-app": "kube-dns",
+}
+
+// IsCoreDNSSchedulableOnFargate checks whether any of the provided Fargate
+// profiles selects coredns pods
+func IsCoreDNSSchedulableOnFargate(profiles []*api.FargateProfile) bool {
+	for _, fp := range profiles {
+		for _, selector := range fp.Selectors {
+			if selector.Matches(metav1.NamespaceSystem, coreDNSLabels) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ScheduleCoreDNSOnFargate removes the compute-type annotation from the coredns
+// deployment, so that its pods can be scheduled on Fargate
+func ScheduleCoreDNSOnFargate(clientSet kubernetes.Interface) error {
+	deployments := clientSet.AppsV1().Deployments(metav1.NamespaceSystem)
+
+	coreDNS, err := deployments.Get(CoreDNS, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "getting %q", CoreDNS)
+	}
+
+	annotations := coreDNS.Spec.Template.Annotations
+	if _, ok := annotations[ComputeTypeAnnotationKey]; !ok {
+		logger.Debug("%q is already schedulable on Fargate", CoreDNS)
+		return nil
+	}
+	delete(annotations, ComputeTypeAnnotationKey)
+
+	if _, err := deployments.Update(coreDNS); err != nil {
+		return errors.Wrapf(err, "updating %q", CoreDNS)
+	}
+
+	logger.Info("%q is now schedulable onto Fargate", CoreDNS)
+	return nil
+}
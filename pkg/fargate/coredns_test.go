@@ -0,0 +1,83 @@
+package fargate_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/fargate"
+)
+
+var _ = Describe("Fargate - coredns", func() {
+	Describe("IsCoreDNSSchedulableOnFargate", func() {
+		It("should match the default Fargate profile", func() {
+			Expect(IsCoreDNSSchedulableOnFargate([]*api.FargateProfile{api.NewDefaultFargateProfile()})).To(BeTrue())
+		})
+
+		It("should not match profiles selecting other namespaces or labels", func() {
+			profiles := []*api.FargateProfile{
+				{
+					Name: "fp-1",
+					Selectors: []api.FargateProfileSelector{
+						{Namespace: "default"},
+						{Namespace: "kube-system", Labels: map[string]string{"app": "other"}},
+					},
+				},
+			}
+			Expect(IsCoreDNSSchedulableOnFargate(profiles)).To(BeFalse())
+			Expect(IsCoreDNSSchedulableOnFargate(nil)).To(BeFalse())
+		})
+	})
+
+	Describe("ScheduleCoreDNSOnFargate", func() {
+		newCoreDNSDeployment := func(annotations map[string]string) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      CoreDNS,
+					Namespace: metav1.NamespaceSystem,
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Annotations: annotations,
+						},
+					},
+				},
+			}
+		}
+
+		getAnnotations := func(clientSet *fake.Clientset) map[string]string {
+			coreDNS, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Get(CoreDNS, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return coreDNS.Spec.Template.Annotations
+		}
+
+		It("should remove the compute-type annotation", func() {
+			clientSet := fake.NewSimpleClientset(newCoreDNSDeployment(map[string]string{
+				ComputeTypeAnnotationKey: "ec2",
+				"foo":                    "bar",
+			}))
+
+			Expect(ScheduleCoreDNSOnFargate(clientSet)).To(Succeed())
+			Expect(getAnnotations(clientSet)).To(Equal(map[string]string{"foo": "bar"}))
+		})
+
+		It("should be a no-op when the annotation is not present", func() {
+			clientSet := fake.NewSimpleClientset(newCoreDNSDeployment(nil))
+
+			Expect(ScheduleCoreDNSOnFargate(clientSet)).To(Succeed())
+			Expect(getAnnotations(clientSet)).To(BeEmpty())
+		})
+
+		It("should fail when coredns is not found", func() {
+			clientSet := fake.NewSimpleClientset()
+
+			Expect(ScheduleCoreDNSOnFargate(clientSet)).ToNot(Succeed())
+		})
+	})
+})
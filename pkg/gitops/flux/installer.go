@@ -11,7 +11,6 @@ import (
 
 	fluxinstall "github.com/fluxcd/flux/pkg/install"
 	helmopinstall "github.com/fluxcd/helm-operator/pkg/install"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/riywo/loginshell"
 	corev1 "k8s.io/api/core/v1"
@@ -22,6 +21,7 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/git"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -181,6 +181,12 @@ func (fi *Installer) Run(ctx context.Context) (string, error) {
 		}
 	}
 
+	// Flux would fail to sync if it was restricted to paths that do not exist
+	// in the repository, so we check this before anything gets applied or committed
+	if err := validateGitPaths(cloneDir, fi.opts.GitPaths); err != nil {
+		return "", err
+	}
+
 	if err := fi.createFluxNamespaceIfMissing(manifests); err != nil {
 		return "", err
 	}
@@ -336,6 +342,19 @@ func (fi *Installer) applySecrets(secrets []*corev1.Secret) error {
 	return fi.applyManifests(secretMap)
 }
 
+func validateGitPaths(cloneDir string, gitPaths []string) error {
+	for _, gitPath := range gitPaths {
+		fullPath := filepath.Join(cloneDir, gitPath)
+		if _, err := os.Stat(fullPath); err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("git path %q does not exist in the repository, Flux would not be able to sync it", gitPath)
+			}
+			return errors.Wrapf(err, "cannot check git path %q", gitPath)
+		}
+	}
+	return nil
+}
+
 func writeFluxManifests(baseDir string, manifests map[string][]byte) error {
 	if err := os.MkdirAll(baseDir, 0700); err != nil {
 		return errors.Wrapf(err, "cannot create Flux manifests directory (%s)", baseDir)
@@ -95,3 +95,27 @@ var _ = Describe("Installer", func() {
 		}
 	}
 })
+
+var _ = Describe("Git paths validation", func() {
+	var cloneDir string
+
+	BeforeEach(func() {
+		var err error
+		cloneDir, err = ioutil.TempDir(os.TempDir(), "validategitpaths")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.MkdirAll(filepath.Join(cloneDir, "clusters", "prod"), 0700)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(cloneDir)
+	})
+
+	It("should accept paths that exist in the repository", func() {
+		Expect(validateGitPaths(cloneDir, []string{"clusters/prod", "clusters/"})).To(Succeed())
+	})
+
+	It("should reject paths missing from the repository", func() {
+		err := validateGitPaths(cloneDir, []string{"clusters/prod", "clusters/staging"})
+		Expect(err).To(MatchError(ContainSubstring(`"clusters/staging" does not exist`)))
+	})
+})
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	portforward "github.com/justinbarrick/go-k8s-portforward"
-	"github.com/kris-nova/logger"
 	fluxapi "github.com/fluxcd/flux/pkg/api/v6"
 	transport "github.com/fluxcd/flux/pkg/http"
 	"github.com/fluxcd/flux/pkg/http/client"
@@ -16,6 +15,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func waitForFluxToStart(ctx context.Context, namespace string, timeout time.Duration, restConfig *rest.Config,
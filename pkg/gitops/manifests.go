@@ -0,0 +1,57 @@
+package gitops
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/git"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// CommitManifests clones the gitops repository, writes the given manifests into the
+// first of the paths Flux is restricted to, and commits and pushes them, so that Flux
+// applies them to the cluster instead of eksctl applying them directly
+func CommitManifests(gitClient *git.Client, repo *api.Repo, manifests map[string][]byte, commitMsg string) error {
+	logger.Info("cloning %s", repo.URL)
+	options := git.CloneOptions{
+		URL:       repo.URL,
+		Branch:    repo.Branch,
+		Bootstrap: true,
+	}
+	cloneDir, err := gitClient.CloneRepoInTmpDir("eksctl-commit-manifests-", options)
+	if err != nil {
+		return errors.Wrapf(err, "cannot clone repository %s", repo.URL)
+	}
+	defer func() {
+		_ = gitClient.DeleteLocalRepo()
+	}()
+
+	manifestsPath := repo.FluxPath
+	if len(repo.Paths) > 0 {
+		manifestsPath = repo.Paths[0]
+	}
+	manifestsDir := filepath.Join(cloneDir, manifestsPath)
+	if err := os.MkdirAll(manifestsDir, 0755); err != nil {
+		return errors.Wrapf(err, "creating directory %q", manifestsDir)
+	}
+	for name, data := range manifests {
+		filePath := filepath.Join(manifestsDir, name)
+		logger.Debug("writing file %q", filePath)
+		if err := ioutil.WriteFile(filePath, data, 0644); err != nil {
+			return errors.Wrapf(err, "writing manifest %q", filePath)
+		}
+	}
+
+	logger.Info("committing and pushing manifests to %s", repo.URL)
+	if err := gitClient.Add(manifestsPath); err != nil {
+		return err
+	}
+	if err := gitClient.Commit(commitMsg, repo.User, repo.Email); err != nil {
+		return err
+	}
+	return gitClient.Push()
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -12,6 +11,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // ImportInstanceRoleFromProfileARN fetches first role ARN from instance profile
@@ -35,7 +35,7 @@ func ImportInstanceRoleFromProfileARN(provider api.ClusterProvider, ng *api.Node
 		return fmt.Errorf("instance profile %q has no roles", profileName)
 	}
 	if len(roles) > 1 {
-		logger.Debug("instance profile %q has %d roles, only first role will be used (%#v)", profileName, roles)
+		logger.Debug("instance profile %q has %d roles, only first role will be used (%#v)", profileName, len(roles), roles)
 	}
 
 	ng.IAM.InstanceRoleARN = *output.InstanceProfile.Roles[0].Arn
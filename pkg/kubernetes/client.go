@@ -1,12 +1,13 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/blang/semver"
 	jsonpatch "github.com/evanphx/json-patch"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
@@ -18,12 +19,15 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/diff"
 	"k8s.io/cli-runtime/pkg/genericclioptions/resource"
 	"k8s.io/client-go/discovery"
 	kubeclient "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // Interface is an alias to avoid having to import k8s.io/client-go/kubernetes
@@ -330,6 +334,91 @@ func (r *RawResource) CreateOrReplace(plan bool) (string, error) {
 	return r.LogAction(plan, "replaced"), nil
 }
 
+// Diff compares this Kubernetes resource with the live object, and returns a
+// description of the differences, along with whether there are any. Only the
+// fields set in the manifest are compared, so that fields defaulted by the API
+// server (along with metadata and status it manages) don't show up as changes.
+// When the object doesn't exist yet, no description is returned, but it is
+// still reported as different.
+func (r *RawResource) Diff() (string, bool, error) {
+	liveObj, exists, err := r.Get()
+	if err != nil {
+		return "", false, errors.Wrap(err, "unexpected non-404 error")
+	}
+	if !exists {
+		return "", true, nil
+	}
+
+	live, err := toComparableObject(liveObj)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "encoding live object %q", r)
+	}
+	desired, err := toComparableObject(r.Info.Object)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "encoding object %q", r)
+	}
+
+	live = pruneToDesiredFields(live, desired).(map[string]interface{})
+	if reflect.DeepEqual(live, desired) {
+		return "", false, nil
+	}
+	return diff.ObjectReflectDiff(live, desired), true, nil
+}
+
+// toComparableObject returns a generic representation of obj, without the
+// fields that are managed by the API server
+func toComparableObject(obj runtime.Object) (map[string]interface{}, error) {
+	data, err := runtime.Encode(unstructured.UnstructuredJSONScheme, obj)
+	if err != nil {
+		return nil, err
+	}
+	u := map[string]interface{}{}
+	if err := json.Unmarshal(data, &u); err != nil {
+		return nil, err
+	}
+	delete(u, "status")
+	if metadata, ok := u["metadata"].(map[string]interface{}); ok {
+		for _, field := range []string{"creationTimestamp", "generation", "resourceVersion", "selfLink", "uid", "managedFields"} {
+			delete(metadata, field)
+		}
+	}
+	return u, nil
+}
+
+// pruneToDesiredFields drops the fields of live that aren't set in desired,
+// lists of different length are retained as they are, as they differ anyway
+func pruneToDesiredFields(live, desired interface{}) interface{} {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		liveMap, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		pruned := map[string]interface{}{}
+		for k, v := range desired {
+			if liveValue, ok := liveMap[k]; ok {
+				pruned[k] = pruneToDesiredFields(liveValue, v)
+			} else if v == nil {
+				// an explicit null in the manifest is the same as not setting the field
+				pruned[k] = nil
+			}
+		}
+		return pruned
+	case []interface{}:
+		liveList, ok := live.([]interface{})
+		if !ok || len(liveList) != len(desired) {
+			return live
+		}
+		pruned := make([]interface{}, len(liveList))
+		for i := range liveList {
+			pruned[i] = pruneToDesiredFields(liveList[i], desired[i])
+		}
+		return pruned
+	default:
+		return live
+	}
+}
+
 /*
 
 	This doesn't work yet. We need to find a way to do defaulting properly, what we have now seems to cause following behaviour and nothing seems to make it go away.
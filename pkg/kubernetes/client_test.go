@@ -235,4 +235,79 @@ var _ = Describe("Kubernetes client wrappers", func() {
 			Expect(ct.DeletedItems()).To(HaveLen(10))
 		})
 	})
+
+	Describe("can diff objects against the live ones", func() {
+		var (
+			rawClient *testutils.FakeRawClient
+			sa        *corev1.ServiceAccount
+		)
+
+		BeforeEach(func() {
+			rawClient = testutils.NewFakeRawClient()
+			rawClient.UseUnionTracker = true
+
+			sa = &corev1.ServiceAccount{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "v1",
+					Kind:       "ServiceAccount",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: metav1.NamespaceDefault,
+					Labels:    map[string]string{"test": "a"},
+				},
+			}
+		})
+
+		It("reports objects that don't exist yet as different", func() {
+			rc, err := rawClient.NewRawResource(sa)
+			Expect(err).ToNot(HaveOccurred())
+
+			diff, changed, err := rc.Diff()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(diff).To(BeEmpty())
+		})
+
+		It("ignores fields that are not set in the manifest", func() {
+			live := sa.DeepCopy()
+			live.ResourceVersion = "123"
+			live.UID = "abc"
+			live.Annotations = map[string]string{"set-by": "server"}
+			live.Secrets = []corev1.ObjectReference{{Name: "test-token"}}
+
+			rc, err := rawClient.NewRawResource(live)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = rc.CreateOrReplace(false)
+			Expect(err).ToNot(HaveOccurred())
+
+			rc, err = rawClient.NewRawResource(sa)
+			Expect(err).ToNot(HaveOccurred())
+
+			diff, changed, err := rc.Diff()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeFalse())
+			Expect(diff).To(BeEmpty())
+		})
+
+		It("describes the fields that differ", func() {
+			rc, err := rawClient.NewRawResource(sa)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = rc.CreateOrReplace(false)
+			Expect(err).ToNot(HaveOccurred())
+
+			updated := sa.DeepCopy()
+			updated.Labels["test"] = "b"
+
+			rc, err = rawClient.NewRawResource(updated)
+			Expect(err).ToNot(HaveOccurred())
+
+			diff, changed, err := rc.Diff()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(diff).To(ContainSubstring(`object[metadata][labels][test]:`))
+			Expect(diff).To(ContainSubstring(`a: "a"`))
+			Expect(diff).To(ContainSubstring(`b: "b"`))
+		})
+	})
 })
@@ -0,0 +1,111 @@
+package kubernetes
+
+import (
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// UpdateNodeLabels sets and removes labels on all nodes matching listOptions,
+// labels that are not mentioned in either set or remove are retained
+func UpdateNodeLabels(clientSet Interface, listOptions metav1.ListOptions, set map[string]string, remove []string) error {
+	return updateNodes(clientSet, listOptions, func(node *corev1.Node) bool {
+		updated := false
+		if node.Labels == nil {
+			node.Labels = make(map[string]string)
+		}
+		for key, value := range set {
+			if currentValue, ok := node.Labels[key]; !ok || currentValue != value {
+				node.Labels[key] = value
+				updated = true
+			}
+		}
+		for _, key := range remove {
+			if _, ok := node.Labels[key]; ok {
+				delete(node.Labels, key)
+				updated = true
+			}
+		}
+		return updated
+	})
+}
+
+// UpdateNodeTaints sets and removes taints on all nodes matching listOptions,
+// a taint in set replaces any existing taint with the same key and effect,
+// all taints with a key given in remove are removed
+func UpdateNodeTaints(clientSet Interface, listOptions metav1.ListOptions, set []corev1.Taint, remove []string) error {
+	return updateNodes(clientSet, listOptions, func(node *corev1.Node) bool {
+		updated := false
+		taints := []corev1.Taint{}
+		for _, taint := range node.Spec.Taints {
+			if hasTaintKey(remove, taint.Key) {
+				updated = true
+				continue
+			}
+			taints = append(taints, taint)
+		}
+		for _, taint := range set {
+			found := false
+			for i := range taints {
+				if taints[i].MatchTaint(&taint) {
+					found = true
+					if taints[i].Value != taint.Value {
+						taints[i].Value = taint.Value
+						updated = true
+					}
+				}
+			}
+			if !found {
+				taints = append(taints, taint)
+				updated = true
+			}
+		}
+		node.Spec.Taints = taints
+		return updated
+	})
+}
+
+func hasTaintKey(keys []string, key string) bool {
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// updateNodes applies mutate to all nodes matching listOptions, it only
+// updates the nodes for which mutate returns true and retries on conflicts
+func updateNodes(clientSet Interface, listOptions metav1.ListOptions, mutate func(*corev1.Node) bool) error {
+	nodes, err := clientSet.CoreV1().Nodes().List(listOptions)
+	if err != nil {
+		return errors.Wrap(err, "listing nodes")
+	}
+
+	for _, node := range nodes.Items {
+		name := node.Name
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			current, err := clientSet.CoreV1().Nodes().Get(name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if !mutate(current) {
+				logger.Debug("node %q is already up-to-date", name)
+				return nil
+			}
+			if _, err := clientSet.CoreV1().Nodes().Update(current); err != nil {
+				return err
+			}
+			logger.Debug("updated node %q", name)
+			return nil
+		})
+		if err != nil {
+			return errors.Wrapf(err, "updating node %q", name)
+		}
+	}
+	logger.Info("updated %d node(s)", len(nodes.Items))
+	return nil
+}
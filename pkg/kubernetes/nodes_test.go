@@ -0,0 +1,89 @@
+package kubernetes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+var _ = Describe("Kubernetes node helpers", func() {
+	var (
+		clientSet   *fake.Clientset
+		listOptions metav1.ListOptions
+	)
+
+	newNode := func(name, nodeGroup string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					"alpha.eksctl.io/nodegroup-name": nodeGroup,
+					"role":                           "worker",
+				},
+			},
+			Spec: corev1.NodeSpec{
+				Taints: []corev1.Taint{
+					{Key: "dedicated", Value: "old", Effect: corev1.TaintEffectNoSchedule},
+				},
+			},
+		}
+	}
+
+	getNode := func(name string) *corev1.Node {
+		node, err := clientSet.CoreV1().Nodes().Get(name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return node
+	}
+
+	BeforeEach(func() {
+		clientSet = fake.NewSimpleClientset(
+			newNode("node-1", "ng-1"),
+			newNode("node-2", "ng-1"),
+			newNode("node-3", "ng-2"),
+		)
+		listOptions = metav1.ListOptions{LabelSelector: "alpha.eksctl.io/nodegroup-name=ng-1"}
+	})
+
+	It("can set and remove node labels", func() {
+		err := UpdateNodeLabels(clientSet, listOptions, map[string]string{"team": "data"}, []string{"role"})
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, name := range []string{"node-1", "node-2"} {
+			Expect(getNode(name).Labels).To(Equal(map[string]string{
+				"alpha.eksctl.io/nodegroup-name": "ng-1",
+				"team":                           "data",
+			}))
+		}
+		Expect(getNode("node-3").Labels).To(HaveKeyWithValue("role", "worker"))
+		Expect(getNode("node-3").Labels).ToNot(HaveKey("team"))
+	})
+
+	It("can set and remove node taints", func() {
+		err := UpdateNodeTaints(clientSet, listOptions, []corev1.Taint{
+			{Key: "gpu", Value: "true", Effect: corev1.TaintEffectNoExecute},
+		}, []string{"dedicated"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(getNode("node-1").Spec.Taints).To(Equal([]corev1.Taint{
+			{Key: "gpu", Value: "true", Effect: corev1.TaintEffectNoExecute},
+		}))
+		Expect(getNode("node-3").Spec.Taints).To(HaveLen(1))
+		Expect(getNode("node-3").Spec.Taints[0].Key).To(Equal("dedicated"))
+	})
+
+	It("replaces the value of an existing taint with the same key and effect", func() {
+		err := UpdateNodeTaints(clientSet, listOptions, []corev1.Taint{
+			{Key: "dedicated", Value: "new", Effect: corev1.TaintEffectNoSchedule},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(getNode("node-2").Spec.Taints).To(Equal([]corev1.Taint{
+			{Key: "dedicated", Value: "new", Effect: corev1.TaintEffectNoSchedule},
+		}))
+	})
+})
@@ -0,0 +1,24 @@
+package kubernetes
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RewriteAllSecrets updates all secrets in all namespaces without changing them,
+// which makes the API server store them again, e.g. to encrypt existing secrets
+// once envelope encryption has been enabled; it returns the number of secrets
+func RewriteAllSecrets(clientSet Interface) (int, error) {
+	secrets, err := clientSet.CoreV1().Secrets(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return 0, errors.Wrap(err, "listing secrets")
+	}
+
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if _, err := clientSet.CoreV1().Secrets(secret.Namespace).Update(secret); err != nil {
+			return i, errors.Wrapf(err, "updating secret %s/%s", secret.Namespace, secret.Name)
+		}
+	}
+	return len(secrets.Items), nil
+}
@@ -0,0 +1,47 @@
+package kubernetes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+var _ = Describe("Kubernetes secret helpers", func() {
+	It("can rewrite all secrets in all namespaces", func() {
+		newSecret := func(namespace, name string) *corev1.Secret {
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+					Name:      name,
+				},
+				Data: map[string][]byte{"key": []byte(name)},
+			}
+		}
+		clientSet := fake.NewSimpleClientset(
+			newSecret("default", "s1"),
+			newSecret("kube-system", "s2"),
+			newSecret("kube-system", "s3"),
+		)
+
+		n, err := RewriteAllSecrets(clientSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(3))
+
+		updated := 0
+		for _, action := range clientSet.Actions() {
+			if action.GetVerb() == "update" && action.GetResource().Resource == "secrets" {
+				updated++
+			}
+		}
+		Expect(updated).To(Equal(3))
+
+		s3, err := clientSet.CoreV1().Secrets("kube-system").Get("s3", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s3.Data).To(Equal(map[string][]byte{"key": []byte("s3")}))
+	})
+})
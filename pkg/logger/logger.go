@@ -0,0 +1,237 @@
+// Package logger provides the logging functions used throughout eksctl; messages are
+// written as text by github.com/kris-nova/logger, or as structured JSON records
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	textlogger "github.com/kris-nova/logger"
+)
+
+// Log formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Logger is the signature of the logging functions
+type Logger = textlogger.Logger
+
+// Level is the verbosity of the logger, use 0 to silence it, 3 for
+// informational messages and 4 for debugging
+var Level = 2
+
+// Options holds the options of the logger
+type Options struct {
+	// Color is "true", "false" or "fabulous", it only applies to text messages
+	Color string
+	// Format is either FormatText or FormatJSON
+	Format string
+	// Quiet drops all messages but errors and successes
+	Quiet bool
+	// Output is where JSON records are written, it defaults to stderr,
+	// so that results printed to stdout can be parsed separately
+	Output io.Writer
+}
+
+type level struct {
+	name      string
+	verbosity int
+	text      Logger
+}
+
+var (
+	alwaysLevel   = level{name: "always", verbosity: 0, text: textlogger.Always}
+	criticalLevel = level{name: "error", verbosity: 1, text: textlogger.Critical}
+	warningLevel  = level{name: "warning", verbosity: 2, text: textlogger.Warning}
+	infoLevel     = level{name: "info", verbosity: 3, text: textlogger.Info}
+	successLevel  = level{name: "success", verbosity: 3, text: textlogger.Success}
+	debugLevel    = level{name: "debug", verbosity: 4, text: textlogger.Debug}
+)
+
+// record is the JSON representation of a message
+type record struct {
+	Level     string            `json:"level"`
+	Timestamp string            `json:"timestamp"`
+	Prefix    string            `json:"prefix,omitempty"`
+	Message   string            `json:"message"`
+	Fields    map[string]string `json:"fields,omitempty"`
+}
+
+var (
+	mutex   sync.Mutex
+	options = Options{Format: FormatText, Output: os.Stderr}
+	fields  = map[string]string{}
+	prefix  string
+)
+
+func init() {
+	// messages are filtered by Level before they are passed on
+	textlogger.Level = debugLevel.verbosity
+}
+
+// Configure sets the options of the logger, Level must be set already
+func Configure(o Options) error {
+	switch o.Format {
+	case FormatText, FormatJSON:
+	default:
+		return fmt.Errorf("unsupported log format %q, valid options: %s, %s", o.Format, FormatText, FormatJSON)
+	}
+	if o.Output == nil {
+		o.Output = os.Stderr
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// Control colored output
+	textlogger.Color = o.Color == "true"
+	textlogger.Fabulous = o.Color == "fabulous"
+	// Add timestamps for debugging
+	textlogger.Timestamps = Level >= debugLevel.verbosity
+
+	if o.Quiet && Level < successLevel.verbosity {
+		Level = successLevel.verbosity
+	}
+
+	options = o
+	fields = map[string]string{}
+	prefix = ""
+	return nil
+}
+
+// SetField adds a field to all JSON records that follow
+func SetField(key, value string) {
+	if value == "" {
+		return
+	}
+	mutex.Lock()
+	defer mutex.Unlock()
+	fields[key] = value
+}
+
+// SetPrefix adds a prefix to all messages that follow, except the ones logged with
+// an Entry, it returns a function that removes it
+func SetPrefix(p string) func() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	prefix = p
+
+	return func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+		prefix = ""
+	}
+}
+
+// Always logs a message regardless of the level
+func Always(format string, a ...interface{}) {
+	alwaysLevel.log("", format, a...)
+}
+
+// Critical logs an error
+func Critical(format string, a ...interface{}) {
+	criticalLevel.log("", format, a...)
+}
+
+// Warning logs a warning
+func Warning(format string, a ...interface{}) {
+	warningLevel.log("", format, a...)
+}
+
+// Info logs an informational message
+func Info(format string, a ...interface{}) {
+	infoLevel.log("", format, a...)
+}
+
+// Success logs a success
+func Success(format string, a ...interface{}) {
+	successLevel.log("", format, a...)
+}
+
+// Debug logs a debugging message
+func Debug(format string, a ...interface{}) {
+	debugLevel.log("", format, a...)
+}
+
+// Entry logs messages with a prefix, to tell apart the messages about different
+// resources that are processed at the same time, e.g. clusters
+type Entry struct {
+	prefix string
+}
+
+// WithPrefix returns an Entry that adds the prefix to its messages
+func WithPrefix(prefix string) Entry {
+	return Entry{prefix: prefix}
+}
+
+// Critical logs an error with the prefix of the entry
+func (e Entry) Critical(format string, a ...interface{}) {
+	criticalLevel.log(e.prefix, format, a...)
+}
+
+// Warning logs a warning with the prefix of the entry
+func (e Entry) Warning(format string, a ...interface{}) {
+	warningLevel.log(e.prefix, format, a...)
+}
+
+// Info logs an informational message with the prefix of the entry
+func (e Entry) Info(format string, a ...interface{}) {
+	infoLevel.log(e.prefix, format, a...)
+}
+
+// Success logs a success with the prefix of the entry
+func (e Entry) Success(format string, a ...interface{}) {
+	successLevel.log(e.prefix, format, a...)
+}
+
+// Debug logs a debugging message with the prefix of the entry
+func (e Entry) Debug(format string, a ...interface{}) {
+	debugLevel.log(e.prefix, format, a...)
+}
+
+func (l level) log(messagePrefix, format string, a ...interface{}) {
+	if Level < l.verbosity {
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if options.Quiet && l.name != criticalLevel.name && l.name != successLevel.name {
+		return
+	}
+
+	if messagePrefix == "" {
+		messagePrefix = prefix
+	}
+	message := strings.TrimRight(fmt.Sprintf(format, a...), "\n")
+	if options.Format != FormatJSON {
+		if messagePrefix != "" {
+			message = "[" + messagePrefix + "] " + message
+		}
+		l.text("%s", message)
+		return
+	}
+
+	r := record{
+		Level:     l.name,
+		Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+		Prefix:    messagePrefix,
+		Message:   message,
+	}
+	if len(fields) > 0 {
+		r.Fields = map[string]string{}
+		for k, v := range fields {
+			r.Fields[k] = v
+		}
+	}
+	// there is nowhere else to report a failure to write a message
+	_ = json.NewEncoder(options.Output).Encode(r)
+}
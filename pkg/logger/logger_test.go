@@ -0,0 +1,102 @@
+package logger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+type record struct {
+	Level     string            `json:"level"`
+	Timestamp string            `json:"timestamp"`
+	Prefix    string            `json:"prefix"`
+	Message   string            `json:"message"`
+	Fields    map[string]string `json:"fields"`
+}
+
+var _ = Describe("logger", func() {
+	var (
+		out   *bytes.Buffer
+		level int
+	)
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		level = logger.Level
+		logger.Level = 3
+	})
+
+	AfterEach(func() {
+		logger.Level = level
+		Expect(logger.Configure(logger.Options{Format: logger.FormatText})).To(Succeed())
+	})
+
+	records := func() []record {
+		result := []record{}
+		for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+			r := record{}
+			Expect(json.Unmarshal([]byte(line), &r)).To(Succeed())
+			result = append(result, r)
+		}
+		return result
+	}
+
+	It("should write messages as JSON records", func() {
+		Expect(logger.Configure(logger.Options{Format: logger.FormatJSON, Output: out})).To(Succeed())
+		logger.SetField("cluster", "test-cluster")
+
+		logger.Info("creating cluster %q", "test-cluster")
+		logger.Critical("failed\ncaused by: %s\n", "timeout")
+		logger.Debug("not logged at level 3")
+
+		result := records()
+		Expect(result).To(HaveLen(2))
+		Expect(result[0].Level).To(Equal("info"))
+		Expect(result[0].Message).To(Equal(`creating cluster "test-cluster"`))
+		Expect(result[0].Fields).To(Equal(map[string]string{"cluster": "test-cluster"}))
+		Expect(result[0].Timestamp).NotTo(BeEmpty())
+		Expect(result[1].Level).To(Equal("error"))
+		Expect(result[1].Message).To(Equal("failed\ncaused by: timeout"))
+	})
+
+	It("should only log errors and successes in quiet mode", func() {
+		Expect(logger.Configure(logger.Options{Format: logger.FormatJSON, Quiet: true, Output: out})).To(Succeed())
+
+		logger.Info("creating cluster")
+		logger.Warning("something is odd")
+		logger.Success("cluster is ready")
+		logger.Critical("failed")
+
+		result := records()
+		Expect(result).To(HaveLen(2))
+		Expect(result[0].Level).To(Equal("success"))
+		Expect(result[1].Level).To(Equal("error"))
+	})
+
+	It("should add prefixes to messages", func() {
+		Expect(logger.Configure(logger.Options{Format: logger.FormatJSON, Output: out})).To(Succeed())
+
+		logger.Info("no prefix")
+		removePrefix := logger.SetPrefix("first")
+		logger.Info("prefixed")
+		logger.WithPrefix("second").Info("prefixed by the entry")
+		removePrefix()
+		logger.Info("no prefix again")
+
+		result := records()
+		Expect(result).To(HaveLen(4))
+		Expect(result[0].Prefix).To(BeEmpty())
+		Expect(result[1].Prefix).To(Equal("first"))
+		Expect(result[2].Prefix).To(Equal("second"))
+		Expect(result[3].Prefix).To(BeEmpty())
+	})
+
+	It("should reject unknown formats", func() {
+		Expect(logger.Configure(logger.Options{Format: "yaml"})).To(MatchError("unsupported log format \"yaml\", valid options: text, json"))
+	})
+})
@@ -1,8 +1,8 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// assets/10-eksclt.al2.conf (999B)
-// assets/bootstrap.al2.sh (843B)
-// assets/bootstrap.ubuntu.sh (1.946kB)
+// assets/10-eksclt.al2.conf (1.007kB)
+// assets/bootstrap.al2.sh (1.166kB)
+// assets/bootstrap.ubuntu.sh (2.18kB)
 // assets/kubelet.yaml (464B)
 
 package nodebootstrap
@@ -72,7 +72,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var __10EkscltAl2Conf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xd1\x6a\xdb\x4a\x10\x7d\xd7\x57\x2c\x24\x0f\xf7\x82\x57\xe2\xe6\x5e\xee\x43\x40\x0f\xaa\xad\x04\x53\x55\x0e\x91\x43\x0b\x6d\x11\xe3\xdd\xb1\x33\x78\x35\x2b\x76\x57\x76\xd2\xe0\x7f\x2f\xb2\xac\xd6\xa1\xa1\xf4\x4d\xbb\x67\xe6\x9c\x33\xb3\x47\x17\x02\xb7\x5e\x05\x23\x7d\x8b\x8a\xd6\xa4\x84\x7f\xf6\x01\x1b\x2d\xb4\xb3\xad\x24\x16\x1d\x53\x10\x6b\xeb\xc4\xb6\x5b\xa1\xc1\x30\x39\x1e\xb2\x06\xbe\x59\x16\x05\x71\xf7\x24\xae\xc4\x5f\x59\x71\xf5\x77\x14\x7d\xae\xd0\xed\x48\xe1\xd7\xe8\x42\x14\x56\x81\x11\x0d\x06\xd0\x10\x40\xb4\xe0\xa0\xc1\x80\xce\x5f\x8b\xfb\xfc\x76\xbe\x28\x27\x22\xfb\x58\xd5\xb3\xfc\x26\x7b\x28\x96\xf5\x70\x17\xe5\xbc\x23\x67\xb9\x41\x0e\x37\x64\x30\x4d\x30\xa8\x64\xb0\x98\x8c\x5c\x31\xf2\x2e\xba\x10\xb7\xc6\xae\xc0\x08\x60\x2d\x7c\x80\x40\xea\x95\xc6\xb4\x78\xa8\x96\xf9\x7d\x3d\x2b\xab\x89\x28\x17\xb3\xbc\x2e\xb2\x77\x79\x31\x1e\x96\xd9\xbc\x5c\x56\xbf\x95\x3b\xcd\x7b\x52\x1b\xc6\x61\xcb\xf2\x0d\xb1\x23\xe5\xfc\x6e\x22\xe6\x65\xb5\xcc\xca\x69\x5e\xcf\x67\x7f\xc4\x6d\x7a\xd6\xa3\x42\x94\x3f\xa1\xaa\x02\xb8\x90\x9e\x7d\x26\x9d\x77\xc9\x8a\x78\x6c\x10\x5f\x22\x21\xa4\x64\xab\x51\x52\x9b\x5e\xbe\x9c\x94\x0f\xe7\x80\x81\x15\x1a\x3f\x82\xc3\xd8\x87\x09\x98\xf6\x11\xe2\x41\x3f\x26\x9b\x10\xfb\x00\xac\x50\x92\x4e\x2f\x5f\xce\x8c\x8f\x5c\x0d\x3c\xc9\xd6\xea\x9e\xe8\x43\xf6\xa9\xbe\x5b\xcc\xaa\x11\x72\xb8\x21\x1f\xd0\x1d\xf5\xd2\xe0\x3a\x3c\xbf\xdc\x53\x78\x94\x01\x88\xc3\x0f\x13\xc3\xba\xc7\x76\x30\xc6\xee\x65\xeb\x68\x47\x06\x37\xa8\x07\x86\x01\x53\xc6\x76\x5a\xb6\xce\xee\x48\xa3\x4b\x61\xef\x47\xc0\x72\xcf\x89\x4e\xba\x8e\x03\x35\x98\x6a\xab\xb6\xe8\xc6\xc9\x31\xec\xad\xdb\xca\xd6\x74\x1b\xe2\x54\x31\x8d\x7d\x4c\x72\x45\x2c\x35\xb9\x34\xb1\x6d\x48\x14\x53\xbf\xd2\x33\x58\x59\x5e\x0f\x78\xff\x44\x3d\xce\x18\x62\x7d\xaa\x68\xad\x96\xc4\x6b\x07\x67\x16\xa8\x81\x0d\xa6\x97\x2f\x7d\x82\xf3\xf7\x55\x9d\x4f\xef\xeb\x6c\x3a\x5d\x3c\x94\xcb\x43\xac\xb7\x2e\x46\xe5\xe2\x01\x7e\x1d\xf0\x43\x0c\xc7\x3f\x07\xf6\x3e\x56\xb6\xe9\xf3\x90\xb4\xd0\x79\x94\xd0\xe8\xff\xff\xbb\xfe\x37\xfe\xe7\x24\xdb\xbf\x78\x6f\x8c\x36\xbf\x24\x67\xb8\x8e\x9f\xa1\x31\x3f\x97\xf3\x56\x61\x1f\xb1\xbe\x2a\xfa\x1e\x00\x00\xff\xff\x5c\x3b\x51\x59\xe7\x03\x00\x00")
+var __10EkscltAl2Conf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x51\x6b\xdb\x48\x10\xc7\xdf\xf5\x29\x16\xe2\x87\x3b\xf0\x4a\x5c\xee\x2d\xa0\x07\x9d\xad\xe4\x4c\x55\x39\x58\x0e\x2d\xb4\x45\x8c\x77\xc7\xce\xe0\xd5\xac\x58\xad\xec\xa4\xc6\xdf\xbd\xc8\x92\x8a\x4b\xda\xd2\x37\xed\xfc\x67\xfe\xbf\x99\xdd\xd1\x8d\xc0\x7d\xa3\xbc\x91\x4d\x8d\x8a\xb6\xa4\x44\xf3\xda\x78\xac\xb4\xd0\xce\xd6\x92\x58\xb4\x4c\x5e\x6c\xad\x13\xfb\x76\x83\x06\xfd\xf4\x72\x48\x2a\xf8\x6a\x59\x64\xc4\xed\x8b\xb8\x15\x7f\x25\xd9\xed\xdf\x41\xf0\xa9\x40\x77\x20\x85\x5f\x82\x1b\x91\x59\x05\x46\x54\xe8\x41\x83\x07\x51\x83\x83\x0a\x3d\xba\xe6\x4e\xac\xd2\x87\xc5\x32\x9f\x8a\xe4\x43\x51\xce\xd3\xfb\xe4\x29\x5b\x97\x7d\x2c\x48\xf9\x40\xce\x72\x85\xec\xef\xc9\x60\x1c\xa1\x57\x51\xdf\x62\x34\x7a\x85\xc8\x87\xe0\x46\x3c\x18\xbb\x01\x23\x80\xb5\x68\x3c\x78\x52\x3f\x30\x66\xd9\x53\xb1\x4e\x57\xe5\x3c\x2f\xa6\x22\x5f\xce\xd3\x32\x4b\xfe\x4b\xb3\xf1\xb0\x4e\x16\xf9\xba\xf8\x2d\x6e\x98\x77\xa0\xf5\xe3\xb0\x65\xf9\x13\xd8\xc5\x7f\xf1\x38\x15\x8b\xbc\x58\x27\xf9\x2c\x2d\x17\xf3\xa9\x48\x56\xb3\xff\xff\x88\x60\x3a\xef\x0b\x27\x48\x5f\x50\x15\x1e\x9c\x8f\xaf\x3e\xa3\xb6\x71\xd1\x86\x78\x2c\x10\x9f\x03\x21\xa4\x64\xab\x51\x52\x1d\x4f\x4e\x03\xff\x7c\x2d\x18\xd8\xa0\x69\x46\xb1\x1f\xfe\x3c\x05\x53\x3f\x43\xd8\xf3\x43\xb2\x11\x71\xe3\x81\x15\x4a\xd2\xf1\xe4\x74\xd5\xfe\xe8\x55\xc1\x8b\xac\xad\xee\x8c\xde\x27\x1f\xcb\xc7\xe5\xbc\x18\x25\x87\x3b\x6a\x3c\xba\x0b\x2f\xf6\xae\xc5\xeb\xe0\x91\xfc\xb3\xf4\x40\xec\xbf\x37\xd1\x5f\xfa\x58\x0e\xc6\xd8\xa3\xac\x1d\x1d\xc8\xe0\x0e\x75\xef\xd0\x6b\xca\xd8\x56\xcb\xda\xd9\x03\x69\x74\x31\x1c\x9b\x51\xb0\xdc\x79\xa2\x93\xae\x65\x4f\x15\xc6\xda\xaa\x3d\xba\x41\x66\xf4\x47\xeb\xf6\xb2\x36\xed\x8e\x38\x56\x4c\x63\x1d\x93\xdc\x10\x4b\x4d\x2e\x8e\x6c\xed\x23\xc5\xd4\x5d\xe9\x95\xac\x2c\x6f\x7b\xbd\x7b\xa2\x4e\x67\xf4\xa1\x1e\x32\x6a\xab\x25\xf1\xd6\xc1\x55\x0b\x54\xc1\x0e\xe3\xc9\xa9\xdb\xe3\xf4\x5d\x51\xa6\xb3\x55\x99\xcc\x66\xcb\xa7\x7c\x7d\x0e\xf5\xde\x85\xa8\x5c\x38\x39\xbd\x5d\xf3\x73\x08\x97\xff\x07\x8e\x4d\xa8\x6c\xd5\x2d\x78\x54\x43\xdb\xa0\x9c\x9c\xba\xad\x39\xdf\xfd\x1b\xfe\x33\x80\xbb\x37\xef\x5a\xa3\xdd\x9b\xdd\xe9\xc3\xe1\x2b\x54\x66\x48\xfe\x45\xa2\x41\x1f\xbe\x42\x65\x82\x6f\x03\x00\x0e\x8d\xca\x6b\xef\x03\x00\x00")
 
 func _10EkscltAl2ConfBytes() ([]byte, error) {
 	return bindataRead(
@@ -88,11 +88,11 @@ func _10EkscltAl2Conf() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "10-eksclt.al2.conf", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x99, 0xe8, 0x6b, 0xb2, 0x26, 0x2e, 0xd0, 0xc, 0x90, 0xe7, 0x18, 0x1d, 0xc, 0xc2, 0x4c, 0x58, 0x6f, 0x79, 0x96, 0x47, 0x5b, 0xec, 0xa3, 0x4e, 0xb7, 0x5b, 0x79, 0x34, 0x47, 0x66, 0x3a, 0x36}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc9, 0x44, 0x42, 0x8f, 0x55, 0x61, 0x58, 0x16, 0xa4, 0x16, 0x39, 0xee, 0x9f, 0x46, 0xbe, 0x58, 0xbc, 0x43, 0x1f, 0xd2, 0xad, 0xc5, 0xb, 0xeb, 0x8e, 0xbc, 0x49, 0xbd, 0x5b, 0x9f, 0x3, 0x2a}}
 	return a, nil
 }
 
-var _bootstrapAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x52\x5d\x6b\xdb\x30\x14\x7d\xd7\xaf\x38\x73\x4d\x69\x18\x8e\xd7\xd1\x0d\xda\x26\x83\xb2\x64\x90\x87\x25\x81\xf6\x61\x23\x64\x46\x91\x6f\x66\x51\x45\x32\xd2\x75\xd6\x12\xbc\xdf\x3e\x9c\xd9\xc5\x19\x7b\x1a\x7b\x92\xce\xc7\x3d\x5c\x1d\x74\xf6\x2a\xdd\x68\x9b\x6e\x64\x28\x84\x08\xc4\x48\x1c\xc8\x7b\x7a\xd2\xdc\xc1\x52\x97\xb4\x95\xda\x74\xd8\xba\xca\x06\x62\x21\xb6\x95\x55\xac\x9d\xc5\x77\xe2\x6c\x27\x9f\xb2\xd2\xe5\xe1\x62\x80\x83\x00\x7e\x14\xda\x10\x3c\xc9\x1c\xda\x06\x96\x56\x51\xc6\xcf\x25\xa1\xf1\xdc\x22\x77\x02\x00\xf4\x16\x58\xad\x10\xc5\x87\x13\x53\x1d\x61\x3c\x6e\xd8\xcb\x3a\xc2\x7a\x8d\xf3\xf3\xd6\xd5\x0c\x37\xe2\x4f\x7c\x5b\xbd\x49\xae\xd7\xaf\xe3\x46\xbe\x05\x17\x64\x8f\x81\x00\xa9\xc2\xa1\x75\xb6\x94\x27\xae\xfc\x6f\x7d\xab\x05\x90\x3b\x4b\x18\x21\x25\x56\x29\x3d\x06\xc5\x26\xed\xb6\x1f\xee\x64\x29\x6a\x21\xe6\x8b\xc9\x34\x9b\x2d\xc7\x51\x7c\xa1\x2a\x6f\x90\x24\x41\x1b\xb2\x8c\x82\xb9\xbc\x49\xd3\xcb\xf7\xd7\xc3\xb7\xef\xae\x86\xed\x99\x1a\xc9\x14\x38\xdd\x11\xcb\x24\x97\x2c\x53\xe3\x94\x34\x89\x2e\xf7\x57\x83\x48\xcc\xe6\xf7\x0f\x77\xf3\x8f\xd3\x6c\x36\xf9\xf7\xc4\xae\xa1\x44\xe7\xfd\xc8\x87\xaf\xcb\xe9\x7f\x08\x6d\x6a\x1f\x44\x42\x04\x57\x79\x45\x27\xdd\x3c\x56\x1b\x32\xc4\x43\xb2\x7b\x9c\x81\x0b\x1d\xa0\xa4\x85\xdb\x93\xf7\x3a\x27\x7c\xbe\xfb\x92\x2d\x17\x93\x7b\x21\x94\x64\x7c\xf8\xeb\xec\xb1\x8e\x63\xc2\x68\x34\x5d\x7c\x7a\xe9\x37\x3e\xb4\xb7\xfa\xa4\xa4\xf8\xd0\x43\xf5\x1f\x8f\xed\x89\x0d\xae\x45\xb7\xc0\x38\x3e\x74\xd7\x9b\x24\xbe\xe8\xff\xca\xe6\xf3\x9c\x4e\x45\x83\x5a\x34\x9b\x88\xf0\x1c\x98\x76\x8a\x0d\x72\x49\x3b\x67\x13\x4f\xc6\xc9\xbc\xc7\x93\x95\x1b\x43\x68\xdf\xd2\x13\x02\x4b\xcf\x2f\xfc\xaf\x00\x00\x00\xff\xff\x07\x7d\xb2\xb5\x4b\x03\x00\x00")
+var _bootstrapAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\x61\x6f\xdb\x36\x10\xfd\xce\x5f\xf1\xc6\x08\x45\xbc\x81\x51\x12\x64\x01\x9a\xc4\x03\x82\xc6\xc3\x82\xa1\xb6\xb1\x78\x40\x87\x20\x33\x68\xe9\x3c\x11\x96\x48\x8d\x3c\xd9\x2d\x0c\xed\xb7\x0f\x54\xe4\x4e\xe9\x96\xf5\x93\x78\xf7\xde\x9d\xde\x3d\x1e\x8f\xbe\x49\x57\xc6\xa6\x2b\x1d\x0a\x21\x02\x31\x94\x03\x79\x4f\x1f\x0d\x1f\xc2\xda\xd4\xb4\xd6\xa6\x3c\xc4\xd6\x35\x36\x10\x0b\xb1\x6e\x6c\xc6\xc6\x59\xfc\x41\xbc\xac\xf4\xc7\x65\xed\xf2\x70\x3c\xc2\x5e\x00\xbb\xc2\x94\x04\x4f\x3a\x87\xb1\x81\xb5\xcd\x68\xc9\x9f\x6a\x42\xe4\x5c\x23\x77\x02\x00\xcc\x1a\x78\x7c\x84\x4c\xf6\x2f\x48\xad\xc4\x78\x1c\xb3\x67\xad\xc4\xd3\x13\xde\xbc\xe9\x59\xb1\x38\x82\x7f\xe1\xf7\xc7\x53\xf5\xf6\xe9\xbb\x24\xc2\xd7\xe0\x82\x6c\xd7\x10\xa0\xac\x70\xe8\x99\x7d\xca\x13\x37\xfe\x19\x5f\x1b\x01\xe4\xce\x12\x6e\x90\x12\x67\x29\x6d\x42\xc6\x65\x7a\x50\x7f\x52\xe9\x5a\xb4\x42\x1c\xe1\xfe\xfd\xdd\xc3\xf6\x1c\x81\x42\x88\x23\xb2\xdb\x90\x0d\xd8\x39\xbf\xc1\xae\x20\x2e\xc8\xc3\x79\x58\xc7\xcf\xcc\x33\x98\x00\xb2\x7a\x55\x52\x2e\x62\x66\xb9\x98\xfd\x3c\x99\x8e\x65\x72\x9c\x35\xbe\x84\x52\xc1\x94\x64\x19\x4a\x45\x2f\xa1\x94\xa7\x3f\x1b\x0a\x8c\xf9\xaf\x0b\x28\x55\x90\xce\xc9\x43\x7e\x50\x7a\x17\x14\x65\xe7\xaa\x22\xd6\xb9\x66\xad\xba\x5f\x2b\xe6\x52\x05\xca\x9c\xcd\xc3\x15\x2e\x4f\x4f\x25\x0a\xe6\xfa\x2a\x4d\xcf\x2e\xdf\x9e\x9c\x7f\x7f\x71\xd2\x7f\xd3\x52\x33\x05\x4e\x75\x6d\xd2\xae\x72\x24\xbf\xbc\xa9\xbe\x71\x7f\x53\xaf\xc8\xfb\x9a\xa0\x2b\x24\xfb\x7f\xe6\x6c\x25\xe4\xff\xeb\x89\xd5\x2a\x96\xa7\xdd\xb5\x46\x93\xa7\xb3\xbb\xc9\xf2\x7e\x1e\x3d\x1a\xea\x42\xe9\x32\x5d\x2a\x53\x6f\x2f\x46\x52\xdc\x4f\x1f\x16\xb7\xd3\x77\x93\xe5\xfd\xdd\xbf\x88\x87\xa5\x51\x26\x1f\x32\x17\xbf\xcd\x27\xaf\x73\xe3\x16\x46\x4f\x32\x1d\x08\x32\x39\x6e\xac\xae\x08\xaa\x1a\x49\x98\xb8\x24\x5a\xfb\xac\xb8\xbc\x18\xe1\xf6\x97\x77\x3f\x8d\xa5\xf6\xd5\xe5\x85\xc4\xf5\xb5\x00\xbe\xfd\x9c\xac\xf2\x3e\x49\x41\x67\x42\x04\xd7\xf8\x8c\x5e\x6c\xd4\xa6\x59\x51\x49\x7c\x42\x76\x8b\x23\x70\x61\x02\x32\x6d\xe1\xb6\xe4\xbd\xc9\x09\xef\x6f\x3f\x2c\xe7\xb3\xbb\x87\x28\x84\xf1\xc3\x7f\xd6\x76\x46\x74\x1d\x6e\x6e\x26\xb3\x1f\x3f\x1b\x96\xec\xfb\x53\xfb\xc2\x9e\x64\x3f\x88\x06\x50\xe7\xc7\x00\x8c\x71\x2b\xba\x49\x92\x7d\xfc\xb4\xe2\xa0\x66\x9c\xec\x0f\xc7\x2b\x95\x1c\x0f\x1f\x36\xe4\x97\x2d\xe4\xa8\x15\x51\x96\x08\x9f\x02\x53\x95\x71\x89\x5c\x53\xe5\xac\xf2\x54\x3a\x9d\x0f\xf2\xcf\x2f\x03\xfd\x60\x03\x20\xb0\xf6\x8c\x4d\xb3\xa2\x92\x58\xfc\x3d\x00\x00\xe7\x88\x66\x8e\x04\x00\x00")
 
 func bootstrapAl2ShBytes() ([]byte, error) {
 	return bindataRead(
@@ -108,11 +108,11 @@ func bootstrapAl2Sh() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "bootstrap.al2.sh", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x63, 0xa0, 0x73, 0xd4, 0x3, 0x5, 0xfb, 0x57, 0xa7, 0x41, 0x53, 0xfb, 0x4b, 0x10, 0xfd, 0x3, 0x84, 0xe, 0xd5, 0xde, 0x6a, 0xea, 0x47, 0xee, 0xb7, 0x76, 0xb5, 0xc7, 0x89, 0x24, 0xc9, 0xd0}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb9, 0x2, 0x10, 0xbb, 0x29, 0x1e, 0xce, 0xac, 0x10, 0x1e, 0x85, 0x5f, 0xec, 0x3b, 0x1b, 0x29, 0x5b, 0xb, 0xea, 0xf7, 0xe1, 0xb4, 0x60, 0x90, 0xb5, 0xea, 0x83, 0x17, 0x79, 0xea, 0x1, 0xd0}}
 	return a, nil
 }
 
-var _bootstrapUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x61\x6f\x22\x37\x10\xfd\xee\x5f\x31\x25\xe8\x0a\x6a\xbc\xdb\x5c\xd3\x93\x2e\xc9\x56\xa5\x09\x57\x45\x4d\x21\xba\x70\x6a\xab\x28\x45\xc6\x1e\x58\x0b\xaf\xbd\xb2\x67\x21\x29\xa2\xbf\xbd\x32\xbb\x4b\x48\x74\xbd\x0f\x55\x3f\xb1\xf6\x7b\xf3\x76\xf6\xf9\x79\x38\xfa\x2a\x9d\x69\x9b\xce\x44\xc8\x19\x0b\x48\xc0\x1d\xa0\xf7\xf8\xa8\xa9\x5d\x96\xba\xc4\xb9\xd0\xa6\x5d\x5b\x57\xd9\x80\xc4\xd8\xbc\xb2\x92\xb4\xb3\xb0\x40\x9a\x16\xe2\x71\x5a\x3a\x15\x7a\x7d\xd8\x30\x80\x75\xae\x0d\x82\x47\xa1\x40\xdb\x40\xc2\x4a\x9c\xd2\x53\x89\x10\x39\xe7\xa0\x1c\x03\x00\xd0\x73\x80\xfb\x7b\xe8\x74\x37\x2f\x48\xdb\x0e\x64\x59\xdc\x3d\xd9\x76\xe0\xe1\x01\xde\xbc\x69\x58\xb1\x38\x82\x7f\xc3\x9f\xf7\xdf\xf2\xf7\x0f\xdf\x74\x23\x7c\x0e\x94\xa3\xdd\x09\x02\xa0\xcc\x1d\x34\xcc\xf3\x66\xcf\x23\x55\xbe\x26\xcc\x35\x03\x50\xce\x22\x5c\x40\x8a\x24\x53\x5c\x06\x49\x26\x6d\xdb\x4f\x0a\x51\xb2\x2d\x63\xa3\xf1\xd5\x70\x7a\x7d\x9b\x75\xba\x3d\x59\x79\x03\x9c\x07\x6d\xd0\x12\xe4\x44\xe5\x59\x9a\x9e\xbc\x7b\x9f\xbc\xfd\xfe\x34\x69\x7e\x53\x23\x08\x03\xa5\x05\x92\xe0\x4a\x90\x48\x8d\x93\xc2\x70\x5d\xae\x4e\xfb\x1d\x76\x3d\xba\x9b\x0c\x46\x97\xc3\xe9\xf5\xd5\x7f\x57\x6c\x2d\xe2\x5a\x1d\x4a\x4e\xfe\xb8\x1d\xfe\x0f\xa2\xd1\xf7\x7e\x87\xb1\xe0\x2a\x2f\xf1\x85\x37\xcb\x6a\x86\x06\x29\x41\xbb\x82\x23\xa0\x5c\x07\x90\xc2\x82\x5b\xa1\xf7\x5a\x21\xfc\x3a\xf8\x7d\x7a\x3b\xbe\xba\x63\x4c\x0a\x82\x1f\x3e\x5b\xbb\xb3\x63\xa7\x70\x71\x31\x1c\x7f\xd8\xfb\xdb\xdd\x34\x4f\xdb\x17\x26\x75\x37\x07\xab\xed\xab\x8f\x3d\x00\xe3\x7a\xcb\xda\x06\xb2\xee\xa6\x7d\x3c\xe3\xdd\xde\x61\x2c\x63\x7a\x5e\x56\x75\xfa\x5b\x16\x3b\x61\xc1\x8a\x12\x84\xd1\x22\x40\xd3\x2d\xc7\x65\x48\x9a\xe7\x76\xef\x35\x4d\x92\xd9\xd3\x24\x99\x76\xaf\xa6\x05\x72\xe5\xa1\x18\x0b\x4f\x81\xb0\x88\x3c\x8f\x01\x89\xc7\xfb\x84\x8a\xb1\x1e\x03\x38\x82\xc9\xf8\x6a\x7c\x16\x43\x1c\x10\x42\xee\x2a\xa3\x60\x86\x60\x9c\x5b\xa2\x02\x41\x80\x2b\xf4\x4f\x40\xba\xc0\x56\x14\x02\x09\x4f\x01\xaa\xf2\x78\xa7\xb0\xce\xb5\xcc\x41\x07\x58\xe7\x82\x60\x8d\xa0\x1c\x68\x0b\x83\x9b\xb7\xd0\xdb\x63\x33\x11\x50\x81\xb3\x50\x1a\xa1\x2d\xd4\x3d\xa9\x5a\x40\x58\x05\x05\x0a\x4b\x40\x2e\xbe\xbc\x74\x9e\xc4\xcc\x60\x5c\x16\x2e\x50\xcb\x06\xa5\x03\x79\x17\xfa\xc7\x30\xab\x08\x34\x7d\x1d\x76\xf5\xd6\x11\x48\x83\xc2\x43\xee\xd6\xb1\xc8\x38\xa1\x9a\x4f\x9a\x7b\x57\x3c\x37\x1e\xfd\x59\x6b\xca\x5d\x45\x90\x8b\x95\xb6\x8b\x9d\x00\x39\x90\x55\x20\x57\xe8\x80\xb1\xae\x26\x6a\x0a\x68\xe6\x0c\xe0\x0b\xb1\xdc\x47\xeb\xcb\xb4\x7f\x25\xc4\xcb\x10\xef\xc2\x8e\xc1\x00\xe6\x46\x2c\x42\xd6\xdb\x8d\x8b\x8e\x75\x0a\xb9\x2e\x0f\x72\xda\xa9\x81\x42\x3c\xf2\x18\xac\x83\xcc\xb5\xd0\xae\xc6\x88\x19\x9a\xd0\xd6\xdd\x0c\x7e\x1a\xde\xdc\x6d\x8f\x85\x29\x73\x91\xd4\x2f\x4e\xb4\x3b\xbc\xd3\xaf\x32\xdf\x68\x09\x63\xdc\x9a\x97\x5e\xaf\xb4\xc1\x05\xaa\x8c\x7c\x85\x0d\x56\x3a\xc5\xb5\x9d\x7b\xc1\xa5\xb3\x24\xb4\x45\xcf\x75\x21\x16\x98\x75\x37\x83\xdf\xee\xa6\xc3\x5f\xee\xa6\xc3\xcb\x8f\xd3\xc1\xe5\xe5\xf8\xd3\x68\xb2\x4d\xd4\xd2\x27\x28\x7d\x52\xc3\x57\xc3\x0f\x83\x4f\x37\x93\xe9\xc7\xe1\xcf\xd7\xe3\xd1\x36\x11\x85\xf8\xcb\x59\xb1\x0e\x89\x74\x45\x34\x27\x2d\x45\x15\x90\x8b\x42\xbd\x3b\x3d\xfb\x2e\x39\x69\x5e\x2b\x8d\xab\x14\x2f\xbd\x5b\x69\x85\x3e\x13\xeb\xd0\x02\x56\xf3\x99\xb6\x5c\x69\x9f\xa5\xae\xa4\x54\x5a\x1d\xff\x5d\x0e\x60\xe9\xec\xbc\xc6\xe3\x01\x44\xdc\x22\x25\xaa\x65\xec\x3f\xc3\x57\x36\xc6\x3d\x53\x4e\x2e\xd1\xb7\xbe\x22\xad\x9d\x5f\xf2\xd2\x54\x0b\x6d\x33\x69\x75\x03\x78\x5c\xe8\x40\xe8\x79\x74\xfe\xd0\xa1\x3d\x10\x03\xc7\xa3\x36\xed\x8f\x64\x32\xb8\x1e\x4d\xf6\x67\xb6\xbb\xbe\xce\xce\xf5\x22\x7b\x1d\x9e\x7a\x3b\x79\x12\x85\x79\xee\xf3\x73\xc4\x98\xb2\x96\xd5\x8f\x49\xaa\x67\xc1\xf3\x0c\x89\xa3\x20\x0e\xa2\x5d\xc2\xee\x7f\x7c\xd8\x76\x58\x9f\xb5\x13\x43\xf8\x17\x3c\xf6\x4f\x00\x00\x00\xff\xff\x8c\x16\xcc\x40\x9a\x07\x00\x00")
+var _bootstrapUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x55\x61\x8f\xdb\x36\x12\xfd\xce\x5f\x31\xa7\x35\x72\x6b\x5c\x28\x65\xf7\x72\x01\xb2\x89\x0e\x75\xd7\x4e\x61\x64\x63\x2f\x62\x07\x4d\xb1\xd8\x1a\xb4\x38\xb6\x08\x53\xa4\x4a\x8e\xec\x6c\x0d\xf7\xb7\x17\x94\x25\x47\xd9\x76\xd3\x4f\x16\xf9\xde\x0c\xdf\x0c\xdf\xd0\x67\xff\x4a\x96\xca\x24\x4b\xe1\x73\xc6\x3c\x12\x70\x0b\xe8\x1c\x7e\x51\xd4\x2e\x4b\x55\xe2\x4a\x28\xdd\xae\x8d\xad\x8c\x47\x62\x6c\x55\x99\x8c\x94\x35\xb0\x46\x5a\x14\xe2\xcb\xa2\xb4\xd2\x9f\xf7\x61\xcf\x00\x76\xb9\xd2\x08\x0e\x85\x04\x65\x3c\x09\x93\xe1\x82\x1e\x4a\x84\xc0\x79\x03\xd2\x32\x00\x00\xb5\x02\xb8\xbb\x83\xa8\xb7\xff\x86\x74\x88\x20\x4d\xc3\xee\xc5\x21\x82\xfb\x7b\x78\xf6\xac\x61\x85\xe0\x00\xfe\x01\xbf\xde\xbd\xe0\xaf\xef\xff\xd3\x0b\xf0\x1b\xa0\x1c\x4d\x9d\x10\x00\xb3\xdc\x42\xc3\x7c\xd3\xec\x39\xa4\xca\x1d\x09\x2b\xc5\x00\xa4\x35\x08\x6f\x21\x41\xca\x12\xdc\xf8\x8c\x74\xd2\xca\x8f\x0b\x51\xb2\x03\x63\x67\x30\xfe\x30\x9c\x6d\x2f\xc1\xa3\xf7\xa1\x46\xb2\x1b\x34\x1e\x76\xd6\x6d\x60\x97\x23\xe5\xe8\xc0\x3a\x30\x96\x8e\xcc\x0b\x50\x1e\xd0\x88\xa5\x46\xc9\xc2\xce\x62\x3e\x7d\x3f\x9a\xa4\x51\xef\x3c\xab\x9c\x06\xce\xbd\xd2\x68\x08\x38\x0f\xcd\x04\xce\x1d\xfe\x56\xa1\x27\xb8\xfd\x34\x07\xce\x73\x14\x12\x1d\x44\x9f\xb9\xd8\x79\x8e\xd9\x25\x2f\x90\x84\x14\x24\x78\x7d\x34\x27\xd2\xdc\x63\x66\x8d\xf4\x57\xf0\xea\xc5\x8b\x08\x72\xa2\xf2\x2a\x49\x2e\x5e\xbd\x8e\x2f\xff\xf7\x32\x6e\x7e\x13\x2d\x08\x3d\x25\xa2\x54\x49\x1d\xd9\x8f\x1e\x5f\x55\x93\xb8\xb9\xaa\x27\xe4\xfd\x93\xa0\x2b\xe8\xed\xbf\xd6\x79\x88\x20\xfa\xbe\x9e\x10\xcd\x43\x78\x52\xdf\x6b\x68\xf2\x64\x3a\x1c\x2d\xc6\xb7\xa1\x47\x5d\x5d\xa0\x6d\x26\x34\x57\xe5\xf6\x65\x3f\x62\xe3\xc9\x6c\x3e\x98\x5c\x8f\x16\xe3\xe1\x5f\x88\xad\x6b\xb8\x92\x5d\xe6\xfc\x97\xdb\xd1\xd3\xdc\x60\xc3\xd0\x13\x6f\x2b\x97\xe1\x37\x2e\xd8\x54\x4b\xd4\x48\x31\x9a\x2d\x9c\x01\xe5\xca\x43\x26\x0c\xd8\x2d\x3a\xa7\x24\xc2\x87\xc1\xe7\xc5\xed\x74\x38\x63\x2c\x13\x04\xff\xff\xdb\xd8\x5a\x7c\x9d\xe1\xed\xdb\xd1\xf4\xdd\xa9\xc8\xde\xbe\xf9\x3a\x7c\x53\x52\x6f\xdf\x59\x75\xa0\xba\x86\x0e\x18\xd6\x07\xd6\x0a\x48\x7b\xfb\xf6\xf3\x8a\xf7\xce\xbb\x03\x08\xd1\xe3\xa8\xa8\x7f\x60\x41\x09\xf3\x46\x94\x20\xb4\x12\x1e\x1a\xb5\x1c\x37\x3e\x6e\xbe\xdb\xbd\xc7\xb4\x8c\xf4\x89\x96\x91\x6e\xf7\x8e\x34\x4f\xb6\xec\x26\x63\xfe\xc1\x13\x16\x81\xe7\xd0\x23\xd5\x66\x47\xc9\xd8\x39\x03\x38\x83\xf9\x74\x38\xbd\x0a\xe3\xea\x11\x7c\x6e\x2b\x2d\x61\x89\xa0\xad\xdd\xa0\x04\x41\x80\x5b\x74\x0f\x40\xaa\xc0\x36\x29\x78\x12\x8e\x3c\x54\xe5\xf3\x3a\xc3\x2e\x57\x59\x1e\x66\x6d\x97\x0b\x82\x1d\x82\xb4\xa0\x0c\x0c\x6e\x2e\xe1\xfc\x84\x2d\x85\x47\x09\xd6\x40\xa9\x85\x32\x70\xd4\x24\x8f\x09\x84\x91\x50\xa0\x30\x04\x64\xc3\xe1\xa5\x75\x14\xa6\x36\x2c\x0b\xeb\xa9\x65\x83\x54\x9e\x9c\xf5\xfd\xe7\xb0\xac\x08\x14\xfd\xdb\xd7\xf1\x61\xe2\x33\x8d\xc2\x41\x6e\x77\x21\x48\x5b\x21\x9b\x92\x56\xce\x16\x5f\x85\x87\xfe\xec\x14\xe5\xb6\x22\xc8\xc5\x56\x99\x75\x9d\x80\x2c\x64\x95\x27\x5b\x28\x8f\x21\xee\x48\x54\xe4\x51\xaf\x18\xc0\x77\x6c\x79\xb2\xd6\xf7\x69\x4f\x12\xda\x59\x08\xee\x64\x0c\x60\xa5\xc5\xda\xa7\xe1\x66\x00\x22\x63\x25\x72\x55\x76\x7c\x1a\x1d\x81\x42\x7c\xe1\xc1\x58\x1d\xcf\xb5\x50\x1d\xa3\xc5\x12\xb5\x6f\xe3\x6e\x06\x3f\x8e\x6e\x66\x87\xe7\x42\x97\xb9\x88\x8f\x07\xc7\xca\x26\x9d\x51\x4d\x3b\x06\x1d\x0f\xdb\x5c\x42\x6b\xbb\xe3\xa5\x53\x5b\xa5\x71\x8d\x32\x25\x57\x61\x83\x95\x56\x72\x65\x56\x4e\xf0\xcc\x1a\x12\xca\xa0\xe3\xaa\x10\x6b\x4c\x7b\xfb\xc1\xcf\xb3\xc5\xe8\xfd\x6c\x31\xba\xfe\xb8\x18\x5c\x5f\x4f\x3f\x4d\xe6\x87\x58\x6e\x5c\x8c\x99\x8b\x8f\xf0\x70\xf4\x6e\xf0\xe9\x66\xbe\xf8\x38\xfa\x69\x3c\x9d\x1c\x62\x51\x88\xdf\xad\x11\x3b\x1f\x67\xb6\x08\xdd\x4b\x4a\x51\x79\xe4\xa2\x90\xaf\x5e\x5e\xfd\x37\xbe\x68\x8e\xcd\xb4\xad\x24\x2f\x9d\xdd\x2a\x89\x2e\x15\x3b\xdf\x02\x46\xf1\xa5\x32\x5c\x2a\x97\x26\xb6\xa4\x24\x33\x2a\xfc\x8f\x76\xe0\xcc\x9a\xd5\x11\x0f\x17\x10\x70\x83\x14\xcb\x96\x71\x2a\xc3\x55\x26\xd8\x3d\x95\x36\xdb\xa0\x6b\x60\x83\x14\xfe\x68\x78\xa9\xab\xb5\x32\x69\x66\x54\x03\x38\x5c\x2b\x4f\xe8\x78\xe8\x7c\xb7\x43\x27\x20\x18\x8e\x87\xdc\x74\xba\x92\xf9\x60\x3c\x99\xcf\xda\x3e\x07\x97\x04\x71\x6a\x9d\x3e\x36\xcf\x71\x3b\x7e\x10\x85\x6e\xc8\x4f\x10\x83\xcb\x5a\x56\x3f\x38\xa9\xb6\xb0\xff\xfa\x86\x84\xa7\x20\x3c\x44\xb5\xc3\xee\x7e\xb8\x3f\x44\xac\xdf\xbc\x3f\xf5\x3c\x77\x79\xec\xcf\x01\x00\x4b\xaa\x28\x2c\x84\x08\x00\x00")
 
 func bootstrapUbuntuShBytes() ([]byte, error) {
 	return bindataRead(
@@ -128,11 +128,11 @@ func bootstrapUbuntuSh() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "bootstrap.ubuntu.sh", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x99, 0x66, 0x92, 0x12, 0x80, 0x3a, 0x7d, 0x6f, 0xf5, 0xf9, 0x2c, 0x3d, 0xe8, 0x7d, 0xb3, 0x6b, 0xa4, 0x87, 0xc5, 0x2d, 0xf0, 0xec, 0x6e, 0x84, 0x93, 0x59, 0x1f, 0xc, 0x6b, 0x7f, 0x5f, 0x4b}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x80, 0x49, 0x7a, 0xb6, 0x3, 0x5a, 0xb2, 0xa7, 0x9b, 0xae, 0xbf, 0x28, 0xcc, 0xc5, 0xe3, 0xe5, 0xe0, 0x7, 0xa1, 0x3f, 0x10, 0xea, 0xf8, 0x29, 0x2a, 0x27, 0x8b, 0xf5, 0x68, 0x8e, 0xd2, 0x8b}}
 	return a, nil
 }
 
-var _kubeletYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x4f\x4f\xc3\x30\x0c\xc5\xef\xf9\x14\xfe\x04\x6d\x07\x9a\x04\xb9\x8d\x4d\x70\x60\x27\x36\xe0\xec\xa6\xee\x16\x35\x8d\x27\xc7\x19\x7f\x3e\x3d\x5a\x5a\x90\x26\xa1\x9c\x9e\xde\xb3\xdf\x4f\xce\xe0\x63\x67\xe1\x39\xb7\x14\x48\xd7\x1c\x7b\x7f\xc8\x82\xea\x39\x1a\x3c\xf9\x37\x92\xe4\x39\x5a\x18\xa6\x40\xe5\x4a\xa2\x1a\xee\x52\xe5\xb9\x3e\x2f\x5a\x52\x5c\x18\x83\x5d\x27\x94\x92\x85\xa6\x2a\xcf\xb8\x90\x93\x92\x6c\x78\x44\x1f\x2d\xcc\xb2\x0a\xec\x30\x18\x83\x59\x8f\x14\xd5\xbb\x52\x64\x0d\x00\x46\x8e\x5f\x23\xe7\x74\x11\x00\x14\xb1\x0d\xd4\x59\xe8\x31\x24\x32\x00\x1f\xd4\x1e\x99\x87\xc9\x75\xe8\x8e\xb4\xdf\x6f\x2d\xdc\x8c\x4d\xba\x1e\x50\xc9\x97\xfc\xe7\xb2\xb9\x9f\xc3\xc1\x53\xd4\xf5\xea\xd1\x07\xb2\x50\x93\xba\x9a\x86\xe4\x34\xd4\x0e\x2b\x27\x3a\xd1\xb0\xf8\xef\x3f\x98\x91\x3b\xb2\xf0\x3e\x55\xfe\x5b\xbe\x9a\x47\xa8\x2b\x18\xcb\x5f\x8c\x62\xbe\x46\xbc\xb6\x6f\x9b\x64\x4c\x22\x39\x93\xec\xb7\xbb\x07\x66\x4d\x2a\x78\x9a\x61\x8d\x3b\x08\xe7\xd3\x46\xfc\x99\xc4\xc2\xa4\xfa\x64\x4c\x4f\xa8\x59\xe8\x09\x95\xca\x59\x5e\x58\x51\x69\xfe\xaa\x5d\x59\xb7\x26\x51\xdf\x5f\xee\x48\xf3\xb6\x9f\x00\x00\x00\xff\xff\x1f\x2f\xa9\x0f\xd0\x01\x00\x00")
+var _kubeletYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x4f\x4f\xc3\x30\x0c\xc5\xef\xf9\x14\xfe\x04\x6d\x07\x9a\x04\xb9\x8d\x4d\x70\x60\x27\x36\xe0\xec\xa6\xee\x16\x35\x8d\x27\xc7\x19\x7f\x3e\x3d\x5a\x5a\x90\x26\xa1\x9c\x9e\xde\x73\xde\x4f\xf6\xe0\x63\x67\xe1\x39\xb7\x14\x48\xd7\x1c\x7b\x7f\xc8\x82\xea\x39\x1a\x3c\xf9\x37\x92\xe4\x39\x5a\x18\xa6\x40\xe5\x4a\xa2\x1a\xee\x52\xe5\xb9\x3e\x2f\x5a\x52\x5c\x18\x83\x5d\x27\x94\x92\x85\xa6\x2a\xcf\xb8\x90\x93\x92\x6c\x78\x44\x1f\x2d\xcc\xb2\x0a\xec\x30\x18\x83\x59\x8f\x14\xd5\xbb\x52\x64\x0d\x00\x46\x8e\x5f\x23\xe7\x74\x11\x00\x14\xb1\x0d\xd4\x59\xe8\x31\x24\x32\x00\x1f\xd4\x1e\x99\x87\xc9\x75\xe8\x8e\xb4\xdf\x6f\x2d\xdc\x8c\x4d\xba\x1e\x50\xc9\x97\xfc\xe7\xb2\xb9\x9f\xc3\xc1\x53\xd4\xf5\xea\xd1\x07\xb2\x50\x93\xba\x9a\x86\xe4\x34\xd4\x0e\x2b\x27\x3a\xd1\xb0\xf8\xef\x3f\x98\x91\x3b\xb2\xf0\x3e\x55\xfe\x5b\xbe\x9a\x47\xa8\x2b\x18\xcb\x5f\x8c\x62\xbe\x46\xbc\xb6\x6f\x9b\x64\x4c\x22\x39\x93\xec\xb7\xbb\x07\x66\x4d\x2a\x78\x9a\x61\x8d\x3b\x08\xe7\xd3\x46\xfc\x99\xc4\xc2\xa4\xfa\x64\x4c\x4f\xa8\x59\xe8\x09\x95\xca\x5a\x5e\x58\x51\x69\x3e\xd5\xae\x7c\xb7\x26\x51\xdf\x5f\xf6\x48\x16\x54\x32\x99\x9f\x01\x00\x1f\x2f\xa9\x0f\xd0\x01\x00\x00")
 
 func kubeletYamlBytes() ([]byte, error) {
 	return bindataRead(
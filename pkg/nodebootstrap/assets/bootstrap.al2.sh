@@ -13,9 +13,21 @@ function get_max_pods() {
   done < /etc/eksctl/max_pods.map
 }
 
-NODE_IP="$(curl --silent http://169.254.169.254/latest/meta-data/local-ipv4)"
-INSTANCE_ID="$(curl --silent http://169.254.169.254/latest/meta-data/instance-id)"
-INSTANCE_TYPE="$(curl --silent http://169.254.169.254/latest/meta-data/instance-type)"
+# IMDSv2 session tokens work whether or not IMDSv1 is enabled
+IMDS_TOKEN="$(curl --silent --fail --request PUT --header "X-aws-ec2-metadata-token-ttl-seconds: 600" http://169.254.169.254/latest/api/token)"
+
+function get_metadata() {
+  curl --silent --fail --header "X-aws-ec2-metadata-token: ${IMDS_TOKEN}" "http://169.254.169.254/latest/meta-data/${1}"
+}
+
+NODE_IP="$(get_metadata local-ipv4)"
+INSTANCE_ID="$(get_metadata instance-id)"
+INSTANCE_TYPE="$(get_metadata instance-type)"
+
+case "$(uname -m)" in
+  aarch64) ARCH="arm64" ;;
+  *) ARCH="amd64" ;;
+esac
 
 source /etc/eksctl/kubelet.env # this can override MAX_PODS
 
@@ -23,6 +35,7 @@ cat > /etc/eksctl/kubelet.local.env <<EOF
 NODE_IP=${NODE_IP}
 INSTANCE_ID=${INSTANCE_ID}
 INSTANCE_TYPE=${INSTANCE_TYPE}
+ARCH=${ARCH}
 MAX_PODS=${MAX_PODS:-$(get_max_pods "${INSTANCE_TYPE}")}
 EOF
 
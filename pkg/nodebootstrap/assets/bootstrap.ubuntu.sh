@@ -13,9 +13,16 @@ function get_max_pods() {
   done < /etc/eksctl/max_pods.map
 }
 
-NODE_IP="$(curl --silent http://169.254.169.254/latest/meta-data/local-ipv4)"
-INSTANCE_ID="$(curl --silent http://169.254.169.254/latest/meta-data/instance-id)"
-INSTANCE_TYPE="$(curl --silent http://169.254.169.254/latest/meta-data/instance-type)"
+# IMDSv2 session tokens work whether or not IMDSv1 is enabled
+IMDS_TOKEN="$(curl --silent --fail --request PUT --header "X-aws-ec2-metadata-token-ttl-seconds: 600" http://169.254.169.254/latest/api/token)"
+
+function get_metadata() {
+  curl --silent --fail --header "X-aws-ec2-metadata-token: ${IMDS_TOKEN}" "http://169.254.169.254/latest/meta-data/${1}"
+}
+
+NODE_IP="$(get_metadata local-ipv4)"
+INSTANCE_ID="$(get_metadata instance-id)"
+INSTANCE_TYPE="$(get_metadata instance-type)"
 
 source /etc/eksctl/kubelet.env # this can override MAX_PODS
 
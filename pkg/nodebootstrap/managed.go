@@ -0,0 +1,74 @@
+package nodebootstrap
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// managedUserDataBoundary is a fixed MIME boundary, so that user data doesn't
+// change (and trigger a launch template update) every time it gets generated
+const managedUserDataBoundary = "//eksctl-managed-nodegroup//"
+
+// NewUserDataForManagedNodeGroup creates new user data for a managed nodegroup;
+// when a custom AMI is used, the user data is a plain shell script that has to
+// bootstrap the node, otherwise it's a MIME multi-part document that EKS merges
+// with its own user data for the EKS-optimised AMI; an empty string is returned
+// when there is nothing to run
+func NewUserDataForManagedNodeGroup(ng *api.ManagedNodeGroup) (string, error) {
+	var script bytes.Buffer
+	script.WriteString("#!/bin/bash\nset -ex\n")
+	for _, command := range ng.PreBootstrapCommands {
+		script.WriteString(command + "\n")
+	}
+
+	if api.IsAMI(ng.AMI) {
+		if ng.OverrideBootstrapCommand == nil {
+			return "", fmt.Errorf("overrideBootstrapCommand must be set when using custom AMI (%s)", ng.AMI)
+		}
+		script.WriteString(*ng.OverrideBootstrapCommand + "\n")
+		logger.Debug("user-data = %s", script.String())
+		return base64.StdEncoding.EncodeToString(script.Bytes()), nil
+	}
+
+	if len(ng.PreBootstrapCommands) == 0 {
+		return "", nil
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.SetBoundary(managedUserDataBoundary); err != nil {
+		return "", errors.Wrap(err, "setting MIME boundary")
+	}
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type": []string{`text/x-shellscript; charset="us-ascii"`},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "creating MIME part")
+	}
+	if _, err := part.Write(script.Bytes()); err != nil {
+		return "", errors.Wrap(err, "writing MIME part")
+	}
+	if err := writer.Close(); err != nil {
+		return "", errors.Wrap(err, "closing MIME document")
+	}
+
+	userData := strings.Join([]string{
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/mixed; boundary=%q", managedUserDataBoundary),
+		"",
+		body.String(),
+	}, "\r\n")
+
+	logger.Debug("user-data = %s", userData)
+	return base64.StdEncoding.EncodeToString([]byte(userData)), nil
+}
@@ -0,0 +1,56 @@
+package nodebootstrap
+
+import (
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("User data for managed nodegroups", func() {
+	var ng *api.ManagedNodeGroup
+
+	BeforeEach(func() {
+		ng = api.NewManagedNodeGroup()
+		ng.Name = "mng-1"
+	})
+
+	decode := func(userData string) string {
+		data, err := base64.StdEncoding.DecodeString(userData)
+		Expect(err).ToNot(HaveOccurred())
+		return string(data)
+	}
+
+	It("should be empty when there are no commands", func() {
+		userData, err := NewUserDataForManagedNodeGroup(ng)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(userData).To(BeEmpty())
+	})
+
+	It("should produce a MIME multi-part document for the EKS-optimised AMI", func() {
+		ng.PreBootstrapCommands = []string{"echo foo > /etc/foo"}
+
+		userData, err := NewUserDataForManagedNodeGroup(ng)
+		Expect(err).ToNot(HaveOccurred())
+
+		data := decode(userData)
+		Expect(data).To(HavePrefix("MIME-Version: 1.0\r\n"))
+		Expect(data).To(ContainSubstring(`Content-Type: multipart/mixed; boundary="//eksctl-managed-nodegroup//"`))
+		Expect(data).To(ContainSubstring(`Content-Type: text/x-shellscript; charset="us-ascii"`))
+		Expect(data).To(ContainSubstring("echo foo > /etc/foo\n"))
+		Expect(data).To(HaveSuffix("--//eksctl-managed-nodegroup//--\r\n"))
+	})
+
+	It("should produce a shell script for a custom AMI", func() {
+		ng.AMI = "ami-0123456789abcdef0"
+		ng.PreBootstrapCommands = []string{"echo foo > /etc/foo"}
+		ng.OverrideBootstrapCommand = new(string)
+		*ng.OverrideBootstrapCommand = "/etc/eks/bootstrap.sh cluster-1"
+
+		userData, err := NewUserDataForManagedNodeGroup(ng)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decode(userData)).To(Equal("#!/bin/bash\nset -ex\necho foo > /etc/foo\n/etc/eks/bootstrap.sh cluster-1\n"))
+	})
+})
@@ -21,8 +21,28 @@ import (
 const (
 	configDir            = "/etc/eksctl/"
 	kubeletDropInUnitDir = "/etc/systemd/system/kubelet.service.d/"
+	// warmPoolScriptDir is not one of the directories that cloud-init runs scripts
+	// from, so that the scripts of nodegroups with a warm pool wait for the gate
+	warmPoolScriptDir = "/var/lib/eksctl/scripts/"
 )
 
+// waitUntilNotWarmedCommand holds the rest of the user data back while the instance is in the warm pool, so that
+// it doesn't join the cluster; instances of running warm pools carry on once they are put in service, stopped ones are
+// stopped while waiting, and as cloud-init won't consider its user scripts as done, they run again on the next start
+const waitUntilNotWarmedCommand = `IMDS_TOKEN="$(curl --silent --fail --request PUT --header "X-aws-ec2-metadata-token-ttl-seconds: 600" http://169.254.169.254/latest/api/token)"
+while true; do
+  state="$(curl --silent --fail --header "X-aws-ec2-metadata-token: ${IMDS_TOKEN}" http://169.254.169.254/latest/meta-data/autoscaling/target-lifecycle-state)"
+  case "${state}" in
+    Warmed:*)
+      rm -f /var/lib/cloud/instance/sem/config_scripts_user
+      sleep 5
+      ;;
+    *)
+      break
+      ;;
+  esac
+done`
+
 type configFile struct {
 	content string
 	isAsset bool
@@ -38,7 +58,15 @@ func getAsset(name string) (string, error) {
 	return string(data), nil
 }
 
-func addFilesAndScripts(config *cloudconfig.CloudConfig, files configFiles, scripts []string) error {
+// addWarmPoolGate makes sure that instances in the warm pool of the nodegroup
+// are only bootstrapped when they are put in service
+func addWarmPoolGate(config *cloudconfig.CloudConfig, ng *api.NodeGroup) {
+	if ng.WarmPool != nil {
+		config.AddShellCommand(waitUntilNotWarmedCommand)
+	}
+}
+
+func addFilesAndScripts(config *cloudconfig.CloudConfig, ng *api.NodeGroup, files configFiles, scripts []string) error {
 	for dir, fileNames := range files {
 		for fileName, file := range fileNames {
 			f := cloudconfig.File{
@@ -61,7 +89,11 @@ func addFilesAndScripts(config *cloudconfig.CloudConfig, files configFiles, scri
 		if err != nil {
 			return err
 		}
-		config.RunScript(scriptName, data)
+		if ng.WarmPool != nil {
+			config.RunScriptFrom(warmPoolScriptDir, scriptName, data)
+		} else {
+			config.RunScript(scriptName, data)
+		}
 	}
 	return nil
 }
@@ -84,6 +116,9 @@ func clusterDNS(spec *api.ClusterConfig, ng *api.NodeGroup) string {
 	if ng.ClusterDNS != "" {
 		return ng.ClusterDNS
 	}
+	if dnsIP := spec.ClusterDNSIP(); dnsIP != "" {
+		return dnsIP
+	}
 	// Default service network is 10.100.0.0, but it gets set 172.20.0.0 automatically when pod network
 	// is anywhere within 10.0.0.0/8
 	if spec.VPC.CIDR != nil && spec.VPC.CIDR.IP[0] == 10 {
@@ -173,6 +208,8 @@ func NewUserData(spec *api.ClusterConfig, ng *api.NodeGroup) (string, error) {
 		return NewUserDataForAmazonLinux2(spec, ng)
 	case api.NodeImageFamilyUbuntu1804:
 		return NewUserDataForUbuntu1804(spec, ng)
+	case api.NodeImageFamilyBottlerocket:
+		return NewUserDataForBottlerocket(spec, ng)
 	case api.NodeImageFamilyWindowsServer2019FullContainer, api.NodeImageFamilyWindowsServer2019CoreContainer:
 		return newUserDataForWindows(spec, ng)
 	default:
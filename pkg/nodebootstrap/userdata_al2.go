@@ -3,12 +3,16 @@ package nodebootstrap
 import (
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
+// installSSMAgentCommand reports failures on the console, as the nodes still join the cluster without the agent
+const installSSMAgentCommand = "(yum install -y amazon-ssm-agent && systemctl enable --now amazon-ssm-agent) || " +
+	"(echo 'eksctl: failed to install amazon-ssm-agent, SSM access to this node will not work' | tee /dev/console >&2; exit 1)"
+
 func makeAmazonLinux2Config(spec *api.ClusterConfig, ng *api.NodeGroup) (configFiles, error) {
 	clientConfigData, err := makeClientConfigData(spec, ng)
 	if err != nil {
@@ -53,6 +57,13 @@ func NewUserDataForAmazonLinux2(spec *api.ClusterConfig, ng *api.NodeGroup) (str
 
 	var scripts []string
 
+	addWarmPoolGate(config, ng)
+
+	if ng.SSH != nil && api.IsEnabled(ng.SSH.EnableSSM) {
+		// the SSM agent is not installed on the EKS-optimised AMI
+		config.AddShellCommand(installSSMAgentCommand)
+	}
+
 	for _, command := range ng.PreBootstrapCommands {
 		config.AddShellCommand(command)
 	}
@@ -63,7 +74,7 @@ func NewUserDataForAmazonLinux2(spec *api.ClusterConfig, ng *api.NodeGroup) (str
 		scripts = append(scripts, "bootstrap.al2.sh")
 	}
 
-	if err = addFilesAndScripts(config, files, scripts); err != nil {
+	if err = addFilesAndScripts(config, ng, files, scripts); err != nil {
 		return "", err
 	}
 
@@ -0,0 +1,114 @@
+package nodebootstrap
+
+import (
+	"bytes"
+	"encoding/base64"
+	"math"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func makeBottlerocketSettings(spec *api.ClusterConfig, ng *api.NodeGroup) (map[string]interface{}, error) {
+	if len(spec.Status.CertificateAuthorityData) == 0 {
+		return nil, errors.New("invalid cluster config: missing CertificateAuthorityData")
+	}
+
+	settings := map[string]interface{}{}
+	if ng.Bottlerocket != nil && ng.Bottlerocket.Settings != nil {
+		settings = copySettings(*ng.Bottlerocket.Settings).(map[string]interface{})
+	}
+
+	kubernetes := map[string]interface{}{
+		"cluster-name":        spec.Metadata.Name,
+		"api-server":          spec.Status.Endpoint,
+		"cluster-certificate": base64.StdEncoding.EncodeToString(spec.Status.CertificateAuthorityData),
+		"cluster-dns-ip":      clusterDNS(spec, ng),
+	}
+	if len(ng.Labels) > 0 {
+		kubernetes["node-labels"] = ng.Labels
+	}
+	if len(ng.Taints) > 0 {
+		kubernetes["node-taints"] = ng.Taints
+	}
+	if ng.MaxPodsPerNode != 0 {
+		kubernetes["max-pods"] = ng.MaxPodsPerNode
+	}
+	mergeSettings(settings, "kubernetes", kubernetes)
+
+	if ng.Bottlerocket != nil && ng.Bottlerocket.EnableAdminContainer != nil {
+		mergeSettings(settings, "host-containers", map[string]interface{}{
+			"admin": map[string]interface{}{
+				"enabled": *ng.Bottlerocket.EnableAdminContainer,
+			},
+		})
+	}
+
+	return settings, nil
+}
+
+// copySettings makes a deep copy of the settings given in the config file;
+// as numbers are decoded from JSON as floats, whole numbers are converted to
+// integers, which is what Bottlerocket expects for settings like max-pods
+func copySettings(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, value := range v {
+			out[k] = copySettings(value)
+		}
+		return out
+	case api.InlineDocument:
+		return copySettings(map[string]interface{}(v))
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			out[i] = copySettings(value)
+		}
+		return out
+	case float64:
+		if v == math.Trunc(v) {
+			return int64(v)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// mergeSettings merges values into the table called key, values set by eksctl
+// take precedence over the ones given in the config file
+func mergeSettings(settings map[string]interface{}, key string, values map[string]interface{}) {
+	existing, ok := settings[key].(map[string]interface{})
+	if !ok {
+		settings[key] = values
+		return
+	}
+	for k, v := range values {
+		if table, ok := v.(map[string]interface{}); ok {
+			mergeSettings(existing, k, table)
+			continue
+		}
+		existing[k] = v
+	}
+}
+
+// NewUserDataForBottlerocket creates new user data for Bottlerocket nodes,
+// which is a TOML document holding the settings of the node
+func NewUserDataForBottlerocket(spec *api.ClusterConfig, ng *api.NodeGroup) (string, error) {
+	settings, err := makeBottlerocketSettings(spec, ng)
+	if err != nil {
+		return "", err
+	}
+
+	var body bytes.Buffer
+	if err := toml.NewEncoder(&body).Encode(map[string]interface{}{"settings": settings}); err != nil {
+		return "", errors.Wrap(err, "encoding user data")
+	}
+
+	logger.Debug("user-data = %s", body.String())
+	return base64.StdEncoding.EncodeToString(body.Bytes()), nil
+}
@@ -0,0 +1,82 @@
+package nodebootstrap
+
+import (
+	"encoding/base64"
+
+	"github.com/BurntSushi/toml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("User data for Bottlerocket", func() {
+	var (
+		clusterConfig *api.ClusterConfig
+		ng            *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		clusterConfig = api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "cluster-1"
+		clusterConfig.Status = &api.ClusterStatus{
+			Endpoint:                 "https://example.eks.amazonaws.com",
+			CertificateAuthorityData: []byte("CA"),
+		}
+
+		ng = api.NewNodeGroup()
+		ng.AMIFamily = api.NodeImageFamilyBottlerocket
+		ng.Labels = map[string]string{"role": "worker"}
+		ng.Taints = map[string]string{"dedicated": "bottlerocket:NoSchedule"}
+	})
+
+	decode := func(userData string) map[string]interface{} {
+		data, err := base64.StdEncoding.DecodeString(userData)
+		Expect(err).ToNot(HaveOccurred())
+
+		doc := map[string]interface{}{}
+		_, err = toml.Decode(string(data), &doc)
+		Expect(err).ToNot(HaveOccurred())
+		return doc["settings"].(map[string]interface{})
+	}
+
+	It("should render the kubernetes settings as TOML", func() {
+		userData, err := NewUserData(clusterConfig, ng)
+		Expect(err).ToNot(HaveOccurred())
+
+		settings := decode(userData)
+		Expect(settings["kubernetes"]).To(Equal(map[string]interface{}{
+			"cluster-name":        "cluster-1",
+			"api-server":          "https://example.eks.amazonaws.com",
+			"cluster-certificate": "Q0E=",
+			"cluster-dns-ip":      "10.100.0.10",
+			"node-labels":         map[string]interface{}{"role": "worker"},
+			"node-taints":         map[string]interface{}{"dedicated": "bottlerocket:NoSchedule"},
+		}))
+		Expect(settings).ToNot(HaveKey("host-containers"))
+	})
+
+	It("should merge additional settings and enable the admin container", func() {
+		ng.Bottlerocket = &api.NodeGroupBottlerocket{
+			EnableAdminContainer: api.Enabled(),
+			Settings: &api.InlineDocument{
+				"motd": "Hello, eksctl!",
+				"kubernetes": map[string]interface{}{
+					"cluster-name": "overridden",
+					"max-pods":     float64(42),
+				},
+			},
+		}
+
+		userData, err := NewUserData(clusterConfig, ng)
+		Expect(err).ToNot(HaveOccurred())
+
+		settings := decode(userData)
+		Expect(settings["motd"]).To(Equal("Hello, eksctl!"))
+		Expect(settings["kubernetes"]).To(HaveKeyWithValue("cluster-name", "cluster-1"))
+		Expect(settings["kubernetes"]).To(HaveKeyWithValue("max-pods", int64(42)))
+		Expect(settings["host-containers"]).To(Equal(map[string]interface{}{
+			"admin": map[string]interface{}{"enabled": true},
+		}))
+	})
+})
@@ -5,9 +5,11 @@ import (
 	"strings"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
 	kubeletapi "k8s.io/kubelet/config/v1beta1"
 	"sigs.k8s.io/yaml"
 )
@@ -76,4 +78,90 @@ var _ = Describe("User data", func() {
 			Expect(kubelet.FeatureGates["RotateKubeletServerCertificate"]).To(Equal(false))
 		})
 	})
+
+	Describe("bootstrap scripts", func() {
+		var (
+			clusterConfig *api.ClusterConfig
+			ng            *api.NodeGroup
+		)
+		BeforeEach(func() {
+			clusterConfig = api.NewClusterConfig()
+			clusterConfig.Metadata.Name = "test-cluster"
+			clusterConfig.Metadata.Region = "us-west-2"
+			clusterConfig.Status = &api.ClusterStatus{
+				Endpoint:                 "https://test.eks.amazonaws.com",
+				CertificateAuthorityData: []byte("CA"),
+			}
+			ng = api.NewNodeGroup()
+			api.SetNodeGroupDefaults(0, ng)
+		})
+
+		bootstrapScript := func(userData, scriptName string) string {
+			config, err := cloudconfig.DecodeCloudConfig(userData)
+			Expect(err).ToNot(HaveOccurred())
+			for _, f := range config.WriteFiles {
+				if strings.HasSuffix(f.Path, "/"+scriptName) {
+					return f.Content
+				}
+			}
+			Fail("script " + scriptName + " not found in user data")
+			return ""
+		}
+
+		It("use IMDSv2 session tokens on Amazon Linux 2", func() {
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+			userData, err := NewUserData(clusterConfig, ng)
+			Expect(err).ToNot(HaveOccurred())
+
+			script := bootstrapScript(userData, "bootstrap.al2.sh")
+			Expect(script).To(ContainSubstring("X-aws-ec2-metadata-token-ttl-seconds"))
+			Expect(script).To(ContainSubstring(`--header "X-aws-ec2-metadata-token: ${IMDS_TOKEN}"`))
+			Expect(script).ToNot(ContainSubstring("curl --silent http://169.254.169.254/latest/meta-data/"))
+		})
+
+		It("use IMDSv2 session tokens on Ubuntu", func() {
+			ng.AMIFamily = api.NodeImageFamilyUbuntu1804
+			userData, err := NewUserData(clusterConfig, ng)
+			Expect(err).ToNot(HaveOccurred())
+
+			script := bootstrapScript(userData, "bootstrap.ubuntu.sh")
+			Expect(script).To(ContainSubstring(`--header "X-aws-ec2-metadata-token: ${IMDS_TOKEN}"`))
+			Expect(script).ToNot(ContainSubstring("curl --silent http://169.254.169.254/latest/meta-data/"))
+		})
+
+		DescribeTable("with a warm pool, wait to be put in service before bootstrapping",
+			func(amiFamily, scriptName string) {
+				ng.AMIFamily = amiFamily
+				ng.WarmPool = &api.WarmPool{PoolState: api.WarmPoolStateStopped}
+				ng.PreBootstrapCommands = []string{"echo pre-bootstrap"}
+				userData, err := NewUserData(clusterConfig, ng)
+				Expect(err).ToNot(HaveOccurred())
+
+				config, err := cloudconfig.DecodeCloudConfig(userData)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.Commands).ToNot(BeEmpty())
+				gate := config.Commands[0].([]interface{})
+				Expect(gate[2]).To(ContainSubstring("autoscaling/target-lifecycle-state"))
+				Expect(gate[2]).To(ContainSubstring("Warmed:*)"))
+				Expect(gate[2]).To(ContainSubstring("rm -f /var/lib/cloud/instance/sem/config_scripts_user"))
+
+				// cloud-init runs the scripts of its own directories before the gate otherwise
+				Expect(config.Commands[len(config.Commands)-1]).To(Equal([]interface{}{"/var/lib/eksctl/scripts/" + scriptName}))
+				for _, f := range config.WriteFiles {
+					Expect(f.Path).ToNot(HavePrefix("/var/lib/cloud/scripts/"))
+				}
+			},
+			Entry("Amazon Linux 2", api.NodeImageFamilyAmazonLinux2, "bootstrap.al2.sh"),
+			Entry("Ubuntu", api.NodeImageFamilyUbuntu1804, "bootstrap.ubuntu.sh"),
+		)
+
+		It("doesn't wait without a warm pool", func() {
+			userData, err := NewUserData(clusterConfig, ng)
+			Expect(err).ToNot(HaveOccurred())
+
+			config, err := cloudconfig.DecodeCloudConfig(userData)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Commands).To(Equal([]interface{}{[]interface{}{"/var/lib/cloud/scripts/per-instance/bootstrap.al2.sh"}}))
+		})
+	})
 })
@@ -4,10 +4,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func makeUbuntu1804Config(spec *api.ClusterConfig, ng *api.NodeGroup) (configFiles, error) {
@@ -55,6 +55,8 @@ func NewUserDataForUbuntu1804(spec *api.ClusterConfig, ng *api.NodeGroup) (strin
 
 	scripts := []string{}
 
+	addWarmPoolGate(config, ng)
+
 	for _, command := range ng.PreBootstrapCommands {
 		config.AddShellCommand(command)
 	}
@@ -65,7 +67,7 @@ func NewUserDataForUbuntu1804(spec *api.ClusterConfig, ng *api.NodeGroup) (strin
 		scripts = append(scripts, "bootstrap.ubuntu.sh")
 	}
 
-	if err = addFilesAndScripts(config, files, scripts); err != nil {
+	if err = addFilesAndScripts(config, ng, files, scripts); err != nil {
 		return "", err
 	}
 
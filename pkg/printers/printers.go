@@ -3,10 +3,26 @@ package printers
 import (
 	"fmt"
 	"io"
+	"strings"
 
-	"github.com/kris-nova/logger"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
+// Supported printer types
+const (
+	// TableType prints objects as a human readable table
+	TableType = "table"
+	// JSONType prints objects as JSON
+	JSONType = "json"
+	// YAMLType prints objects as YAML
+	YAMLType = "yaml"
+)
+
+// SupportedTypes returns the printer types that NewPrinter accepts
+func SupportedTypes() []string {
+	return []string{TableType, JSONType, YAMLType}
+}
+
 // OutputPrinter is the interface that printer must implement. This allows
 // new printers to be added in the future.
 type OutputPrinter interface {
@@ -21,14 +37,14 @@ func NewPrinter(printerType string) (OutputPrinter, error) {
 	var printer OutputPrinter
 
 	switch printerType {
-	case "yaml":
+	case YAMLType:
 		printer = NewYAMLPrinter()
-	case "json":
+	case JSONType:
 		printer = NewJSONPrinter()
-	case "table":
+	case TableType:
 		printer = NewTablePrinter()
 	default:
-		return nil, fmt.Errorf("unknown output printer type: %s", printerType)
+		return nil, fmt.Errorf("unknown output printer type: %s (valid options: %s)", printerType, strings.Join(SupportedTypes(), ", "))
 	}
 
 	return printer, nil
@@ -0,0 +1,32 @@
+package printers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/printers"
+)
+
+var _ = Describe("NewPrinter", func() {
+
+	It("should create a printer for each of the supported types", func() {
+		Expect(SupportedTypes()).To(ConsistOf("table", "json", "yaml"))
+
+		printer, err := NewPrinter(TableType)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(printer).To(BeAssignableToTypeOf(&TablePrinter{}))
+
+		printer, err = NewPrinter(JSONType)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(printer).To(BeAssignableToTypeOf(&JSONPrinter{}))
+
+		printer, err = NewPrinter(YAMLType)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(printer).To(BeAssignableToTypeOf(&YAMLPrinter{}))
+	})
+
+	It("should list the supported types when given an unknown type", func() {
+		_, err := NewPrinter("xml")
+		Expect(err).To(MatchError("unknown output printer type: xml (valid options: table, json, yaml)"))
+	})
+})
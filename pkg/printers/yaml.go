@@ -5,11 +5,11 @@ import (
 	"io"
 	"strings"
 
-	"github.com/kris-nova/logger"
-
 	"k8s.io/apimachinery/pkg/runtime"
 	cliruntime "k8s.io/cli-runtime/pkg/genericclioptions/printers"
 	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // YAMLPrinter is a printer that outputs an object formatted
@@ -7,7 +7,6 @@ import (
 	"io/ioutil"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,6 +15,7 @@ import (
 	"k8s.io/kops/pkg/pki"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // LoadKeyFromFile loads and imports a public SSH key from a file provided a path to that file.
@@ -67,13 +67,19 @@ func LoadKeyByContent(key *string, clusterName, ngName string, provider api.Clus
 
 // DeleteKeys will delete the public SSH key, if it exists
 func DeleteKeys(clusterName string, provider api.ClusterProvider) {
-	existing, err := provider.EC2().DescribeKeyPairs(&ec2.DescribeKeyPairsInput{})
+	prefix := getKeyName(clusterName, "", "")
+	// DescribeKeyPairs is not paginated, so only the keys of the cluster are requested
+	existing, err := provider.EC2().DescribeKeyPairs(&ec2.DescribeKeyPairsInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("key-name"),
+			Values: aws.StringSlice([]string{prefix + "*"}),
+		}},
+	})
 	if err != nil {
 		logger.Debug("cannot describe keys: %v", err)
 		return
 	}
 	var matching []*string
-	prefix := getKeyName(clusterName, "", "")
 	logger.Debug("existing = %#v", existing)
 	for _, e := range existing.KeyPairs {
 		if !strings.HasPrefix(*e.KeyName, prefix) {
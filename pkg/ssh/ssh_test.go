@@ -2,6 +2,7 @@ package ssh
 
 import (
 	"errors"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	. "github.com/onsi/ginkgo"
@@ -129,6 +130,14 @@ var _ = Describe("ssh public key", func() {
 
 			DeleteKeys(clusterName, mockProvider)
 
+			mockProvider.MockEC2().AssertCalled(GinkgoT(),
+				"DescribeKeyPairs",
+				&ec2.DescribeKeyPairsInput{
+					Filters: []*ec2.Filter{{
+						Name:   aws.String("key-name"),
+						Values: aws.StringSlice([]string{"eksctl-sshtestcluster*"}),
+					}},
+				})
 			mockProvider.MockEC2().AssertNumberOfCalls(GinkgoT(), "DeleteKeyPair", 2)
 			mockProvider.MockEC2().AssertCalled(GinkgoT(),
 				"DeleteKeyPair",
@@ -108,6 +108,14 @@ func (t *requestTracker) IsMissing(req *http.Request, item runtime.Object) bool
 	return *t.missing
 }
 
+func (t *requestTracker) Tracked(req *http.Request, item runtime.Object) (runtime.Object, bool) {
+	if !*t.unionised || t.collection == nil {
+		return nil, false
+	}
+	obj, ok := t.collection.objects[objectKey(req, item)]
+	return obj, ok
+}
+
 func (t *requestTracker) Create(req *http.Request, item runtime.Object) bool {
 	*t.missing = false
 	if t.collection != nil {
@@ -216,7 +224,11 @@ func NewFakeRawResource(item runtime.Object, missing, unionised bool, ct *Collec
 	}
 
 	asResult := func(req *http.Request) (*http.Response, error) {
-		data, err := runtime.Encode(unstructured.UnstructuredJSONScheme, item)
+		result := item
+		if tracked, ok := rt.Tracked(req, item); ok {
+			result = tracked // return the live object, which may differ from item
+		}
+		data, err := runtime.Encode(unstructured.UnstructuredJSONScheme, result)
 		Expect(err).To(Not(HaveOccurred()))
 		res := &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(data))}
 		return res, nil
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -58,6 +59,7 @@ type MockProvider struct {
 	ssm        *mocks.SSMAPI
 	iam        *mocks.IAMAPI
 	cloudtrail *mocks.CloudTrailAPI
+	asg        *mocks.AutoScalingAPI
 }
 
 // NewMockProvider returns a new MockProvider
@@ -74,6 +76,7 @@ func NewMockProvider() *MockProvider {
 		ssm:        &mocks.SSMAPI{},
 		iam:        &mocks.IAMAPI{},
 		cloudtrail: &mocks.CloudTrailAPI{},
+		asg:        &mocks.AutoScalingAPI{},
 	}
 }
 
@@ -132,6 +135,12 @@ func (m MockProvider) MockCloudTrail() *mocks.CloudTrailAPI {
 	return m.CloudTrail().(*mocks.CloudTrailAPI)
 }
 
+// ASG returns a representation of the AutoScaling API
+func (m MockProvider) ASG() autoscalingiface.AutoScalingAPI { return m.asg }
+
+// MockASG returns a mocked AutoScaling API
+func (m MockProvider) MockASG() *mocks.AutoScalingAPI { return m.ASG().(*mocks.AutoScalingAPI) }
+
 // Profile returns current profile setting
 func (m MockProvider) Profile() string { return ProviderConfig.Profile }
 
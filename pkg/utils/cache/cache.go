@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils/file"
+)
+
+// DefaultDir is the directory where the results of lookups are cached
+const DefaultDir = "~/.eksctl/cache"
+
+// Cache stores the results of lookups on disk, so that later invocations
+// can reuse them until they expire; a nil Cache doesn't cache anything
+type Cache struct {
+	dir string
+	now func() time.Time
+}
+
+type entry struct {
+	Expires time.Time       `json:"expires"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// New creates a Cache that stores values in the given directory
+func New(dir string) *Cache {
+	return &Cache{
+		dir: file.ExpandPath(dir),
+		now: time.Now,
+	}
+}
+
+// Get decodes the value stored under key into value, it returns false
+// when there is no such value or when it has expired
+func (c *Cache) Get(key string, value interface{}) bool {
+	if c == nil {
+		return false
+	}
+
+	data, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+	e := entry{}
+	if err := json.Unmarshal(data, &e); err != nil {
+		logger.Debug("ignoring invalid cache entry for %q: %s", key, err.Error())
+		return false
+	}
+	if !c.now().Before(e.Expires) {
+		return false
+	}
+	if err := json.Unmarshal(e.Value, value); err != nil {
+		logger.Debug("ignoring invalid cache entry for %q: %s", key, err.Error())
+		return false
+	}
+
+	logger.Debug("using cached value of %q", key)
+	return true
+}
+
+// Set stores value under key until ttl has passed; the cache is only an
+// optimisation, so failures to write it are logged and otherwise ignored
+func (c *Cache) Set(key string, value interface{}, ttl time.Duration) {
+	if c == nil {
+		return
+	}
+
+	if err := c.write(key, value, ttl); err != nil {
+		logger.Debug("not caching value of %q: %s", key, err.Error())
+	}
+}
+
+func (c *Cache) write(key string, value interface{}, ttl time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	data, err = json.Marshal(entry{
+		Expires: c.now().Add(ttl),
+		Value:   data,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+	// entries are written to a temporary file first, so that concurrent
+	// invocations never read partially written entries
+	tmpFile, err := ioutil.TempFile(c.dir, "tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), c.path(key))
+}
+
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
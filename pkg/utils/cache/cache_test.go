@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestSuite(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
+
+var _ = Describe("cache", func() {
+	var (
+		dir   string
+		now   time.Time
+		cache *Cache
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "eksctl-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		now = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		cache = New(dir)
+		cache.now = func() time.Time { return now }
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should return values until they expire", func() {
+		cache.Set("zones/us-west-2", []string{"us-west-2a", "us-west-2b"}, time.Hour)
+
+		var zones []string
+		Expect(cache.Get("zones/us-west-2", &zones)).To(BeTrue())
+		Expect(zones).To(Equal([]string{"us-west-2a", "us-west-2b"}))
+
+		Expect(cache.Get("zones/us-east-1", &zones)).To(BeFalse())
+
+		now = now.Add(time.Hour)
+		Expect(cache.Get("zones/us-west-2", &zones)).To(BeFalse())
+	})
+
+	It("should share values between instances using the same directory", func() {
+		cache.Set("ami", "ami-123", time.Hour)
+
+		other := New(dir)
+		other.now = cache.now
+
+		var ami string
+		Expect(other.Get("ami", &ami)).To(BeTrue())
+		Expect(ami).To(Equal("ami-123"))
+	})
+
+	It("should ignore invalid entries", func() {
+		Expect(ioutil.WriteFile(cache.path("ami"), []byte("{"), 0600)).To(Succeed())
+
+		var ami string
+		Expect(cache.Get("ami", &ami)).To(BeFalse())
+	})
+
+	It("should not cache anything when it is nil", func() {
+		var nilCache *Cache
+		nilCache.Set("ami", "ami-123", time.Hour)
+
+		var ami string
+		Expect(nilCache.Get("ami", &ami)).To(BeFalse())
+	})
+})
@@ -5,14 +5,15 @@ import (
 	"os"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/weaveworks/eksctl/pkg/utils/file"
 
 	"os/exec"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
@@ -20,6 +21,10 @@ import (
 // DefaultPath defines the default path
 var DefaultPath = clientcmd.RecommendedHomeFile
 
+// modifyMutex serialises the read-merge-write cycles of kubeconfig files, as
+// clusters can be created or deleted concurrently with --all
+var modifyMutex sync.Mutex
+
 const (
 	// AWSIAMAuthenticator defines the name of the AWS IAM authenticator
 	AWSIAMAuthenticator = "aws-iam-authenticator"
@@ -72,15 +77,53 @@ func New(spec *api.ClusterConfig, username, certificateAuthorityPath string) (*c
 	return c, clusterName, contextName
 }
 
+// Options controls how the kubeconfig for kubectl is generated
+type Options struct {
+	// Authenticator is one of AuthenticatorCommands, when it's
+	// not set, the first one that is installed gets used
+	Authenticator string
+	// RoleARN is the IAM role the authenticator assumes
+	RoleARN string
+	// Profile is set as AWS_PROFILE for the authenticator
+	Profile string
+	// ContextAlias replaces the generated context name
+	ContextAlias string
+}
+
+// ValidateAuthenticator returns an error if the given authenticator is not one of AuthenticatorCommands
+func ValidateAuthenticator(authenticator string) error {
+	if authenticator == "" {
+		return nil
+	}
+	for _, cmd := range AuthenticatorCommands() {
+		if authenticator == cmd {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown authenticator %q (valid options: %s)", authenticator, strings.Join(AuthenticatorCommands(), ", "))
+}
+
 // NewForKubectl creates configuration for kubectl using a suitable authenticator
-func NewForKubectl(spec *api.ClusterConfig, username, roleARN, profile string) *clientcmdapi.Config {
-	config, _, _ := New(spec, username, "")
-	authenticator, found := LookupAuthenticator()
-	if !found {
-		// fall back to aws-iam-authenticator
-		authenticator = AWSIAMAuthenticator
-	}
-	AppendAuthenticator(config, spec, authenticator, roleARN, profile)
+func NewForKubectl(spec *api.ClusterConfig, username string, options Options) *clientcmdapi.Config {
+	config, _, contextName := New(spec, username, "")
+	if options.ContextAlias != "" && options.ContextAlias != contextName {
+		config.Contexts[options.ContextAlias] = config.Contexts[contextName]
+		config.Contexts[options.ContextAlias].AuthInfo = options.ContextAlias
+		delete(config.Contexts, contextName)
+		delete(config.AuthInfos, contextName)
+		config.CurrentContext = options.ContextAlias
+	}
+
+	authenticator := options.Authenticator
+	if authenticator == "" {
+		var found bool
+		authenticator, found = LookupAuthenticator()
+		if !found {
+			// fall back to aws-iam-authenticator
+			authenticator = AWSIAMAuthenticator
+		}
+	}
+	AppendAuthenticator(config, spec, authenticator, options.RoleARN, options.Profile)
 	return config
 }
 
@@ -133,6 +176,9 @@ func AppendAuthenticator(config *clientcmdapi.Config, spec *api.ClusterConfig, a
 // If file pointed to by path doesn't exist it will be created.
 // If the file already exists then the configuration will be merged with the existing file.
 func Write(path string, newConfig clientcmdapi.Config, setContext bool) (string, error) {
+	modifyMutex.Lock()
+	defer modifyMutex.Unlock()
+
 	configAccess := getConfigAccess(path)
 
 	config, err := configAccess.GetStartingConfig()
@@ -198,15 +244,20 @@ func isValidConfig(p, name string) error {
 	ctxFmtErr := fmt.Errorf("unable to verify ownership of config %q, unexpected contex name %q", p, clientConfig.CurrentContext)
 
 	ctx := strings.Split(clientConfig.CurrentContext, "@")
-	if len(ctx) != 2 {
-		return ctxFmtErr
+	if len(ctx) == 2 && isClusterName(ctx[1], name) {
+		return nil
 	}
-	if strings.HasPrefix(ctx[1], name+".") && strings.HasSuffix(ctx[1], ".eksctl.io") {
+	// the context may have been given an alias, in which case the cluster it refers to is checked
+	if context, ok := clientConfig.Contexts[clientConfig.CurrentContext]; ok && isClusterName(context.Cluster, name) {
 		return nil
 	}
 	return ctxFmtErr
 }
 
+func isClusterName(clusterName, name string) bool {
+	return strings.HasPrefix(clusterName, name+".") && strings.HasSuffix(clusterName, ".eksctl.io")
+}
+
 // MaybeDeleteConfig will delete the auto-generated kubeconfig, if it exists
 func MaybeDeleteConfig(meta *api.ClusterMeta) {
 	p := AutoPath(meta.Name)
@@ -222,6 +273,9 @@ func MaybeDeleteConfig(meta *api.ClusterMeta) {
 		return
 	}
 
+	modifyMutex.Lock()
+	defer modifyMutex.Unlock()
+
 	configAccess := getConfigAccess(DefaultPath)
 	config, err := configAccess.GetStartingConfig()
 	if err != nil {
@@ -270,7 +324,7 @@ func deleteClusterInfo(existing *clientcmdapi.Config, meta *api.ClusterMeta) boo
 
 	if existing.CurrentContext == currentContextName {
 		existing.CurrentContext = ""
-		logger.Debug("reset current-context in kubeconfig", currentContextName)
+		logger.Debug("reset current-context %q in kubeconfig", currentContextName)
 		isChanged = true
 	}
 
@@ -278,7 +332,7 @@ func deleteClusterInfo(existing *clientcmdapi.Config, meta *api.ClusterMeta) boo
 		if strings.HasSuffix(parts[1], "eksctl.io") {
 			if _, ok := existing.Contexts[existing.CurrentContext]; !ok {
 				existing.CurrentContext = ""
-				logger.Debug("reset stale current-context in kubeconfig", currentContextName)
+				logger.Debug("reset stale current-context %q in kubeconfig", currentContextName)
 				isChanged = true
 			}
 		}
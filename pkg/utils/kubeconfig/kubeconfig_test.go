@@ -137,6 +137,60 @@ var _ = Describe("Kubeconfig", func() {
 		Expect(readConfig.CurrentContext).To(Equal("minikube"))
 	})
 
+	Context("for kubectl", func() {
+		cfg := &eksctlapi.ClusterConfig{
+			Metadata: &eksctlapi.ClusterMeta{
+				Name:   "cluster-1",
+				Region: "us-west-2",
+			},
+			Status: &eksctlapi.ClusterStatus{
+				Endpoint:                 "https://TEST.aws",
+				CertificateAuthorityData: []byte("123"),
+			},
+		}
+
+		It("uses 'aws eks get-token' with a role ARN, a profile and a context alias", func() {
+			config := kubeconfig.NewForKubectl(cfg, "user", kubeconfig.Options{
+				Authenticator: kubeconfig.AWSEKSAuthenticator,
+				RoleARN:       "arn:aws:iam::123456789012:role/admin",
+				Profile:       "production",
+				ContextAlias:  "prod",
+			})
+
+			Expect(config.CurrentContext).To(Equal("prod"))
+			Expect(config.Contexts).To(HaveLen(1))
+			Expect(config.Contexts).To(HaveKey("prod"))
+			Expect(config.Contexts["prod"].Cluster).To(Equal("cluster-1.us-west-2.eksctl.io"))
+			Expect(config.Contexts["prod"].AuthInfo).To(Equal("prod"))
+
+			Expect(config.AuthInfos).To(HaveLen(1))
+			Expect(config.AuthInfos).To(HaveKey("prod"))
+			exec := config.AuthInfos["prod"].Exec
+			Expect(exec.Command).To(Equal("aws"))
+			Expect(exec.Args).To(Equal([]string{"eks", "get-token", "--cluster-name", "cluster-1", "--region", "us-west-2", "--role-arn", "arn:aws:iam::123456789012:role/admin"}))
+			Expect(exec.Env).To(ConsistOf(api.ExecEnvVar{Name: "AWS_PROFILE", Value: "production"}))
+		})
+
+		It("uses the generated context name without an alias", func() {
+			config := kubeconfig.NewForKubectl(cfg, "user", kubeconfig.Options{
+				Authenticator: kubeconfig.AWSIAMAuthenticator,
+			})
+
+			Expect(config.CurrentContext).To(Equal("user@cluster-1.us-west-2.eksctl.io"))
+			Expect(config.AuthInfos).To(HaveKey("user@cluster-1.us-west-2.eksctl.io"))
+			exec := config.AuthInfos["user@cluster-1.us-west-2.eksctl.io"].Exec
+			Expect(exec.Command).To(Equal("aws-iam-authenticator"))
+			Expect(exec.Args).To(Equal([]string{"token", "-i", "cluster-1"}))
+			Expect(exec.Env).To(BeEmpty())
+		})
+
+		It("validates the authenticator", func() {
+			Expect(kubeconfig.ValidateAuthenticator("")).To(Succeed())
+			Expect(kubeconfig.ValidateAuthenticator("aws")).To(Succeed())
+			Expect(kubeconfig.ValidateAuthenticator("kubectl")).To(MatchError(`unknown authenticator "kubectl" (valid options: aws-iam-authenticator, heptio-authenticator-aws, aws)`))
+		})
+	})
+
 	Context("delete config", func() {
 		// Default cluster name is 'foo' and region is 'us-west-2'
 		var apiClusterConfigSample = eksctlapi.ClusterConfig{
@@ -7,10 +7,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func fmtSecurityGroupNameRegexForCluster(name string) string {
@@ -75,7 +75,119 @@ func CleanupNetworkInterfaces(ec2API ec2iface.EC2API, spec *api.ClusterConfig) e
 			return errors.Wrapf(err, "unable to delete network interface %q", eniID)
 		}
 		logger.Debug("deleted network interface %q", eniID)
-		return nil
 	}
 	return nil
 }
+
+// SecurityGroupReferences holds the rules of a security group that was not created by eksctl,
+// which refer to security groups of a cluster
+type SecurityGroupReferences struct {
+	GroupID   string
+	GroupName string
+	Ingress   []*ec2.IpPermission
+	Egress    []*ec2.IpPermission
+}
+
+// FindSecurityGroupReferences finds rules of security groups that were not created by eksctl,
+// which refer to security groups of the cluster, as those references prevent the security groups
+// of the cluster from being deleted along with its stacks
+func FindSecurityGroupReferences(ec2API ec2iface.EC2API, spec *api.ClusterConfig) ([]*SecurityGroupReferences, error) {
+	securityGroupRE, err := regexp.Compile(fmtSecurityGroupNameRegexForCluster(spec.Metadata.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create security group regex")
+	}
+
+	input := &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []*string{&spec.VPC.ID},
+			},
+		},
+	}
+
+	var ours, others []*ec2.SecurityGroup
+	err = ec2API.DescribeSecurityGroupsPages(input, func(output *ec2.DescribeSecurityGroupsOutput, _ bool) bool {
+		for _, sg := range output.SecurityGroups {
+			if securityGroupRE.MatchString(aws.StringValue(sg.GroupName)) {
+				ours = append(ours, sg)
+			} else {
+				others = append(others, sg)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list security groups in %q", spec.VPC.ID)
+	}
+
+	ourIDs := map[string]string{}
+	for _, sg := range ours {
+		ourIDs[aws.StringValue(sg.GroupId)] = aws.StringValue(sg.GroupName)
+	}
+
+	var references []*SecurityGroupReferences
+	for _, sg := range others {
+		ingress := permissionsReferringTo(sg.IpPermissions, ourIDs)
+		egress := permissionsReferringTo(sg.IpPermissionsEgress, ourIDs)
+		if len(ingress) > 0 || len(egress) > 0 {
+			references = append(references, &SecurityGroupReferences{
+				GroupID:   aws.StringValue(sg.GroupId),
+				GroupName: aws.StringValue(sg.GroupName),
+				Ingress:   ingress,
+				Egress:    egress,
+			})
+		}
+	}
+	return references, nil
+}
+
+// RevokeSecurityGroupReferences revokes the given rules, which were found by FindSecurityGroupReferences
+func RevokeSecurityGroupReferences(ec2API ec2iface.EC2API, references []*SecurityGroupReferences) error {
+	for _, ref := range references {
+		if len(ref.Ingress) > 0 {
+			logger.Info("revoking %d ingress rule(s) of security group %q (%s)", len(ref.Ingress), ref.GroupName, ref.GroupID)
+			if _, err := ec2API.RevokeSecurityGroupIngress(&ec2.RevokeSecurityGroupIngressInput{
+				GroupId:       aws.String(ref.GroupID),
+				IpPermissions: ref.Ingress,
+			}); err != nil {
+				return errors.Wrapf(err, "unable to revoke ingress rules of security group %q", ref.GroupID)
+			}
+		}
+		if len(ref.Egress) > 0 {
+			logger.Info("revoking %d egress rule(s) of security group %q (%s)", len(ref.Egress), ref.GroupName, ref.GroupID)
+			if _, err := ec2API.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+				GroupId:       aws.String(ref.GroupID),
+				IpPermissions: ref.Egress,
+			}); err != nil {
+				return errors.Wrapf(err, "unable to revoke egress rules of security group %q", ref.GroupID)
+			}
+		}
+	}
+	return nil
+}
+
+// permissionsReferringTo returns the parts of the given permissions that refer to any of the given security groups
+func permissionsReferringTo(permissions []*ec2.IpPermission, securityGroupIDs map[string]string) []*ec2.IpPermission {
+	var matching []*ec2.IpPermission
+	for _, p := range permissions {
+		var pairs []*ec2.UserIdGroupPair
+		for _, pair := range p.UserIdGroupPairs {
+			if _, ok := securityGroupIDs[aws.StringValue(pair.GroupId)]; ok {
+				pairs = append(pairs, &ec2.UserIdGroupPair{
+					GroupId: pair.GroupId,
+					UserId:  pair.UserId,
+				})
+			}
+		}
+		if len(pairs) > 0 {
+			matching = append(matching, &ec2.IpPermission{
+				IpProtocol:       p.IpProtocol,
+				FromPort:         p.FromPort,
+				ToPort:           p.ToPort,
+				UserIdGroupPairs: pairs,
+			})
+		}
+	}
+	return matching
+}
@@ -0,0 +1,105 @@
+package vpc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("VPC cleanup", func() {
+	var (
+		spec     *api.ClusterConfig
+		provider *mockprovider.MockProvider
+	)
+
+	groupPair := func(id string) *ec2.UserIdGroupPair {
+		return &ec2.UserIdGroupPair{GroupId: aws.String(id), UserId: aws.String("123456789012")}
+	}
+
+	databaseIngress := &ec2.IpPermission{
+		IpProtocol:       aws.String("tcp"),
+		FromPort:         aws.Int64(5432),
+		ToPort:           aws.Int64(5432),
+		UserIdGroupPairs: []*ec2.UserIdGroupPair{groupPair("sg-cluster")},
+	}
+
+	BeforeEach(func() {
+		spec = api.NewClusterConfig()
+		spec.Metadata.Name = "test-cluster"
+		spec.VPC.ID = "vpc-1"
+		provider = mockprovider.NewMockProvider()
+
+		provider.MockEC2().On("DescribeSecurityGroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeSecurityGroupsOutput, bool) bool)
+			consume(&ec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []*ec2.SecurityGroup{
+					{
+						GroupId:   aws.String("sg-cluster"),
+						GroupName: aws.String("eksctl-test-cluster-cluster-ClusterSharedNodeSecurityGroup-1"),
+					},
+					{
+						GroupId:   aws.String("sg-db"),
+						GroupName: aws.String("database"),
+						IpPermissions: []*ec2.IpPermission{
+							{
+								IpProtocol:       aws.String("tcp"),
+								FromPort:         aws.Int64(5432),
+								ToPort:           aws.Int64(5432),
+								UserIdGroupPairs: []*ec2.UserIdGroupPair{groupPair("sg-cluster"), groupPair("sg-other")},
+							},
+							{
+								IpProtocol:       aws.String("tcp"),
+								FromPort:         aws.Int64(22),
+								ToPort:           aws.Int64(22),
+								UserIdGroupPairs: []*ec2.UserIdGroupPair{groupPair("sg-other")},
+							},
+						},
+					},
+				},
+			}, false)
+			consume(&ec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []*ec2.SecurityGroup{
+					{
+						GroupId:   aws.String("sg-untouched"),
+						GroupName: aws.String("untouched"),
+					},
+				},
+			}, true)
+		}).Return(nil)
+
+	})
+
+	It("should find rules of other security groups that refer to security groups of the cluster", func() {
+		references, err := FindSecurityGroupReferences(provider.EC2(), spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(references).To(Equal([]*SecurityGroupReferences{
+			{
+				GroupID:   "sg-db",
+				GroupName: "database",
+				Ingress:   []*ec2.IpPermission{databaseIngress},
+			},
+		}))
+		provider.MockEC2().AssertNotCalled(GinkgoT(), "RevokeSecurityGroupIngress", mock.Anything)
+	})
+
+	It("should revoke the rules that were found", func() {
+		provider.MockEC2().On("RevokeSecurityGroupIngress", mock.Anything).Return(&ec2.RevokeSecurityGroupIngressOutput{}, nil)
+
+		references, err := FindSecurityGroupReferences(provider.EC2(), spec)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(RevokeSecurityGroupReferences(provider.EC2(), references)).To(Succeed())
+
+		provider.MockEC2().AssertNumberOfCalls(GinkgoT(), "RevokeSecurityGroupIngress", 1)
+		provider.MockEC2().AssertNotCalled(GinkgoT(), "RevokeSecurityGroupEgress", mock.Anything)
+
+		input := provider.MockEC2().Calls[1].Arguments[0].(*ec2.RevokeSecurityGroupIngressInput)
+		Expect(*input.GroupId).To(Equal("sg-db"))
+		Expect(input.IpPermissions).To(Equal([]*ec2.IpPermission{databaseIngress}))
+	})
+})
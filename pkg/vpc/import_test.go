@@ -0,0 +1,124 @@
+package vpc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Importing VPC from control plane", func() {
+	var (
+		spec      *api.ClusterConfig
+		provider  *mockprovider.MockProvider
+		vpcConfig *awseks.VpcConfigResponse
+	)
+
+	newSubnet := func(id, az, cidr string) *ec2.Subnet {
+		return &ec2.Subnet{
+			SubnetId:         aws.String(id),
+			AvailabilityZone: aws.String(az),
+			CidrBlock:        aws.String(cidr),
+			VpcId:            aws.String("vpc-1"),
+		}
+	}
+
+	subnets := map[string]*ec2.Subnet{
+		"subnet-private-a": newSubnet("subnet-private-a", "us-west-2a", "10.0.0.0/19"),
+		"subnet-private-b": newSubnet("subnet-private-b", "us-west-2b", "10.0.32.0/19"),
+		"subnet-public-a":  newSubnet("subnet-public-a", "us-west-2a", "10.0.64.0/19"),
+	}
+
+	BeforeEach(func() {
+		spec = api.NewClusterConfig()
+		spec.Metadata.Name = "registered-cluster"
+
+		vpcConfig = &awseks.VpcConfigResponse{
+			VpcId:                 aws.String("vpc-1"),
+			SubnetIds:             aws.StringSlice([]string{"subnet-private-a", "subnet-private-b", "subnet-public-a"}),
+			SecurityGroupIds:      aws.StringSlice([]string{"sg-control-plane"}),
+			EndpointPublicAccess:  aws.Bool(true),
+			EndpointPrivateAccess: aws.Bool(false),
+		}
+
+		provider = mockprovider.NewMockProvider()
+
+		provider.MockEC2().On("DescribeVpcsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeVpcsOutput, bool) bool)
+			consume(&ec2.DescribeVpcsOutput{
+				Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("10.0.0.0/16")}},
+			}, true)
+		}).Return(nil)
+
+		provider.MockEC2().On("DescribeSubnetsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			input := args[0].(*ec2.DescribeSubnetsInput)
+			output := &ec2.DescribeSubnetsOutput{}
+			for _, id := range input.SubnetIds {
+				output.Subnets = append(output.Subnets, subnets[*id])
+			}
+			consume := args[1].(func(*ec2.DescribeSubnetsOutput, bool) bool)
+			consume(output, true)
+		}).Return(nil)
+
+		provider.MockEC2().On("DescribeRouteTablesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeRouteTablesOutput, bool) bool)
+			consume(&ec2.DescribeRouteTablesOutput{RouteTables: []*ec2.RouteTable{
+				{
+					RouteTableId: aws.String("rtb-private"),
+					Associations: []*ec2.RouteTableAssociation{{Main: aws.Bool(true)}},
+					Routes:       []*ec2.Route{{NatGatewayId: aws.String("nat-1")}},
+				},
+				{
+					RouteTableId: aws.String("rtb-public"),
+					Associations: []*ec2.RouteTableAssociation{{Main: aws.Bool(false), SubnetId: aws.String("subnet-public-a")}},
+					Routes:       []*ec2.Route{{GatewayId: aws.String("igw-1")}},
+				},
+			}}, true)
+		}).Return(nil)
+	})
+
+	It("should import the VPC, subnets and security group of the control plane", func() {
+		Expect(ImportFromControlPlane(provider, spec, vpcConfig)).To(Succeed())
+
+		Expect(spec.VPC.ID).To(Equal("vpc-1"))
+		Expect(spec.VPC.CIDR.String()).To(Equal("10.0.0.0/16"))
+		Expect(spec.VPC.SecurityGroup).To(Equal("sg-control-plane"))
+		Expect(*spec.VPC.NAT.Gateway).To(Equal(api.ClusterDisableNAT))
+		Expect(*spec.VPC.ClusterEndpoints.PublicAccess).To(BeTrue())
+		Expect(*spec.VPC.ClusterEndpoints.PrivateAccess).To(BeFalse())
+
+		Expect(spec.PrivateSubnetIDs()).To(ConsistOf("subnet-private-a", "subnet-private-b"))
+		Expect(spec.PublicSubnetIDs()).To(ConsistOf("subnet-public-a"))
+		Expect(spec.AvailabilityZones).To(ConsistOf("us-west-2a", "us-west-2b"))
+	})
+
+	It("should keep the security group that is set in the config", func() {
+		spec.VPC.SecurityGroup = "sg-other"
+		Expect(ImportFromControlPlane(provider, spec, vpcConfig)).To(Succeed())
+		Expect(spec.VPC.SecurityGroup).To(Equal("sg-other"))
+	})
+
+	It("should fall back to the cluster security group when the control plane has no additional security groups", func() {
+		vpcConfig.SecurityGroupIds = nil
+		vpcConfig.ClusterSecurityGroupId = aws.String("sg-cluster")
+		Expect(ImportFromControlPlane(provider, spec, vpcConfig)).To(Succeed())
+		Expect(spec.VPC.SecurityGroup).To(Equal("sg-cluster"))
+	})
+
+	It("should prefer the additional security groups of the control plane", func() {
+		vpcConfig.ClusterSecurityGroupId = aws.String("sg-cluster")
+		Expect(ImportFromControlPlane(provider, spec, vpcConfig)).To(Succeed())
+		Expect(spec.VPC.SecurityGroup).To(Equal("sg-control-plane"))
+	})
+
+	It("should fail when the control plane has no security groups", func() {
+		vpcConfig.SecurityGroupIds = nil
+		Expect(ImportFromControlPlane(provider, spec, vpcConfig)).To(MatchError(ContainSubstring("has no security groups")))
+	})
+})
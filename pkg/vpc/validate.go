@@ -0,0 +1,199 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	publicELBRoleTag   = "kubernetes.io/role/elb"
+	internalELBRoleTag = "kubernetes.io/role/internal-elb"
+)
+
+// ValidateExistingVPC checks that the subnets and security groups of an existing VPC
+// given by the user can be used for the cluster, so that a misconfiguration is reported
+// before any stacks get created, rather than resulting in a broken cluster
+func ValidateExistingVPC(provider api.ClusterProvider, spec *api.ClusterConfig) error {
+	if err := validateNodeGroupZones(spec); err != nil {
+		return err
+	}
+
+	routeTables, err := describeRouteTables(provider, spec.VPC.ID)
+	if err != nil {
+		return err
+	}
+
+	for topology, subnetIDs := range map[api.SubnetTopology][]string{
+		api.SubnetTopologyPrivate: spec.PrivateSubnetIDs(),
+		api.SubnetTopologyPublic:  spec.PublicSubnetIDs(),
+	} {
+		if len(subnetIDs) == 0 {
+			continue
+		}
+		subnets, err := describeSubnets(provider, subnetIDs...)
+		if err != nil {
+			return err
+		}
+		for _, subnet := range subnets {
+			rt := routeTableForSubnet(routeTables, *subnet.SubnetId)
+			if rt == nil {
+				return fmt.Errorf("unable to determine route table of subnet %q", *subnet.SubnetId)
+			}
+			if err := validateSubnetRouting(topology, subnet, rt, spec.IsFullyPrivate()); err != nil {
+				return err
+			}
+			checkSubnetRoleTags(topology, subnet)
+		}
+	}
+
+	return validateSecurityGroups(provider, spec)
+}
+
+// validateNodeGroupZones ensures there is a subnet of the right type in
+// every availability zone that a nodegroup is pinned to
+func validateNodeGroupZones(spec *api.ClusterConfig) error {
+	check := func(path string, zones []string, privateNetworking bool) error {
+		topology, subnets := api.SubnetTopologyPublic, spec.VPC.Subnets.Public
+		if privateNetworking {
+			topology, subnets = api.SubnetTopologyPrivate, spec.VPC.Subnets.Private
+		}
+		for _, zone := range zones {
+			if _, ok := subnets[zone]; !ok {
+				return fmt.Errorf("%s.availabilityZones includes %q, but there is no %s subnet in that zone", path, zone, strings.ToLower(string(topology)))
+			}
+		}
+		return nil
+	}
+
+	for i, ng := range spec.NodeGroups {
+		if err := check(fmt.Sprintf("nodeGroups[%d]", i), ng.AvailabilityZones, ng.PrivateNetworking); err != nil {
+			return err
+		}
+	}
+	for i, ng := range spec.ManagedNodeGroups {
+		if err := check(fmt.Sprintf("managedNodeGroups[%d]", i), ng.AvailabilityZones, ng.PrivateNetworking); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSubnetRouting makes sure that public subnets are routed via an internet gateway,
+// and private subnets are not, as otherwise nodes won't be able to reach the control plane
+func validateSubnetRouting(topology api.SubnetTopology, subnet *ec2.Subnet, rt *ec2.RouteTable, fullyPrivate bool) error {
+	viaInternetGateway := routesViaInternetGateway(rt)
+	var viaNATGateway bool
+	for _, route := range rt.Routes {
+		if route.NatGatewayId != nil || route.InstanceId != nil || route.TransitGatewayId != nil {
+			viaNATGateway = true
+		}
+	}
+
+	switch topology {
+	case api.SubnetTopologyPublic:
+		if !viaInternetGateway {
+			return fmt.Errorf("subnet %q was given as public, but its route table %q has no route to an internet gateway; "+
+				"use it as a private subnet instead, or add a route to an internet gateway", *subnet.SubnetId, *rt.RouteTableId)
+		}
+	case api.SubnetTopologyPrivate:
+		if viaInternetGateway {
+			return fmt.Errorf("subnet %q was given as private, but its route table %q has a route to an internet gateway; "+
+				"use it as a public subnet instead, or route outbound traffic via a NAT gateway", *subnet.SubnetId, *rt.RouteTableId)
+		}
+		if !viaNATGateway && !fullyPrivate {
+			logger.Warning("private subnet %q has no route to a NAT gateway, nodes in it won't be able to access the internet unless VPC endpoints are configured", *subnet.SubnetId)
+		}
+	}
+	return nil
+}
+
+// routesViaInternetGateway returns true when the route table has a route to an internet gateway
+func routesViaInternetGateway(rt *ec2.RouteTable) bool {
+	for _, route := range rt.Routes {
+		if route.GatewayId != nil && strings.HasPrefix(*route.GatewayId, "igw-") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSubnetRoleTags warns about subnets that Kubernetes won't be able to
+// discover when creating load balancers
+func checkSubnetRoleTags(topology api.SubnetTopology, subnet *ec2.Subnet) {
+	roleTag := internalELBRoleTag
+	if topology == api.SubnetTopologyPublic {
+		roleTag = publicELBRoleTag
+	}
+	for _, tag := range subnet.Tags {
+		if tag.Key != nil && *tag.Key == roleTag {
+			return
+		}
+	}
+	logger.Warning("%s subnet %q is missing the %q tag, Kubernetes may not be able to create load balancers in it", strings.ToLower(string(topology)), *subnet.SubnetId, roleTag)
+}
+
+// validateSecurityGroups ensures all of the security groups given by the user exist in the VPC
+func validateSecurityGroups(provider api.ClusterProvider, spec *api.ClusterConfig) error {
+	paths := map[string]string{}
+	if spec.VPC.SecurityGroup != "" {
+		paths[spec.VPC.SecurityGroup] = "vpc.securityGroup"
+	}
+	if spec.VPC.SharedNodeSecurityGroup != "" {
+		paths[spec.VPC.SharedNodeSecurityGroup] = "vpc.sharedNodeSecurityGroup"
+	}
+	for i, id := range spec.VPC.ControlPlaneSecurityGroupIDs {
+		paths[id] = fmt.Sprintf("vpc.controlPlaneSecurityGroupIDs[%d]", i)
+	}
+	for i, ng := range spec.NodeGroups {
+		if ng.SecurityGroups == nil {
+			continue
+		}
+		for j, id := range ng.SecurityGroups.AttachIDs {
+			paths[id] = fmt.Sprintf("nodeGroups[%d].securityGroups.attachIDs[%d]", i, j)
+		}
+	}
+	for i, ng := range spec.ManagedNodeGroups {
+		if ng.SecurityGroups == nil {
+			continue
+		}
+		for j, id := range ng.SecurityGroups.AttachIDs {
+			paths[id] = fmt.Sprintf("managedNodeGroups[%d].securityGroups.attachIDs[%d]", i, j)
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	input := &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{spec.VPC.ID}),
+			},
+		},
+	}
+	found := map[string]bool{}
+	err := provider.EC2().DescribeSecurityGroupsPages(input, func(output *ec2.DescribeSecurityGroupsOutput, _ bool) bool {
+		for _, sg := range output.SecurityGroups {
+			found[*sg.GroupId] = true
+		}
+		return true
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing security groups of VPC %q", spec.VPC.ID)
+	}
+
+	for id, path := range paths {
+		if !found[id] {
+			return fmt.Errorf("security group %q (%s) doesn't exist in VPC %q", id, path, spec.VPC.ID)
+		}
+	}
+	return nil
+}
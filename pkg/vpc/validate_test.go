@@ -0,0 +1,119 @@
+package vpc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Existing VPC validation", func() {
+	var (
+		spec        *api.ClusterConfig
+		provider    *mockprovider.MockProvider
+		routeTables []*ec2.RouteTable
+	)
+
+	newSubnet := func(id, az, roleTag string) *ec2.Subnet {
+		return &ec2.Subnet{
+			SubnetId:         aws.String(id),
+			AvailabilityZone: aws.String(az),
+			VpcId:            aws.String("vpc-1"),
+			Tags:             []*ec2.Tag{{Key: aws.String(roleTag), Value: aws.String("1")}},
+		}
+	}
+
+	subnets := map[string]*ec2.Subnet{
+		"subnet-private-a": newSubnet("subnet-private-a", "us-west-2a", internalELBRoleTag),
+		"subnet-private-b": newSubnet("subnet-private-b", "us-west-2b", internalELBRoleTag),
+		"subnet-public-a":  newSubnet("subnet-public-a", "us-west-2a", publicELBRoleTag),
+		"subnet-public-b":  newSubnet("subnet-public-b", "us-west-2b", publicELBRoleTag),
+	}
+
+	BeforeEach(func() {
+		spec = api.NewClusterConfig()
+		spec.VPC.ID = "vpc-1"
+		Expect(spec.ImportSubnet(api.SubnetTopologyPrivate, "us-west-2a", "subnet-private-a", "192.168.0.0/19")).To(Succeed())
+		Expect(spec.ImportSubnet(api.SubnetTopologyPrivate, "us-west-2b", "subnet-private-b", "192.168.32.0/19")).To(Succeed())
+		Expect(spec.ImportSubnet(api.SubnetTopologyPublic, "us-west-2a", "subnet-public-a", "192.168.64.0/19")).To(Succeed())
+		Expect(spec.ImportSubnet(api.SubnetTopologyPublic, "us-west-2b", "subnet-public-b", "192.168.96.0/19")).To(Succeed())
+
+		routeTables = []*ec2.RouteTable{
+			{
+				RouteTableId: aws.String("rtb-private"),
+				Associations: []*ec2.RouteTableAssociation{{Main: aws.Bool(true)}},
+				Routes:       []*ec2.Route{{NatGatewayId: aws.String("nat-1")}},
+			},
+			{
+				RouteTableId: aws.String("rtb-public"),
+				Associations: []*ec2.RouteTableAssociation{
+					{Main: aws.Bool(false), SubnetId: aws.String("subnet-public-a")},
+					{Main: aws.Bool(false), SubnetId: aws.String("subnet-public-b")},
+				},
+				Routes: []*ec2.Route{{GatewayId: aws.String("igw-1")}},
+			},
+		}
+
+		provider = mockprovider.NewMockProvider()
+
+		provider.MockEC2().On("DescribeRouteTablesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeRouteTablesOutput, bool) bool)
+			consume(&ec2.DescribeRouteTablesOutput{RouteTables: routeTables}, true)
+		}).Return(nil)
+
+		provider.MockEC2().On("DescribeSubnetsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			input := args[0].(*ec2.DescribeSubnetsInput)
+			output := &ec2.DescribeSubnetsOutput{}
+			for _, id := range input.SubnetIds {
+				output.Subnets = append(output.Subnets, subnets[*id])
+			}
+			consume := args[1].(func(*ec2.DescribeSubnetsOutput, bool) bool)
+			consume(output, true)
+		}).Return(nil)
+
+		provider.MockEC2().On("DescribeSecurityGroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeSecurityGroupsOutput, bool) bool)
+			consume(&ec2.DescribeSecurityGroupsOutput{
+				SecurityGroups: []*ec2.SecurityGroup{{GroupId: aws.String("sg-1")}},
+			}, true)
+		}).Return(nil)
+	})
+
+	It("should accept correctly configured subnets and security groups", func() {
+		spec.VPC.ControlPlaneSecurityGroupIDs = []string{"sg-1"}
+		Expect(ValidateExistingVPC(provider, spec)).To(Succeed())
+	})
+
+	It("should reject public subnets without a route to an internet gateway", func() {
+		routeTables[1].Routes = []*ec2.Route{{NatGatewayId: aws.String("nat-1")}}
+		err := ValidateExistingVPC(provider, spec)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("was given as public"))
+	})
+
+	It("should reject private subnets with a route to an internet gateway", func() {
+		routeTables[0].Routes = []*ec2.Route{{GatewayId: aws.String("igw-1")}}
+		err := ValidateExistingVPC(provider, spec)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("was given as private"))
+	})
+
+	It("should reject nodegroups in zones without subnets", func() {
+		ng := spec.NewNodeGroup()
+		ng.PrivateNetworking = true
+		ng.AvailabilityZones = []string{"us-west-2a", "us-west-2c"}
+		err := ValidateExistingVPC(provider, spec)
+		Expect(err).To(MatchError(`nodeGroups[0].availabilityZones includes "us-west-2c", but there is no private subnet in that zone`))
+	})
+
+	It("should reject security groups that are not in the VPC", func() {
+		spec.VPC.ControlPlaneSecurityGroupIDs = []string{"sg-2"}
+		err := ValidateExistingVPC(provider, spec)
+		Expect(err).To(MatchError(`security group "sg-2" (vpc.controlPlaneSecurityGroupIDs[0]) doesn't exist in VPC "vpc-1"`))
+	})
+})
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 
 	"github.com/aws/aws-sdk-go/aws"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -13,6 +13,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 
 	"k8s.io/kops/pkg/util/subnet"
@@ -54,12 +55,42 @@ func SetSubnets(spec *api.ClusterConfig) error {
 		vpc.Subnets.Private[zone] = api.Network{
 			CIDR: &ipnet.IPNet{IPNet: *private},
 		}
+		if spec.IsFullyPrivate() {
+			// a fully-private cluster has no internet access, so public subnets are not needed
+			logger.Info("subnets for %s - private:%s", zone, private.String())
+			continue
+		}
 		vpc.Subnets.Public[zone] = api.Network{
 			CIDR: &ipnet.IPNet{IPNet: *public},
 		}
 		logger.Info("subnets for %s - public:%s private:%s", zone, public.String(), private.String())
 	}
 
+	if spec.HasCustomNetworking() && spec.VPC.CustomNetworking.SecondaryCIDR != nil {
+		return setPodSubnets(spec)
+	}
+	return nil
+}
+
+// setPodSubnets divides the secondary CIDR into pod subnets, one for each availability zone
+func setPodSubnets(spec *api.ClusterConfig) error {
+	cn := spec.VPC.CustomNetworking
+	cn.PodSubnets = map[string]api.Network{}
+
+	podCIDRs, err := subnet.SplitInto8(&cn.SecondaryCIDR.IPNet)
+	if err != nil {
+		return err
+	}
+	if len(spec.AvailabilityZones) > len(podCIDRs) {
+		return fmt.Errorf("insufficient number of pod subnets (have %d, but need %d)", len(podCIDRs), len(spec.AvailabilityZones))
+	}
+
+	for i, zone := range spec.AvailabilityZones {
+		cn.PodSubnets[zone] = api.Network{
+			CIDR: &ipnet.IPNet{IPNet: *podCIDRs[i]},
+		}
+		logger.Info("pod subnet for %s - %s", zone, podCIDRs[i].String())
+	}
 	return nil
 }
 
@@ -67,22 +98,90 @@ func describeSubnets(provider api.ClusterProvider, subnetIDs ...string) ([]*ec2.
 	input := &ec2.DescribeSubnetsInput{
 		SubnetIds: aws.StringSlice(subnetIDs),
 	}
-	output, err := provider.EC2().DescribeSubnets(input)
-	if err != nil {
+	subnets := []*ec2.Subnet{}
+	if err := provider.EC2().DescribeSubnetsPages(input, func(p *ec2.DescribeSubnetsOutput, _ bool) bool {
+		subnets = append(subnets, p.Subnets...)
+		return true
+	}); err != nil {
 		return nil, err
 	}
-	return output.Subnets, nil
+	return subnets, nil
 }
 
 func describe(provider api.ClusterProvider, vpcID string) (*ec2.Vpc, error) {
 	input := &ec2.DescribeVpcsInput{
 		VpcIds: []*string{aws.String(vpcID)},
 	}
-	output, err := provider.EC2().DescribeVpcs(input)
+	vpcs := []*ec2.Vpc{}
+	if err := provider.EC2().DescribeVpcsPages(input, func(p *ec2.DescribeVpcsOutput, _ bool) bool {
+		vpcs = append(vpcs, p.Vpcs...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	if len(vpcs) == 0 {
+		return nil, fmt.Errorf("VPC %q not found", vpcID)
+	}
+	return vpcs[0], nil
+}
+
+func describeRouteTables(provider api.ClusterProvider, vpcID string) ([]*ec2.RouteTable, error) {
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{vpcID}),
+			},
+		},
+	}
+	routeTables := []*ec2.RouteTable{}
+	if err := provider.EC2().DescribeRouteTablesPages(input, func(p *ec2.DescribeRouteTablesOutput, _ bool) bool {
+		routeTables = append(routeTables, p.RouteTables...)
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "describing route tables of VPC %q", vpcID)
+	}
+	return routeTables, nil
+}
+
+// routeTableForSubnet returns the route table associated with the given subnet,
+// or the main route table of the VPC when there is no explicit association
+func routeTableForSubnet(routeTables []*ec2.RouteTable, subnetID string) *ec2.RouteTable {
+	var mainRouteTable *ec2.RouteTable
+	for _, rt := range routeTables {
+		for _, association := range rt.Associations {
+			if association.SubnetId != nil && *association.SubnetId == subnetID {
+				return rt
+			}
+			if association.Main != nil && *association.Main {
+				mainRouteTable = rt
+			}
+		}
+	}
+	return mainRouteTable
+}
+
+// GetRouteTableIDs returns the IDs of the route tables used by the given subnets,
+// subnets without an explicit association use the main route table of the VPC
+func GetRouteTableIDs(provider api.ClusterProvider, vpcID string, subnetIDs []string) ([]string, error) {
+	routeTables, err := describeRouteTables(provider, vpcID)
 	if err != nil {
 		return nil, err
 	}
-	return output.Vpcs[0], nil
+
+	routeTableIDs := []string{}
+	seen := map[string]bool{}
+	for _, subnetID := range subnetIDs {
+		rt := routeTableForSubnet(routeTables, subnetID)
+		if rt == nil {
+			return nil, fmt.Errorf("unable to determine route table of subnet %q", subnetID)
+		}
+		if !seen[*rt.RouteTableId] {
+			seen[*rt.RouteTableId] = true
+			routeTableIDs = append(routeTableIDs, *rt.RouteTableId)
+		}
+	}
+	return routeTableIDs, nil
 }
 
 // UseFromCluster retrieves the VPC configuration from an existing cluster
@@ -125,6 +224,9 @@ func UseFromCluster(provider api.ClusterProvider, stack *cfn.Stack, spec *api.Cl
 		outputs.ClusterSubnetsPublic: func(v string) error {
 			return ImportSubnetsFromList(provider, spec, api.SubnetTopologyPublic, strings.Split(v, ","))
 		},
+		outputs.ClusterSubnetsPod: func(v string) error {
+			return ImportPodSubnetsFromList(provider, spec, strings.Split(v, ","))
+		},
 	}
 
 	if !outputs.Exists(*stack, outputs.ClusterSubnetsPublic) &&
@@ -196,6 +298,10 @@ func ImportSubnets(provider api.ClusterProvider, spec *api.ClusterConfig, topolo
 			return fmt.Errorf("given %s is in %s, not in %s", *subnet.SubnetId, *subnet.VpcId, spec.VPC.ID)
 		}
 
+		if api.IsEdgeZone(spec.Metadata.Region, *subnet.AvailabilityZone) {
+			return fmt.Errorf("given %s is in %s, a Local Zone or Wavelength Zone, which cannot be used by the control plane, use nodeGroups[*].subnets to launch nodes in it", *subnet.SubnetId, *subnet.AvailabilityZone)
+		}
+
 		if err := spec.ImportSubnet(topology, *subnet.AvailabilityZone, *subnet.SubnetId, *subnet.CidrBlock); err != nil {
 			return err
 		}
@@ -236,10 +342,90 @@ func ImportAllSubnets(provider api.ClusterProvider, spec *api.ClusterConfig) err
 	if err := ImportSubnetsFromList(provider, spec, api.SubnetTopologyPublic, spec.PublicSubnetIDs()); err != nil {
 		return err
 	}
+	if err := ImportPodSubnetsFromList(provider, spec, spec.PodSubnetIDs()); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// ImportPodSubnetsFromList will update spec with pod subnets used for custom networking,
+// all subnets must be in the same VPC as the cluster
+func ImportPodSubnetsFromList(provider api.ClusterProvider, spec *api.ClusterConfig, subnetIDs []string) error {
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+	subnets, err := describeSubnets(provider, subnetIDs...)
+	if err != nil {
+		return err
+	}
+	if spec.VPC.CustomNetworking == nil {
+		spec.VPC.CustomNetworking = &api.ClusterCustomNetworking{}
+	}
+	for _, subnet := range subnets {
+		if spec.VPC.ID != *subnet.VpcId {
+			return fmt.Errorf("given pod subnet %s is in %s, not in %s", *subnet.SubnetId, *subnet.VpcId, spec.VPC.ID)
+		}
+		if err := spec.ImportPodSubnet(*subnet.AvailabilityZone, *subnet.SubnetId, *subnet.CidrBlock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportFromControlPlane will update spec with the VPC, subnets and security group of
+// a control plane that was not created by eksctl; subnets with a route to an internet
+// gateway are imported as public subnets, all other subnets as private subnets; when
+// the control plane has no additional security groups, the cluster security group that
+// EKS created is used instead
+func ImportFromControlPlane(provider api.ClusterProvider, spec *api.ClusterConfig, vpcConfig *awseks.VpcConfigResponse) error {
+	if spec.VPC == nil {
+		spec.VPC = api.NewClusterVPC()
+	}
+	// the CIDR can only be set due to defaulting, the VPC of the cluster is authoritative
+	spec.VPC.CIDR = nil
+	spec.VPC.ID = *vpcConfig.VpcId
+
+	if spec.VPC.SecurityGroup == "" {
+		switch {
+		case len(vpcConfig.SecurityGroupIds) > 0:
+			spec.VPC.SecurityGroup = *vpcConfig.SecurityGroupIds[0]
+		case aws.StringValue(vpcConfig.ClusterSecurityGroupId) != "":
+			spec.VPC.SecurityGroup = *vpcConfig.ClusterSecurityGroupId
+		default:
+			return fmt.Errorf("control plane of cluster %q has no security groups, set vpc.securityGroup to the security group that nodes should allow traffic from", spec.Metadata.Name)
+		}
+	}
+
+	spec.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+		PublicAccess:  vpcConfig.EndpointPublicAccess,
+		PrivateAccess: vpcConfig.EndpointPrivateAccess,
+	}
+
+	subnets, err := describeSubnets(provider, aws.StringValueSlice(vpcConfig.SubnetIds)...)
+	if err != nil {
+		return errors.Wrapf(err, "describing subnets of cluster %q", spec.Metadata.Name)
+	}
+	routeTables, err := describeRouteTables(provider, spec.VPC.ID)
+	if err != nil {
+		return err
+	}
+
+	var publicSubnets, privateSubnets []*ec2.Subnet
+	for _, subnet := range subnets {
+		if rt := routeTableForSubnet(routeTables, *subnet.SubnetId); rt != nil && routesViaInternetGateway(rt) {
+			publicSubnets = append(publicSubnets, subnet)
+		} else {
+			privateSubnets = append(privateSubnets, subnet)
+		}
+	}
+
+	if err := ImportSubnets(provider, spec, api.SubnetTopologyPrivate, privateSubnets); err != nil {
+		return err
+	}
+	return ImportSubnets(provider, spec, api.SubnetTopologyPublic, publicSubnets)
+}
+
 //UseEndpointAccessFromCluster retrieves the Cluster's endpoint access configuration via the SDK
 // as the CloudFormation Stack doesn't support that configuration currently
 func UseEndpointAccessFromCluster(provider api.ClusterProvider, spec *api.ClusterConfig) error {